@@ -12,14 +12,30 @@ import (
 
 	"github.com/lever-dev/padel-backend/internal/config"
 	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/payments"
 	courtRepo "github.com/lever-dev/padel-backend/internal/repositories/courts"
+	"github.com/lever-dev/padel-backend/internal/repositories/locker"
 	organizationRepo "github.com/lever-dev/padel-backend/internal/repositories/organization"
+	"github.com/lever-dev/padel-backend/internal/repositories/otp"
+	paymentsRepo "github.com/lever-dev/padel-backend/internal/repositories/payments"
+	policiesRepo "github.com/lever-dev/padel-backend/internal/repositories/policies"
+	pricingRepo "github.com/lever-dev/padel-backend/internal/repositories/pricing"
 	reservationRepo "github.com/lever-dev/padel-backend/internal/repositories/reservation"
+	"github.com/lever-dev/padel-backend/internal/repositories/sessions"
 	"github.com/lever-dev/padel-backend/internal/repositories/users"
 	"github.com/lever-dev/padel-backend/internal/services/auth"
+	"github.com/lever-dev/padel-backend/internal/services/blackout"
 	"github.com/lever-dev/padel-backend/internal/services/court"
+	"github.com/lever-dev/padel-backend/internal/services/match"
 	"github.com/lever-dev/padel-backend/internal/services/organization"
+	"github.com/lever-dev/padel-backend/internal/services/payment"
+	"github.com/lever-dev/padel-backend/internal/services/policy"
+	"github.com/lever-dev/padel-backend/internal/services/pricing"
+	"github.com/lever-dev/padel-backend/internal/services/rating"
 	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/roster"
+	"github.com/lever-dev/padel-backend/internal/sms"
+	"github.com/lever-dev/padel-backend/pkg/clock"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -49,6 +65,16 @@ var serveCmd = &cobra.Command{
 		if err := usersRepo.Connect(ctx); err != nil {
 			log.Fatal().Err(err).Msg("failed to connect to postgres")
 		}
+		otpRepo := otp.NewRepository(cfg.Postgres.ConnectionURL)
+		if err := otpRepo.Connect(ctx); err != nil {
+			log.Fatal().Err(err).Msg("failed to connect to postgres")
+		}
+
+		sessionsRepo := sessions.NewRepository(cfg.Postgres.ConnectionURL)
+		if err := sessionsRepo.Connect(ctx); err != nil {
+			log.Fatal().Err(err).Msg("failed to connect to postgres")
+		}
+
 		courtRepo := courtRepo.NewRepository(cfg.Postgres.ConnectionURL)
 		if err := courtRepo.Connect(ctx); err != nil {
 			log.Fatal().Err(err).Msg("failed to connect to postgres")
@@ -59,18 +85,143 @@ var serveCmd = &cobra.Command{
 			log.Fatal().Err(err).Msg("failed to connect to postgres")
 		}
 
-		reservationService := reservation.NewService(reservationRepo, reservation.NewLocalLocker())
-		courtService := court.NewService(courtRepo)
-		authService := auth.NewService(usersRepo)
-		organizationService := organization.NewService(organizationRepo)
+		pricingRepo := pricingRepo.NewRepository(cfg.Postgres.ConnectionURL)
+		if err := pricingRepo.Connect(ctx); err != nil {
+			log.Fatal().Err(err).Msg("failed to connect to postgres")
+		}
 
-		organizationHandler := httpPkg.NewOrganizationHandler(organizationService)
-		reservationHandler := httpPkg.NewReservationHandler(reservationService)
-		courtHandler := httpPkg.NewCourtHandler(courtService)
-		authHandler := httpPkg.NewAuthHandler(authService)
-		authMiddleware := httpPkg.NewAuthMiddleware(authService)
+		policiesRepo := policiesRepo.NewRepository(cfg.Postgres.ConnectionURL)
+		if err := policiesRepo.Connect(ctx); err != nil {
+			log.Fatal().Err(err).Msg("failed to connect to postgres")
+		}
+
+		paymentsRepo := paymentsRepo.NewRepository(cfg.Postgres.ConnectionURL)
+		if err := paymentsRepo.Connect(ctx); err != nil {
+			log.Fatal().Err(err).Msg("failed to connect to postgres")
+		}
 
-		router := httpPkg.NewRouter(reservationHandler, organizationHandler, courtHandler, authHandler, authMiddleware)
+		var courtLocker reservation.Locker
+
+		switch cfg.Reservation.Locker {
+		case config.LocalLocker:
+			courtLocker = reservation.NewLocalLocker()
+		case config.PostgresLocker:
+			pgLocker := locker.NewLocker(cfg.Postgres.ConnectionURL, cfg.Reservation.LockTimeout)
+			if err := pgLocker.Connect(ctx); err != nil {
+				log.Fatal().Err(err).Msg("failed to connect to postgres")
+			}
+			defer pgLocker.Close()
+
+			courtLocker = pgLocker
+		default:
+			log.Fatal().Str("locker", cfg.Reservation.Locker).Msg("unknown reservation locker")
+		}
+
+		var paymentProvider payment.PaymentProvider
+
+		switch cfg.Payments.Provider {
+		case config.FakePaymentProvider:
+			paymentProvider = payments.NewFakeProvider(cfg.Payments.WebhookSecret)
+		default:
+			log.Fatal().Str("provider", cfg.Payments.Provider).Msg("unknown payment provider")
+		}
+
+		courtService := court.NewService(courtRepo, organizationRepo, clock.Real{})
+		pricingService := pricing.NewService(pricingRepo, courtRepo, courtService)
+		policyService := policy.NewService(policiesRepo, courtRepo)
+		rosterService := roster.NewService(reservationRepo, courtRepo, usersRepo, clock.Real{})
+		paymentService := payment.NewService(
+			paymentsRepo,
+			reservationRepo,
+			courtRepo,
+			rosterService,
+			paymentProvider,
+			clock.Real{},
+		)
+		matchService := match.NewService(reservationRepo, courtRepo, organizationRepo, usersRepo, clock.Real{})
+		ratingService := rating.NewService(reservationRepo, courtRepo, usersRepo, clock.Real{})
+		reservationService := reservation.NewService(
+			reservation.Dependencies{
+				Reservations: reservationRepo,
+				Courts:       courtRepo,
+				Pricer:       pricingService,
+				Policies:     policyService,
+				Scheduler:    courtService,
+				Refunder:     paymentService,
+				Locker:       courtLocker,
+				Clock:        clock.Real{},
+			},
+			cfg.Reservation.HoldTTL,
+		)
+		keyConfigs := make([]auth.KeyConfig, 0, len(cfg.Auth.Signing.Keys))
+		for _, key := range cfg.Auth.Signing.Keys {
+			keyConfigs = append(keyConfigs, auth.KeyConfig(key))
+		}
+
+		signingKeys, err := auth.NewKeySet(cfg.Auth.Signing.ActiveKey, keyConfigs)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load signing keys")
+		}
+
+		var smsSender auth.SMSSender
+
+		switch cfg.SMS.Sender {
+		case config.LogSMSSender:
+			smsSender = sms.LogSender{}
+		case config.FileSMSSender:
+			smsSender = sms.NewFileSender(cfg.SMS.FilePath)
+		default:
+			log.Fatal().Str("sender", cfg.SMS.Sender).Msg("unknown sms sender")
+		}
+
+		authService := auth.NewService(
+			usersRepo,
+			sessionsRepo,
+			otpRepo,
+			smsSender,
+			signingKeys,
+			clock.Real{},
+			auth.Config{
+				AccessTokenTTL:  cfg.Auth.AccessTokenTTL,
+				RefreshTokenTTL: cfg.Auth.RefreshTokenTTL,
+				OTP:             auth.OTPConfig(cfg.Auth.OTP),
+			},
+		)
+		organizationService := organization.NewService(organizationRepo, usersRepo)
+		blackoutService := blackout.NewService(
+			reservationRepo,
+			reservationRepo,
+			courtRepo,
+			paymentsRepo,
+			usersRepo,
+			reservationService,
+			courtService,
+			courtLocker,
+			smsSender,
+			clock.Real{},
+		)
+
+		router := httpPkg.NewRouter(
+			httpPkg.Handlers{
+				Reservation:  httpPkg.NewReservationHandler(reservationService),
+				Organization: httpPkg.NewOrganizationHandler(organizationService),
+				Court:        httpPkg.NewCourtHandler(courtService),
+				Availability: httpPkg.NewAvailabilityHandler(reservationService),
+				Series:       httpPkg.NewSeriesHandler(reservationService),
+				Auth:         httpPkg.NewAuthHandler(authService),
+				Member:       httpPkg.NewMemberHandler(organizationService),
+				Pricing:      httpPkg.NewPricingHandler(pricingService),
+				Policy:       httpPkg.NewPolicyHandler(policyService),
+				Payment:      httpPkg.NewPaymentHandler(paymentService),
+				Roster:       httpPkg.NewRosterHandler(rosterService),
+				Match:        httpPkg.NewMatchHandler(matchService),
+				Result:       httpPkg.NewResultHandler(ratingService),
+				Waitlist:     httpPkg.NewWaitlistHandler(reservationService),
+				Blackout:     httpPkg.NewBlackoutHandler(blackoutService),
+			},
+			httpPkg.NewAuthMiddleware(authService),
+			httpPkg.NewRoleMiddleware(organizationService),
+		)
 
 		httpServer := http.Server{
 			Addr:              cfg.HTTPServerAddr,
@@ -78,6 +229,11 @@ var serveCmd = &cobra.Command{
 			ReadHeaderTimeout: 5 * time.Second,
 		}
 
+		expirerCtx, stopExpirer := context.WithCancel(ctx)
+		defer stopExpirer()
+
+		go reservation.NewExpirer(reservationService, cfg.Reservation.ExpirerInterval).Run(expirerCtx)
+
 		go func() {
 			log.Info().Msg("started http server")
 
@@ -103,10 +259,17 @@ var serveCmd = &cobra.Command{
 			log.Fatal().Err(err).Msg("failed on shutdown server")
 		}
 
+		stopExpirer()
+
 		courtRepo.Close()
 		reservationRepo.Close()
 		organizationRepo.Close()
+		pricingRepo.Close()
+		policiesRepo.Close()
+		paymentsRepo.Close()
 		usersRepo.Close()
+		sessionsRepo.Close()
+		otpRepo.Close()
 
 		log.Info().Msg("Bye Bye !")
 
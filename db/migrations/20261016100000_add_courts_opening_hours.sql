@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE courts
+    ADD COLUMN opening_hours JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD COLUMN slot_minutes INT NOT NULL DEFAULT 30 CHECK (slot_minutes > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE courts
+    DROP COLUMN IF EXISTS slot_minutes,
+    DROP COLUMN IF EXISTS opening_hours;
+-- +goose StatementEnd
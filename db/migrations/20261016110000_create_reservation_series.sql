@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reservation_series (
+    id TEXT PRIMARY KEY,
+    court_id TEXT NOT NULL,
+    frequency TEXT NOT NULL,
+    repeat_interval INT NOT NULL DEFAULT 1 CHECK (repeat_interval > 0),
+    weekdays INT[] NOT NULL DEFAULT '{}',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    start_minute INT NOT NULL,
+    duration_minutes INT NOT NULL CHECK (duration_minutes > 0),
+    reserved_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (start_date <= end_date)
+);
+
+ALTER TABLE reservations ADD COLUMN series_id TEXT NULL;
+
+CREATE INDEX idx_reservations_series_id ON reservations (series_id) WHERE series_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reservations_series_id;
+
+ALTER TABLE reservations DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS reservation_series;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reservations ADD COLUMN expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX idx_reservations_pending_expires_at ON reservations (expires_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reservations_pending_expires_at;
+
+ALTER TABLE reservations DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
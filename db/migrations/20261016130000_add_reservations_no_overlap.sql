@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Holds that ran out but were not released by the expirer yet no longer keep their slot, release them so they
+-- do not count as overlaps.
+UPDATE reservations
+SET status = 'expired'
+WHERE status = 'pending'
+    AND expires_at IS NOT NULL
+    AND expires_at <= now();
+
+-- Any other overlap is a double booking. Cancelling either side could leave a player who booked without a
+-- court, so the migration lists the overlapping pairs and stops until they have been settled by hand, rather
+-- than failing on the first one with the bare constraint error.
+DO $$
+DECLARE
+    overlaps TEXT;
+BEGIN
+    SELECT string_agg(
+        format(
+            '%s (%s) and %s (%s) on court %s from %s to %s',
+            a.id, a.status, b.id, b.status, a.court_id,
+            greatest(a.reserved_from, b.reserved_from), least(a.reserved_to, b.reserved_to)
+        ),
+        E'\n'
+        ORDER BY a.court_id, a.reserved_from, a.id, b.id
+    )
+    INTO overlaps
+    FROM reservations a
+    JOIN reservations b
+        ON b.court_id = a.court_id
+        AND b.id > a.id
+        AND tstzrange(b.reserved_from, b.reserved_to) && tstzrange(a.reserved_from, a.reserved_to)
+    WHERE a.status IN ('pending', 'reserved')
+        AND b.status IN ('pending', 'reserved');
+
+    IF overlaps IS NOT NULL THEN
+        RAISE EXCEPTION E'active reservations overlap:\n%', overlaps
+            USING HINT = 'Cancel or move one reservation of every pair, then run the migration again.';
+    END IF;
+END
+$$;
+
+-- Only pending and reserved reservations hold a slot, cancelled and expired ones may overlap freely.
+ALTER TABLE reservations ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+    court_id WITH =,
+    tstzrange(reserved_from, reserved_to) WITH &&
+) WHERE (status IN ('pending', 'reserved'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reservations DROP CONSTRAINT IF EXISTS reservations_no_overlap;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN phone_verified_at TIMESTAMPTZ NULL;
+
+CREATE TABLE IF NOT EXISTS otp_codes (
+    id TEXT PRIMARY KEY,
+    phone_number TEXT NOT NULL,
+    purpose TEXT NOT NULL CHECK (purpose IN ('verify_phone', 'login', 'reset_password')),
+    code_hash TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    consumed_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_otp_codes_phone_number_created_at ON otp_codes (phone_number, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_otp_codes_phone_number_created_at;
+
+DROP TABLE IF EXISTS otp_codes;
+
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pricing_rules (
+    organization_id TEXT NOT NULL,
+    court_id TEXT NOT NULL DEFAULT '',
+    currency TEXT NOT NULL,
+    base_hourly_rate BIGINT NOT NULL,
+    bands JSONB NOT NULL DEFAULT '[]',
+    holidays JSONB NOT NULL DEFAULT '[]',
+    holiday_hourly_rate BIGINT NOT NULL DEFAULT 0,
+    minimum_charge BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (organization_id, court_id)
+);
+
+ALTER TABLE reservations ADD COLUMN price_amount BIGINT NULL;
+ALTER TABLE reservations ADD COLUMN price_currency TEXT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reservations DROP COLUMN IF EXISTS price_currency;
+ALTER TABLE reservations DROP COLUMN IF EXISTS price_amount;
+
+DROP TABLE IF EXISTS pricing_rules;
+-- +goose StatementEnd
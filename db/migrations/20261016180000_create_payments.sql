@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payments (
+    id TEXT PRIMARY KEY,
+    reservation_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    currency TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'succeeded', 'failed', 'refunded')),
+    provider TEXT NOT NULL,
+    provider_ref TEXT NOT NULL,
+    client_secret TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_payments_provider_ref ON payments (provider, provider_ref);
+
+-- a reservation is paid at most once, failed and refunded payments do not count
+CREATE UNIQUE INDEX idx_payments_active_reservation_id ON payments (reservation_id)
+    WHERE status IN ('pending', 'succeeded');
+
+CREATE TABLE IF NOT EXISTS payment_events (
+    id BIGSERIAL PRIMARY KEY,
+    payment_id TEXT NOT NULL REFERENCES payments (id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    reason TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_payment_events_payment_id ON payment_events (payment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_events_payment_id;
+
+DROP TABLE IF EXISTS payment_events;
+
+DROP INDEX IF EXISTS idx_payments_active_reservation_id;
+DROP INDEX IF EXISTS idx_payments_provider_ref;
+
+DROP TABLE IF EXISTS payments;
+-- +goose StatementEnd
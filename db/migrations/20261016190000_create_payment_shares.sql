@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payment_shares (
+    id TEXT PRIMARY KEY,
+    reservation_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    currency TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('unpaid', 'paid', 'covered')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_payment_shares_reservation_id_user_id ON payment_shares (reservation_id, user_id);
+
+ALTER TABLE payments ADD COLUMN share_id TEXT NULL REFERENCES payment_shares (id);
+
+-- a reservation is paid at most once by its booker and each share at most once by its player
+DROP INDEX IF EXISTS idx_payments_active_reservation_id;
+
+CREATE UNIQUE INDEX idx_payments_active_reservation_id ON payments (reservation_id)
+    WHERE share_id IS NULL AND status IN ('pending', 'succeeded');
+
+CREATE UNIQUE INDEX idx_payments_active_share_id ON payments (share_id)
+    WHERE share_id IS NOT NULL AND status IN ('pending', 'succeeded');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payments_active_share_id;
+DROP INDEX IF EXISTS idx_payments_active_reservation_id;
+
+DELETE FROM payments WHERE share_id IS NOT NULL;
+
+CREATE UNIQUE INDEX idx_payments_active_reservation_id ON payments (reservation_id)
+    WHERE status IN ('pending', 'succeeded');
+
+ALTER TABLE payments DROP COLUMN IF EXISTS share_id;
+
+DROP INDEX IF EXISTS idx_payment_shares_reservation_id_user_id;
+
+DROP TABLE IF EXISTS payment_shares;
+-- +goose StatementEnd
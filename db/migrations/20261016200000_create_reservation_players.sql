@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE courts
+    ADD COLUMN max_players INT NOT NULL DEFAULT 4 CHECK (max_players > 0);
+
+CREATE TABLE IF NOT EXISTS reservation_players (
+    reservation_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    invited_by TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('invited', 'accepted', 'declined', 'left')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (reservation_id, user_id)
+);
+
+CREATE INDEX idx_reservation_players_user_id ON reservation_players (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reservation_players_user_id;
+
+DROP TABLE IF EXISTS reservation_players;
+
+ALTER TABLE courts
+    DROP COLUMN IF EXISTS max_players;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN level NUMERIC(3, 2) CHECK (level BETWEEN 0 AND 7);
+
+ALTER TABLE reservation_players
+    DROP CONSTRAINT IF EXISTS reservation_players_status_check;
+
+ALTER TABLE reservation_players
+    ADD CONSTRAINT reservation_players_status_check
+        CHECK (status IN ('invited', 'accepted', 'declined', 'left', 'requested'));
+
+CREATE TABLE IF NOT EXISTS open_matches (
+    reservation_id TEXT PRIMARY KEY,
+    min_level NUMERIC(3, 2) NOT NULL CHECK (min_level BETWEEN 0 AND 7),
+    max_level NUMERIC(3, 2) NOT NULL CHECK (max_level BETWEEN 0 AND 7),
+    missing_players INT NOT NULL CHECK (missing_players >= 0),
+    auto_accept BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (min_level <= max_level)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS open_matches;
+
+DELETE FROM reservation_players WHERE status = 'requested';
+
+ALTER TABLE reservation_players
+    DROP CONSTRAINT IF EXISTS reservation_players_status_check;
+
+ALTER TABLE reservation_players
+    ADD CONSTRAINT reservation_players_status_check
+        CHECK (status IN ('invited', 'accepted', 'declined', 'left'));
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS level;
+-- +goose StatementEnd
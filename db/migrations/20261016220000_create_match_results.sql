@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN rating NUMERIC(7, 2) NOT NULL DEFAULT 1500,
+    ADD COLUMN rated_games INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS match_results (
+    reservation_id TEXT PRIMARY KEY,
+    team_a TEXT[] NOT NULL,
+    team_b TEXT[] NOT NULL,
+    team_a_games INT[] NOT NULL,
+    team_b_games INT[] NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'confirmed', 'disputed')),
+    reported_by TEXT NOT NULL,
+    answered_by TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (cardinality(team_a_games) = cardinality(team_b_games))
+);
+
+CREATE TABLE IF NOT EXISTS rating_history (
+    user_id TEXT NOT NULL,
+    reservation_id TEXT NOT NULL,
+    rating_before NUMERIC(7, 2) NOT NULL,
+    rating_after NUMERIC(7, 2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, reservation_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rating_history_user_created_at
+    ON rating_history (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_rating_history_user_created_at;
+DROP TABLE IF EXISTS rating_history;
+DROP TABLE IF EXISTS match_results;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS rated_games,
+    DROP COLUMN IF EXISTS rating;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id TEXT PRIMARY KEY,
+    organization_id TEXT NOT NULL,
+    court_id TEXT NULL,
+    user_id TEXT NOT NULL,
+    reserved_from TIMESTAMPTZ NOT NULL,
+    reserved_to TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('waiting', 'offered')),
+    reservation_id TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (reserved_from < reserved_to)
+);
+
+CREATE INDEX idx_waitlist_entries_queue ON waitlist_entries (organization_id, status, created_at, id);
+CREATE INDEX idx_waitlist_entries_user_id ON waitlist_entries (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_waitlist_entries_user_id;
+DROP INDEX IF EXISTS idx_waitlist_entries_queue;
+DROP TABLE IF EXISTS waitlist_entries;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS cancellation_policies (
+    organization_id TEXT PRIMARY KEY,
+    tiers JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE reservations ADD COLUMN cancellation_refund_percent INT NULL
+    CHECK (cancellation_refund_percent BETWEEN 0 AND 100);
+ALTER TABLE reservations ADD COLUMN cancellation_fee BIGINT NULL;
+ALTER TABLE reservations ADD COLUMN cancellation_waived BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reservations DROP COLUMN IF EXISTS cancellation_waived;
+ALTER TABLE reservations DROP COLUMN IF EXISTS cancellation_fee;
+ALTER TABLE reservations DROP COLUMN IF EXISTS cancellation_refund_percent;
+
+DROP TABLE IF EXISTS cancellation_policies;
+-- +goose StatementEnd
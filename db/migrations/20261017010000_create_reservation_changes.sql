@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reservation_changes (
+    id TEXT PRIMARY KEY,
+    reservation_id TEXT NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    previous_court_id TEXT NOT NULL,
+    previous_from TIMESTAMPTZ NOT NULL,
+    previous_to TIMESTAMPTZ NOT NULL,
+    court_id TEXT NOT NULL,
+    reserved_from TIMESTAMPTZ NOT NULL,
+    reserved_to TIMESTAMPTZ NOT NULL,
+    changed_by TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (reserved_from < reserved_to)
+);
+
+CREATE INDEX idx_reservation_changes_reservation_id ON reservation_changes (reservation_id, changed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reservation_changes_reservation_id;
+DROP TABLE IF EXISTS reservation_changes;
+-- +goose StatementEnd
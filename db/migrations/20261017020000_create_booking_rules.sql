@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS booking_rules (
+    organization_id TEXT NOT NULL,
+    court_id TEXT NOT NULL DEFAULT '',
+    min_duration_minutes INT NOT NULL DEFAULT 0,
+    max_duration_minutes INT NOT NULL DEFAULT 0,
+    durations_minutes INT[] NOT NULL DEFAULT '{}',
+    slot_interval_minutes INT NOT NULL DEFAULT 0,
+    max_advance_minutes INT NOT NULL DEFAULT 0,
+    min_notice_minutes INT NOT NULL DEFAULT 0,
+    max_active_bookings INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (organization_id, court_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservations_reserved_by ON reservations (reserved_by, reserved_from);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reservations_reserved_by;
+
+DROP TABLE IF EXISTS booking_rules;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS court_blackouts (
+    id TEXT PRIMARY KEY,
+    organization_id TEXT NOT NULL,
+    court_id TEXT NULL,
+    blackout_from TIMESTAMPTZ NOT NULL,
+    blackout_to TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (blackout_from < blackout_to)
+);
+
+CREATE INDEX idx_court_blackouts_organization_id ON court_blackouts (organization_id, blackout_from);
+CREATE INDEX idx_court_blackouts_court_id ON court_blackouts (court_id, blackout_from);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_court_blackouts_court_id;
+DROP INDEX IF EXISTS idx_court_blackouts_organization_id;
+DROP TABLE IF EXISTS court_blackouts;
+-- +goose StatementEnd
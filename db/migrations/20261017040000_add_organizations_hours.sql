@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE organizations
+    ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN opening_hours JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD COLUMN special_hours JSONB NOT NULL DEFAULT '[]'::jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE organizations
+    DROP COLUMN IF EXISTS special_hours,
+    DROP COLUMN IF EXISTS opening_hours,
+    DROP COLUMN IF EXISTS time_zone;
+-- +goose StatementEnd
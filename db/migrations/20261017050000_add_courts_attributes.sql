@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE courts
+    ADD COLUMN environment TEXT NOT NULL DEFAULT '' CHECK (environment IN ('', 'indoor', 'outdoor')),
+    ADD COLUMN surface TEXT NOT NULL DEFAULT ''
+        CHECK (surface IN ('', 'artificial_grass', 'concrete', 'acrylic')),
+    ADD COLUMN format TEXT NOT NULL DEFAULT 'doubles' CHECK (format IN ('', 'singles', 'doubles')),
+    ADD COLUMN panoramic BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN lighting BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE courts
+    DROP COLUMN IF EXISTS lighting,
+    DROP COLUMN IF EXISTS panoramic,
+    DROP COLUMN IF EXISTS format,
+    DROP COLUMN IF EXISTS surface,
+    DROP COLUMN IF EXISTS environment;
+-- +goose StatementEnd
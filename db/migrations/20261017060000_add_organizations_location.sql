@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE organizations
+    ADD COLUMN address TEXT NOT NULL DEFAULT '',
+    ADD COLUMN latitude DOUBLE PRECISION NULL CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude DOUBLE PRECISION NULL CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT organizations_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+-- The nearby search narrows the organizations down to a band of latitudes before it computes distances.
+CREATE INDEX idx_organizations_latitude ON organizations (latitude) WHERE latitude IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organizations_latitude;
+
+ALTER TABLE organizations
+    DROP CONSTRAINT IF EXISTS organizations_coordinates_check,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS address;
+-- +goose StatementEnd
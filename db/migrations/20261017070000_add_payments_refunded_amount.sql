@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payments
+    ADD COLUMN refunded_amount BIGINT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT payments_refunded_amount_check CHECK (refunded_amount BETWEEN 0 AND amount),
+    DROP CONSTRAINT IF EXISTS payments_status_check,
+    ADD CONSTRAINT payments_status_check
+        CHECK (status IN ('pending', 'succeeded', 'failed', 'refunded', 'partially_refunded'));
+
+-- payments refunded before the amount was recorded are taken as refunded in full
+UPDATE payments SET refunded_amount = amount WHERE status = 'refunded';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE payments SET status = 'refunded' WHERE status = 'partially_refunded';
+
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS payments_status_check,
+    ADD CONSTRAINT payments_status_check CHECK (status IN ('pending', 'succeeded', 'failed', 'refunded')),
+    DROP CONSTRAINT IF EXISTS payments_refunded_amount_check,
+    DROP COLUMN IF EXISTS refunded_amount;
+-- +goose StatementEnd
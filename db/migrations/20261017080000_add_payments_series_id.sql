@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payments
+    ALTER COLUMN reservation_id DROP NOT NULL,
+    ADD COLUMN series_id TEXT NULL REFERENCES reservation_series (id),
+    ADD CONSTRAINT payments_paid_for_check CHECK ((reservation_id IS NULL) <> (series_id IS NULL));
+
+-- a series is paid at most once, for every priced occurrence together
+CREATE UNIQUE INDEX idx_payments_active_series_id ON payments (series_id)
+    WHERE series_id IS NOT NULL AND status IN ('pending', 'succeeded');
+
+-- what a series payment gave back for each cancelled occurrence, a retried refund is recorded once
+CREATE TABLE IF NOT EXISTS payment_refunds (
+    payment_id TEXT NOT NULL REFERENCES payments (id) ON DELETE CASCADE,
+    reservation_id TEXT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (payment_id, reservation_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment_refunds;
+
+DROP INDEX IF EXISTS idx_payments_active_series_id;
+
+DELETE FROM payments WHERE series_id IS NOT NULL;
+
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS payments_paid_for_check,
+    DROP COLUMN IF EXISTS series_id,
+    ALTER COLUMN reservation_id SET NOT NULL;
+-- +goose StatementEnd
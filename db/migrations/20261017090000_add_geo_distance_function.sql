@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- geo_distance is the great-circle distance between two points given in decimal degrees, by the haversine
+-- formula, in the unit of the radius. It is NULL when either point is. Being a plain SQL function, the planner
+-- inlines it into the queries that call it.
+CREATE OR REPLACE FUNCTION geo_distance(
+    lat1 DOUBLE PRECISION,
+    lng1 DOUBLE PRECISION,
+    lat2 DOUBLE PRECISION,
+    lng2 DOUBLE PRECISION,
+    radius DOUBLE PRECISION
+) RETURNS DOUBLE PRECISION
+LANGUAGE sql
+IMMUTABLE
+PARALLEL SAFE
+AS $$
+    SELECT 2 * radius * asin(least(1, sqrt(
+        power(sin(radians(lat2 - lat1) / 2), 2)
+        + cos(radians(lat1)) * cos(radians(lat2)) * power(sin(radians(lng2 - lng1) / 2), 2)
+    )))
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS geo_distance;
+-- +goose StatementEnd
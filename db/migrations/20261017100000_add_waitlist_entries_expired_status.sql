@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE waitlist_entries DROP CONSTRAINT IF EXISTS waitlist_entries_status_check;
+ALTER TABLE waitlist_entries ADD CONSTRAINT waitlist_entries_status_check
+    CHECK (status IN ('waiting', 'offered', 'expired'));
+
+CREATE INDEX idx_waitlist_entries_status_reserved_from ON waitlist_entries (status, reserved_from);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_waitlist_entries_status_reserved_from;
+DELETE FROM waitlist_entries WHERE status = 'expired';
+ALTER TABLE waitlist_entries DROP CONSTRAINT IF EXISTS waitlist_entries_status_check;
+ALTER TABLE waitlist_entries ADD CONSTRAINT waitlist_entries_status_check
+    CHECK (status IN ('waiting', 'offered'));
+-- +goose StatementEnd
@@ -15,8 +15,29 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Lists the public keys of the asymmetric signing keys, including retired keys whose tokens\nmay still be valid. Tokens carry the id of their key in the kid header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Public keys for verifying access tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.JWKSResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/auth/login": {
             "post": {
+                "description": "Opens a new session and returns an access token together with a refresh token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -42,7 +63,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.LoginResponse"
+                            "$ref": "#/definitions/internal_controllers_http.TokenResponse"
                         }
                     },
                     "400": {
@@ -63,7 +84,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/auth/register": {
+        "/v1/auth/login/otp": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -74,23 +95,23 @@ const docTemplate = `{
                 "tags": [
                     "auth"
                 ],
-                "summary": "Register a new user",
+                "summary": "Login with a code sent by SMS",
                 "parameters": [
                     {
-                        "description": "Registration payload",
-                        "name": "register",
+                        "description": "OTP login payload",
+                        "name": "login",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.RegisterUserRequest"
+                            "$ref": "#/definitions/internal_controllers_http.OTPLoginRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.RegisterUserResponse"
+                            "$ref": "#/definitions/internal_controllers_http.TokenResponse"
                         }
                     },
                     "400": {
@@ -99,45 +120,36 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/v1/organizations": {
-            "get": {
+        "/v1/auth/logout": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all organizations in a specific city",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Revokes the session of the access token, its refresh token stops working as well.",
                 "tags": [
-                    "organizations"
-                ],
-                "summary": "List organizations by city",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "City name",
-                        "name": "city",
-                        "in": "query",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "Log out of the current session",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.ListOrganizationsResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
@@ -146,38 +158,32 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 }
-            },
+            }
+        },
+        "/v1/auth/otp": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new organization in the city",
+                "description": "Sends a code for phone verification, passwordless login or password reset. The response\nis the same whether or not the number is registered.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "organizations"
+                    "auth"
                 ],
-                "summary": "Create a new organization",
+                "summary": "Send a one-time code by SMS",
                 "parameters": [
                     {
-                        "description": "Organization payload",
-                        "name": "organization",
+                        "description": "OTP request payload",
+                        "name": "otp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CreateOrganizationRequest"
+                            "$ref": "#/definitions/internal_controllers_http.RequestOTPRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CreateOrganizationResponse"
-                        }
+                    "202": {
+                        "description": "Accepted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -185,8 +191,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
@@ -197,36 +203,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/organizations/{orgID}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieves an organization by ID",
-                "produces": [
+        "/v1/auth/password/reset": {
+            "post": {
+                "description": "Sets the new password and signs out every session of the user.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "organizations"
+                    "auth"
                 ],
-                "summary": "Get an organization",
+                "summary": "Reset the password with a code sent by SMS",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
+                        "description": "Password reset payload",
+                        "name": "reset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ResetPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -234,52 +234,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Updates an existing organization",
+            }
+        },
+        "/v1/auth/phone/verify": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "organizations"
+                    "auth"
                 ],
-                "summary": "Update an organization",
+                "summary": "Verify a phone number with the code sent by SMS",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Organization payload",
-                        "name": "organization",
+                        "description": "Verification payload",
+                        "name": "verify",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.UpdateOrganizationRequest"
+                            "$ref": "#/definitions/internal_controllers_http.VerifyPhoneRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -287,8 +276,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error"
@@ -296,35 +288,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/organizations/{orgID}/courts": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/v1/auth/refresh": {
+            "post": {
+                "description": "The refresh token rotates on every use. Presenting an already used refresh token\nrevokes the whole session.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Returns all courts belonging to the specified organization",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courts"
+                    "auth"
                 ],
-                "summary": "List all courts for an organization",
+                "summary": "Exchange a refresh token for a new token pair",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
+                        "description": "Refresh payload",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.RefreshRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.ListCourtsResponse"
+                            "$ref": "#/definitions/internal_controllers_http.TokenResponse"
                         }
                     },
                     "400": {
@@ -333,18 +325,21 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
-            },
+            }
+        },
+        "/v1/auth/register": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates a new court for the specified organization",
+                "description": "Creates the user and sends a code by SMS to verify the phone number.",
                 "consumes": [
                     "application/json"
                 ],
@@ -352,24 +347,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "courts"
+                    "auth"
                 ],
-                "summary": "Create a new court",
+                "summary": "Register a new user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Court creation payload",
-                        "name": "court",
+                        "description": "Registration payload",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CreateCourtRequest"
+                            "$ref": "#/definitions/internal_controllers_http.RegisterUserRequest"
                         }
                     }
                 ],
@@ -377,7 +365,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CreateCourtResponse"
+                            "$ref": "#/definitions/internal_controllers_http.RegisterUserResponse"
                         }
                     },
                     "400": {
@@ -392,52 +380,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/organizations/{orgID}/courts/{courtID}": {
+        "/v1/auth/sessions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a single court by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courts"
-                ],
-                "summary": "Get a court by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Court ID",
-                        "name": "courtID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "List my active sessions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CourtResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                            "$ref": "#/definitions/internal_controllers_http.ListSessionsResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
@@ -446,58 +411,35 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/auth/sessions/{sessionID}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing court's information",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Signs the device of the session out.",
                 "tags": [
-                    "courts"
+                    "auth"
                 ],
-                "summary": "Update a court",
+                "summary": "Revoke one of my sessions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Court ID",
-                        "name": "courtID",
+                        "description": "Session ID",
+                        "name": "sessionID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Court update payload",
-                        "name": "court",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.UpdateCourtRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CourtResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
@@ -514,56 +456,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/organizations/{orgID}/courts/{courtID}/reservations": {
+        "/v1/courts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all reservations for a court within a time range",
+                "description": "Returns the courts matching the attribute filters at the organizations of the city, grouped by\norganization. Organizations without a matching court are left out.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reservations"
+                    "courts"
                 ],
-                "summary": "List reservations",
+                "summary": "Search courts in a city",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "query",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "indoor",
+                            "outdoor"
+                        ],
                         "type": "string",
-                        "description": "Court ID",
-                        "name": "courtID",
-                        "in": "path",
-                        "required": true
+                        "description": "Indoor or outdoor",
+                        "name": "environment",
+                        "in": "query"
                     },
                     {
+                        "enum": [
+                            "artificial_grass",
+                            "concrete",
+                            "acrylic"
+                        ],
                         "type": "string",
-                        "description": "Start time in RFC3339 format",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
+                        "description": "Court surface",
+                        "name": "surface",
+                        "in": "query"
                     },
                     {
+                        "enum": [
+                            "singles",
+                            "doubles"
+                        ],
                         "type": "string",
-                        "description": "End time in RFC3339 format",
-                        "name": "to",
-                        "in": "query",
-                        "required": true
+                        "description": "Singles or doubles",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only panoramic courts when true, only the others when false",
+                        "name": "panoramic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only lit courts when true, only the others when false",
+                        "name": "lighting",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.ListReservationsResponse"
+                            "$ref": "#/definitions/internal_controllers_http.SearchCourtsResponse"
                         }
                     },
                     "400": {
@@ -576,49 +540,54 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/matches": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a reservation for the specified organization and court.",
-                "consumes": [
+                "description": "Returns the open matches at the clubs of the city on the day which still miss players,\nordered by their start. When level is set only the matches admitting it are returned.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reservations"
+                    "matches"
                 ],
-                "summary": "Reserve a court",
+                "summary": "Search open matches",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Court ID",
-                        "name": "courtID",
-                        "in": "path",
+                        "description": "Day in YYYY-MM-DD format",
+                        "name": "date",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Reservation payload",
-                        "name": "reservation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.ReserveCourtRequest"
-                        }
+                        "type": "number",
+                        "description": "Skill level of the player, from 0 to 7",
+                        "name": "level",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.MatchListingResponse"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -626,8 +595,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
@@ -638,114 +607,72 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}": {
+        "/v1/me/games": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the reservation with the specified ID.",
+                "description": "Returns the reservations the current user booked, was invited to or accepted which did not end yet",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reservations"
-                ],
-                "summary": "Get a reservation",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Court ID",
-                        "name": "courtID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Reservation ID",
-                        "name": "reservationID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "roster"
                 ],
+                "summary": "List upcoming games",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.GameResponse"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/me/level": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cancels the reservation with the specified ID.",
+                "description": "Declares the skill level of the current user, open matches admit players by it.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "reservations"
+                    "auth"
                 ],
-                "summary": "Cancel a reservation",
+                "summary": "Set my skill level",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "orgID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Court ID",
-                        "name": "courtID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Reservation ID",
-                        "name": "reservationID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Cancellation payload",
-                        "name": "cancel",
+                        "description": "Skill level",
+                        "name": "level",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_controllers_http.CancelReservationRequest"
+                            "$ref": "#/definitions/internal_controllers_http.SetLevelRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -753,8 +680,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
                         }
@@ -764,250 +691,6952 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "internal_controllers_http.CancelReservationRequest": {
-            "type": "object",
-            "properties": {
-                "cancelledBy": {
-                    "description": "CancelledBy is the ID or name of the user performing the cancellation\nexample: \"user_123\"",
-                    "type": "string"
-                }
-            }
         },
-        "internal_controllers_http.CourtResponse": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
-                    "type": "string",
-                    "format": "date-time",
-                    "example": "2025-11-01T10:00:00Z"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "court-123"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "Court 1"
-                },
-                "organizationId": {
-                    "type": "string",
-                    "example": "org-456"
-                },
-                "updatedAt": {
-                    "type": "string",
-                    "format": "date-time",
-                    "example": "2025-11-01T10:00:00Z"
+        "/v1/me/rating": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the rating of the current user, computed from the confirmed results of their matches,\nwith the latest rating changes first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "results"
+                ],
+                "summary": "Get my rating",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.RatingResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
                 }
             }
         },
-        "internal_controllers_http.CreateCourtRequest": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "Court 1"
+        "/v1/me/waitlist": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the windows the current user waits for, the earliest first. Entries that were offered\na hold carry the reservation to confirm.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "List my waitlist entries",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.WaitlistEntryResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
                 }
             }
         },
-        "internal_controllers_http.CreateCourtResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "court-123"
-                },
-                "name": {
+        "/v1/me/waitlist/{entryID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes the current user off the waitlist. Entries that were offered a hold can not be left,\nthe hold is cancelled instead.",
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Leave the waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Waitlist entry ID",
+                        "name": "entryID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all organizations in a specific city",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List organizations by city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ListOrganizationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new organization in the city. The caller becomes its owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Create a new organization",
+                "parameters": [
+                    {
+                        "description": "Organization payload",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CreateOrganizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CreateOrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/nearby": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the organizations within the radius of the point, the nearest first, at most limit of\nthem. Organizations that have not been placed on the map are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List organizations near a point",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude in decimal degrees",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude in decimal degrees",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Radius in meters, at most 100000",
+                        "name": "radius",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Organizations returned at most, 20 by default and 100 at most",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.NearbyOrganizationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves an organization by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing organization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Update an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Organization payload",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.UpdateOrganizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/availability": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns free and booked slots of every court of an organization for a day",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "availability"
+                ],
+                "summary": "Get organization availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day in YYYY-MM-DD format",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.OrganizationAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/blackouts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the blackouts of the organization overlapping the time range, on any of its courts or\non all of them, the earliest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "List blackouts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time in RFC3339 format",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time in RFC3339 format",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.BlackoutResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes a court, or every court of the organization, for maintenance, an event or a private hire.\nNo reservation can be made on the closed courts during the blackout. The active reservations it\noverlaps are returned, with cancelAffected they are cancelled, refunded in full and their players\nare notified. Staff only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Create a blackout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blackout",
+                        "name": "blackout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BlackoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BlackoutImpactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/blackouts/{blackoutID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Get a blackout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Blackout ID",
+                        "name": "blackoutID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BlackoutResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the court, the time range and the reason of the blackout. The active reservations the\nupdated blackout overlaps are returned and, with cancelAffected, cancelled as on creation.\nStaff only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Update a blackout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Blackout ID",
+                        "name": "blackoutID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blackout",
+                        "name": "blackout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BlackoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BlackoutImpactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reopens the courts closed by the blackout. Reservations it cancelled stay cancelled. Staff only.",
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Delete a blackout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Blackout ID",
+                        "name": "blackoutID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/booking-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the default booking rules of the organization, used by courts without rules of their own",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Get organization booking rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BookingRulesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the default booking rules of the organization. Existing reservations are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Set organization booking rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Booking rules payload",
+                        "name": "rules",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BookingRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BookingRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/cancellation-policy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the cancellation policy of the organization. Organizations without a policy refund\nthe whole price of cancelled reservations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Get cancellation policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CancellationPolicyResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the cancellation policy of the organization. The policy is evaluated when a\nreservation is cancelled, reservations cancelled before keep the terms they were cancelled on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Set cancellation policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation policy payload",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CancellationPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CancellationPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the courts belonging to the specified organization, narrowed by the attribute filters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courts"
+                ],
+                "summary": "List all courts for an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "indoor",
+                            "outdoor"
+                        ],
+                        "type": "string",
+                        "description": "Indoor or outdoor",
+                        "name": "environment",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "artificial_grass",
+                            "concrete",
+                            "acrylic"
+                        ],
+                        "type": "string",
+                        "description": "Court surface",
+                        "name": "surface",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "singles",
+                            "doubles"
+                        ],
+                        "type": "string",
+                        "description": "Singles or doubles",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only panoramic courts when true, only the others when false",
+                        "name": "panoramic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only lit courts when true, only the others when false",
+                        "name": "lighting",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ListCourtsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new court for the specified organization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courts"
+                ],
+                "summary": "Create a new court",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Court creation payload",
+                        "name": "court",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CreateCourtRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CreateCourtResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single court by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courts"
+                ],
+                "summary": "Get a court by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CourtResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing court's information. Omitted fields keep their current value.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courts"
+                ],
+                "summary": "Update a court",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Court update payload",
+                        "name": "court",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.UpdateCourtRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CourtResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/availability": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns free and booked slots of a court for a day, based on the court opening hours",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "availability"
+                ],
+                "summary": "Get court availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day in YYYY-MM-DD format",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CourtAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/booking-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the booking rules of the court, or the organization default when the court has none",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Get court booking rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BookingRulesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the booking rules of the court, overriding the organization default.\nExisting reservations are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Set court booking rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Booking rules payload",
+                        "name": "rules",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BookingRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.BookingRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/opening-hours": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the weekly opening hours and the slot granularity of a court",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courts"
+                ],
+                "summary": "Update court opening hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Opening hours payload",
+                        "name": "hours",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.UpdateOpeningHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CourtResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/pricing": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the pricing rule of the court, or the organization default when the court has none",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Get court pricing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PricingRuleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the pricing rule of the court, overriding the organization default.\nExisting reservations keep the price they were booked at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Set court pricing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pricing rule payload",
+                        "name": "pricing",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PricingRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PricingRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/quote": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Prices the slot on the court before booking it, broken down by the rates that apply",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Quote a court slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time in RFC3339 format",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time in RFC3339 format, at most 24 hours after from",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.QuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all reservations for a court within a time range. Times without an offset are read in\nthe time zone of the organization.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "List reservations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time in RFC3339 format",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time in RFC3339 format",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ListReservationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Places a pending hold on the slot of the specified court. The hold has to be confirmed\nbefore expiresAt, otherwise the slot is released. When the slot is taken, the user may join\nthe waitlist of the organization to be offered it if it is freed. Times without an offset are\nread in the time zone of the organization. Bookings that break the booking rules of the court\nor fall outside its opening hours are rejected with 422.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Reserve a court",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reservation payload",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReserveCourtRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the reservation with the specified ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Get a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancels the reservation with the specified ID. Users can cancel their own bookings,\nstaff of the organization can cancel any booking on its courts. The cancellation policy of the\norganization decides how much of the price is refunded, the terms are returned with the\nreservation. Staff may waive the policy to refund the whole price.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Cancel a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Cancel without applying the cancellation policy, staff only",
+                        "name": "waivePolicy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves the reservation to a new time and/or another court of the organization, without releasing\nits slot in between. The reservation keeps its ID, roster, payments and price. Users can move\ntheir own bookings, staff of the organization any booking on its courts, as long as it has not\nstarted and the new slot fits the booking rules and the opening hours of the court and costs\nthe same as the reservation was booked for. Times without an offset are read in the time zone\nof the organization. Every move is recorded in the history of the reservation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Reschedule a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New slot",
+                        "name": "reschedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.RescheduleReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/changes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the history of moves of the reservation, the earliest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "List reservation changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ListReservationChangesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirms a pending hold so the slot stays reserved. Only the user who placed the hold may confirm it.\nHolds with a price are confirmed by paying them instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Confirm a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publishes a reservation that misses players, so other players of the level range can join it.\nOpening a match that is already open replaces its settings. Only the booker opens a match.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Open a match",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Match settings",
+                        "name": "match",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.OpenMatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.MatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops the match from taking players. The players who joined it stay on the roster.",
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Close a match",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/join": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds the current user to an open match their level fits. The user takes a spot right away\nwhen the match auto-accepts players, otherwise the request waits for the booker.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Join a match",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PlayerResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/requests/{userID}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lets the player who asked to join the match take one of its missing spots",
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Approve a request to join",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the player",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/requests/{userID}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turns down the request of the player to join the match",
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Reject a request to join",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the player",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/payment": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Opens a payment for the price of a pending hold. The hold is confirmed once the provider\nreports the payment as succeeded and released when it fails. A pending payment is returned as is.\nOn a split reservation the booker covers the shares the other players have not paid yet.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Pay a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PaymentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the roster of a reservation, the booker first. Players who declined or left are\nlisted with their status. Only the booker and the invited players may see it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roster"
+                ],
+                "summary": "List the players of a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.PlayerResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invites a player to the roster of a reservation by nickname or by phone number. Only the booker\ninvites, up to the number of players the court allows.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roster"
+                ],
+                "summary": "Invite a player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invited player",
+                        "name": "player",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CoPlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes the player off the roster. Players leave on their own, the booker may remove any of them.",
+                "tags": [
+                    "roster"
+                ],
+                "summary": "Leave a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the player",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes the spot the current user was invited to",
+                "tags": [
+                    "roster"
+                ],
+                "summary": "Accept an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the invited player",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turns the invitation of the current user down and frees the spot",
+                "tags": [
+                    "roster"
+                ],
+                "summary": "Decline an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the invited player",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "results"
+                ],
+                "summary": "Get a match result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ResultResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records the teams and set scores of a reservation that is over. The reporter plays in one of\nthe teams and every player must have been on the roster. The opposing team confirms the result.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "results"
+                ],
+                "summary": "Report a match result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Teams and set scores",
+                        "name": "result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReportResultRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accepts the pending result on behalf of the opposing team and updates the ratings of the players.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "results"
+                ],
+                "summary": "Confirm a match result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ResultResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/dispute": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Contests the pending result on behalf of the opposing team. The ratings of the players do not\nchange until the club resolves the dispute.",
+                "tags": [
+                    "results"
+                ],
+                "summary": "Dispute a match result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Settles a disputed result with the teams and set scores the club decided on, and updates the\nratings of the players. Requires the staff role in the organization.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "results"
+                ],
+                "summary": "Resolve a disputed match result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Teams and set scores",
+                        "name": "result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ReportResultRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/shares": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the shares of a split reservation with their payment status. Only the booker and\nthe invited players may see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "List the shares of a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.PaymentShareResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/shares/{shareID}/payment": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Opens a payment for the share the current user owes on a split reservation. A pending payment\nof the share is returned as is.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Pay a share",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share ID",
+                        "name": "shareID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PaymentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/split": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Splits the price of a pending hold between the booker and the invited players, as many as\nthe court allows besides the booker. Each player pays their own share, the hold is confirmed\nonce every share is paid or the booker covers the rest.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Split a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invited players",
+                        "name": "split",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.SplitReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_controllers_http.PaymentShareResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/series": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Books every occurrence of a recurrence rule between two dates.\nOccurrences clashing with existing bookings are skipped and reported as conflicts.\nPriced occurrences are held together and paid at once through the payment of the series\nbefore the hold expires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Create a recurring reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Series payload",
+                        "name": "series",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CreateSeriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CreateSeriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/series/{seriesID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the series and all of its occurrences.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Get a recurring reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "seriesID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.SeriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancels all occurrences of the series, or only the ones that have not started yet.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Cancel a recurring reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "seriesID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Either 'all' (default) or 'future'",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.CancelSeriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/series/{seriesID}/payment": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Opens one payment for the price of every hold of a series. The holds are confirmed together\nonce the provider reports the payment as succeeded, holds released in the meantime are refunded,\nand all of them are released when it fails. A pending payment is returned as is.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Pay a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "seriesID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PaymentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/courts/{courtID}/series/{seriesID}/reservations/{reservationID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Cancel one occurrence of a recurring reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Court ID",
+                        "name": "courtID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "seriesID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "reservationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the members of the organization with their roles. Available to staff and above.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "List organization members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ListMembersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds the user with the given nickname. Managers may add staff and players,\nonly owners may add managers and owners.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Add a member to the organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Member payload",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.InviteMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.MemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/members/{userID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Members may leave on their own. Managers may remove staff and players, owners may remove anyone.\nThe last owner cannot be removed.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Remove a member from the organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/opening-hours": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the weekly opening hours of the organization. They apply to the courts that have no\nopening hours of their own, in the time zone of the organization.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Set organization opening hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Opening hours payload",
+                        "name": "hours",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.UpdateOrganizationHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/pricing": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the default pricing rule of the organization, used by courts without a rule of their own",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Get organization pricing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PricingRuleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the default pricing rule of the organization.\nExisting reservations keep the price they were booked at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Set organization pricing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pricing rule payload",
+                        "name": "pricing",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PricingRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PricingRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/special-hours": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the holidays and special dates of the organization. On each date the given windows\nreplace the opening hours of every court of the organization, a date without windows closes\nthe organization for the day.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Set organization holidays and special hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Special hours payload",
+                        "name": "hours",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.UpdateSpecialHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/organizations/{orgID}/waitlist": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues the current user for a fully booked window, on a court or on any court of the organization.\nWhen a matching reservation is cancelled or its hold expires, the first user in the queue is\noffered a pending hold on the window, which they have to confirm before it expires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Join the waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "orgID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Waited window",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.JoinWaitlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.WaitlistEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/payments/webhook": {
+            "post": {
+                "description": "Receives the outcome of a payment from the provider. The body is verified with the signature header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Payment provider webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider signature of the body",
+                        "name": "X-Payment-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/payments/{paymentID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a payment of the current user with its status history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Get a payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payment ID",
+                        "name": "paymentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.PaymentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/search/availability": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the courts at the organizations of the city that are free to book for the duration from\na start between from and from plus the window, the earliest start first. Starts lie on the slot\ngrid of each court, counted from midnight in the time zone of its club, and starts outside its\nopening hours or refused by its booking rules are left out. When lat and lng are given, courts with\nthe same start are ranked by the distance of their organization from the point. A page holds at\nmost limit courts; pass its nextCursor as after to get the next one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courts"
+                ],
+                "summary": "Search free courts in a city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest start, RFC3339 or YYYY-MM-DDTHH:MM in the time zone of the city",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Duration of the game in minutes",
+                        "name": "duration",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minutes after from in which a game may start, 120 by default",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude of the player, given together with lng",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude of the player, given together with lat",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "indoor",
+                            "outdoor"
+                        ],
+                        "type": "string",
+                        "description": "Indoor or outdoor",
+                        "name": "environment",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "artificial_grass",
+                            "concrete",
+                            "acrylic"
+                        ],
+                        "type": "string",
+                        "description": "Court surface",
+                        "name": "surface",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "singles",
+                            "doubles"
+                        ],
+                        "type": "string",
+                        "description": "Singles or doubles",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only panoramic courts when true, only the others when false",
+                        "name": "panoramic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only lit courts when true, only the others when false",
+                        "name": "lighting",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Courts per page, 50 by default and 200 at most",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "after",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.SearchAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_controllers_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "internal_controllers_http.BlackoutImpactResponse": {
+            "type": "object",
+            "properties": {
+                "affectedReservations": {
+                    "description": "AffectedReservations are the active reservations the blackout overlaps, cancelled when asked to",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                    }
+                },
+                "blackout": {
+                    "$ref": "#/definitions/internal_controllers_http.BlackoutResponse"
+                }
+            }
+        },
+        "internal_controllers_http.BlackoutRequest": {
+            "type": "object",
+            "properties": {
+                "cancelAffected": {
+                    "description": "CancelAffected cancels the reservations the blackout overlaps, refunds them in full and notifies\ntheir players",
+                    "type": "boolean",
+                    "example": false
+                },
+                "courtId": {
+                    "description": "CourtID is the court closed, every court of the organization when empty",
+                    "type": "string",
+                    "example": "court-456"
+                },
+                "endTime": {
+                    "description": "EndTime is the end of the blackout, in the time zone of the organization unless it has an offset",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T14:00"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "resurfacing"
+                },
+                "startTime": {
+                    "description": "StartTime is the start of the blackout, in the time zone of the organization unless it has an offset",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T08:00"
+                }
+            }
+        },
+        "internal_controllers_http.BlackoutResponse": {
+            "type": "object",
+            "properties": {
+                "courtId": {
+                    "type": "string",
+                    "example": "court-456"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "createdBy": {
+                    "type": "string",
+                    "example": "user-789"
+                },
+                "endTime": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T14:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "blackout-123"
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-123"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "resurfacing"
+                },
+                "startTime": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T08:00:00Z"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.BookingRulesRequest": {
+            "type": "object",
+            "properties": {
+                "durationsMinutes": {
+                    "description": "DurationsMinutes are the only durations allowed when set",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        60,
+                        90,
+                        120
+                    ]
+                },
+                "maxActiveBookings": {
+                    "description": "MaxActiveBookings caps the upcoming reservations a player holds in the organization",
+                    "type": "integer",
+                    "example": 3
+                },
+                "maxAdvanceMinutes": {
+                    "description": "MaxAdvanceMinutes is how long before its start a reservation may be made at the earliest",
+                    "type": "integer",
+                    "example": 20160
+                },
+                "maxDurationMinutes": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "minDurationMinutes": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "minNoticeMinutes": {
+                    "description": "MinNoticeMinutes is how long before its start a reservation has to be made at the latest",
+                    "type": "integer",
+                    "example": 60
+                },
+                "slotIntervalMinutes": {
+                    "description": "SlotIntervalMinutes aligns the start and the end of reservations to multiples of it from midnight",
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "internal_controllers_http.BookingRulesResponse": {
+            "type": "object",
+            "properties": {
+                "courtId": {
+                    "description": "CourtID is empty for the organization default",
+                    "type": "string",
+                    "example": "court-123"
+                },
+                "durationsMinutes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "maxActiveBookings": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "maxAdvanceMinutes": {
+                    "type": "integer",
+                    "example": 20160
+                },
+                "maxDurationMinutes": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "minDurationMinutes": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "minNoticeMinutes": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-456"
+                },
+                "slotIntervalMinutes": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.CancelSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "internal_controllers_http.CancellationPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "tiers": {
+                    "description": "Tiers are applied from the longest notice down, cancellations with less notice than every tier\nare not refunded",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.CancellationTierWindow"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.CancellationPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-456"
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.CancellationTierWindow"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.CancellationResponse": {
+            "type": "object",
+            "properties": {
+                "fee": {
+                    "description": "Fee is the part of the price the club keeps, Refund the part given back, both in minor units",
+                    "type": "integer",
+                    "example": 1500
+                },
+                "refund": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "refundPercent": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "waived": {
+                    "description": "Waived is set when staff cancelled without applying the cancellation policy",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_controllers_http.CancellationTierWindow": {
+            "type": "object",
+            "properties": {
+                "noticeMinutes": {
+                    "type": "integer",
+                    "example": 1440
+                },
+                "refundPercent": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "internal_controllers_http.CoPlayerRequest": {
+            "type": "object",
+            "properties": {
+                "nickname": {
+                    "type": "string",
+                    "example": "bob"
+                },
+                "phoneNumber": {
+                    "type": "string",
+                    "example": "+34600000000"
+                }
+            }
+        },
+        "internal_controllers_http.CourtAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "courtId": {
+                    "type": "string",
+                    "example": "court-123"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-11-04"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SlotResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.CourtResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "environment": {
+                    "type": "string",
+                    "example": "indoor"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "doubles"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "court-123"
+                },
+                "lighting": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "maxPlayers": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Court 1"
+                },
+                "openingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OpeningHoursWindow"
+                    }
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-456"
+                },
+                "panoramic": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "slotMinutes": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "surface": {
+                    "type": "string",
+                    "example": "artificial_grass"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.CreateCourtRequest": {
+            "type": "object",
+            "properties": {
+                "environment": {
+                    "description": "Environment is indoor or outdoor, not specified when omitted",
+                    "type": "string",
+                    "enum": [
+                        "indoor",
+                        "outdoor"
+                    ],
+                    "example": "indoor"
+                },
+                "format": {
+                    "description": "Format is singles or doubles, doubles by default",
+                    "type": "string",
+                    "enum": [
+                        "singles",
+                        "doubles"
+                    ],
+                    "example": "doubles"
+                },
+                "lighting": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "maxPlayers": {
+                    "description": "MaxPlayers caps the roster of a reservation, the booker included, by default the size of a full game\nin the format of the court",
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Court 1"
+                },
+                "openingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OpeningHoursWindow"
+                    }
+                },
+                "panoramic": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "slotMinutes": {
+                    "description": "SlotMinutes is the availability slot granularity, 30 minutes by default",
+                    "type": "integer",
+                    "example": 60
+                },
+                "surface": {
+                    "description": "Surface is artificial_grass, concrete or acrylic, not specified when omitted",
+                    "type": "string",
+                    "enum": [
+                        "artificial_grass",
+                        "concrete",
+                        "acrylic"
+                    ],
+                    "example": "artificial_grass"
+                }
+            }
+        },
+        "internal_controllers_http.CreateCourtResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "court-123"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Court 1"
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-456"
+                }
+            }
+        },
+        "internal_controllers_http.CreateOrganizationRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Address is the street address of the organization\nexample: Abay Ave 10",
+                    "type": "string",
+                    "example": "Abay Ave 10"
+                },
+                "city": {
+                    "description": "City is the city in which the organization itself is located\nexample: Almaty",
+                    "type": "string",
+                    "example": "Astana"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude place the organization on the map, they are given together",
+                    "type": "number",
+                    "example": 43.2389
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 76.8897
+                },
+                "name": {
+                    "description": "Name is a organization name\nexample: Padel Club #1",
+                    "type": "string",
+                    "example": "Padel club"
+                },
+                "timeZone": {
+                    "description": "TimeZone is the IANA time zone of the organization, UTC when omitted\nexample: Asia/Almaty",
+                    "type": "string",
+                    "example": "Asia/Almaty"
+                }
+            }
+        },
+        "internal_controllers_http.CreateOrganizationResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Abay Ave 10"
+                },
+                "city": {
+                    "description": "City is the city in which the organization itself is located\nexample: Almaty",
+                    "type": "string",
+                    "example": "Astana"
+                },
+                "createdAt": {
+                    "description": "CreatedAt is the timestamp when the organization was created\nexample: 2025-11-01T10:00:00Z",
+                    "type": "string",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "id": {
+                    "description": "ID is a organization UUID",
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 43.2389
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 76.8897
+                },
+                "name": {
+                    "description": "Name is a organization name\nexample: Padel Club #1",
+                    "type": "string",
+                    "example": "Padel club"
+                },
+                "timeZone": {
+                    "description": "TimeZone is the IANA time zone of the organization\nexample: Asia/Almaty",
+                    "type": "string",
+                    "example": "Asia/Almaty"
+                }
+            }
+        },
+        "internal_controllers_http.CreateSeriesRequest": {
+            "type": "object",
+            "properties": {
+                "durationMinutes": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "endDate": {
+                    "description": "EndDate is the last day of the series, inclusive",
+                    "type": "string",
+                    "example": "2026-05-26"
+                },
+                "frequency": {
+                    "description": "Frequency is either \"daily\" or \"weekly\"",
+                    "type": "string",
+                    "example": "weekly"
+                },
+                "interval": {
+                    "description": "Interval is the number of days or weeks between occurrences, 1 by default",
+                    "type": "integer",
+                    "example": 1
+                },
+                "startDate": {
+                    "description": "StartDate is the first day of the series, inclusive",
+                    "type": "string",
+                    "example": "2025-09-02"
+                },
+                "startTime": {
+                    "description": "StartTime is the time of day every occurrence starts at, in the time zone of the organization",
+                    "type": "string",
+                    "example": "19:00"
+                },
+                "weekdays": {
+                    "description": "Weekdays of a weekly series, 0 is Sunday. Defaults to the weekday of startDate",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        2
+                    ]
+                }
+            }
+        },
+        "internal_controllers_http.CreateSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "string",
+                    "example": "series-123"
+                },
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SeriesOccurrenceResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Message provides a human-readable description of the error.",
+                    "type": "string",
+                    "example": "invalid JSON body"
+                }
+            }
+        },
+        "internal_controllers_http.FreeCourtResponse": {
+            "type": "object",
+            "properties": {
+                "court": {
+                    "$ref": "#/definitions/internal_controllers_http.CourtResponse"
+                },
+                "distance": {
+                    "description": "Distance from the searched point in meters, omitted when no point was given or the organization has no\ncoordinates",
+                    "type": "number",
+                    "example": 1250.5
+                },
+                "endTime": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T20:30:00+05:00"
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-456"
+                },
+                "organizationName": {
+                    "type": "string",
+                    "example": "Padel Club"
+                },
+                "startTime": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:00:00+05:00"
+                }
+            }
+        },
+        "internal_controllers_http.GameResponse": {
+            "type": "object",
+            "properties": {
+                "playerStatus": {
+                    "description": "PlayerStatus is the answer of the current user to the invitation, the booker has accepted by booking",
+                    "type": "string",
+                    "example": "accepted"
+                },
+                "reservation": {
+                    "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                }
+            }
+        },
+        "internal_controllers_http.InviteMemberRequest": {
+            "type": "object",
+            "properties": {
+                "nickname": {
+                    "description": "Nickname is the nickname of the user to add",
+                    "type": "string",
+                    "example": "john"
+                },
+                "role": {
+                    "description": "Role is one of owner, manager, staff or player",
+                    "type": "string",
+                    "example": "staff"
+                }
+            }
+        },
+        "internal_controllers_http.JSONWebKey": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string",
+                    "example": "EdDSA"
+                },
+                "crv": {
+                    "type": "string",
+                    "example": "Ed25519"
+                },
+                "e": {
+                    "type": "string",
+                    "example": "AQAB"
+                },
+                "kid": {
+                    "type": "string",
+                    "example": "2026-10"
+                },
+                "kty": {
+                    "type": "string",
+                    "example": "OKP"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string",
+                    "example": "sig"
+                },
+                "x": {
+                    "type": "string",
+                    "example": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+                }
+            }
+        },
+        "internal_controllers_http.JWKSResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.JSONWebKey"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.JoinWaitlistRequest": {
+            "type": "object",
+            "properties": {
+                "courtId": {
+                    "description": "CourtID is the court waited for, any court of the organization when empty",
+                    "type": "string",
+                    "example": "court-456"
+                },
+                "endTime": {
+                    "description": "EndTime is the end of the window, in the time zone of the organization unless it has an offset",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:45"
+                },
+                "startTime": {
+                    "description": "StartTime is the start of the window, in the time zone of the organization unless it has an offset",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T18:30"
+                }
+            }
+        },
+        "internal_controllers_http.ListCourtsResponse": {
+            "type": "object",
+            "properties": {
+                "courts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.CourtResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.ListMembersResponse": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.MemberResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.ListOrganizationsResponse": {
+            "type": "object",
+            "properties": {
+                "organizations": {
+                    "description": "Organizations is the list of organizations",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.ListReservationChangesResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.ReservationChangeResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.ListReservationsResponse": {
+            "type": "object",
+            "properties": {
+                "reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.ListSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SessionResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "deviceName": {
+                    "description": "DeviceName is a human readable name of the device shown in the list of sessions",
+                    "type": "string",
+                    "example": "iPhone 15"
+                },
+                "nickname": {
+                    "type": "string",
+                    "example": "johnny"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "super-secret"
+                }
+            }
+        },
+        "internal_controllers_http.MatchListingResponse": {
+            "type": "object",
+            "properties": {
+                "match": {
+                    "$ref": "#/definitions/internal_controllers_http.MatchResponse"
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-123"
+                },
+                "reservation": {
+                    "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                }
+            }
+        },
+        "internal_controllers_http.MatchResponse": {
+            "type": "object",
+            "properties": {
+                "autoAccept": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "maxLevel": {
+                    "type": "number",
+                    "example": 4
+                },
+                "minLevel": {
+                    "type": "number",
+                    "example": 2.5
+                },
+                "missingPlayers": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "reservationId": {
+                    "type": "string",
+                    "example": "res-123"
+                }
+            }
+        },
+        "internal_controllers_http.MemberResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "invitedBy": {
+                    "type": "string",
+                    "example": "user-456"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "staff"
+                },
+                "userId": {
+                    "type": "string",
+                    "example": "user-123"
+                }
+            }
+        },
+        "internal_controllers_http.NearbyOrganizationResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "description": "Distance is the great-circle distance from the searched point in meters",
+                    "type": "number",
+                    "example": 1250.5
+                },
+                "organization": {
+                    "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
+                }
+            }
+        },
+        "internal_controllers_http.NearbyOrganizationsResponse": {
+            "type": "object",
+            "properties": {
+                "organizations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.NearbyOrganizationResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.OTPLoginRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "deviceName": {
+                    "type": "string",
+                    "example": "iPhone 15"
+                },
+                "phoneNumber": {
+                    "type": "string",
+                    "example": "+77010000000"
+                }
+            }
+        },
+        "internal_controllers_http.OpenMatchRequest": {
+            "type": "object",
+            "properties": {
+                "autoAccept": {
+                    "description": "AutoAccept lets players join right away, otherwise the booker approves every request to join",
+                    "type": "boolean",
+                    "example": true
+                },
+                "maxLevel": {
+                    "type": "number",
+                    "example": 4
+                },
+                "minLevel": {
+                    "type": "number",
+                    "example": 2.5
+                },
+                "missingPlayers": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "internal_controllers_http.OpeningHoursWindow": {
+            "type": "object",
+            "properties": {
+                "closesAt": {
+                    "type": "string",
+                    "example": "23:00"
+                },
+                "opensAt": {
+                    "type": "string",
+                    "example": "08:00"
+                },
+                "weekday": {
+                    "description": "Weekday is the day of week, 0 is Sunday",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "internal_controllers_http.OrganizationAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "courts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.CourtAvailabilityResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.OrganizationCourtsResponse": {
+            "type": "object",
+            "properties": {
+                "courts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.CourtResponse"
+                    }
+                },
+                "organization": {
+                    "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
+                }
+            }
+        },
+        "internal_controllers_http.OrganizationResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Address is the street address of the organization\nexample: Abay Ave 10",
+                    "type": "string",
+                    "example": "Abay Ave 10"
+                },
+                "city": {
+                    "description": "City is the city where the organization is located\nexample: Almaty",
+                    "type": "string",
+                    "example": "Almaty"
+                },
+                "createdAt": {
+                    "description": "CreatedAt is the timestamp when the organization was created\nexample: 2025-11-01T10:00:00Z",
+                    "type": "string",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the created organization\nexample: org-123",
+                    "type": "string",
+                    "example": "org-1"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude are omitted when the organization has not been placed on the map",
+                    "type": "number",
+                    "example": 43.2389
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 76.8897
+                },
+                "name": {
+                    "description": "Name is the organization name\nexample: Padel Club Almaty",
+                    "type": "string",
+                    "example": "Padel Club Almaty"
+                },
+                "openingHours": {
+                    "description": "OpeningHours apply to the courts of the organization that have no opening hours of their own",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OpeningHoursWindow"
+                    }
+                },
+                "specialHours": {
+                    "description": "SpecialHours replace the opening hours of every court on holidays and special dates",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SpecialHoursDay"
+                    }
+                },
+                "timeZone": {
+                    "description": "TimeZone is the IANA time zone the organization books in\nexample: Asia/Almaty",
+                    "type": "string",
+                    "example": "Asia/Almaty"
+                },
+                "updatedAt": {
+                    "description": "UpdatedAt is the timestamp when the organization was last updated (RFC3339 format)\nexample: 2025-11-01T10:00:00Z",
+                    "type": "string",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.PaymentEventResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": ""
+                },
+                "status": {
+                    "type": "string",
+                    "example": "succeeded"
+                }
+            }
+        },
+        "internal_controllers_http.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 3000
+                },
+                "clientSecret": {
+                    "description": "ClientSecret completes the payment with the provider, it is only returned while the payment is pending",
+                    "type": "string",
+                    "example": "fake_pi_pay-123_secret"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.PaymentEventResponse"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "pay-123"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "fake"
+                },
+                "refundedAmount": {
+                    "description": "RefundedAmount is the part of the amount given back, in minor units",
+                    "type": "integer",
+                    "example": 0
+                },
+                "reservationId": {
+                    "type": "string",
+                    "example": "res-123"
+                },
+                "seriesId": {
+                    "description": "SeriesID is set instead of the reservation id when the payment pays the holds of a series",
+                    "type": "string",
+                    "example": "series-123"
+                },
+                "shareId": {
+                    "type": "string",
+                    "example": "share-123"
+                },
+                "status": {
+                    "description": "Status is pending, succeeded, failed, refunded or partially_refunded",
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.PaymentShareResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 750
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "share-123"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "unpaid"
+                },
+                "userId": {
+                    "type": "string",
+                    "example": "user-123"
+                }
+            }
+        },
+        "internal_controllers_http.PlayerResponse": {
+            "type": "object",
+            "properties": {
+                "invitedBy": {
+                    "type": "string",
+                    "example": "user-789"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "invited"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "userId": {
+                    "type": "string",
+                    "example": "user-123"
+                }
+            }
+        },
+        "internal_controllers_http.PriceBandWindow": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "18:00"
+                },
+                "hourlyRate": {
+                    "type": "integer",
+                    "example": 3000
+                },
+                "to": {
+                    "type": "string",
+                    "example": "22:00"
+                },
+                "weekdays": {
+                    "description": "Weekdays are days of week, 0 is Sunday. The band applies every day when empty",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3,
+                        4,
+                        5
+                    ]
+                }
+            }
+        },
+        "internal_controllers_http.PriceResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount is in the minor units of the currency",
+                    "type": "integer",
+                    "example": 3000
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                }
+            }
+        },
+        "internal_controllers_http.PricingRuleRequest": {
+            "type": "object",
+            "properties": {
+                "bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.PriceBandWindow"
+                    }
+                },
+                "baseHourlyRate": {
+                    "type": "integer",
+                    "example": 2000
+                },
+                "currency": {
+                    "description": "Currency is an ISO 4217 code, all amounts are in its minor units",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "holidayHourlyRate": {
+                    "type": "integer",
+                    "example": 4000
+                },
+                "holidays": {
+                    "description": "Holidays are dates in YYYY-MM-DD format billed at holidayHourlyRate",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-12-25"
+                    ]
+                },
+                "minimumCharge": {
+                    "type": "integer",
+                    "example": 1500
+                }
+            }
+        },
+        "internal_controllers_http.PricingRuleResponse": {
+            "type": "object",
+            "properties": {
+                "bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.PriceBandWindow"
+                    }
+                },
+                "baseHourlyRate": {
+                    "type": "integer",
+                    "example": 2000
+                },
+                "courtId": {
+                    "description": "CourtID is empty for the organization default",
+                    "type": "string",
+                    "example": "court-123"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "holidayHourlyRate": {
+                    "type": "integer",
+                    "example": 4000
+                },
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "minimumCharge": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "organizationId": {
+                    "type": "string",
+                    "example": "org-456"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.QuoteLineResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 3000
+                },
+                "from": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T18:00:00Z"
+                },
+                "hourlyRate": {
+                    "type": "integer",
+                    "example": 3000
+                },
+                "to": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T19:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.QuoteResponse": {
+            "type": "object",
+            "properties": {
+                "courtId": {
+                    "type": "string",
+                    "example": "court-123"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "from": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T17:30Z"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.QuoteLineResponse"
+                    }
+                },
+                "minimumChargeApplied": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 4000
+                },
+                "to": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T19:00Z"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 4000
+                }
+            }
+        },
+        "internal_controllers_http.RatingChangeResponse": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "type": "number",
+                    "example": 1520.5
+                },
+                "before": {
+                    "type": "number",
+                    "example": 1500
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "reservationId": {
+                    "type": "string",
+                    "example": "res-123"
+                }
+            }
+        },
+        "internal_controllers_http.RatingResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.RatingChangeResponse"
+                    }
+                },
+                "ratedGames": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 1520.5
+                }
+            }
+        },
+        "internal_controllers_http.RefreshRequest": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "example": "opaque-refresh-token"
+                }
+            }
+        },
+        "internal_controllers_http.RegisterUserRequest": {
+            "type": "object",
+            "properties": {
+                "firstName": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "lastName": {
+                    "description": "LastName of the registering user",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "nickname": {
+                    "type": "string",
+                    "example": "johnny"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "super-secret"
+                },
+                "phoneNumber": {
+                    "type": "string",
+                    "example": "+77010000000"
+                }
+            }
+        },
+        "internal_controllers_http.RegisterUserResponse": {
+            "type": "object",
+            "properties": {
+                "firstName": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "user-123"
+                },
+                "lastName": {
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "nickname": {
+                    "type": "string",
+                    "example": "johnny"
+                },
+                "phoneNumber": {
+                    "type": "string",
+                    "example": "+77010000000"
+                }
+            }
+        },
+        "internal_controllers_http.ReportResultRequest": {
+            "type": "object",
+            "properties": {
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SetScore"
+                    }
+                },
+                "teamA": {
+                    "description": "TeamA and TeamB hold the user IDs of the players, one or two per team",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "user-1",
+                        "user-2"
+                    ]
+                },
+                "teamB": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "user-3",
+                        "user-4"
+                    ]
+                }
+            }
+        },
+        "internal_controllers_http.RequestOTPRequest": {
+            "type": "object",
+            "properties": {
+                "phoneNumber": {
+                    "type": "string",
+                    "example": "+77010000000"
+                },
+                "purpose": {
+                    "description": "Purpose is one of verify_phone, login or reset_password",
+                    "type": "string",
+                    "example": "login"
+                }
+            }
+        },
+        "internal_controllers_http.RescheduleReservationRequest": {
+            "type": "object",
+            "properties": {
+                "courtId": {
+                    "description": "CourtID is the court of the organization to move the reservation to, the current court when empty",
+                    "type": "string",
+                    "example": "court-457"
+                },
+                "endTime": {
+                    "description": "EndTime is the new end timestamp, in the time zone of the organization unless it has an offset\nexample: 2025-11-04T20:45\nformat: date-time",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T20:45"
+                },
+                "startTime": {
+                    "description": "StartTime is the new start timestamp, in the time zone of the organization unless it has an offset\nexample: 2025-11-04T19:30\nformat: date-time",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:30"
+                }
+            }
+        },
+        "internal_controllers_http.ReservationChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-02T09:00:00Z"
+                },
+                "changedBy": {
+                    "type": "string",
+                    "example": "user-789"
+                },
+                "courtId": {
+                    "type": "string",
+                    "example": "court-457"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "chg-123"
+                },
+                "previousCourtId": {
+                    "type": "string",
+                    "example": "court-456"
+                },
+                "previousFrom": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T18:30Z"
+                },
+                "previousTo": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:45Z"
+                },
+                "reservedFrom": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:30Z"
+                },
+                "reservedTo": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T20:45Z"
+                }
+            }
+        },
+        "internal_controllers_http.ReservationResponse": {
+            "type": "object",
+            "properties": {
+                "cancellation": {
+                    "description": "Cancellation holds the terms a cancelled reservation was cancelled on",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_controllers_http.CancellationResponse"
+                        }
+                    ]
+                },
+                "cancelledBy": {
+                    "type": "string",
+                    "example": ""
+                },
+                "courtId": {
+                    "type": "string",
+                    "example": "court-456"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:15:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "res-123"
+                },
+                "price": {
+                    "$ref": "#/definitions/internal_controllers_http.PriceResponse"
+                },
+                "reservedBy": {
+                    "type": "string",
+                    "example": "user-789"
+                },
+                "reservedFrom": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T18:30Z"
+                },
+                "reservedTo": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:45Z"
+                },
+                "seriesId": {
+                    "type": "string",
+                    "example": ""
+                },
+                "status": {
+                    "type": "string",
+                    "example": "reserved"
+                }
+            }
+        },
+        "internal_controllers_http.ReserveCourtRequest": {
+            "type": "object",
+            "properties": {
+                "endTime": {
+                    "description": "EndTime is the reservation end timestamp, in the time zone of the organization unless it has an offset\nexample: 2025-11-04T19:45\nformat: date-time",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:45"
+                },
+                "startTime": {
+                    "description": "StartTime is the reservation start timestamp, in the time zone of the organization unless it has an offset\nexample: 2025-11-04T18:30\nformat: date-time",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T18:30"
+                }
+            }
+        },
+        "internal_controllers_http.ResetPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "newPassword": {
+                    "type": "string",
+                    "example": "new-super-secret"
+                },
+                "phoneNumber": {
+                    "type": "string",
+                    "example": "+77010000000"
+                }
+            }
+        },
+        "internal_controllers_http.ResultResponse": {
+            "type": "object",
+            "properties": {
+                "answeredBy": {
+                    "type": "string",
+                    "example": "user-3"
+                },
+                "reportedBy": {
+                    "type": "string",
+                    "example": "user-1"
+                },
+                "reservationId": {
+                    "type": "string",
+                    "example": "res-123"
+                },
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SetScore"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "teamA": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "user-1",
+                        "user-2"
+                    ]
+                },
+                "teamB": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "user-3",
+                        "user-4"
+                    ]
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_controllers_http.SearchAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "courts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.FreeCourtResponse"
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is passed as after to get the next page, omitted on the last page",
+                    "type": "string",
+                    "example": "eyJmIjoiMjAyNS0xMS0wNFQxNDowMDowMFoifQ"
+                }
+            }
+        },
+        "internal_controllers_http.SearchCourtsResponse": {
+            "type": "object",
+            "properties": {
+                "organizations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OrganizationCourtsResponse"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.SeriesOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "endTime": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-09-02T20:30:00Z"
+                },
+                "reservationId": {
+                    "type": "string",
+                    "example": "res-123"
+                },
+                "startTime": {
                     "type": "string",
-                    "example": "Court 1"
+                    "format": "date-time",
+                    "example": "2025-09-02T19:00:00Z"
                 },
-                "organizationId": {
+                "status": {
+                    "description": "Status is \"pending\" for priced occurrences waiting to be paid, \"reserved\" for free ones and \"conflict\"\nfor occurrences clashing with another booking",
                     "type": "string",
-                    "example": "org-456"
+                    "example": "reserved"
                 }
             }
         },
-        "internal_controllers_http.CreateOrganizationRequest": {
+        "internal_controllers_http.SeriesResponse": {
             "type": "object",
             "properties": {
-                "city": {
-                    "description": "City is the city in which the organization itself is located\nexample: Almaty",
+                "courtId": {
                     "type": "string",
-                    "example": "Astana"
+                    "example": "court-456"
                 },
-                "name": {
-                    "description": "Name is a organization name\nexample: Padel Club #1",
+                "createdAt": {
                     "type": "string",
-                    "example": "Padel club"
+                    "format": "date-time",
+                    "example": "2025-08-01T10:00:00Z"
+                },
+                "durationMinutes": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2026-05-26"
+                },
+                "frequency": {
+                    "type": "string",
+                    "example": "weekly"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "series-123"
+                },
+                "interval": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                    }
+                },
+                "reservedBy": {
+                    "type": "string",
+                    "example": "user-789"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-09-02"
+                },
+                "startTime": {
+                    "type": "string",
+                    "example": "19:00"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        2
+                    ]
                 }
             }
         },
-        "internal_controllers_http.CreateOrganizationResponse": {
+        "internal_controllers_http.SessionResponse": {
             "type": "object",
             "properties": {
-                "city": {
-                    "description": "City is the city in which the organization itself is located\nexample: Almaty",
-                    "type": "string",
-                    "example": "Astana"
-                },
                 "createdAt": {
-                    "description": "CreatedAt is the timestamp when the organization was created\nexample: 2025-11-01T10:00:00Z",
                     "type": "string",
+                    "format": "date-time",
                     "example": "2025-11-01T10:00:00Z"
                 },
+                "current": {
+                    "description": "Current is true for the session of the access token used for the request",
+                    "type": "boolean",
+                    "example": true
+                },
+                "deviceName": {
+                    "type": "string",
+                    "example": "iPhone 15"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-12-02T10:00:00Z"
+                },
                 "id": {
-                    "description": "ID is a organization UUID",
-                    "type": "string"
+                    "type": "string",
+                    "example": "session-123"
                 },
-                "name": {
-                    "description": "Name is a organization name\nexample: Padel Club #1",
+                "ipAddress": {
                     "type": "string",
-                    "example": "Padel club"
+                    "example": "10.0.0.1"
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-02T10:00:00Z"
+                },
+                "userAgent": {
+                    "type": "string",
+                    "example": "padel-ios/1.0"
                 }
             }
         },
-        "internal_controllers_http.ErrorResponse": {
+        "internal_controllers_http.SetLevelRequest": {
             "type": "object",
             "properties": {
-                "message": {
-                    "description": "Message provides a human-readable description of the error.",
-                    "type": "string",
-                    "example": "invalid JSON body"
+                "level": {
+                    "description": "Level is on the padel scale, from 0 for a beginner to 7 for a professional",
+                    "type": "number",
+                    "example": 3.5
                 }
             }
         },
-        "internal_controllers_http.ListCourtsResponse": {
+        "internal_controllers_http.SetScore": {
             "type": "object",
             "properties": {
-                "courts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_controllers_http.CourtResponse"
-                    }
+                "teamA": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "teamB": {
+                    "type": "integer",
+                    "example": 4
                 }
             }
         },
-        "internal_controllers_http.ListOrganizationsResponse": {
+        "internal_controllers_http.SlotResponse": {
             "type": "object",
             "properties": {
-                "organizations": {
-                    "description": "Organizations is the list of organizations",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_controllers_http.OrganizationResponse"
-                    }
+                "from": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T18:00:00Z"
+                },
+                "status": {
+                    "description": "Status is \"free\", \"booked\" or \"blocked\" by a blackout",
+                    "type": "string",
+                    "example": "free"
+                },
+                "to": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-11-04T19:00:00Z"
                 }
             }
         },
-        "internal_controllers_http.ListReservationsResponse": {
+        "internal_controllers_http.SpecialHoursDay": {
             "type": "object",
             "properties": {
-                "reservations": {
+                "date": {
+                    "description": "Date is the calendar date in YYYY-MM-DD format",
+                    "type": "string",
+                    "example": "2025-12-24"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Christmas Eve"
+                },
+                "windows": {
+                    "description": "Windows are the opening windows on the date, the organization is closed all day when empty",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_controllers_http.ReservationResponse"
+                        "$ref": "#/definitions/internal_controllers_http.SpecialHoursWindow"
                     }
                 }
             }
         },
-        "internal_controllers_http.LoginRequest": {
+        "internal_controllers_http.SpecialHoursWindow": {
             "type": "object",
             "properties": {
-                "nickname": {
+                "closesAt": {
                     "type": "string",
-                    "example": "johnny"
+                    "example": "14:00"
                 },
-                "password": {
+                "opensAt": {
                     "type": "string",
-                    "example": "super-secret"
+                    "example": "08:00"
                 }
             }
         },
-        "internal_controllers_http.LoginResponse": {
+        "internal_controllers_http.SplitReservationRequest": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string",
-                    "example": "jwt-token"
+                "players": {
+                    "description": "Players are invited by nickname or by phone number, the booker is added to the split",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.CoPlayerRequest"
+                    }
                 }
             }
         },
-        "internal_controllers_http.OrganizationResponse": {
+        "internal_controllers_http.TokenResponse": {
             "type": "object",
             "properties": {
-                "city": {
-                    "description": "City is the city where the organization is located\nexample: Almaty",
+                "expiresAt": {
                     "type": "string",
-                    "example": "Almaty"
+                    "format": "date-time",
+                    "example": "2025-11-01T10:15:00Z"
                 },
-                "createdAt": {
-                    "description": "CreatedAt is the timestamp when the organization was created\nexample: 2025-11-01T10:00:00Z",
+                "refreshToken": {
+                    "description": "RefreshToken is exchanged for a new token pair on POST /v1/auth/refresh, it can be used only once",
                     "type": "string",
-                    "example": "2025-11-01T10:00:00Z"
+                    "example": "opaque-refresh-token"
                 },
-                "id": {
-                    "description": "ID is the unique identifier of the created organization\nexample: org-123",
+                "refreshTokenExpiresAt": {
                     "type": "string",
-                    "example": "org-1"
+                    "format": "date-time",
+                    "example": "2025-12-01T10:00:00Z"
                 },
-                "name": {
-                    "description": "Name is the organization name\nexample: Padel Club Almaty",
+                "sessionId": {
                     "type": "string",
-                    "example": "Padel Club Almaty"
+                    "example": "session-123"
                 },
-                "updatedAt": {
-                    "description": "UpdatedAt is the timestamp when the organization was last updated (RFC3339 format)\nexample: 2025-11-01T10:00:00Z",
+                "token": {
+                    "description": "Token is the short-lived access token to send as a Bearer token",
                     "type": "string",
-                    "example": "2025-11-01T10:00:00Z"
+                    "example": "jwt-token"
                 }
             }
         },
-        "internal_controllers_http.RegisterUserRequest": {
+        "internal_controllers_http.UpdateCourtRequest": {
             "type": "object",
             "properties": {
-                "firstName": {
+                "environment": {
+                    "description": "Environment, Surface and Format keep the current value when omitted",
                     "type": "string",
-                    "example": "John"
+                    "enum": [
+                        "indoor",
+                        "outdoor"
+                    ],
+                    "example": "indoor"
                 },
-                "lastName": {
-                    "description": "LastName of the registering user",
+                "format": {
                     "type": "string",
-                    "example": "Doe"
+                    "enum": [
+                        "singles",
+                        "doubles"
+                    ],
+                    "example": "doubles"
                 },
-                "nickname": {
-                    "type": "string",
-                    "example": "johnny"
+                "lighting": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "password": {
+                "maxPlayers": {
+                    "description": "MaxPlayers caps the roster of a reservation, the current value is kept when omitted",
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "description": "Name is the new name, the current one is kept when omitted",
                     "type": "string",
-                    "example": "super-secret"
+                    "example": "Updated Court Name"
                 },
-                "phoneNumber": {
+                "panoramic": {
+                    "description": "Panoramic and Lighting keep the current value when omitted",
+                    "type": "boolean",
+                    "example": true
+                },
+                "surface": {
                     "type": "string",
-                    "example": "+77010000000"
+                    "enum": [
+                        "artificial_grass",
+                        "concrete",
+                        "acrylic"
+                    ],
+                    "example": "artificial_grass"
                 }
             }
         },
-        "internal_controllers_http.RegisterUserResponse": {
+        "internal_controllers_http.UpdateOpeningHoursRequest": {
             "type": "object",
             "properties": {
-                "firstName": {
+                "openingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OpeningHoursWindow"
+                    }
+                },
+                "slotMinutes": {
+                    "description": "SlotMinutes is the availability slot granularity, the current value is kept when omitted",
+                    "type": "integer",
+                    "example": 60
+                }
+            }
+        },
+        "internal_controllers_http.UpdateOrganizationHoursRequest": {
+            "type": "object",
+            "properties": {
+                "openingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.OpeningHoursWindow"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.UpdateOrganizationRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Address is the street address of the organization, the current one is kept when omitted and removed\nwhen null or empty\nexample: Abay Ave 10",
                     "type": "string",
-                    "example": "John"
+                    "example": "Abay Ave 10"
                 },
-                "id": {
+                "city": {
+                    "description": "City is the updated city where the organization is located\nexample: Astana",
                     "type": "string",
-                    "example": "user-123"
+                    "example": "Astana"
                 },
-                "lastName": {
+                "latitude": {
+                    "description": "Latitude and Longitude are given together, the current coordinates are kept when both are omitted and\nremoved when both are null",
+                    "type": "number",
+                    "example": 43.2389
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 76.8897
+                },
+                "name": {
+                    "description": "Name is the updated organization name\nexample: Updated Padel Club",
                     "type": "string",
-                    "example": "Doe"
+                    "example": "Updated Padel Club"
                 },
-                "nickname": {
+                "timeZone": {
+                    "description": "TimeZone is the IANA time zone of the organization, the current one is kept when omitted\nexample: Asia/Almaty",
                     "type": "string",
-                    "example": "johnny"
+                    "example": "Asia/Almaty"
+                }
+            }
+        },
+        "internal_controllers_http.UpdateSpecialHoursRequest": {
+            "type": "object",
+            "properties": {
+                "specialHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_controllers_http.SpecialHoursDay"
+                    }
+                }
+            }
+        },
+        "internal_controllers_http.VerifyPhoneRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
                 },
                 "phoneNumber": {
                     "type": "string",
@@ -1015,13 +7644,9 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_controllers_http.ReservationResponse": {
+        "internal_controllers_http.WaitlistEntryResponse": {
             "type": "object",
             "properties": {
-                "cancelledBy": {
-                    "type": "string",
-                    "example": ""
-                },
                 "courtId": {
                     "type": "string",
                     "example": "court-456"
@@ -1033,11 +7658,16 @@ const docTemplate = `{
                 },
                 "id": {
                     "type": "string",
-                    "example": "res-123"
+                    "example": "wl-123"
                 },
-                "reservedBy": {
+                "organizationId": {
                     "type": "string",
-                    "example": "user-789"
+                    "example": "org-123"
+                },
+                "reservationId": {
+                    "description": "ReservationID is the pending hold offered to the user, it has to be confirmed before it expires",
+                    "type": "string",
+                    "example": "res-123"
                 },
                 "reservedFrom": {
                     "type": "string",
@@ -1050,49 +7680,9 @@ const docTemplate = `{
                     "example": "2025-11-04T19:45Z"
                 },
                 "status": {
+                    "description": "Status is \"waiting\", \"offered\" once a hold was handed to the user, or \"expired\" when the window started\nbefore a slot was freed for it",
                     "type": "string",
-                    "example": "reserved"
-                }
-            }
-        },
-        "internal_controllers_http.ReserveCourtRequest": {
-            "type": "object",
-            "properties": {
-                "endTime": {
-                    "description": "EndTime is the reservation end timestamp in RFC3339 format\nexample: 2025-11-04T19:45\nformat: date-time",
-                    "type": "string",
-                    "format": "date-time",
-                    "example": "2025-11-04T19:45"
-                },
-                "startTime": {
-                    "description": "StartTime is the reservation start timestamp in RFC3339 format\nexample: 2025-11-04T18:30\nformat: date-time",
-                    "type": "string",
-                    "format": "date-time",
-                    "example": "2025-11-04T18:30"
-                }
-            }
-        },
-        "internal_controllers_http.UpdateCourtRequest": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "Updated Court Name"
-                }
-            }
-        },
-        "internal_controllers_http.UpdateOrganizationRequest": {
-            "type": "object",
-            "properties": {
-                "city": {
-                    "description": "City is the updated city where the organization is located\nexample: Astana",
-                    "type": "string",
-                    "example": "Astana"
-                },
-                "name": {
-                    "description": "Name is the updated organization name\nexample: Updated Padel Club",
-                    "type": "string",
-                    "example": "Updated Padel Club"
+                    "example": "waiting"
                 }
             }
         }
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
@@ -15,12 +16,85 @@ const (
 	ProductionEnv = "production"
 )
 
+const (
+	LocalLocker    = "local"
+	PostgresLocker = "postgres"
+)
+
+const (
+	LogSMSSender  = "log"
+	FileSMSSender = "file"
+)
+
+const (
+	FakePaymentProvider = "fake"
+)
+
 type Config struct {
 	HTTPServerAddr string `mapstructure:"http_server_addr"`
 	LogLevel       string `mapstructure:"log_level"`
 	Postgres       struct {
 		ConnectionURL string `mapstructure:"connection_url"`
 	} `mapstructure:"postgres"`
+	Reservation struct {
+		// HoldTTL is how long a pending reservation keeps its slot before it has to be confirmed
+		HoldTTL time.Duration `mapstructure:"hold_ttl"`
+		// ExpirerInterval is how often unconfirmed holds are released
+		ExpirerInterval time.Duration `mapstructure:"expirer_interval"`
+		// Locker selects how concurrent bookings of a court are serialised, local or postgres
+		Locker string `mapstructure:"locker"`
+		// LockTimeout bounds how long a booking waits for the court lock
+		LockTimeout time.Duration `mapstructure:"lock_timeout"`
+	} `mapstructure:"reservation"`
+	Auth struct {
+		// AccessTokenTTL is how long an access token is accepted
+		AccessTokenTTL time.Duration `mapstructure:"access_token_ttl"`
+		// RefreshTokenTTL is how long a session survives without being refreshed
+		RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
+		Signing         struct {
+			// ActiveKey is the id of the key new tokens are signed with, the other keys only verify
+			ActiveKey string       `mapstructure:"active_key"`
+			Keys      []SigningKey `mapstructure:"keys"`
+		} `mapstructure:"signing"`
+		OTP struct {
+			// TTL is how long a code sent by SMS can be used
+			TTL time.Duration `mapstructure:"ttl"`
+			// MaxAttempts is how many guesses a code allows
+			MaxAttempts int `mapstructure:"max_attempts"`
+			// ResendInterval is how long a new code for the same purpose has to wait
+			ResendInterval time.Duration `mapstructure:"resend_interval"`
+			// HourlyLimit caps the codes sent to one phone number per hour
+			HourlyLimit int `mapstructure:"hourly_limit"`
+		} `mapstructure:"otp"`
+	} `mapstructure:"auth"`
+	SMS struct {
+		// Sender selects where text messages go, log or file. It defaults to the log sender in development
+		// environments only
+		Sender string `mapstructure:"sender"`
+		// FilePath is the file the file sender appends messages to
+		FilePath string `mapstructure:"file_path"`
+	} `mapstructure:"sms"`
+	Payments struct {
+		// Provider selects who collects the money, only the fake provider is available for now. It defaults to
+		// the fake provider in development environments only
+		Provider string `mapstructure:"provider"`
+		// WebhookSecret verifies the signature of the webhooks sent by the provider
+		WebhookSecret string `mapstructure:"webhook_secret"`
+	} `mapstructure:"payments"`
+}
+
+// SigningKey configures a JWT signing key. Key material is set inline or as a path to a file:
+// a secret for HS256, a PEM private key for RS256 and EdDSA, or only the PEM public key of a
+// retired key that still has to verify tokens issued before the rotation.
+type SigningKey struct {
+	ID             string `mapstructure:"id"`
+	Algorithm      string `mapstructure:"algorithm"`
+	Secret         string `mapstructure:"secret"`
+	SecretFile     string `mapstructure:"secret_file"`
+	PrivateKey     string `mapstructure:"private_key"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	PublicKey      string `mapstructure:"public_key"`
+	PublicKeyFile  string `mapstructure:"public_key_file"`
 }
 
 func LoadConfig() (Config, error) {
@@ -32,6 +106,23 @@ func LoadConfig() (Config, error) {
 
 	viper.AutomaticEnv()
 
+	viper.SetDefault("reservation.hold_ttl", "15m")
+	viper.SetDefault("reservation.expirer_interval", "1m")
+	viper.SetDefault("reservation.locker", LocalLocker)
+	viper.SetDefault("reservation.lock_timeout", "5s")
+	viper.SetDefault("auth.access_token_ttl", "15m")
+	viper.SetDefault("auth.refresh_token_ttl", "720h")
+	viper.SetDefault("auth.otp.ttl", "5m")
+	viper.SetDefault("auth.otp.max_attempts", 5)
+	viper.SetDefault("auth.otp.resend_interval", "1m")
+	viper.SetDefault("auth.otp.hourly_limit", 5)
+
+	// the log sender delivers no codes and the fake provider collects no money, deployments pick their own
+	if IsDevelopment() {
+		viper.SetDefault("sms.sender", LogSMSSender)
+		viper.SetDefault("payments.provider", FakePaymentProvider)
+	}
+
 	err := viper.ReadInConfig()
 	if err != nil {
 		return Config{}, fmt.Errorf("read in config: %w", err)
@@ -44,11 +135,35 @@ func LoadConfig() (Config, error) {
 		return Config{}, fmt.Errorf("unmarshal: %w", err)
 	}
 
+	if err := cfg.validate(); err != nil {
+		return Config{}, fmt.Errorf("validate: %w", err)
+	}
+
 	log.Info().Str("env", Environment()).Msg("loaded config successfully")
 
 	return cfg, nil
 }
 
+// validate rejects settings the server cannot safely run with.
+func (c Config) validate() error {
+	if c.SMS.Sender == "" {
+		return fmt.Errorf("sms.sender is required in the %s environment", Environment())
+	}
+
+	if c.Payments.Provider == "" {
+		return fmt.Errorf("payments.provider is required in the %s environment", Environment())
+	}
+
+	// webhooks confirm payments, only the fake provider of a development environment may take them unsigned
+	unsigned := c.Payments.Provider == FakePaymentProvider && IsDevelopment()
+	if c.Payments.WebhookSecret == "" && !unsigned {
+		return fmt.Errorf("payments.webhook_secret is required for the %s provider in the %s environment",
+			c.Payments.Provider, Environment())
+	}
+
+	return nil
+}
+
 func Environment() string {
 	env := os.Getenv("APP_ENV")
 	if env == "" {
@@ -57,3 +172,13 @@ func Environment() string {
 
 	return env
 }
+
+// IsDevelopment tells whether the server runs on a developer machine or in a test environment.
+func IsDevelopment() bool {
+	switch Environment() {
+	case LocalEnv, SmokeEnv, TestingEnv:
+		return true
+	default:
+		return false
+	}
+}
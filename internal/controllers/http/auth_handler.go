@@ -2,10 +2,17 @@ package http
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"math/big"
+	"net"
 	"net/http"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/lever-dev/padel-backend/internal/entities"
 	"github.com/lever-dev/padel-backend/pkg/httputil"
@@ -13,8 +20,21 @@ import (
 )
 
 type AuthService interface {
-	LoginViaPassword(ctx context.Context, nickname, password string) (string, error)
+	LoginViaPassword(
+		ctx context.Context,
+		nickname, password string,
+		device entities.Device,
+	) (*entities.TokenPair, error)
 	RegisterUser(ctx context.Context, user *entities.User, password string) error
+	Refresh(ctx context.Context, refreshToken string, device entities.Device) (*entities.TokenPair, error)
+	ListSessions(ctx context.Context, userID string) ([]entities.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	PublicKeys() []entities.PublicKey
+	RequestOTP(ctx context.Context, phoneNumber string, purpose entities.OTPPurpose) error
+	VerifyPhone(ctx context.Context, phoneNumber, code string) error
+	LoginViaOTP(ctx context.Context, phoneNumber, code string, device entities.Device) (*entities.TokenPair, error)
+	ResetPassword(ctx context.Context, phoneNumber, code, newPassword string) error
+	SetLevel(ctx context.Context, userID string, level float64) error
 }
 
 type AuthHandler struct {
@@ -32,21 +52,40 @@ func NewAuthHandler(service AuthService) *AuthHandler {
 type LoginRequest struct {
 	Nickname string `json:"nickname" example:"johnny"`
 	Password string `json:"password" example:"super-secret"`
+	// DeviceName is a human readable name of the device shown in the list of sessions
+	DeviceName string `json:"deviceName,omitempty" example:"iPhone 15"`
 }
 
-// LoginResponse represents the successful login response.
-// swagger:model LoginResponse
-type LoginResponse struct {
-	Token string `json:"token" example:"jwt-token"`
+// TokenResponse is returned on login and on refresh.
+// swagger:model TokenResponse
+type TokenResponse struct {
+	// Token is the short-lived access token to send as a Bearer token
+	Token     string    `json:"token"     example:"jwt-token"`
+	ExpiresAt time.Time `json:"expiresAt" example:"2025-11-01T10:15:00Z" format:"date-time"`
+	// RefreshToken is exchanged for a new token pair on POST /v1/auth/refresh, it can be used only once
+	RefreshToken          string    `json:"refreshToken"          example:"opaque-refresh-token"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt" example:"2025-12-01T10:00:00Z" format:"date-time"`
+	SessionID             string    `json:"sessionId"             example:"session-123"`
+}
+
+func newTokenResponse(pair *entities.TokenPair) TokenResponse {
+	return TokenResponse{
+		Token:                 pair.AccessToken,
+		ExpiresAt:             pair.AccessTokenExpiresAt,
+		RefreshToken:          pair.RefreshToken,
+		RefreshTokenExpiresAt: pair.RefreshTokenExpiresAt,
+		SessionID:             pair.SessionID,
+	}
 }
 
 // Login godoc
 // @Summary Login with nickname and password
+// @Description Opens a new session and returns an access token together with a refresh token.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param login body LoginRequest true "Login payload"
-// @Success 200 {object} LoginResponse
+// @Success 200 {object} TokenResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 500
@@ -63,7 +102,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := h.authService.LoginViaPassword(r.Context(), req.Nickname, req.Password)
+	pair, err := h.authService.LoginViaPassword(r.Context(), req.Nickname, req.Password, requestDevice(r, req.DeviceName))
 	if err != nil {
 		if errors.Is(err, entities.ErrInvalidCredentials) {
 			httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "invalid credentials"})
@@ -75,7 +114,478 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httputil.JSON(w, http.StatusOK, LoginResponse{Token: token})
+	httputil.JSON(w, http.StatusOK, newTokenResponse(pair))
+}
+
+// RefreshRequest represents the expected payload for the refresh endpoint.
+// swagger:model RefreshRequest
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" example:"opaque-refresh-token"`
+}
+
+// Refresh godoc
+// @Summary Exchange a refresh token for a new token pair
+// @Description The refresh token rotates on every use. Presenting an already used refresh token
+// @Description revokes the whole session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param refresh body RefreshRequest true "Refresh payload"
+// @Success 200 {object} TokenResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/refresh [post]
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.RefreshToken == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "refreshToken is required"})
+		return
+	}
+
+	pair, err := h.authService.Refresh(r.Context(), req.RefreshToken, requestDevice(r, ""))
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidToken) {
+			httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "invalid refresh token"})
+			return
+		}
+
+		log.Error().Err(err).Msg("refresh token failed")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newTokenResponse(pair))
+}
+
+// Logout godoc
+// @Summary Log out of the current session
+// @Description Revokes the session of the access token, its refresh token stops working as well.
+// @Tags auth
+// @Security BearerAuth
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/logout [post]
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.RevokeSession(r.Context(), claims.UserID, claims.SessionID); err != nil &&
+		!errors.Is(err, entities.ErrNotFound) {
+		log.Error().Err(err).Str("session id", claims.SessionID).Msg("logout failed")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SessionResponse describes one signed-in device.
+// swagger:model SessionResponse
+type SessionResponse struct {
+	ID         string    `json:"id"         example:"session-123"`
+	DeviceName string    `json:"deviceName" example:"iPhone 15"`
+	UserAgent  string    `json:"userAgent"  example:"padel-ios/1.0"`
+	IPAddress  string    `json:"ipAddress"  example:"10.0.0.1"`
+	CreatedAt  time.Time `json:"createdAt"  example:"2025-11-01T10:00:00Z" format:"date-time"`
+	LastUsedAt time.Time `json:"lastUsedAt" example:"2025-11-02T10:00:00Z" format:"date-time"`
+	ExpiresAt  time.Time `json:"expiresAt"  example:"2025-12-02T10:00:00Z" format:"date-time"`
+	// Current is true for the session of the access token used for the request
+	Current bool `json:"current" example:"true"`
+}
+
+// ListSessionsResponse lists the active sessions of the user.
+// swagger:model ListSessionsResponse
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// ListSessions godoc
+// @Summary List my active sessions
+// @Tags auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} ListSessionsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/sessions [get]
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(r.Context(), claims.UserID)
+	if err != nil {
+		log.Error().Err(err).Str("user id", claims.UserID).Msg("failed to list sessions")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := ListSessionsResponse{Sessions: make([]SessionResponse, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, SessionResponse{
+			ID:         session.ID,
+			DeviceName: session.Device.Name,
+			UserAgent:  session.Device.UserAgent,
+			IPAddress:  session.Device.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == claims.SessionID,
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// RevokeSession godoc
+// @Summary Revoke one of my sessions
+// @Description Signs the device of the session out.
+// @Tags auth
+// @Security BearerAuth
+// @Param sessionID path string true "Session ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/sessions/{sessionID} [delete]
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	sessionID := chi.URLParam(r, "sessionID")
+
+	if err := h.authService.RevokeSession(r.Context(), claims.UserID, sessionID); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "session not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("session id", sessionID).Msg("failed to revoke session")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	log.Info().
+		Str("user id", claims.UserID).
+		Str("session id", sessionID).
+		Msg("session revoked")
+}
+
+// SetLevelRequest represents the expected payload for declaring a skill level.
+// swagger:model SetLevelRequest
+type SetLevelRequest struct {
+	// Level is on the padel scale, from 0 for a beginner to 7 for a professional
+	Level float64 `json:"level" example:"3.5"`
+}
+
+// SetLevel godoc
+// @Summary Set my skill level
+// @Description Declares the skill level of the current user, open matches admit players by it.
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Param level body SetLevelRequest true "Skill level"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/me/level [put]
+func (h *AuthHandler) SetLevel(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if err := h.authService.SetLevel(r.Context(), claims.UserID, req.Level); err != nil {
+		if errors.Is(err, entities.ErrInvalidSkillLevel) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		log.Error().Err(err).Str("user id", claims.UserID).Msg("failed to set level")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RequestOTPRequest represents the expected payload for requesting a code by SMS.
+// swagger:model RequestOTPRequest
+type RequestOTPRequest struct {
+	PhoneNumber string `json:"phoneNumber" example:"+77010000000"`
+	// Purpose is one of verify_phone, login or reset_password
+	Purpose string `json:"purpose" example:"login"`
+}
+
+// RequestOTP godoc
+// @Summary Send a one-time code by SMS
+// @Description Sends a code for phone verification, passwordless login or password reset. The response
+// @Description is the same whether or not the number is registered.
+// @Tags auth
+// @Accept json
+// @Param otp body RequestOTPRequest true "OTP request payload"
+// @Success 202
+// @Failure 400 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/otp [post]
+func (h *AuthHandler) RequestOTP(w http.ResponseWriter, r *http.Request) {
+	var req RequestOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.PhoneNumber == "" || req.Purpose == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "phoneNumber and purpose are required"})
+		return
+	}
+
+	if err := h.authService.RequestOTP(r.Context(), req.PhoneNumber, entities.OTPPurpose(req.Purpose)); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidOTPPurpose):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+				Message: "purpose must be one of verify_phone, login or reset_password",
+			})
+		case errors.Is(err, entities.ErrTooManyRequests):
+			httputil.JSON(w, http.StatusTooManyRequests, ErrorResponse{Message: "too many codes requested, try later"})
+		default:
+			log.Error().Err(err).Str("purpose", req.Purpose).Msg("request otp failed")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// VerifyPhoneRequest represents the expected payload for phone verification.
+// swagger:model VerifyPhoneRequest
+type VerifyPhoneRequest struct {
+	PhoneNumber string `json:"phoneNumber" example:"+77010000000"`
+	Code        string `json:"code"        example:"123456"`
+}
+
+// VerifyPhone godoc
+// @Summary Verify a phone number with the code sent by SMS
+// @Tags auth
+// @Accept json
+// @Param verify body VerifyPhoneRequest true "Verification payload"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/phone/verify [post]
+func (h *AuthHandler) VerifyPhone(w http.ResponseWriter, r *http.Request) {
+	var req VerifyPhoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.PhoneNumber == "" || req.Code == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "phoneNumber and code are required"})
+		return
+	}
+
+	if err := h.authService.VerifyPhone(r.Context(), req.PhoneNumber, req.Code); err != nil {
+		if errors.Is(err, entities.ErrInvalidOTP) {
+			httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "invalid or expired code"})
+			return
+		}
+
+		log.Error().Err(err).Msg("verify phone failed")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// OTPLoginRequest represents the expected payload for passwordless login.
+// swagger:model OTPLoginRequest
+type OTPLoginRequest struct {
+	PhoneNumber string `json:"phoneNumber"          example:"+77010000000"`
+	Code        string `json:"code"                 example:"123456"`
+	DeviceName  string `json:"deviceName,omitempty" example:"iPhone 15"`
+}
+
+// LoginViaOTP godoc
+// @Summary Login with a code sent by SMS
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param login body OTPLoginRequest true "OTP login payload"
+// @Success 200 {object} TokenResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/login/otp [post]
+func (h *AuthHandler) LoginViaOTP(w http.ResponseWriter, r *http.Request) {
+	var req OTPLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.PhoneNumber == "" || req.Code == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "phoneNumber and code are required"})
+		return
+	}
+
+	pair, err := h.authService.LoginViaOTP(r.Context(), req.PhoneNumber, req.Code, requestDevice(r, req.DeviceName))
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidOTP) {
+			httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "invalid or expired code"})
+			return
+		}
+
+		log.Error().Err(err).Msg("login via otp failed")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newTokenResponse(pair))
+}
+
+// ResetPasswordRequest represents the expected payload for resetting a password.
+// swagger:model ResetPasswordRequest
+type ResetPasswordRequest struct {
+	PhoneNumber string `json:"phoneNumber" example:"+77010000000"`
+	Code        string `json:"code"        example:"123456"`
+	NewPassword string `json:"newPassword" example:"new-super-secret"`
+}
+
+// ResetPassword godoc
+// @Summary Reset the password with a code sent by SMS
+// @Description Sets the new password and signs out every session of the user.
+// @Tags auth
+// @Accept json
+// @Param reset body ResetPasswordRequest true "Password reset payload"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.PhoneNumber == "" || req.Code == "" || req.NewPassword == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "phoneNumber, code and newPassword are required",
+		})
+		return
+	}
+
+	if err := h.authService.ResetPassword(r.Context(), req.PhoneNumber, req.Code, req.NewPassword); err != nil {
+		if errors.Is(err, entities.ErrInvalidOTP) {
+			httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "invalid or expired code"})
+			return
+		}
+
+		log.Error().Err(err).Msg("reset password failed")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// JSONWebKey is a public verification key in the RFC 7517 format.
+// swagger:model JSONWebKey
+type JSONWebKey struct {
+	Kty string `json:"kty"           example:"OKP"`
+	Kid string `json:"kid"           example:"2026-10"`
+	Use string `json:"use"           example:"sig"`
+	Alg string `json:"alg"           example:"EdDSA"`
+	Crv string `json:"crv,omitempty" example:"Ed25519"`
+	X   string `json:"x,omitempty"   example:"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"   example:"AQAB"`
+}
+
+// JWKSResponse is a JSON Web Key Set.
+// swagger:model JWKSResponse
+type JWKSResponse struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS godoc
+// @Summary Public keys for verifying access tokens
+// @Description Lists the public keys of the asymmetric signing keys, including retired keys whose tokens
+// @Description may still be valid. Tokens carry the id of their key in the kid header.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} JWKSResponse
+// @Router /.well-known/jwks.json [get]
+func (h *AuthHandler) JWKS(w http.ResponseWriter, _ *http.Request) {
+	resp := JWKSResponse{Keys: []JSONWebKey{}}
+
+	for _, key := range h.authService.PublicKeys() {
+		jwk := JSONWebKey{
+			Kid: key.ID,
+			Use: "sig",
+			Alg: key.Algorithm,
+		}
+
+		switch pub := key.Key.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+
+		resp.Keys = append(resp.Keys, jwk)
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// requestDevice describes the client of the request. RemoteAddr already holds the client address
+// when the request came through the RealIP middleware.
+func requestDevice(r *http.Request, name string) entities.Device {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+
+	return entities.Device{
+		Name:      name,
+		UserAgent: r.UserAgent(),
+		IPAddress: ip,
+	}
 }
 
 // RegisterUserRequest represents the expected payload for user registration.
@@ -101,6 +611,7 @@ type RegisterUserResponse struct {
 
 // RegisterUser godoc
 // @Summary Register a new user
+// @Description Creates the user and sends a code by SMS to verify the phone number.
 // @Tags auth
 // @Accept json
 // @Produce json
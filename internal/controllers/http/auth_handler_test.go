@@ -0,0 +1,71 @@
+package http_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeAuth struct {
+	httpPkg.AuthService
+
+	keys []entities.PublicKey
+}
+
+func (f fakeAuth) PublicKeys() []entities.PublicKey {
+	return f.keys
+}
+
+func TestAuthHandler_JWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	handler := httpPkg.NewAuthHandler(fakeAuth{keys: []entities.PublicKey{
+		{ID: "rs", Algorithm: "RS256", Key: &rsaKey.PublicKey},
+		{ID: "ed", Algorithm: "EdDSA", Key: edPublic},
+	}})
+
+	rec := httptest.NewRecorder()
+	handler.JWKS(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp httpPkg.JWKSResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Keys, 2)
+
+	rs := resp.Keys[0]
+	require.Equal(t, "RSA", rs.Kty)
+	require.Equal(t, "rs", rs.Kid)
+	require.Equal(t, "RS256", rs.Alg)
+
+	n, err := base64.RawURLEncoding.DecodeString(rs.N)
+	require.NoError(t, err)
+	require.Equal(t, 0, rsaKey.N.Cmp(new(big.Int).SetBytes(n)))
+
+	e, err := base64.RawURLEncoding.DecodeString(rs.E)
+	require.NoError(t, err)
+	require.Equal(t, int64(rsaKey.E), new(big.Int).SetBytes(e).Int64())
+
+	ed := resp.Keys[1]
+	require.Equal(t, "OKP", ed.Kty)
+	require.Equal(t, "Ed25519", ed.Crv)
+
+	x, err := base64.RawURLEncoding.DecodeString(ed.X)
+	require.NoError(t, err)
+	require.Equal(t, []byte(edPublic), x)
+}
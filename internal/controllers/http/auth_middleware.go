@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -11,7 +12,30 @@ import (
 )
 
 type TokenVerifier interface {
-	VerifyToken(token string) error
+	VerifyToken(ctx context.Context, token string) (*entities.Claims, error)
+}
+
+type claimsContextKey struct{}
+
+// ClaimsFromContext returns the identity put on the request context by the auth middleware.
+func ClaimsFromContext(ctx context.Context) (*entities.Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*entities.Claims)
+	return claims, ok && claims != nil
+}
+
+func withClaims(ctx context.Context, claims *entities.Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// requireClaims writes 401 and returns false when the request carries no authenticated user.
+func requireClaims(w http.ResponseWriter, r *http.Request) (*entities.Claims, bool) {
+	claims, ok := ClaimsFromContext(r.Context())
+	if !ok {
+		httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "unauthorized"})
+		return nil, false
+	}
+
+	return claims, true
 }
 
 func NewAuthMiddleware(verifier TokenVerifier) func(http.Handler) http.Handler {
@@ -31,7 +55,8 @@ func NewAuthMiddleware(verifier TokenVerifier) func(http.Handler) http.Handler {
 
 			token := strings.TrimSpace(parts[1])
 
-			if err := verifier.VerifyToken(token); err != nil {
+			claims, err := verifier.VerifyToken(r.Context(), token)
+			if err != nil {
 				if errors.Is(err, entities.ErrInvalidToken) || errors.Is(err, entities.ErrExpiredToken) {
 					httputil.JSON(w, http.StatusUnauthorized, ErrorResponse{Message: "invalid token"})
 					return
@@ -42,7 +67,7 @@ func NewAuthMiddleware(verifier TokenVerifier) func(http.Handler) http.Handler {
 				return
 			}
 
-			next.ServeHTTP(w, r)
+			next.ServeHTTP(w, r.WithContext(withClaims(r.Context(), claims)))
 		})
 	}
 }
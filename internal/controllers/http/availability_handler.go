@@ -0,0 +1,191 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type AvailabilityService interface {
+	GetCourtAvailability(
+		ctx context.Context,
+		organizationID, courtID string,
+		date time.Time,
+	) (*entities.CourtAvailability, error)
+	GetOrganizationAvailability(
+		ctx context.Context,
+		organizationID string,
+		date time.Time,
+	) ([]entities.CourtAvailability, error)
+}
+
+type AvailabilityHandler struct {
+	availabilityService AvailabilityService
+}
+
+func NewAvailabilityHandler(service AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		availabilityService: service,
+	}
+}
+
+// swagger:model SlotResponse
+type SlotResponse struct {
+	From time.Time `json:"from" example:"2025-11-04T18:00:00Z" format:"date-time"`
+	To   time.Time `json:"to"   example:"2025-11-04T19:00:00Z" format:"date-time"`
+	// Status is "free", "booked" or "blocked" by a blackout
+	Status string `json:"status" example:"free"`
+}
+
+// swagger:model CourtAvailabilityResponse
+type CourtAvailabilityResponse struct {
+	CourtID string         `json:"courtId" example:"court-123"`
+	Date    string         `json:"date"    example:"2025-11-04"`
+	Slots   []SlotResponse `json:"slots"`
+}
+
+// swagger:model OrganizationAvailabilityResponse
+type OrganizationAvailabilityResponse struct {
+	Courts []CourtAvailabilityResponse `json:"courts"`
+}
+
+func newCourtAvailabilityResponse(a entities.CourtAvailability) CourtAvailabilityResponse {
+	resp := CourtAvailabilityResponse{
+		CourtID: a.CourtID,
+		Date:    a.Date.Format(time.DateOnly),
+		Slots:   make([]SlotResponse, 0, len(a.Slots)),
+	}
+
+	for _, slot := range a.Slots {
+		resp.Slots = append(resp.Slots, SlotResponse{
+			From:   slot.From,
+			To:     slot.To,
+			Status: string(slot.Status),
+		})
+	}
+
+	return resp
+}
+
+// GetCourtAvailability godoc
+// @Summary Get court availability
+// @Description Returns free and booked slots of a court for a day, based on the court opening hours
+// @Tags availability
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param date query string true "Day in YYYY-MM-DD format" format:"date"
+// @Produce json
+// @Success 200 {object} CourtAvailabilityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/availability [get]
+func (h *AvailabilityHandler) GetCourtAvailability(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+
+	if orgID == "" || courtID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID and courtID are required",
+		})
+		return
+	}
+
+	date, ok := parseDateQuery(w, r)
+	if !ok {
+		return
+	}
+
+	availability, err := h.availabilityService.GetCourtAvailability(r.Context(), orgID, courtID, date)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "court not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Str("courtID", courtID).
+			Msg("failed to get court availability")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newCourtAvailabilityResponse(*availability))
+}
+
+// GetOrganizationAvailability godoc
+// @Summary Get organization availability
+// @Description Returns free and booked slots of every court of an organization for a day
+// @Tags availability
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param date query string true "Day in YYYY-MM-DD format" format:"date"
+// @Produce json
+// @Success 200 {object} OrganizationAvailabilityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/availability [get]
+func (h *AvailabilityHandler) GetOrganizationAvailability(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	if orgID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "orgID is required"})
+		return
+	}
+
+	date, ok := parseDateQuery(w, r)
+	if !ok {
+		return
+	}
+
+	courts, err := h.availabilityService.GetOrganizationAvailability(r.Context(), orgID, date)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Msg("failed to get organization availability")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := OrganizationAvailabilityResponse{
+		Courts: make([]CourtAvailabilityResponse, 0, len(courts)),
+	}
+
+	for _, c := range courts {
+		resp.Courts = append(resp.Courts, newCourtAvailabilityResponse(c))
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+func parseDateQuery(w http.ResponseWriter, r *http.Request) (time.Time, bool) {
+	dateStr := r.URL.Query().Get("date")
+	if dateStr == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "date query parameter is required (YYYY-MM-DD format)",
+		})
+		return time.Time{}, false
+	}
+
+	date, err := httputil.ParseDate(dateStr)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "invalid date format, expected YYYY-MM-DD",
+		})
+		return time.Time{}, false
+	}
+
+	return date, true
+}
@@ -0,0 +1,388 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type BlackoutService interface {
+	CreateBlackout(
+		ctx context.Context,
+		blackout *entities.Blackout,
+		actor entities.Actor,
+		cancelAffected bool,
+	) ([]entities.Reservation, error)
+	UpdateBlackout(
+		ctx context.Context,
+		blackout *entities.Blackout,
+		actor entities.Actor,
+		cancelAffected bool,
+	) ([]entities.Reservation, error)
+	GetBlackout(ctx context.Context, organizationID, blackoutID string) (*entities.Blackout, error)
+	ListBlackouts(ctx context.Context, organizationID string, from, to time.Time) ([]entities.Blackout, error)
+	DeleteBlackout(ctx context.Context, organizationID, blackoutID string) error
+	OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error)
+}
+
+type BlackoutHandler struct {
+	blackoutService BlackoutService
+}
+
+func NewBlackoutHandler(service BlackoutService) *BlackoutHandler {
+	return &BlackoutHandler{
+		blackoutService: service,
+	}
+}
+
+// swagger:model BlackoutRequest
+type BlackoutRequest struct {
+	// CourtID is the court closed, every court of the organization when empty
+	CourtID string `json:"courtId"        example:"court-456"`
+	// StartTime is the start of the blackout, in the time zone of the organization unless it has an offset
+	StartTime string `json:"startTime"      example:"2025-11-04T08:00" format:"date-time"`
+	// EndTime is the end of the blackout, in the time zone of the organization unless it has an offset
+	EndTime string `json:"endTime"        example:"2025-11-04T14:00" format:"date-time"`
+	Reason  string `json:"reason"         example:"resurfacing"`
+	// CancelAffected cancels the reservations the blackout overlaps, refunds them in full and notifies
+	// their players
+	CancelAffected bool `json:"cancelAffected" example:"false"`
+}
+
+// swagger:model BlackoutResponse
+type BlackoutResponse struct {
+	ID             string    `json:"id"                example:"blackout-123"`
+	OrganizationID string    `json:"organizationId"    example:"org-123"`
+	CourtID        string    `json:"courtId,omitempty" example:"court-456"`
+	StartTime      time.Time `json:"startTime"         example:"2025-11-04T08:00:00Z" format:"date-time"`
+	EndTime        time.Time `json:"endTime"           example:"2025-11-04T14:00:00Z" format:"date-time"`
+	Reason         string    `json:"reason"            example:"resurfacing"`
+	CreatedBy      string    `json:"createdBy"         example:"user-789"`
+	CreatedAt      time.Time `json:"createdAt"         example:"2025-11-01T10:00:00Z" format:"date-time"`
+	UpdatedAt      time.Time `json:"updatedAt"         example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newBlackoutResponse(b entities.Blackout) BlackoutResponse {
+	return BlackoutResponse{
+		ID:             b.ID,
+		OrganizationID: b.OrganizationID,
+		CourtID:        b.CourtID,
+		StartTime:      b.From,
+		EndTime:        b.To,
+		Reason:         b.Reason,
+		CreatedBy:      b.CreatedBy,
+		CreatedAt:      b.CreatedAt,
+		UpdatedAt:      b.UpdatedAt,
+	}
+}
+
+// swagger:model BlackoutImpactResponse
+type BlackoutImpactResponse struct {
+	Blackout BlackoutResponse `json:"blackout"`
+	// AffectedReservations are the active reservations the blackout overlaps, cancelled when asked to
+	AffectedReservations []ReservationResponse `json:"affectedReservations"`
+}
+
+func newBlackoutImpactResponse(b entities.Blackout, affected []entities.Reservation) BlackoutImpactResponse {
+	resp := BlackoutImpactResponse{
+		Blackout:             newBlackoutResponse(b),
+		AffectedReservations: make([]ReservationResponse, 0, len(affected)),
+	}
+
+	for _, rsv := range affected {
+		resp.AffectedReservations = append(resp.AffectedReservations, newReservationResponse(rsv))
+	}
+
+	return resp
+}
+
+// CreateBlackout godoc
+// @Summary Create a blackout
+// @Description Closes a court, or every court of the organization, for maintenance, an event or a private hire.
+// @Description No reservation can be made on the closed courts during the blackout. The active reservations it
+// @Description overlaps are returned, with cancelAffected they are cancelled, refunded in full and their players
+// @Description are notified. Staff only.
+// @Tags blackouts
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param blackout body BlackoutRequest true "Blackout"
+// @Success 201 {object} BlackoutImpactResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/blackouts [post]
+func (h *BlackoutHandler) CreateBlackout(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req BlackoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	from, to, ok := h.parseRange(w, r, orgID, req)
+	if !ok {
+		return
+	}
+
+	blackout := entities.NewBlackout(
+		orgID,
+		req.CourtID,
+		from,
+		to,
+		req.Reason,
+		claims.UserID,
+		time.Now().UTC(),
+	)
+	actor := entities.Actor{UserID: claims.UserID, Role: RoleFromContext(r.Context())}
+
+	affected, err := h.blackoutService.CreateBlackout(r.Context(), blackout, actor, req.CancelAffected)
+	if err != nil {
+		h.writeError(w, err, blackout, "failed to create blackout")
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newBlackoutImpactResponse(*blackout, affected))
+
+	log.Info().
+		Str("blackout_id", blackout.ID).
+		Str("organization_id", orgID).
+		Str("court_id", req.CourtID).
+		Int("affected_reservations", len(affected)).
+		Bool("cancel_affected", req.CancelAffected).
+		Msg("blackout created")
+}
+
+// UpdateBlackout godoc
+// @Summary Update a blackout
+// @Description Changes the court, the time range and the reason of the blackout. The active reservations the
+// @Description updated blackout overlaps are returned and, with cancelAffected, cancelled as on creation.
+// @Description Staff only.
+// @Tags blackouts
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param blackoutID path string true "Blackout ID"
+// @Param blackout body BlackoutRequest true "Blackout"
+// @Success 200 {object} BlackoutImpactResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/blackouts/{blackoutID} [put]
+func (h *BlackoutHandler) UpdateBlackout(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	blackoutID := chi.URLParam(r, "blackoutID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req BlackoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	from, to, ok := h.parseRange(w, r, orgID, req)
+	if !ok {
+		return
+	}
+
+	blackout := &entities.Blackout{
+		ID:             blackoutID,
+		OrganizationID: orgID,
+		CourtID:        req.CourtID,
+		From:           from,
+		To:             to,
+		Reason:         req.Reason,
+	}
+	actor := entities.Actor{UserID: claims.UserID, Role: RoleFromContext(r.Context())}
+
+	affected, err := h.blackoutService.UpdateBlackout(r.Context(), blackout, actor, req.CancelAffected)
+	if err != nil {
+		h.writeError(w, err, blackout, "failed to update blackout")
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newBlackoutImpactResponse(*blackout, affected))
+}
+
+// parseRange parses the time range of the request in the time zone of the organization and writes the error
+// response when it can't.
+func (h *BlackoutHandler) parseRange(
+	w http.ResponseWriter,
+	r *http.Request,
+	orgID string,
+	req BlackoutRequest,
+) (time.Time, time.Time, bool) {
+	loc, ok := resolveLocation(w, r, h.blackoutService.OrganizationLocation, "organization", orgID)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+
+	from, to, err := parseSlot(req.StartTime, req.EndTime, loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, true
+}
+
+func (h *BlackoutHandler) writeError(w http.ResponseWriter, err error, blackout *entities.Blackout, msg string) {
+	switch {
+	case errors.Is(err, entities.ErrInvalidBlackout):
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	case errors.Is(err, entities.ErrNotFound):
+		httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "blackout or court not found"})
+	default:
+		log.Error().
+			Err(err).
+			Str("blackout_id", blackout.ID).
+			Str("organization_id", blackout.OrganizationID).
+			Str("court_id", blackout.CourtID).
+			Msg(msg)
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// ListBlackouts godoc
+// @Summary List blackouts
+// @Description Returns the blackouts of the organization overlapping the time range, on any of its courts or
+// @Description on all of them, the earliest first.
+// @Tags blackouts
+// @Security BearerAuth
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param from query string true "Start time in RFC3339 format" format:"date-time"
+// @Param to query string true "End time in RFC3339 format" format:"date-time"
+// @Success 200 {array} BlackoutResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/blackouts [get]
+func (h *BlackoutHandler) ListBlackouts(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	loc, ok := resolveLocation(w, r, h.blackoutService.OrganizationLocation, "organization", orgID)
+	if !ok {
+		return
+	}
+
+	from, err := httputil.ParseTimeIn(r.URL.Query().Get("from"), loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid from time format, expected RFC3339"})
+		return
+	}
+
+	to, err := httputil.ParseTimeIn(r.URL.Query().Get("to"), loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid to time format, expected RFC3339"})
+		return
+	}
+
+	if !from.Before(to) {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "from must be before to"})
+		return
+	}
+
+	blackouts, err := h.blackoutService.ListBlackouts(r.Context(), orgID, from, to)
+	if err != nil {
+		log.Error().Err(err).Str("organization_id", orgID).Msg("failed to list blackouts")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]BlackoutResponse, 0, len(blackouts))
+	for _, b := range blackouts {
+		resp = append(resp, newBlackoutResponse(b))
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// GetBlackout godoc
+// @Summary Get a blackout
+// @Tags blackouts
+// @Security BearerAuth
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param blackoutID path string true "Blackout ID"
+// @Success 200 {object} BlackoutResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/blackouts/{blackoutID} [get]
+func (h *BlackoutHandler) GetBlackout(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	blackoutID := chi.URLParam(r, "blackoutID")
+
+	blackout, err := h.blackoutService.GetBlackout(r.Context(), orgID, blackoutID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "blackout not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("blackout_id", blackoutID).Msg("failed to get blackout")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newBlackoutResponse(*blackout))
+}
+
+// DeleteBlackout godoc
+// @Summary Delete a blackout
+// @Description Reopens the courts closed by the blackout. Reservations it cancelled stay cancelled. Staff only.
+// @Tags blackouts
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param blackoutID path string true "Blackout ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/blackouts/{blackoutID} [delete]
+func (h *BlackoutHandler) DeleteBlackout(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	blackoutID := chi.URLParam(r, "blackoutID")
+
+	if err := h.blackoutService.DeleteBlackout(r.Context(), orgID, blackoutID); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "blackout not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("blackout_id", blackoutID).Msg("failed to delete blackout")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	log.Info().Str("blackout_id", blackoutID).Str("organization_id", orgID).Msg("blackout deleted")
+}
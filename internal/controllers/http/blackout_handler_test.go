@@ -0,0 +1,229 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeBlackouts struct {
+	created        *entities.Blackout
+	actor          entities.Actor
+	cancelAffected bool
+	affected       []entities.Reservation
+	loc            *time.Location
+	err            error
+}
+
+func (f *fakeBlackouts) CreateBlackout(
+	_ context.Context,
+	blackout *entities.Blackout,
+	actor entities.Actor,
+	cancelAffected bool,
+) ([]entities.Reservation, error) {
+	if err := blackout.Validate(); err != nil {
+		return nil, err
+	}
+
+	f.created = blackout
+	f.actor = actor
+	f.cancelAffected = cancelAffected
+	return f.affected, f.err
+}
+
+func (f *fakeBlackouts) UpdateBlackout(
+	context.Context,
+	*entities.Blackout,
+	entities.Actor,
+	bool,
+) ([]entities.Reservation, error) {
+	return nil, f.err
+}
+
+func (f *fakeBlackouts) GetBlackout(context.Context, string, string) (*entities.Blackout, error) {
+	return nil, f.err
+}
+
+func (f *fakeBlackouts) ListBlackouts(context.Context, string, time.Time, time.Time) ([]entities.Blackout, error) {
+	return nil, f.err
+}
+
+func (f *fakeBlackouts) DeleteBlackout(context.Context, string, string) error {
+	return f.err
+}
+
+func (f *fakeBlackouts) OrganizationLocation(context.Context, string) (*time.Location, error) {
+	if f.loc == nil {
+		return time.UTC, nil
+	}
+
+	return f.loc, nil
+}
+
+func newBlackoutRouter(blackouts *fakeBlackouts) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Blackout: httpPkg.NewBlackoutHandler(blackouts)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+			"staff-token":  {UserID: "staff-1"},
+		},
+		fakeRoles{
+			"club-a/player-1": entities.PlayerRole,
+			"club-a/staff-1":  entities.StaffRole,
+		},
+	)
+}
+
+func TestBlackoutHandler_CreateBlackout(t *testing.T) {
+	const body = `{
+		"courtId": "court-1",
+		"startTime": "2025-11-04T08:00",
+		"endTime": "2025-11-04T14:00",
+		"reason": "resurfacing",
+		"cancelAffected": true
+	}`
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		token      string
+		body       string
+		loc        *time.Location
+		err        error
+		wantStatus int
+		wantFrom   time.Time
+	}{
+		{
+			name:       "staff closes the court",
+			token:      "staff-token",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 11, 4, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "local time of the club",
+			token:      "staff-token",
+			body:       body,
+			loc:        madrid,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 11, 4, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "player cannot close the court",
+			token:      "player-token",
+			body:       body,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "missing reason",
+			token:      "staff-token",
+			body:       `{"startTime": "2025-11-04T08:00", "endTime": "2025-11-04T14:00"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid time",
+			token:      "staff-token",
+			body:       `{"startTime": "tomorrow", "endTime": "2025-11-04T14:00", "reason": "tournament"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "court of another organization",
+			token:      "staff-token",
+			body:       body,
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blackouts := &fakeBlackouts{
+				loc: tt.loc,
+				err: tt.err,
+				affected: []entities.Reservation{{
+					ID:           "res-1",
+					CourtID:      "court-1",
+					Status:       entities.CancelledReservationStatus,
+					ReservedFrom: time.Date(2025, 11, 4, 10, 0, 0, 0, time.UTC),
+					ReservedTo:   time.Date(2025, 11, 4, 11, 0, 0, 0, time.UTC),
+					ReservedBy:   "player-2",
+				}},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/organizations/club-a/blackouts", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newBlackoutRouter(blackouts).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			require.Equal(t, "club-a", blackouts.created.OrganizationID)
+			require.Equal(t, "court-1", blackouts.created.CourtID)
+			require.True(t, tt.wantFrom.Equal(blackouts.created.From), blackouts.created.From)
+			require.Equal(t, "staff-1", blackouts.created.CreatedBy)
+			require.Equal(t, entities.StaffRole, blackouts.actor.Role)
+			require.True(t, blackouts.cancelAffected)
+
+			var resp httpPkg.BlackoutImpactResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, blackouts.created.ID, resp.Blackout.ID)
+			require.Equal(t, "resurfacing", resp.Blackout.Reason)
+			require.Len(t, resp.AffectedReservations, 1)
+			require.Equal(t, "cancelled", resp.AffectedReservations[0].Status)
+		})
+	}
+}
+
+func TestBlackoutHandler_DeleteBlackout(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "staff reopens the court",
+			token:      "staff-token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "player cannot reopen the court",
+			token:      "player-token",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "blackout not found",
+			token:      "staff-token",
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/v1/organizations/club-a/blackouts/blackout-1", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newBlackoutRouter(&fakeBlackouts{err: tt.err}).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
@@ -2,9 +2,13 @@ package http
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -16,8 +20,25 @@ import (
 type CourtService interface {
 	Create(ctx context.Context, court *entities.Court) error
 	GetByID(ctx context.Context, organizationID, courtID string) (*entities.Court, error)
-	ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error)
-	UpdateName(ctx context.Context, organizationID, courtID, name string) (*entities.Court, error)
+	ListByOrganizationID(
+		ctx context.Context,
+		organizationID string,
+		filter entities.CourtFilter,
+	) ([]entities.Court, error)
+	SearchCourts(ctx context.Context, city string, filter entities.CourtFilter) ([]entities.OrganizationCourts, error)
+	SearchAvailability(ctx context.Context, search entities.AvailabilitySearch) (entities.FreeCourtPage, error)
+	CityLocation(ctx context.Context, city string) (*time.Location, error)
+	UpdateDetails(
+		ctx context.Context,
+		organizationID, courtID string,
+		details entities.CourtDetails,
+	) (*entities.Court, error)
+	UpdateOpeningHours(
+		ctx context.Context,
+		organizationID, courtID string,
+		hours []entities.OpeningHours,
+		slotDuration time.Duration,
+	) (*entities.Court, error)
 }
 
 type CourtHandler struct {
@@ -30,9 +51,32 @@ func NewCourtHandler(service CourtService) *CourtHandler {
 	}
 }
 
+// OpeningHoursWindow is a single opening window of a court on a weekday.
+// swagger:model OpeningHoursWindow
+type OpeningHoursWindow struct {
+	// Weekday is the day of week, 0 is Sunday
+	Weekday  int    `json:"weekday"  example:"1"`
+	OpensAt  string `json:"opensAt"  example:"08:00"`
+	ClosesAt string `json:"closesAt" example:"23:00"`
+}
+
 // swagger:model CreateCourtRequest
 type CreateCourtRequest struct {
 	Name string `json:"name" example:"Court 1"`
+	// SlotMinutes is the availability slot granularity, 30 minutes by default
+	SlotMinutes int `json:"slotMinutes,omitempty"  example:"60"`
+	// MaxPlayers caps the roster of a reservation, the booker included, by default the size of a full game
+	// in the format of the court
+	MaxPlayers   int                  `json:"maxPlayers,omitempty"   example:"4"`
+	OpeningHours []OpeningHoursWindow `json:"openingHours,omitempty"`
+	// Environment is indoor or outdoor, not specified when omitted
+	Environment string `json:"environment,omitempty" example:"indoor"  enums:"indoor,outdoor"`
+	// Surface is artificial_grass, concrete or acrylic, not specified when omitted
+	Surface string `json:"surface,omitempty"     example:"artificial_grass" enums:"artificial_grass,concrete,acrylic"`
+	// Format is singles or doubles, doubles by default
+	Format    string `json:"format,omitempty"      example:"doubles" enums:"singles,doubles"`
+	Panoramic bool   `json:"panoramic,omitempty"   example:"true"`
+	Lighting  bool   `json:"lighting,omitempty"    example:"true"`
 }
 
 // swagger:model CreateCourtResponse
@@ -44,11 +88,183 @@ type CreateCourtResponse struct {
 
 // swagger:model CourtResponse
 type CourtResponse struct {
-	ID             string     `json:"id"                  example:"court-123"`
-	OrganizationID string     `json:"organizationId"      example:"org-456"`
-	Name           string     `json:"name"                example:"Court 1"`
-	CreatedAt      time.Time  `json:"createdAt"           example:"2025-11-01T10:00:00Z" format:"date-time"`
-	UpdatedAt      *time.Time `json:"updatedAt,omitempty" example:"2025-11-01T10:00:00Z" format:"date-time"`
+	ID             string               `json:"id"                  example:"court-123"`
+	OrganizationID string               `json:"organizationId"      example:"org-456"`
+	Name           string               `json:"name"                example:"Court 1"`
+	SlotMinutes    int                  `json:"slotMinutes"         example:"60"`
+	MaxPlayers     int                  `json:"maxPlayers"          example:"4"`
+	Environment    string               `json:"environment"         example:"indoor"`
+	Surface        string               `json:"surface"             example:"artificial_grass"`
+	Format         string               `json:"format"              example:"doubles"`
+	Panoramic      bool                 `json:"panoramic"           example:"true"`
+	Lighting       bool                 `json:"lighting"            example:"true"`
+	OpeningHours   []OpeningHoursWindow `json:"openingHours"`
+	CreatedAt      time.Time            `json:"createdAt"           example:"2025-11-01T10:00:00Z" format:"date-time"`
+	UpdatedAt      *time.Time           `json:"updatedAt,omitempty" example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newCourtResponse(c entities.Court) CourtResponse {
+	resp := CourtResponse{
+		ID:             c.ID,
+		OrganizationID: c.OrganizationID,
+		Name:           c.Name,
+		SlotMinutes:    int(c.SlotDuration / time.Minute),
+		MaxPlayers:     c.MaxPlayers,
+		Environment:    string(c.Environment),
+		Surface:        string(c.Surface),
+		Format:         string(c.Format),
+		Panoramic:      c.Panoramic,
+		Lighting:       c.Lighting,
+		OpeningHours:   make([]OpeningHoursWindow, 0, len(c.OpeningHours)),
+		CreatedAt:      c.CreatedAt,
+	}
+
+	for _, h := range c.OpeningHours {
+		resp.OpeningHours = append(resp.OpeningHours, OpeningHoursWindow{
+			Weekday:  int(h.Weekday),
+			OpensAt:  h.OpensAt.String(),
+			ClosesAt: h.ClosesAt.String(),
+		})
+	}
+
+	if !c.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &c.UpdatedAt
+	}
+
+	return resp
+}
+
+func parseOpeningHours(windows []OpeningHoursWindow) ([]entities.OpeningHours, error) {
+	hours := make([]entities.OpeningHours, 0, len(windows))
+
+	for _, w := range windows {
+		opensAt, err := entities.ParseTimeOfDay(w.OpensAt)
+		if err != nil {
+			return nil, err
+		}
+
+		closesAt, err := entities.ParseTimeOfDay(w.ClosesAt)
+		if err != nil {
+			return nil, err
+		}
+
+		hours = append(hours, entities.OpeningHours{
+			Weekday:  time.Weekday(w.Weekday),
+			OpensAt:  opensAt,
+			ClosesAt: closesAt,
+		})
+	}
+
+	return hours, nil
+}
+
+// parseCourtFilter reads the attribute filters of a court listing from the query.
+func parseCourtFilter(r *http.Request) (entities.CourtFilter, error) {
+	query := r.URL.Query()
+
+	panoramic, err := parseBoolQuery(query, "panoramic")
+	if err != nil {
+		return entities.CourtFilter{}, err
+	}
+
+	lighting, err := parseBoolQuery(query, "lighting")
+	if err != nil {
+		return entities.CourtFilter{}, err
+	}
+
+	return entities.CourtFilter{
+		Environment: entities.CourtEnvironment(query.Get("environment")),
+		Surface:     entities.CourtSurface(query.Get("surface")),
+		Format:      entities.CourtFormat(query.Get("format")),
+		Panoramic:   panoramic,
+		Lighting:    lighting,
+	}, nil
+}
+
+// parseBoolQuery reads an optional boolean query parameter, nil when it is not given.
+func parseBoolQuery(query url.Values, name string) (*bool, error) {
+	raw := query.Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a boolean", name)
+	}
+
+	return &v, nil
+}
+
+// parsePointQuery reads the optional lat and lng query parameters, nil when both are omitted.
+func parsePointQuery(query url.Values) (*entities.GeoPoint, error) {
+	latitude, err := parseFloatQuery(query, "lat")
+	if err != nil {
+		return nil, err
+	}
+
+	longitude, err := parseFloatQuery(query, "lng")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCoordinates(latitude, longitude)
+}
+
+// parseFloatQuery reads an optional number query parameter, nil when it is not given.
+func parseFloatQuery(query url.Values, name string) (*float64, error) {
+	raw := query.Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a number", name)
+	}
+
+	return &v, nil
+}
+
+// searchCursor is the JSON of the cursor of an availability search. Clients pass it back as it is, base64
+// encoded, so its fields may change between releases.
+type searchCursor struct {
+	From           time.Time `json:"f"`
+	Distance       *float64  `json:"d,omitempty"`
+	OrganizationID string    `json:"o"`
+	CourtName      string    `json:"n"`
+	CourtID        string    `json:"c"`
+}
+
+func encodeSearchCursor(c entities.SearchCursor) (string, error) {
+	raw, err := json.Marshal(searchCursor(c))
+	if err != nil {
+		return "", fmt.Errorf("marshal search cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// parseSearchCursor reads the optional after query parameter, nil when it is not given.
+func parseSearchCursor(query url.Values) (*entities.SearchCursor, error) {
+	raw := query.Get("after")
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, errors.New("after must be a cursor returned by the search")
+	}
+
+	var c searchCursor
+	if err := json.Unmarshal(decoded, &c); err != nil || c.CourtID == "" {
+		return nil, errors.New("after must be a cursor returned by the search")
+	}
+
+	cursor := entities.SearchCursor(c)
+
+	return &cursor, nil
 }
 
 // swagger:model ListCourtsResponse
@@ -56,9 +272,51 @@ type ListCourtsResponse struct {
 	Courts []CourtResponse `json:"courts"`
 }
 
+// OrganizationCourtsResponse are the matching courts of an organization.
+// swagger:model OrganizationCourtsResponse
+type OrganizationCourtsResponse struct {
+	Organization OrganizationResponse `json:"organization"`
+	Courts       []CourtResponse      `json:"courts"`
+}
+
+// swagger:model SearchCourtsResponse
+type SearchCourtsResponse struct {
+	Organizations []OrganizationCourtsResponse `json:"organizations"`
+}
+
+// FreeCourtResponse is a court free to book from StartTime to EndTime, in the time zone of its organization.
+// swagger:model FreeCourtResponse
+type FreeCourtResponse struct {
+	OrganizationID   string        `json:"organizationId"   example:"org-456"`
+	OrganizationName string        `json:"organizationName" example:"Padel Club"`
+	Court            CourtResponse `json:"court"`
+	StartTime        time.Time     `json:"startTime"        example:"2025-11-04T19:00:00+05:00" format:"date-time"`
+	EndTime          time.Time     `json:"endTime"          example:"2025-11-04T20:30:00+05:00" format:"date-time"`
+	// Distance from the searched point in meters, omitted when no point was given or the organization has no
+	// coordinates
+	Distance *float64 `json:"distance,omitempty" example:"1250.5"`
+}
+
+// swagger:model SearchAvailabilityResponse
+type SearchAvailabilityResponse struct {
+	Courts []FreeCourtResponse `json:"courts"`
+	// NextCursor is passed as after to get the next page, omitted on the last page
+	NextCursor string `json:"nextCursor,omitempty" example:"eyJmIjoiMjAyNS0xMS0wNFQxNDowMDowMFoifQ"`
+}
+
 // swagger:model UpdateCourtRequest
 type UpdateCourtRequest struct {
-	Name string `json:"name" example:"Updated Court Name"`
+	// Name is the new name, the current one is kept when omitted
+	Name string `json:"name,omitempty" example:"Updated Court Name"`
+	// MaxPlayers caps the roster of a reservation, the current value is kept when omitted
+	MaxPlayers int `json:"maxPlayers,omitempty" example:"4"`
+	// Environment, Surface and Format keep the current value when omitted
+	Environment string `json:"environment,omitempty" example:"indoor"           enums:"indoor,outdoor"`
+	Surface     string `json:"surface,omitempty"     example:"artificial_grass" enums:"artificial_grass,concrete,acrylic"`
+	Format      string `json:"format,omitempty"      example:"doubles"          enums:"singles,doubles"`
+	// Panoramic and Lighting keep the current value when omitted
+	Panoramic *bool `json:"panoramic,omitempty" example:"true"`
+	Lighting  *bool `json:"lighting,omitempty"  example:"true"`
 }
 
 // CreateCourt godoc
@@ -72,6 +330,7 @@ type UpdateCourtRequest struct {
 // @Param court body CreateCourtRequest true "Court creation payload"
 // @Success 201 {object} CreateCourtResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 500
 // @Router /v1/organizations/{orgID}/courts [post]
 func (h *CourtHandler) CreateCourt(w http.ResponseWriter, r *http.Request) {
@@ -98,9 +357,49 @@ func (h *CourtHandler) CreateCourt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.SlotMinutes < 0 {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "slotMinutes must be positive",
+		})
+		return
+	}
+
+	if req.MaxPlayers < 0 {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "maxPlayers must be positive",
+		})
+		return
+	}
+
+	hours, err := parseOpeningHours(req.OpeningHours)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
 	court := entities.NewCourt(orgID, req.Name)
+	court.OpeningHours = hours
+	court.Environment = entities.CourtEnvironment(req.Environment)
+	court.Surface = entities.CourtSurface(req.Surface)
+	court.Panoramic = req.Panoramic
+	court.Lighting = req.Lighting
+	if req.Format != "" {
+		court.Format = entities.CourtFormat(req.Format)
+	}
+	if req.SlotMinutes > 0 {
+		court.SlotDuration = time.Duration(req.SlotMinutes) * time.Minute
+	}
+	court.MaxPlayers = court.Format.Players()
+	if req.MaxPlayers > 0 {
+		court.MaxPlayers = req.MaxPlayers
+	}
 
 	if err := h.courtService.Create(r.Context(), court); err != nil {
+		if errors.Is(err, entities.ErrInvalidOpeningHours) || errors.Is(err, entities.ErrInvalidCourtAttributes) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
 		log.Error().Err(err).Str("orgID", orgID).Msg("failed to create court")
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -163,18 +462,7 @@ func (h *CourtHandler) GetCourt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := CourtResponse{
-		ID:             court.ID,
-		OrganizationID: court.OrganizationID,
-		Name:           court.Name,
-		CreatedAt:      court.CreatedAt,
-	}
-
-	if !court.UpdatedAt.IsZero() {
-		resp.UpdatedAt = &court.UpdatedAt
-	}
-
-	httputil.JSON(w, http.StatusOK, resp)
+	httputil.JSON(w, http.StatusOK, newCourtResponse(*court))
 
 	log.Info().
 		Str("orgID", orgID).
@@ -184,10 +472,15 @@ func (h *CourtHandler) GetCourt(w http.ResponseWriter, r *http.Request) {
 
 // ListCourts godoc
 // @Summary List all courts for an organization
-// @Description Returns all courts belonging to the specified organization
+// @Description Returns the courts belonging to the specified organization, narrowed by the attribute filters
 // @Tags courts
 // @Security BearerAuth
 // @Param orgID path string true "Organization ID"
+// @Param environment query string false "Indoor or outdoor" Enums(indoor, outdoor)
+// @Param surface query string false "Court surface" Enums(artificial_grass, concrete, acrylic)
+// @Param format query string false "Singles or doubles" Enums(singles, doubles)
+// @Param panoramic query bool false "Only panoramic courts when true, only the others when false"
+// @Param lighting query bool false "Only lit courts when true, only the others when false"
 // @Produce json
 // @Success 200 {object} ListCourtsResponse
 // @Failure 400 {object} ErrorResponse
@@ -203,8 +496,19 @@ func (h *CourtHandler) ListCourts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	courts, err := h.courtService.ListByOrganizationID(r.Context(), orgID)
+	filter, err := parseCourtFilter(r)
 	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	courts, err := h.courtService.ListByOrganizationID(r.Context(), orgID, filter)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCourtAttributes) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
 		log.Error().
 			Err(err).
 			Str("orgID", orgID).
@@ -216,18 +520,7 @@ func (h *CourtHandler) ListCourts(w http.ResponseWriter, r *http.Request) {
 
 	dtos := make([]CourtResponse, 0, len(courts))
 	for _, c := range courts {
-		resp := CourtResponse{
-			ID:             c.ID,
-			OrganizationID: c.OrganizationID,
-			Name:           c.Name,
-			CreatedAt:      c.CreatedAt,
-		}
-
-		if !c.UpdatedAt.IsZero() {
-			resp.UpdatedAt = &c.UpdatedAt
-		}
-
-		dtos = append(dtos, resp)
+		dtos = append(dtos, newCourtResponse(c))
 	}
 
 	httputil.JSON(w, http.StatusOK, ListCourtsResponse{Courts: dtos})
@@ -238,9 +531,215 @@ func (h *CourtHandler) ListCourts(w http.ResponseWriter, r *http.Request) {
 		Msg("successfully listed courts")
 }
 
+// SearchCourts godoc
+// @Summary Search courts in a city
+// @Description Returns the courts matching the attribute filters at the organizations of the city, grouped by
+// @Description organization. Organizations without a matching court are left out.
+// @Tags courts
+// @Security BearerAuth
+// @Param city query string true "City name"
+// @Param environment query string false "Indoor or outdoor" Enums(indoor, outdoor)
+// @Param surface query string false "Court surface" Enums(artificial_grass, concrete, acrylic)
+// @Param format query string false "Singles or doubles" Enums(singles, doubles)
+// @Param panoramic query bool false "Only panoramic courts when true, only the others when false"
+// @Param lighting query bool false "Only lit courts when true, only the others when false"
+// @Produce json
+// @Success 200 {object} SearchCourtsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/courts [get]
+func (h *CourtHandler) SearchCourts(w http.ResponseWriter, r *http.Request) {
+	city := r.URL.Query().Get("city")
+	if city == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "city query parameter is required"})
+		return
+	}
+
+	filter, err := parseCourtFilter(r)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	found, err := h.courtService.SearchCourts(r.Context(), city, filter)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCourtAttributes) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		log.Error().Err(err).Str("city", city).Msg("failed to search courts")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := SearchCourtsResponse{
+		Organizations: make([]OrganizationCourtsResponse, 0, len(found)),
+	}
+
+	for _, oc := range found {
+		courts := make([]CourtResponse, 0, len(oc.Courts))
+		for _, c := range oc.Courts {
+			courts = append(courts, newCourtResponse(c))
+		}
+
+		resp.Organizations = append(resp.Organizations, OrganizationCourtsResponse{
+			Organization: newOrganizationResponse(oc.Organization),
+			Courts:       courts,
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+	log.Info().Str("city", city).Int("organizations", len(found)).Msg("searched courts")
+}
+
+// SearchAvailability godoc
+// @Summary Search free courts in a city
+// @Description Returns the courts at the organizations of the city that are free to book for the duration from
+// @Description a start between from and from plus the window, the earliest start first. Starts lie on the slot
+// @Description grid of each court, counted from midnight in the time zone of its club, and starts outside its
+// @Description opening hours or refused by its booking rules are left out. When lat and lng are given, courts with
+// @Description the same start are ranked by the distance of their organization from the point. A page holds at
+// @Description most limit courts; pass its nextCursor as after to get the next one.
+// @Tags courts
+// @Security BearerAuth
+// @Param city query string true "City name"
+// @Param from query string true "Earliest start, RFC3339 or YYYY-MM-DDTHH:MM in the time zone of the city"
+// @Param duration query int true "Duration of the game in minutes"
+// @Param window query int false "Minutes after from in which a game may start, 120 by default"
+// @Param lat query number false "Latitude of the player, given together with lng"
+// @Param lng query number false "Longitude of the player, given together with lat"
+// @Param environment query string false "Indoor or outdoor" Enums(indoor, outdoor)
+// @Param surface query string false "Court surface" Enums(artificial_grass, concrete, acrylic)
+// @Param format query string false "Singles or doubles" Enums(singles, doubles)
+// @Param panoramic query bool false "Only panoramic courts when true, only the others when false"
+// @Param lighting query bool false "Only lit courts when true, only the others when false"
+// @Param limit query int false "Courts per page, 50 by default and 200 at most"
+// @Param after query string false "nextCursor of the previous page"
+// @Produce json
+// @Success 200 {object} SearchAvailabilityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/search/availability [get]
+func (h *CourtHandler) SearchAvailability(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	city := query.Get("city")
+
+	loc, err := h.courtService.CityLocation(r.Context(), city)
+	if err != nil {
+		log.Error().Err(err).Str("city", city).Msg("failed to get city time zone")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	from, err := httputil.ParseTimeIn(query.Get("from"), loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "from must be a valid time"})
+		return
+	}
+
+	duration, err := strconv.Atoi(query.Get("duration"))
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "duration must be a number of minutes"})
+		return
+	}
+
+	window := entities.DefaultSearchWindow
+	if raw := query.Get("window"); raw != "" {
+		minutes, err := strconv.Atoi(raw)
+		if err != nil {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "window must be a number of minutes"})
+			return
+		}
+
+		window = time.Duration(minutes) * time.Minute
+	}
+
+	filter, err := parseCourtFilter(r)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	near, err := parsePointQuery(query)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	limit := entities.DefaultSearchLimit
+	if raw := query.Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "limit must be a number"})
+			return
+		}
+	}
+
+	after, err := parseSearchCursor(query)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	search := entities.AvailabilitySearch{
+		City:     city,
+		From:     from,
+		Duration: time.Duration(duration) * time.Minute,
+		Window:   window,
+		Filter:   filter,
+		Near:     near,
+		Limit:    limit,
+		After:    after,
+	}
+
+	page, err := h.courtService.SearchAvailability(r.Context(), search)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidAvailabilitySearch) ||
+			errors.Is(err, entities.ErrInvalidCourtAttributes) ||
+			errors.Is(err, entities.ErrInvalidCoordinates) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		log.Error().Err(err).Str("city", search.City).Msg("failed to search availability")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := SearchAvailabilityResponse{
+		Courts: make([]FreeCourtResponse, 0, len(page.Courts)),
+	}
+
+	if page.Next != nil {
+		resp.NextCursor, err = encodeSearchCursor(*page.Next)
+		if err != nil {
+			log.Error().Err(err).Str("city", search.City).Msg("failed to encode search cursor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	for _, f := range page.Courts {
+		loc := f.Organization.Location()
+
+		resp.Courts = append(resp.Courts, FreeCourtResponse{
+			OrganizationID:   f.Organization.ID,
+			OrganizationName: f.Organization.Name,
+			Court:            newCourtResponse(f.Court),
+			StartTime:        f.From.In(loc),
+			EndTime:          f.To.In(loc),
+			Distance:         f.Distance,
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+	log.Info().Str("city", search.City).Int("count", len(page.Courts)).Msg("searched availability")
+}
+
 // UpdateCourt godoc
 // @Summary Update a court
-// @Description Updates an existing court's information
+// @Description Updates an existing court's information. Omitted fields keep their current value.
 // @Tags courts
 // @Security BearerAuth
 // @Param orgID path string true "Organization ID"
@@ -250,6 +749,7 @@ func (h *CourtHandler) ListCourts(w http.ResponseWriter, r *http.Request) {
 // @Param court body UpdateCourtRequest true "Court update payload"
 // @Success 200 {object} CourtResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500
 // @Router /v1/organizations/{orgID}/courts/{courtID} [put]
@@ -271,15 +771,28 @@ func (h *CourtHandler) UpdateCourt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Name == "" {
+	if req.MaxPlayers < 0 {
 		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
-			Message: "name is required",
+			Message: "maxPlayers must be positive",
 		})
 		return
 	}
 
-	updatedCourt, err := h.courtService.UpdateName(r.Context(), orgID, courtID, req.Name)
+	updatedCourt, err := h.courtService.UpdateDetails(r.Context(), orgID, courtID, entities.CourtDetails{
+		Name:        req.Name,
+		MaxPlayers:  req.MaxPlayers,
+		Environment: entities.CourtEnvironment(req.Environment),
+		Surface:     entities.CourtSurface(req.Surface),
+		Format:      entities.CourtFormat(req.Format),
+		Panoramic:   req.Panoramic,
+		Lighting:    req.Lighting,
+	})
 	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCourtAttributes) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
 		if errors.Is(err, entities.ErrNotFound) {
 			httputil.JSON(w, http.StatusNotFound, ErrorResponse{
 				Message: "court not found",
@@ -292,21 +805,98 @@ func (h *CourtHandler) UpdateCourt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := CourtResponse{
-		ID:             updatedCourt.ID,
-		OrganizationID: updatedCourt.OrganizationID,
-		Name:           updatedCourt.Name,
-		CreatedAt:      updatedCourt.CreatedAt,
+	httputil.JSON(w, http.StatusOK, newCourtResponse(*updatedCourt))
+
+	log.Info().
+		Str("orgID", orgID).
+		Str("courtID", courtID).
+		Msg("court updated successfully")
+}
+
+// swagger:model UpdateOpeningHoursRequest
+type UpdateOpeningHoursRequest struct {
+	// SlotMinutes is the availability slot granularity, the current value is kept when omitted
+	SlotMinutes  int                  `json:"slotMinutes,omitempty" example:"60"`
+	OpeningHours []OpeningHoursWindow `json:"openingHours"`
+}
+
+// UpdateOpeningHours godoc
+// @Summary Update court opening hours
+// @Description Replaces the weekly opening hours and the slot granularity of a court
+// @Tags courts
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Accept json
+// @Produce json
+// @Param hours body UpdateOpeningHoursRequest true "Opening hours payload"
+// @Success 200 {object} CourtResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/opening-hours [put]
+func (h *CourtHandler) UpdateOpeningHours(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+
+	if orgID == "" || courtID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID and courtID are required",
+		})
+		return
 	}
 
-	if !updatedCourt.UpdatedAt.IsZero() {
-		resp.UpdatedAt = &updatedCourt.UpdatedAt
+	var req UpdateOpeningHoursRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().Err(err).Str("courtID", courtID).Msg("failed to decode update opening hours request")
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
 	}
 
-	httputil.JSON(w, http.StatusOK, resp)
+	if req.SlotMinutes < 0 {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "slotMinutes must be positive",
+		})
+		return
+	}
+
+	hours, err := parseOpeningHours(req.OpeningHours)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	updatedCourt, err := h.courtService.UpdateOpeningHours(
+		r.Context(),
+		orgID,
+		courtID,
+		hours,
+		time.Duration(req.SlotMinutes)*time.Minute,
+	)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidOpeningHours) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{
+				Message: "court not found",
+			})
+			return
+		}
+
+		log.Error().Err(err).Str("courtID", courtID).Msg("failed to update court opening hours")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newCourtResponse(*updatedCourt))
 
 	log.Info().
 		Str("orgID", orgID).
 		Str("courtID", courtID).
-		Msg("court updated successfully")
+		Int("windows", len(hours)).
+		Msg("court opening hours updated successfully")
 }
@@ -0,0 +1,342 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func newCourtRouter(courts *fakeCourts) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Court: httpPkg.NewCourtHandler(courts)},
+		fakeVerifier{"manager-token": {UserID: "manager-1"}},
+		fakeRoles{"club-a/manager-1": entities.ManagerRole},
+	)
+}
+
+func TestCreateCourt_Attributes(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		wantStatus     int
+		wantMaxPlayers int
+		wantAttributes entities.CourtAttributes
+	}{
+		{
+			name:           "singles court defaults to two players",
+			body:           `{"name":"Court 1","environment":"indoor","format":"singles","panoramic":true}`,
+			wantStatus:     http.StatusCreated,
+			wantMaxPlayers: 2,
+			wantAttributes: entities.CourtAttributes{
+				Environment: entities.IndoorCourtEnvironment,
+				Format:      entities.SinglesCourtFormat,
+				Panoramic:   true,
+			},
+		},
+		{
+			name:           "format defaults to doubles",
+			body:           `{"name":"Court 1","surface":"concrete","lighting":true}`,
+			wantStatus:     http.StatusCreated,
+			wantMaxPlayers: entities.DefaultMaxPlayers,
+			wantAttributes: entities.CourtAttributes{
+				Surface:  entities.ConcreteCourtSurface,
+				Format:   entities.DoublesCourtFormat,
+				Lighting: true,
+			},
+		},
+		{
+			name:       "unknown surface",
+			body:       `{"name":"Court 1","surface":"clay"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courts := &fakeCourts{}
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/organizations/club-a/courts", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer manager-token")
+
+			rec := httptest.NewRecorder()
+			newCourtRouter(courts).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus == http.StatusCreated {
+				require.Equal(t, tt.wantMaxPlayers, courts.created.MaxPlayers)
+				require.Equal(t, tt.wantAttributes, courts.created.CourtAttributes)
+			}
+		})
+	}
+}
+
+func TestListCourts_Filter(t *testing.T) {
+	yes := true
+	no := false
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantFilter entities.CourtFilter
+	}{
+		{
+			name:       "no filters",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "attributes and flags",
+			query:      "?environment=outdoor&format=doubles&panoramic=true&lighting=false",
+			wantStatus: http.StatusOK,
+			wantFilter: entities.CourtFilter{
+				Environment: entities.OutdoorCourtEnvironment,
+				Format:      entities.DoublesCourtFormat,
+				Panoramic:   &yes,
+				Lighting:    &no,
+			},
+		},
+		{
+			name:       "flag is not a boolean",
+			query:      "?lighting=sometimes",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown environment",
+			query:      "?environment=underwater",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courts := &fakeCourts{}
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/organizations/club-a/courts"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer manager-token")
+
+			rec := httptest.NewRecorder()
+			newCourtRouter(courts).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus == http.StatusOK {
+				require.Equal(t, tt.wantFilter, courts.filter)
+			}
+		})
+	}
+}
+
+func TestSearchCourts(t *testing.T) {
+	courts := &fakeCourts{
+		found: []entities.OrganizationCourts{
+			{
+				Organization: entities.Organization{ID: "club-a", Name: "Club A", City: "Almaty"},
+				Courts: []entities.Court{
+					{
+						ID:              "court-1",
+						OrganizationID:  "club-a",
+						CourtAttributes: entities.CourtAttributes{Environment: entities.IndoorCourtEnvironment},
+					},
+				},
+			},
+		},
+	}
+	router := newCourtRouter(courts)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/courts?environment=indoor", nil)
+	req.Header.Set("Authorization", "Bearer manager-token")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/courts?city=Almaty&environment=indoor", nil)
+	req.Header.Set("Authorization", "Bearer manager-token")
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, entities.CourtFilter{Environment: entities.IndoorCourtEnvironment}, courts.filter)
+
+	var resp httpPkg.SearchCourtsResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Organizations, 1)
+	require.Equal(t, "club-a", resp.Organizations[0].Organization.ID)
+	require.Len(t, resp.Organizations[0].Courts, 1)
+	require.Equal(t, "indoor", resp.Organizations[0].Courts[0].Environment)
+}
+
+func TestSearchAvailability(t *testing.T) {
+	from := time.Date(2031, 3, 5, 14, 0, 0, 0, time.UTC)
+
+	courts := &fakeCourts{
+		free: entities.FreeCourtPage{
+			Courts: []entities.FreeCourt{
+				{
+					Organization: entities.Organization{ID: "club-a", Name: "Club A", TimeZone: "Asia/Almaty"},
+					Court:        entities.Court{ID: "court-1", OrganizationID: "club-a"},
+					From:         from,
+					To:           from.Add(90 * time.Minute),
+				},
+			},
+		},
+	}
+	router := newCourtRouter(courts)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "no city", query: "?from=2031-03-05T14:00:00Z&duration=90", wantStatus: http.StatusBadRequest},
+		{name: "bad from", query: "?city=Almaty&from=tonight&duration=90", wantStatus: http.StatusBadRequest},
+		{name: "no duration", query: "?city=Almaty&from=2031-03-05T14:00:00Z", wantStatus: http.StatusBadRequest},
+		{
+			name:       "window too wide",
+			query:      "?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&window=1440",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "limit too high",
+			query:      "?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&limit=1000",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "bad limit",
+			query:      "?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&limit=all",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "bad cursor",
+			query:      "?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&after=page-2",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "latitude without longitude",
+			query:      "?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&lat=43.2389",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "free courts",
+			query:      "?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&lighting=true",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/search/availability"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer manager-token")
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+
+	lit := true
+	require.Equal(t, entities.AvailabilitySearch{
+		City:     "Almaty",
+		From:     from,
+		Duration: 90 * time.Minute,
+		Window:   entities.DefaultSearchWindow,
+		Filter:   entities.CourtFilter{Lighting: &lit},
+		Limit:    entities.DefaultSearchLimit,
+	}, courts.search)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/v1/search/availability?city=Almaty&from=2031-03-05T14:00:00Z&duration=90",
+		nil,
+	)
+	req.Header.Set("Authorization", "Bearer manager-token")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var raw struct {
+		Courts []struct {
+			OrganizationID string `json:"organizationId"`
+			StartTime      string `json:"startTime"`
+			EndTime        string `json:"endTime"`
+		} `json:"courts"`
+		NextCursor *string `json:"nextCursor"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&raw))
+	require.Nil(t, raw.NextCursor, "the last page has no cursor")
+	require.Len(t, raw.Courts, 1)
+	require.Equal(t, "club-a", raw.Courts[0].OrganizationID)
+	require.Equal(t, "2031-03-05T19:00:00+05:00", raw.Courts[0].StartTime)
+	require.Equal(t, "2031-03-05T20:30:00+05:00", raw.Courts[0].EndTime)
+
+	// a start without an offset is read in the time zone of the city
+	almaty, err := time.LoadLocation("Asia/Almaty")
+	require.NoError(t, err)
+	courts.loc = almaty
+
+	req = httptest.NewRequest(
+		http.MethodGet,
+		"/v1/search/availability?city=Almaty&from=2031-03-05T19:00&duration=90",
+		nil,
+	)
+	req.Header.Set("Authorization", "Bearer manager-token")
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.True(t, from.Equal(courts.search.From), courts.search.From)
+}
+
+func TestSearchAvailability_NextPage(t *testing.T) {
+	from := time.Date(2031, 3, 5, 14, 0, 0, 0, time.UTC)
+	distance := 1250.5
+	next := entities.SearchCursor{
+		From:           from,
+		Distance:       &distance,
+		OrganizationID: "club-a",
+		CourtName:      "Court 1",
+		CourtID:        "court-1",
+	}
+
+	courts := &fakeCourts{free: entities.FreeCourtPage{Next: &next}}
+	router := newCourtRouter(courts)
+
+	search := func(query string) httpPkg.SearchAvailabilityResponse {
+		req := httptest.NewRequest(http.MethodGet, "/v1/search/availability"+query, nil)
+		req.Header.Set("Authorization", "Bearer manager-token")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp httpPkg.SearchAvailabilityResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+
+		return resp
+	}
+
+	first := search("?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&limit=1")
+	require.NotEmpty(t, first.NextCursor)
+	require.Equal(t, 1, courts.search.Limit)
+	require.Nil(t, courts.search.After)
+
+	// the cursor of a page is passed back as it is to get the next one
+	search("?city=Almaty&from=2031-03-05T14:00:00Z&duration=90&limit=1&after=" + first.NextCursor)
+	require.NotNil(t, courts.search.After)
+	require.True(t, from.Equal(courts.search.After.From))
+	require.Equal(t, &distance, courts.search.After.Distance)
+	require.Equal(t, "court-1", courts.search.After.CourtID)
+	require.Equal(t, "Court 1", courts.search.After.CourtName)
+	require.Equal(t, "club-a", courts.search.After.OrganizationID)
+}
@@ -0,0 +1,401 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type MatchService interface {
+	OpenMatch(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+		match *entities.OpenMatch,
+	) error
+	CloseMatch(ctx context.Context, organizationID, courtID, reservationID, userID string) error
+	JoinMatch(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+	) (*entities.ReservationPlayer, error)
+	ApproveRequest(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error
+	RejectRequest(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error
+	SearchMatches(ctx context.Context, city string, date time.Time, level *float64) ([]entities.MatchListing, error)
+}
+
+type MatchHandler struct {
+	matchService MatchService
+}
+
+func NewMatchHandler(service MatchService) *MatchHandler {
+	return &MatchHandler{
+		matchService: service,
+	}
+}
+
+// swagger:model OpenMatchRequest
+type OpenMatchRequest struct {
+	MinLevel       float64 `json:"minLevel"       example:"2.5"`
+	MaxLevel       float64 `json:"maxLevel"       example:"4"`
+	MissingPlayers int     `json:"missingPlayers" example:"2"`
+	// AutoAccept lets players join right away, otherwise the booker approves every request to join
+	AutoAccept bool `json:"autoAccept" example:"true"`
+}
+
+// swagger:model MatchResponse
+type MatchResponse struct {
+	ReservationID  string  `json:"reservationId"  example:"res-123"`
+	MinLevel       float64 `json:"minLevel"       example:"2.5"`
+	MaxLevel       float64 `json:"maxLevel"       example:"4"`
+	MissingPlayers int     `json:"missingPlayers" example:"2"`
+	AutoAccept     bool    `json:"autoAccept"     example:"true"`
+}
+
+func newMatchResponse(m entities.OpenMatch) MatchResponse {
+	return MatchResponse{
+		ReservationID:  m.ReservationID,
+		MinLevel:       m.MinLevel,
+		MaxLevel:       m.MaxLevel,
+		MissingPlayers: m.MissingPlayers,
+		AutoAccept:     m.AutoAccept,
+	}
+}
+
+// swagger:model MatchListingResponse
+type MatchListingResponse struct {
+	OrganizationID string              `json:"organizationId" example:"org-123"`
+	Match          MatchResponse       `json:"match"`
+	Reservation    ReservationResponse `json:"reservation"`
+}
+
+// OpenMatch godoc
+// @Summary Open a match
+// @Description Publishes a reservation that misses players, so other players of the level range can join it.
+// @Description Opening a match that is already open replaces its settings. Only the booker opens a match.
+// @Tags matches
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param match body OpenMatchRequest true "Match settings"
+// @Success 200 {object} MatchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match [put]
+func (h *MatchHandler) OpenMatch(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req OpenMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	match := &entities.OpenMatch{
+		MinLevel:       req.MinLevel,
+		MaxLevel:       req.MaxLevel,
+		MissingPlayers: req.MissingPlayers,
+		AutoAccept:     req.AutoAccept,
+	}
+
+	err := h.matchService.OpenMatch(r.Context(), orgID, courtID, reservationID, claims.UserID, match)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidMatch):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation started or was cancelled"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to open match")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newMatchResponse(*match))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Int("missing_players", match.MissingPlayers).
+		Msg("match opened")
+}
+
+// CloseMatch godoc
+// @Summary Close a match
+// @Description Stops the match from taking players. The players who joined it stay on the roster.
+// @Tags matches
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match [delete]
+func (h *MatchHandler) CloseMatch(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.matchService.CloseMatch(r.Context(), orgID, courtID, reservationID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "match not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation started or was cancelled"})
+		default:
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to close match")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// JoinMatch godoc
+// @Summary Join a match
+// @Description Adds the current user to an open match their level fits. The user takes a spot right away
+// @Description when the match auto-accepts players, otherwise the request waits for the booker.
+// @Tags matches
+// @Security BearerAuth
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Success 201 {object} PlayerResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/join [post]
+func (h *MatchHandler) JoinMatch(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	player, err := h.matchService.JoinMatch(r.Context(), orgID, courtID, reservationID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "match not found"})
+		case errors.Is(err, entities.ErrLevelOutOfRange):
+			httputil.JSON(w, http.StatusUnprocessableEntity, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation started or was cancelled"})
+		case errors.Is(err, entities.ErrMatchFull), errors.Is(err, entities.ErrRosterFull):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "match is full"})
+		case errors.Is(err, entities.ErrPlayerAlreadyInvited):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "user is already on the roster"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to join match")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newPlayerResponse(*player))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("user_id", player.UserID).
+		Str("status", string(player.Status)).
+		Msg("match joined")
+}
+
+// ApproveJoinRequest godoc
+// @Summary Approve a request to join
+// @Description Lets the player who asked to join the match take one of its missing spots
+// @Tags matches
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param userID path string true "User ID of the player"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/requests/{userID}/accept [post]
+func (h *MatchHandler) ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.answerRequest(w, r, h.matchService.ApproveRequest, "approve request")
+}
+
+// RejectJoinRequest godoc
+// @Summary Reject a request to join
+// @Description Turns down the request of the player to join the match
+// @Tags matches
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param userID path string true "User ID of the player"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/requests/{userID}/reject [post]
+func (h *MatchHandler) RejectJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.answerRequest(w, r, h.matchService.RejectRequest, "reject request")
+}
+
+func (h *MatchHandler) answerRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	answer func(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error,
+	action string,
+) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+	playerID := chi.URLParam(r, "userID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	err := answer(r.Context(), orgID, courtID, reservationID, playerID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation started or was cancelled"})
+		case errors.Is(err, entities.ErrInvalidPlayerTransition):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "player has no pending request"})
+		case errors.Is(err, entities.ErrMatchFull), errors.Is(err, entities.ErrRosterFull):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "match is full"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Str("user_id", playerID).
+				Msg("failed to " + action)
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SearchMatches godoc
+// @Summary Search open matches
+// @Description Returns the open matches at the clubs of the city on the day which still miss players,
+// @Description ordered by their start. When level is set only the matches admitting it are returned.
+// @Tags matches
+// @Security BearerAuth
+// @Produce json
+// @Param city query string true "City"
+// @Param date query string true "Day in YYYY-MM-DD format" format:"date"
+// @Param level query number false "Skill level of the player, from 0 to 7"
+// @Success 200 {array} MatchListingResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/matches [get]
+func (h *MatchHandler) SearchMatches(w http.ResponseWriter, r *http.Request) {
+	city := r.URL.Query().Get("city")
+	if city == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "city query parameter is required"})
+		return
+	}
+
+	date, ok := parseDateQuery(w, r)
+	if !ok {
+		return
+	}
+
+	var level *float64
+	if raw := r.URL.Query().Get("level"); raw != "" {
+		l, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "level must be a number"})
+			return
+		}
+
+		level = &l
+	}
+
+	listings, err := h.matchService.SearchMatches(r.Context(), city, date, level)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidSkillLevel) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		log.Error().Err(err).Str("city", city).Msg("failed to search matches")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]MatchListingResponse, 0, len(listings))
+	for _, l := range listings {
+		resp = append(resp, MatchListingResponse{
+			OrganizationID: l.OrganizationID,
+			Match:          newMatchResponse(l.Match),
+			Reservation:    newReservationResponse(l.Reservation),
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,206 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeMatches struct {
+	city     string
+	date     time.Time
+	level    *float64
+	listings []entities.MatchListing
+	err      error
+}
+
+func (f *fakeMatches) OpenMatch(context.Context, string, string, string, string, *entities.OpenMatch) error {
+	return f.err
+}
+
+func (f *fakeMatches) CloseMatch(context.Context, string, string, string, string) error {
+	return f.err
+}
+
+func (f *fakeMatches) JoinMatch(
+	_ context.Context,
+	_, _, reservationID string,
+	userID string,
+) (*entities.ReservationPlayer, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	player := entities.NewReservationPlayer(reservationID, userID, userID, time.Now())
+	player.Status = entities.RequestedPlayerStatus
+	return player, nil
+}
+
+func (f *fakeMatches) ApproveRequest(context.Context, string, string, string, string, string) error {
+	return f.err
+}
+
+func (f *fakeMatches) RejectRequest(context.Context, string, string, string, string, string) error {
+	return f.err
+}
+
+func (f *fakeMatches) SearchMatches(
+	_ context.Context,
+	city string,
+	date time.Time,
+	level *float64,
+) ([]entities.MatchListing, error) {
+	f.city, f.date, f.level = city, date, level
+	return f.listings, f.err
+}
+
+func newMatchRouter(matches *fakeMatches) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Match: httpPkg.NewMatchHandler(matches)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+		},
+		fakeRoles{},
+	)
+}
+
+func TestMatchHandler_JoinMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "joined",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "match is not open",
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "match is full",
+			err:        entities.ErrMatchFull,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "already on the roster",
+			err:        entities.ErrPlayerAlreadyInvited,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "level out of range",
+			err:        entities.ErrLevelOutOfRange,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/reservations/res-1/match/join",
+				nil,
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newMatchRouter(&fakeMatches{err: tt.err}).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var resp httpPkg.PlayerResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, "player-1", resp.UserID)
+			require.Equal(t, string(entities.RequestedPlayerStatus), resp.Status)
+		})
+	}
+}
+
+func TestMatchHandler_SearchMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+		wantLevel  *float64
+	}{
+		{
+			name:       "by level",
+			query:      "?city=Madrid&date=2025-11-04&level=3.5",
+			wantStatus: http.StatusOK,
+			wantLevel:  func() *float64 { l := 3.5; return &l }(),
+		},
+		{
+			name:       "any level",
+			query:      "?city=Madrid&date=2025-11-04",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing city",
+			query:      "?date=2025-11-04",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "level is not a number",
+			query:      "?city=Madrid&date=2025-11-04&level=pro",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "level out of the scale",
+			query:      "?city=Madrid&date=2025-11-04&level=9",
+			err:        entities.ErrInvalidSkillLevel,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := &fakeMatches{
+				err: tt.err,
+				listings: []entities.MatchListing{
+					{
+						Match:          entities.OpenMatch{ReservationID: "res-1", MaxLevel: 7, MissingPlayers: 1},
+						Reservation:    entities.Reservation{ID: "res-1", Status: entities.ReservedReservationStatus},
+						OrganizationID: "club-a",
+					},
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/matches"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newMatchRouter(matches).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, "Madrid", matches.city)
+			require.Equal(t, time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC), matches.date)
+			require.Equal(t, tt.wantLevel, matches.level)
+
+			var resp []httpPkg.MatchListingResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Len(t, resp, 1)
+			require.Equal(t, "club-a", resp[0].OrganizationID)
+			require.Equal(t, 1, resp[0].Match.MissingPlayers)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type MemberService interface {
+	ListMembers(ctx context.Context, organizationID string) ([]entities.Membership, error)
+	InviteMember(
+		ctx context.Context,
+		organizationID, invitedBy string,
+		nickname string,
+		role entities.Role,
+	) (*entities.Membership, error)
+	RemoveMember(ctx context.Context, organizationID, removedBy, userID string) error
+}
+
+type MemberHandler struct {
+	memberService MemberService
+}
+
+func NewMemberHandler(service MemberService) *MemberHandler {
+	return &MemberHandler{
+		memberService: service,
+	}
+}
+
+type InviteMemberRequest struct {
+	// Nickname is the nickname of the user to add
+	Nickname string `json:"nickname" example:"john"`
+	// Role is one of owner, manager, staff or player
+	Role string `json:"role" example:"staff"`
+}
+
+type MemberResponse struct {
+	UserID    string    `json:"userId"              example:"user-123"`
+	Role      string    `json:"role"                example:"staff"`
+	InvitedBy string    `json:"invitedBy,omitempty" example:"user-456"`
+	CreatedAt time.Time `json:"createdAt"           example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newMemberResponse(m entities.Membership) MemberResponse {
+	return MemberResponse{
+		UserID:    m.UserID,
+		Role:      string(m.Role),
+		InvitedBy: m.InvitedBy,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type ListMembersResponse struct {
+	Members []MemberResponse `json:"members"`
+}
+
+// ListMembers godoc
+// @Summary List organization members
+// @Description Lists the members of the organization with their roles. Available to staff and above.
+// @Tags members
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Produce json
+// @Success 200 {object} ListMembersResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/members [get]
+func (h *MemberHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	members, err := h.memberService.ListMembers(r.Context(), orgID)
+	if err != nil {
+		log.Error().Err(err).Str("organization id", orgID).Msg("failed to list members")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := ListMembersResponse{Members: make([]MemberResponse, 0, len(members))}
+	for _, m := range members {
+		resp.Members = append(resp.Members, newMemberResponse(m))
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// InviteMember godoc
+// @Summary Add a member to the organization
+// @Description Adds the user with the given nickname. Managers may add staff and players,
+// @Description only owners may add managers and owners.
+// @Tags members
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Accept json
+// @Produce json
+// @Param member body InviteMemberRequest true "Member payload"
+// @Success 201 {object} MemberResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/members [post]
+func (h *MemberHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req InviteMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().Err(err).Msg("failed to decode invite member request")
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.Nickname == "" || req.Role == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "nickname and role are required"})
+		return
+	}
+
+	member, err := h.memberService.InviteMember(r.Context(), orgID, claims.UserID, req.Nickname, entities.Role(req.Role))
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidRole):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+				Message: "role must be one of owner, manager, staff or player",
+			})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "not allowed to grant this role"})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "user not found"})
+		case errors.Is(err, entities.ErrMemberAlreadyExist):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "user is already a member"})
+		default:
+			log.Error().Err(err).Str("organization id", orgID).Msg("failed to invite member")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newMemberResponse(*member))
+
+	log.Info().
+		Str("organization id", orgID).
+		Str("user id", member.UserID).
+		Str("role", string(member.Role)).
+		Str("invited_by", claims.UserID).
+		Msg("member added")
+}
+
+// RemoveMember godoc
+// @Summary Remove a member from the organization
+// @Description Members may leave on their own. Managers may remove staff and players, owners may remove anyone.
+// @Description The last owner cannot be removed.
+// @Tags members
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param userID path string true "User ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/members/{userID} [delete]
+func (h *MemberHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	userID := chi.URLParam(r, "userID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.memberService.RemoveMember(r.Context(), orgID, claims.UserID, userID); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "not allowed to remove this member"})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "member not found"})
+		case errors.Is(err, entities.ErrLastOwner):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "organization must keep at least one owner"})
+		default:
+			log.Error().Err(err).
+				Str("organization id", orgID).
+				Str("user id", userID).
+				Msg("failed to remove member")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	log.Info().
+		Str("organization id", orgID).
+		Str("user id", userID).
+		Str("removed_by", claims.UserID).
+		Msg("member removed")
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -14,10 +16,18 @@ import (
 )
 
 type OrganizationService interface {
-	CreateOrganization(ctx context.Context, organization *entities.Organization) error
+	CreateOrganization(ctx context.Context, organization *entities.Organization, ownerID string) error
 	GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error)
+	NearbyOrganizations(
+		ctx context.Context,
+		point entities.GeoPoint,
+		radius float64,
+		limit int,
+	) ([]entities.NearbyOrganization, error)
 	GetOrganization(ctx context.Context, organizationID string) (*entities.Organization, error)
-	UpdateOrganization(ctx context.Context, organization *entities.Organization) error
+	UpdateOrganization(ctx context.Context, update entities.OrganizationUpdate) error
+	SetOpeningHours(ctx context.Context, orgID string, hours []entities.OpeningHours) (*entities.Organization, error)
+	SetSpecialHours(ctx context.Context, orgID string, hours []entities.SpecialHours) (*entities.Organization, error)
 }
 
 type OrganizationHandler struct {
@@ -38,6 +48,18 @@ type CreateOrganizationRequest struct {
 	// City is the city in which the organization itself is located
 	// example: Almaty
 	City string `json:"city" example:"Astana"`
+
+	// TimeZone is the IANA time zone of the organization, UTC when omitted
+	// example: Asia/Almaty
+	TimeZone string `json:"timeZone,omitempty" example:"Asia/Almaty"`
+
+	// Address is the street address of the organization
+	// example: Abay Ave 10
+	Address string `json:"address,omitempty" example:"Abay Ave 10"`
+
+	// Latitude and Longitude place the organization on the map, they are given together
+	Latitude  *float64 `json:"latitude,omitempty"  example:"43.2389"`
+	Longitude *float64 `json:"longitude,omitempty" example:"76.8897"`
 }
 
 type CreateOrganizationResponse struct {
@@ -52,6 +74,14 @@ type CreateOrganizationResponse struct {
 	// example: Almaty
 	City string `json:"city" example:"Astana"`
 
+	// TimeZone is the IANA time zone of the organization
+	// example: Asia/Almaty
+	TimeZone string `json:"timeZone" example:"Asia/Almaty"`
+
+	Address   string   `json:"address,omitempty"   example:"Abay Ave 10"`
+	Latitude  *float64 `json:"latitude,omitempty"  example:"43.2389"`
+	Longitude *float64 `json:"longitude,omitempty" example:"76.8897"`
+
 	// CreatedAt is the timestamp when the organization was created
 	// example: 2025-11-01T10:00:00Z
 	CreatedAt time.Time `json:"createdAt" example:"2025-11-01T10:00:00Z"`
@@ -59,17 +89,23 @@ type CreateOrganizationResponse struct {
 
 // CreateOrganization godoc
 // @Summary Create a new organization
-// @Description Create a new organization in the city
+// @Description Create a new organization in the city. The caller becomes its owner.
 // @Tags organizations
 // @Security BearerAuth
 // @Accept json
 // @Param organization body CreateOrganizationRequest true "Organization payload"
 // @Success 201 {object} CreateOrganizationResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
 // @Failure 500
 // @Router /v1/organizations [post]
 func (o *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
 	var req CreateOrganizationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		log.Error().Err(err).Msg("failed to decode create organization request")
@@ -85,9 +121,23 @@ func (o *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.
 		return
 	}
 
+	coordinates, err := parseCoordinates(req.Latitude, req.Longitude)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
 	org := entities.NewOrganization(req.Name, req.City)
+	org.TimeZone = req.TimeZone
+	org.Address = req.Address
+	org.Coordinates = coordinates
+
+	if err := o.orgService.CreateOrganization(r.Context(), org, claims.UserID); err != nil {
+		if errors.Is(err, entities.ErrInvalidTimeZone) || errors.Is(err, entities.ErrInvalidCoordinates) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
 
-	if err := o.orgService.CreateOrganization(r.Context(), org); err != nil {
 		if errors.Is(err, entities.ErrOrganizationAlreadyExist) {
 			httputil.JSON(w, http.StatusConflict, ErrorResponse{
 				Message: "organization with this name already exists in this city",
@@ -105,6 +155,10 @@ func (o *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.
 		ID:        org.ID,
 		Name:      org.Name,
 		City:      org.City,
+		TimeZone:  org.Location().String(),
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
 		CreatedAt: org.CreatedAt,
 	})
 
@@ -128,6 +182,24 @@ type OrganizationResponse struct {
 	// example: Almaty
 	City string `json:"city" example:"Almaty"`
 
+	// TimeZone is the IANA time zone the organization books in
+	// example: Asia/Almaty
+	TimeZone string `json:"timeZone" example:"Asia/Almaty"`
+
+	// Address is the street address of the organization
+	// example: Abay Ave 10
+	Address string `json:"address" example:"Abay Ave 10"`
+
+	// Latitude and Longitude are omitted when the organization has not been placed on the map
+	Latitude  *float64 `json:"latitude,omitempty"  example:"43.2389"`
+	Longitude *float64 `json:"longitude,omitempty" example:"76.8897"`
+
+	// OpeningHours apply to the courts of the organization that have no opening hours of their own
+	OpeningHours []OpeningHoursWindow `json:"openingHours"`
+
+	// SpecialHours replace the opening hours of every court on holidays and special dates
+	SpecialHours []SpecialHoursDay `json:"specialHours"`
+
 	// CreatedAt is the timestamp when the organization was created
 	// example: 2025-11-01T10:00:00Z
 	CreatedAt time.Time `json:"createdAt" example:"2025-11-01T10:00:00Z"`
@@ -137,6 +209,102 @@ type OrganizationResponse struct {
 	UpdatedAt time.Time `json:"updatedAt" example:"2025-11-01T10:00:00Z"`
 }
 
+// SpecialHoursDay replaces the weekly opening hours of the organization on a single date.
+// swagger:model SpecialHoursDay
+type SpecialHoursDay struct {
+	// Date is the calendar date in YYYY-MM-DD format
+	Date string `json:"date" example:"2025-12-24"`
+	// Windows are the opening windows on the date, the organization is closed all day when empty
+	Windows []SpecialHoursWindow `json:"windows"`
+	Reason  string               `json:"reason" example:"Christmas Eve"`
+}
+
+// SpecialHoursWindow is a single opening window on a special date.
+// swagger:model SpecialHoursWindow
+type SpecialHoursWindow struct {
+	OpensAt  string `json:"opensAt"  example:"08:00"`
+	ClosesAt string `json:"closesAt" example:"14:00"`
+}
+
+func newOrganizationResponse(org entities.Organization) OrganizationResponse {
+	resp := OrganizationResponse{
+		ID:           org.ID,
+		Name:         org.Name,
+		City:         org.City,
+		TimeZone:     org.Location().String(),
+		Address:      org.Address,
+		OpeningHours: make([]OpeningHoursWindow, 0, len(org.OpeningHours)),
+		SpecialHours: make([]SpecialHoursDay, 0, len(org.SpecialHours)),
+		CreatedAt:    org.CreatedAt,
+		UpdatedAt:    org.UpdatedAt,
+	}
+
+	if org.Coordinates != nil {
+		resp.Latitude = &org.Coordinates.Latitude
+		resp.Longitude = &org.Coordinates.Longitude
+	}
+
+	for _, h := range org.OpeningHours {
+		resp.OpeningHours = append(resp.OpeningHours, OpeningHoursWindow{
+			Weekday:  int(h.Weekday),
+			OpensAt:  h.OpensAt.String(),
+			ClosesAt: h.ClosesAt.String(),
+		})
+	}
+
+	for _, h := range org.SpecialHours {
+		day := SpecialHoursDay{
+			Date:    h.Date.Format(time.DateOnly),
+			Windows: make([]SpecialHoursWindow, 0, len(h.Windows)),
+			Reason:  h.Reason,
+		}
+
+		for _, w := range h.Windows {
+			day.Windows = append(day.Windows, SpecialHoursWindow{
+				OpensAt:  w.OpensAt.String(),
+				ClosesAt: w.ClosesAt.String(),
+			})
+		}
+
+		resp.SpecialHours = append(resp.SpecialHours, day)
+	}
+
+	return resp
+}
+
+func parseSpecialHours(days []SpecialHoursDay) ([]entities.SpecialHours, error) {
+	hours := make([]entities.SpecialHours, 0, len(days))
+
+	for _, d := range days {
+		date, err := httputil.ParseDate(d.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", d.Date)
+		}
+
+		windows := make([]OpeningHoursWindow, 0, len(d.Windows))
+		for _, w := range d.Windows {
+			windows = append(windows, OpeningHoursWindow{
+				Weekday:  int(date.Weekday()),
+				OpensAt:  w.OpensAt,
+				ClosesAt: w.ClosesAt,
+			})
+		}
+
+		parsed, err := parseOpeningHours(windows)
+		if err != nil {
+			return nil, err
+		}
+
+		hours = append(hours, entities.SpecialHours{
+			Date:    date,
+			Windows: parsed,
+			Reason:  d.Reason,
+		})
+	}
+
+	return hours, nil
+}
+
 // GetOrganization godoc
 // @Summary Get an organization
 // @Description Retrieves an organization by ID
@@ -167,15 +335,7 @@ func (h *OrganizationHandler) GetOrganization(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	resp := OrganizationResponse{
-		ID:        org.ID,
-		Name:      org.Name,
-		City:      org.City,
-		CreatedAt: org.CreatedAt,
-		UpdatedAt: org.UpdatedAt,
-	}
-
-	httputil.JSON(w, http.StatusOK, resp)
+	httputil.JSON(w, http.StatusOK, newOrganizationResponse(*org))
 }
 
 type ListOrganizationsResponse struct {
@@ -213,19 +373,113 @@ func (h *OrganizationHandler) GetOrganizationsByCity(w http.ResponseWriter, r *h
 	}
 
 	for _, org := range orgs {
-		resp.Organizations = append(resp.Organizations, OrganizationResponse{
-			ID:        org.ID,
-			Name:      org.Name,
-			City:      org.City,
-			CreatedAt: org.CreatedAt,
-			UpdatedAt: org.UpdatedAt,
-		})
+		resp.Organizations = append(resp.Organizations, newOrganizationResponse(org))
 	}
 
 	httputil.JSON(w, http.StatusOK, resp)
 	log.Info().Str("city", city).Int("count", len(orgs)).Msg("listed organizations by city")
 }
 
+// parseCoordinates pairs the latitude and the longitude of a request, nil when both are omitted.
+func parseCoordinates(latitude, longitude *float64) (*entities.GeoPoint, error) {
+	if latitude == nil && longitude == nil {
+		return nil, nil
+	}
+
+	if latitude == nil || longitude == nil {
+		return nil, errors.New("latitude and longitude must be given together")
+	}
+
+	return &entities.GeoPoint{Latitude: *latitude, Longitude: *longitude}, nil
+}
+
+// NearbyOrganizationResponse is an organization found by a nearby search.
+// swagger:model NearbyOrganizationResponse
+type NearbyOrganizationResponse struct {
+	Organization OrganizationResponse `json:"organization"`
+	// Distance is the great-circle distance from the searched point in meters
+	Distance float64 `json:"distance" example:"1250.5"`
+}
+
+// swagger:model NearbyOrganizationsResponse
+type NearbyOrganizationsResponse struct {
+	Organizations []NearbyOrganizationResponse `json:"organizations"`
+}
+
+// NearbyOrganizations godoc
+// @Summary List organizations near a point
+// @Description Returns the organizations within the radius of the point, the nearest first, at most limit of
+// @Description them. Organizations that have not been placed on the map are left out.
+// @Tags organizations
+// @Security BearerAuth
+// @Produce json
+// @Param lat query number true "Latitude in decimal degrees"
+// @Param lng query number true "Longitude in decimal degrees"
+// @Param radius query number true "Radius in meters, at most 100000"
+// @Param limit query int false "Organizations returned at most, 20 by default and 100 at most"
+// @Success 200 {object} NearbyOrganizationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/nearby [get]
+func (h *OrganizationHandler) NearbyOrganizations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	latitude, err := strconv.ParseFloat(query.Get("lat"), 64)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "lat must be a number"})
+		return
+	}
+
+	longitude, err := strconv.ParseFloat(query.Get("lng"), 64)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "lng must be a number"})
+		return
+	}
+
+	radius, err := strconv.ParseFloat(query.Get("radius"), 64)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "radius must be a number"})
+		return
+	}
+
+	limit := entities.DefaultNearbyLimit
+	if raw := query.Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "limit must be a number"})
+			return
+		}
+	}
+
+	point := entities.GeoPoint{Latitude: latitude, Longitude: longitude}
+
+	orgs, err := h.orgService.NearbyOrganizations(r.Context(), point, radius, limit)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCoordinates) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		log.Error().Err(err).Msg("failed to get nearby organizations")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := NearbyOrganizationsResponse{
+		Organizations: make([]NearbyOrganizationResponse, 0, len(orgs)),
+	}
+
+	for _, org := range orgs {
+		resp.Organizations = append(resp.Organizations, NearbyOrganizationResponse{
+			Organization: newOrganizationResponse(org.Organization),
+			Distance:     org.Distance,
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+	log.Info().Float64("radius", radius).Int("count", len(orgs)).Msg("listed nearby organizations")
+}
+
 type UpdateOrganizationRequest struct {
 	// Name is the updated organization name
 	// example: Updated Padel Club
@@ -234,6 +488,20 @@ type UpdateOrganizationRequest struct {
 	// City is the updated city where the organization is located
 	// example: Astana
 	City string `json:"city" example:"Astana"`
+
+	// TimeZone is the IANA time zone of the organization, the current one is kept when omitted
+	// example: Asia/Almaty
+	TimeZone string `json:"timeZone,omitempty" example:"Asia/Almaty"`
+
+	// Address is the street address of the organization, the current one is kept when omitted and removed
+	// when null or empty
+	// example: Abay Ave 10
+	Address httputil.Optional[string] `json:"address" swaggertype:"string" example:"Abay Ave 10"`
+
+	// Latitude and Longitude are given together, the current coordinates are kept when both are omitted and
+	// removed when both are null
+	Latitude  httputil.Optional[float64] `json:"latitude"  swaggertype:"number" example:"43.2389"`
+	Longitude httputil.Optional[float64] `json:"longitude" swaggertype:"number" example:"76.8897"`
 }
 
 // UpdateOrganization godoc
@@ -247,6 +515,7 @@ type UpdateOrganizationRequest struct {
 // @Param organization body UpdateOrganizationRequest true "Organization payload"
 // @Success 200
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404
 // @Failure 500
 // @Router /v1/organizations/{orgID} [put]
@@ -268,14 +537,44 @@ func (h *OrganizationHandler) UpdateOrganization(w http.ResponseWriter, r *http.
 		return
 	}
 
-	org := &entities.Organization{
-		ID:        orgID,
-		Name:      req.Name,
-		City:      req.City,
-		UpdatedAt: time.Now().UTC(), // Maybe delete this row
+	if req.Latitude.Set != req.Longitude.Set {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "latitude and longitude must be given together"})
+		return
+	}
+
+	coordinates, err := parseCoordinates(req.Latitude.Value, req.Longitude.Value)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	var address string
+	if req.Address.Value != nil {
+		address = *req.Address.Value
+	}
+
+	org := entities.Organization{
+		ID:          orgID,
+		Name:        req.Name,
+		City:        req.City,
+		TimeZone:    req.TimeZone,
+		Address:     address,
+		Coordinates: coordinates,
+		UpdatedAt:   time.Now().UTC(), // Maybe delete this row
+	}
+
+	update := entities.OrganizationUpdate{
+		Organization:   org,
+		SetAddress:     req.Address.Set,
+		SetCoordinates: req.Latitude.Set,
 	}
 
-	if err := h.orgService.UpdateOrganization(r.Context(), org); err != nil {
+	if err := h.orgService.UpdateOrganization(r.Context(), update); err != nil {
+		if errors.Is(err, entities.ErrInvalidTimeZone) || errors.Is(err, entities.ErrInvalidCoordinates) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
 		log.Error().Err(err).Str("orgID", orgID).Msg("failed to update organization")
 		if errors.Is(err, entities.ErrNotFound) {
 			w.WriteHeader(http.StatusNotFound)
@@ -287,3 +586,118 @@ func (h *OrganizationHandler) UpdateOrganization(w http.ResponseWriter, r *http.
 
 	log.Info().Str("organization_id", org.ID).Msg("organization updated successfully")
 }
+
+// swagger:model UpdateOrganizationHoursRequest
+type UpdateOrganizationHoursRequest struct {
+	OpeningHours []OpeningHoursWindow `json:"openingHours"`
+}
+
+// SetOpeningHours godoc
+// @Summary Set organization opening hours
+// @Description Replaces the weekly opening hours of the organization. They apply to the courts that have no
+// @Description opening hours of their own, in the time zone of the organization.
+// @Tags organizations
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Accept json
+// @Produce json
+// @Param hours body UpdateOrganizationHoursRequest true "Opening hours payload"
+// @Success 200 {object} OrganizationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/opening-hours [put]
+func (h *OrganizationHandler) SetOpeningHours(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	if orgID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "orgID is required"})
+		return
+	}
+
+	var req UpdateOrganizationHoursRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	hours, err := parseOpeningHours(req.OpeningHours)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	org, err := h.orgService.SetOpeningHours(r.Context(), orgID, hours)
+	if err != nil {
+		h.writeHoursError(w, orgID, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newOrganizationResponse(*org))
+
+	log.Info().Str("organization_id", orgID).Int("windows", len(hours)).Msg("organization opening hours updated")
+}
+
+// swagger:model UpdateSpecialHoursRequest
+type UpdateSpecialHoursRequest struct {
+	SpecialHours []SpecialHoursDay `json:"specialHours"`
+}
+
+// SetSpecialHours godoc
+// @Summary Set organization holidays and special hours
+// @Description Replaces the holidays and special dates of the organization. On each date the given windows
+// @Description replace the opening hours of every court of the organization, a date without windows closes
+// @Description the organization for the day.
+// @Tags organizations
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Accept json
+// @Produce json
+// @Param hours body UpdateSpecialHoursRequest true "Special hours payload"
+// @Success 200 {object} OrganizationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/special-hours [put]
+func (h *OrganizationHandler) SetSpecialHours(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	if orgID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "orgID is required"})
+		return
+	}
+
+	var req UpdateSpecialHoursRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	hours, err := parseSpecialHours(req.SpecialHours)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	org, err := h.orgService.SetSpecialHours(r.Context(), orgID, hours)
+	if err != nil {
+		h.writeHoursError(w, orgID, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newOrganizationResponse(*org))
+
+	log.Info().Str("organization_id", orgID).Int("dates", len(hours)).Msg("organization special hours updated")
+}
+
+func (h *OrganizationHandler) writeHoursError(w http.ResponseWriter, orgID string, err error) {
+	switch {
+	case errors.Is(err, entities.ErrInvalidOpeningHours):
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	case errors.Is(err, entities.ErrNotFound):
+		httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "organization not found"})
+	default:
+		log.Error().Err(err).Str("orgID", orgID).Msg("failed to update organization hours")
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,364 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeOrganizations struct {
+	special []entities.SpecialHours
+	point   entities.GeoPoint
+	radius  float64
+	limit   int
+	created *entities.Organization
+	updated *entities.OrganizationUpdate
+}
+
+func (f *fakeOrganizations) CreateOrganization(_ context.Context, org *entities.Organization, _ string) error {
+	if org.Coordinates != nil {
+		if err := org.Coordinates.Validate(); err != nil {
+			return err
+		}
+	}
+
+	f.created = org
+	return nil
+}
+
+func (f *fakeOrganizations) GetOrganizationsByCity(context.Context, string) ([]entities.Organization, error) {
+	return nil, nil
+}
+
+func (f *fakeOrganizations) NearbyOrganizations(
+	_ context.Context,
+	point entities.GeoPoint,
+	radius float64,
+	limit int,
+) ([]entities.NearbyOrganization, error) {
+	if err := point.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := entities.ValidateNearbyLimit(limit); err != nil {
+		return nil, err
+	}
+
+	f.point = point
+	f.radius = radius
+	f.limit = limit
+
+	return []entities.NearbyOrganization{
+		{
+			Organization: entities.Organization{
+				ID:          "club-a",
+				Address:     "Abay Ave 10",
+				Coordinates: &entities.GeoPoint{Latitude: 43.2400, Longitude: 76.8897},
+			},
+			Distance: 122.3,
+		},
+	}, nil
+}
+
+func (f *fakeOrganizations) GetOrganization(context.Context, string) (*entities.Organization, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakeOrganizations) UpdateOrganization(_ context.Context, update entities.OrganizationUpdate) error {
+	f.updated = &update
+	return nil
+}
+
+func (f *fakeOrganizations) SetOpeningHours(
+	_ context.Context,
+	orgID string,
+	hours []entities.OpeningHours,
+) (*entities.Organization, error) {
+	return &entities.Organization{ID: orgID, OpeningHours: hours}, nil
+}
+
+func (f *fakeOrganizations) SetSpecialHours(
+	_ context.Context,
+	orgID string,
+	hours []entities.SpecialHours,
+) (*entities.Organization, error) {
+	if err := entities.ValidateSpecialHours(hours); err != nil {
+		return nil, err
+	}
+
+	f.special = hours
+	return &entities.Organization{ID: orgID, TimeZone: "Europe/Madrid", SpecialHours: hours}, nil
+}
+
+func newOrganizationRouter(orgs *fakeOrganizations) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Organization: httpPkg.NewOrganizationHandler(orgs)},
+		fakeVerifier{
+			"staff-token":   {UserID: "staff-1"},
+			"manager-token": {UserID: "manager-1"},
+		},
+		fakeRoles{
+			"club-a/staff-1":   entities.StaffRole,
+			"club-a/manager-1": entities.ManagerRole,
+		},
+	)
+}
+
+func TestOrganizationHandler_SetSpecialHours(t *testing.T) {
+	const body = `{"specialHours": [
+		{"date": "2025-12-24", "windows": [{"opensAt": "08:00", "closesAt": "14:00"}], "reason": "Christmas Eve"},
+		{"date": "2025-12-25", "reason": "Christmas"}
+	]}`
+
+	tests := []struct {
+		name       string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "manager sets holidays",
+			token:      "manager-token",
+			body:       body,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "staff cannot set holidays",
+			token:      "staff-token",
+			body:       body,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid date",
+			token:      "manager-token",
+			body:       `{"specialHours": [{"date": "24.12.2025", "reason": "Christmas Eve"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "date given twice",
+			token:      "manager-token",
+			body:       `{"specialHours": [{"date": "2025-12-25"}, {"date": "2025-12-25"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgs := &fakeOrganizations{}
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/v1/organizations/club-a/special-hours",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newOrganizationRouter(orgs).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			require.Len(t, orgs.special, 2)
+			require.Equal(t, time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC), orgs.special[0].Date)
+			require.Equal(t, time.Wednesday, orgs.special[0].Windows[0].Weekday)
+			require.Empty(t, orgs.special[1].Windows)
+
+			var resp httpPkg.OrganizationResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, "Europe/Madrid", resp.TimeZone)
+			require.Len(t, resp.SpecialHours, 2)
+			require.Equal(t, "2025-12-24", resp.SpecialHours[0].Date)
+			require.Equal(t, "14:00", resp.SpecialHours[0].Windows[0].ClosesAt)
+			require.Equal(t, "Christmas", resp.SpecialHours[1].Reason)
+		})
+	}
+}
+
+func TestOrganizationHandler_CreateOrganizationLocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantPoint  *entities.GeoPoint
+	}{
+		{
+			name:       "with coordinates",
+			body:       `{"name": "Club A", "city": "Almaty", "address": "Abay Ave 10", "latitude": 43.24, "longitude": 76.89}`,
+			wantStatus: http.StatusCreated,
+			wantPoint:  &entities.GeoPoint{Latitude: 43.24, Longitude: 76.89},
+		},
+		{
+			name:       "without coordinates",
+			body:       `{"name": "Club A", "city": "Almaty"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "latitude only",
+			body:       `{"name": "Club A", "city": "Almaty", "latitude": 43.24}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "latitude out of range",
+			body:       `{"name": "Club A", "city": "Almaty", "latitude": 143.24, "longitude": 76.89}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgs := &fakeOrganizations{}
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/organizations", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer staff-token")
+
+			rec := httptest.NewRecorder()
+			newOrganizationRouter(orgs).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus == http.StatusCreated {
+				require.Equal(t, tt.wantPoint, orgs.created.Coordinates)
+			}
+		})
+	}
+}
+
+func TestOrganizationHandler_UpdateOrganizationLocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       *entities.OrganizationUpdate
+	}{
+		{
+			name:       "keeps the location when omitted",
+			body:       `{"name": "Club A", "city": "Almaty"}`,
+			wantStatus: http.StatusOK,
+			want:       &entities.OrganizationUpdate{},
+		},
+		{
+			name:       "moves the club",
+			body:       `{"name": "Club A", "city": "Almaty", "address": "Dostyk Ave 5", "latitude": 43.23, "longitude": 76.95}`,
+			wantStatus: http.StatusOK,
+			want: &entities.OrganizationUpdate{
+				Organization: entities.Organization{
+					Address:     "Dostyk Ave 5",
+					Coordinates: &entities.GeoPoint{Latitude: 43.23, Longitude: 76.95},
+				},
+				SetAddress:     true,
+				SetCoordinates: true,
+			},
+		},
+		{
+			name:       "clears the location with nulls",
+			body:       `{"name": "Club A", "city": "Almaty", "address": null, "latitude": null, "longitude": null}`,
+			wantStatus: http.StatusOK,
+			want:       &entities.OrganizationUpdate{SetAddress: true, SetCoordinates: true},
+		},
+		{
+			name:       "latitude only",
+			body:       `{"name": "Club A", "city": "Almaty", "latitude": 43.23}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "clears the latitude only",
+			body:       `{"name": "Club A", "city": "Almaty", "latitude": null, "longitude": 76.95}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgs := &fakeOrganizations{}
+
+			req := httptest.NewRequest(http.MethodPut, "/v1/organizations/club-a", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer manager-token")
+
+			rec := httptest.NewRecorder()
+			newOrganizationRouter(orgs).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.want == nil {
+				require.Nil(t, orgs.updated)
+				return
+			}
+
+			require.NotNil(t, orgs.updated)
+			require.Equal(t, tt.want.Address, orgs.updated.Address)
+			require.Equal(t, tt.want.Coordinates, orgs.updated.Coordinates)
+			require.Equal(t, tt.want.SetAddress, orgs.updated.SetAddress)
+			require.Equal(t, tt.want.SetCoordinates, orgs.updated.SetCoordinates)
+		})
+	}
+}
+
+func TestOrganizationHandler_NearbyOrganizations(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantLimit  int
+	}{
+		{
+			name:       "nearby",
+			query:      "?lat=43.2389&lng=76.8897&radius=5000",
+			wantStatus: http.StatusOK,
+			wantLimit:  entities.DefaultNearbyLimit,
+		},
+		{
+			name:       "nearest five",
+			query:      "?lat=43.2389&lng=76.8897&radius=5000&limit=5",
+			wantStatus: http.StatusOK,
+			wantLimit:  5,
+		},
+		{name: "limit too high", query: "?lat=43.2389&lng=76.8897&radius=5000&limit=500", wantStatus: http.StatusBadRequest},
+		{name: "bad limit", query: "?lat=43.2389&lng=76.8897&radius=5000&limit=all", wantStatus: http.StatusBadRequest},
+		{name: "no radius", query: "?lat=43.2389&lng=76.8897", wantStatus: http.StatusBadRequest},
+		{name: "not a number", query: "?lat=north&lng=76.8897&radius=5000", wantStatus: http.StatusBadRequest},
+		{name: "out of range", query: "?lat=43.2389&lng=276.8897&radius=5000", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgs := &fakeOrganizations{}
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/organizations/nearby"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer staff-token")
+
+			rec := httptest.NewRecorder()
+			newOrganizationRouter(orgs).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, entities.GeoPoint{Latitude: 43.2389, Longitude: 76.8897}, orgs.point)
+			require.Equal(t, 5000.0, orgs.radius)
+			require.Equal(t, tt.wantLimit, orgs.limit)
+
+			var resp httpPkg.NearbyOrganizationsResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Len(t, resp.Organizations, 1)
+			require.Equal(t, "club-a", resp.Organizations[0].Organization.ID)
+			require.Equal(t, "Abay Ave 10", resp.Organizations[0].Organization.Address)
+			require.InDelta(t, 43.24, *resp.Organizations[0].Organization.Latitude, 1e-9)
+			require.InDelta(t, 122.3, resp.Organizations[0].Distance, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,520 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+// PaymentSignatureHeader carries the provider signature of a payment webhook.
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+const maxWebhookSize = 1 << 20
+
+type PaymentService interface {
+	PayReservation(ctx context.Context, courtID, reservationID, userID string) (*entities.Payment, error)
+	PaySeries(ctx context.Context, courtID, seriesID, userID string) (*entities.Payment, error)
+	GetPayment(ctx context.Context, paymentID, userID string) (*entities.Payment, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+	SplitReservation(
+		ctx context.Context,
+		courtID, reservationID string,
+		userID string,
+		players []entities.CoPlayer,
+	) ([]entities.PaymentShare, error)
+	ListShares(ctx context.Context, courtID, reservationID, userID string) ([]entities.PaymentShare, error)
+	PayShare(ctx context.Context, courtID, reservationID, shareID, userID string) (*entities.Payment, error)
+}
+
+type PaymentHandler struct {
+	paymentService PaymentService
+}
+
+func NewPaymentHandler(service PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: service,
+	}
+}
+
+// swagger:model PaymentEventResponse
+type PaymentEventResponse struct {
+	Status    string    `json:"status"           example:"succeeded"`
+	Reason    string    `json:"reason,omitempty" example:""`
+	CreatedAt time.Time `json:"createdAt"        example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+// swagger:model PaymentResponse
+type PaymentResponse struct {
+	ID            string `json:"id"                      example:"pay-123"`
+	ReservationID string `json:"reservationId,omitempty" example:"res-123"`
+	ShareID       string `json:"shareId,omitempty"       example:"share-123"`
+	// SeriesID is set instead of the reservation id when the payment pays the holds of a series
+	SeriesID string `json:"seriesId,omitempty" example:"series-123"`
+	Amount   int64  `json:"amount"             example:"3000"`
+	Currency string `json:"currency"           example:"EUR"`
+	// Status is pending, succeeded, failed, refunded or partially_refunded
+	Status string `json:"status" example:"pending"`
+	// RefundedAmount is the part of the amount given back, in minor units
+	RefundedAmount int64  `json:"refundedAmount" example:"0"`
+	Provider       string `json:"provider"       example:"fake"`
+	// ClientSecret completes the payment with the provider, it is only returned while the payment is pending
+	ClientSecret string                 `json:"clientSecret,omitempty" example:"fake_pi_pay-123_secret"`
+	History      []PaymentEventResponse `json:"history"`
+	CreatedAt    time.Time              `json:"createdAt"              example:"2025-11-01T10:00:00Z" format:"date-time"`
+	UpdatedAt    time.Time              `json:"updatedAt"              example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newPaymentResponse(p entities.Payment) PaymentResponse {
+	resp := PaymentResponse{
+		ID:             p.ID,
+		ReservationID:  p.ReservationID,
+		ShareID:        p.ShareID,
+		SeriesID:       p.SeriesID,
+		Amount:         p.Amount,
+		Currency:       p.Currency,
+		Status:         string(p.Status),
+		RefundedAmount: p.RefundedAmount,
+		Provider:       p.Provider,
+		History:        make([]PaymentEventResponse, 0, len(p.History)),
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+
+	if p.Status == entities.PendingPaymentStatus {
+		resp.ClientSecret = p.ClientSecret
+	}
+
+	for _, e := range p.History {
+		resp.History = append(resp.History, PaymentEventResponse{
+			Status:    string(e.Status),
+			Reason:    e.Reason,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return resp
+}
+
+// PayReservation godoc
+// @Summary Pay a reservation
+// @Description Opens a payment for the price of a pending hold. The hold is confirmed once the provider
+// @Description reports the payment as succeeded and released when it fails. A pending payment is returned as is.
+// @Description On a split reservation the booker covers the shares the other players have not paid yet.
+// @Tags payments
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Produce json
+// @Success 201 {object} PaymentResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/payment [post]
+func (h *PaymentHandler) PayReservation(w http.ResponseWriter, r *http.Request) {
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	payment, err := h.paymentService.PayReservation(r.Context(), courtID, reservationID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationHoldExpired):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation hold has expired"})
+		case errors.Is(err, entities.ErrReservationNotPending):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is not pending"})
+		case errors.Is(err, entities.ErrNothingToPay):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation has nothing to pay"})
+		case errors.Is(err, entities.ErrPaidWithSeries):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is paid with its series"})
+		case errors.Is(err, entities.ErrPaymentAlreadyExist):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is already being paid"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to pay reservation")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newPaymentResponse(*payment))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("payment_id", payment.ID).
+		Msg("payment opened")
+}
+
+// PaySeries godoc
+// @Summary Pay a series
+// @Description Opens one payment for the price of every hold of a series. The holds are confirmed together
+// @Description once the provider reports the payment as succeeded, holds released in the meantime are refunded,
+// @Description and all of them are released when it fails. A pending payment is returned as is.
+// @Tags payments
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param seriesID path string true "Series ID"
+// @Produce json
+// @Success 201 {object} PaymentResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/series/{seriesID}/payment [post]
+func (h *PaymentHandler) PaySeries(w http.ResponseWriter, r *http.Request) {
+	courtID := chi.URLParam(r, "courtID")
+	seriesID := chi.URLParam(r, "seriesID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	payment, err := h.paymentService.PaySeries(r.Context(), courtID, seriesID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "series not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "series was booked by another user"})
+		case errors.Is(err, entities.ErrNothingToPay):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "series has no holds left to pay"})
+		case errors.Is(err, entities.ErrPaymentAlreadyExist):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "series is already being paid"})
+		default:
+			log.Error().
+				Err(err).
+				Str("series_id", seriesID).
+				Msg("failed to pay series")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newPaymentResponse(*payment))
+
+	log.Info().
+		Str("series_id", seriesID).
+		Str("payment_id", payment.ID).
+		Msg("series payment opened")
+}
+
+// GetPayment godoc
+// @Summary Get a payment
+// @Description Returns a payment of the current user with its status history
+// @Tags payments
+// @Security BearerAuth
+// @Param paymentID path string true "Payment ID"
+// @Produce json
+// @Success 200 {object} PaymentResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/payments/{paymentID} [get]
+func (h *PaymentHandler) GetPayment(w http.ResponseWriter, r *http.Request) {
+	paymentID := chi.URLParam(r, "paymentID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	payment, err := h.paymentService.GetPayment(r.Context(), paymentID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "payment not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("payment_id", paymentID).Msg("failed to get payment")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newPaymentResponse(*payment))
+}
+
+// PaymentWebhook godoc
+// @Summary Payment provider webhook
+// @Description Receives the outcome of a payment from the provider. The body is verified with the signature header.
+// @Tags payments
+// @Accept json
+// @Param X-Payment-Signature header string true "Provider signature of the body"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/payments/webhook [post]
+func (h *PaymentHandler) PaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookSize))
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "failed to read body"})
+		return
+	}
+
+	err = h.paymentService.HandleWebhook(r.Context(), payload, r.Header.Get(PaymentSignatureHeader))
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidWebhook):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "payment not found"})
+		case errors.Is(err, entities.ErrInvalidPaymentTransition):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "payment was already settled"})
+		default:
+			log.Error().Err(err).Msg("failed to handle payment webhook")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:model CoPlayerRequest
+type CoPlayerRequest struct {
+	Nickname    string `json:"nickname,omitempty"    example:"bob"`
+	PhoneNumber string `json:"phoneNumber,omitempty" example:"+34600000000"`
+}
+
+// swagger:model SplitReservationRequest
+type SplitReservationRequest struct {
+	// Players are invited by nickname or by phone number, the booker is added to the split
+	Players []CoPlayerRequest `json:"players"`
+}
+
+// swagger:model PaymentShareResponse
+type PaymentShareResponse struct {
+	ID       string `json:"id"       example:"share-123"`
+	UserID   string `json:"userId"   example:"user-123"`
+	Amount   int64  `json:"amount"   example:"750"`
+	Currency string `json:"currency" example:"EUR"`
+	Status   string `json:"status"   example:"unpaid"`
+}
+
+func newPaymentShareResponses(shares []entities.PaymentShare) []PaymentShareResponse {
+	resp := make([]PaymentShareResponse, 0, len(shares))
+	for _, share := range shares {
+		resp = append(resp, PaymentShareResponse{
+			ID:       share.ID,
+			UserID:   share.UserID,
+			Amount:   share.Amount,
+			Currency: share.Currency,
+			Status:   string(share.Status),
+		})
+	}
+
+	return resp
+}
+
+// SplitReservation godoc
+// @Summary Split a reservation
+// @Description Splits the price of a pending hold between the booker and the invited players, as many as
+// @Description the court allows besides the booker. Each player pays their own share, the hold is confirmed
+// @Description once every share is paid or the booker covers the rest.
+// @Tags payments
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param split body SplitReservationRequest true "Invited players"
+// @Success 201 {array} PaymentShareResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/split [post]
+func (h *PaymentHandler) SplitReservation(w http.ResponseWriter, r *http.Request) {
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req SplitReservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	players := make([]entities.CoPlayer, 0, len(req.Players))
+	for _, p := range req.Players {
+		players = append(players, entities.CoPlayer{Nickname: p.Nickname, PhoneNumber: p.PhoneNumber})
+	}
+
+	shares, err := h.paymentService.SplitReservation(r.Context(), courtID, reservationID, claims.UserID, players)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidSplit), errors.Is(err, entities.ErrInvalidInvitation):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation or player not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationHoldExpired):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation hold has expired"})
+		case errors.Is(err, entities.ErrReservationNotPending):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is not pending"})
+		case errors.Is(err, entities.ErrNothingToPay):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation has nothing to pay"})
+		case errors.Is(err, entities.ErrPaidWithSeries):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is paid with its series"})
+		case errors.Is(err, entities.ErrReservationAlreadySplit):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is already split"})
+		case errors.Is(err, entities.ErrPaymentAlreadyExist):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is already being paid"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to split reservation")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newPaymentShareResponses(shares))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Int("shares", len(shares)).
+		Msg("reservation split")
+}
+
+// ListShares godoc
+// @Summary List the shares of a reservation
+// @Description Returns the shares of a split reservation with their payment status. Only the booker and
+// @Description the invited players may see them.
+// @Tags payments
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Produce json
+// @Success 200 {array} PaymentShareResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/shares [get]
+func (h *PaymentHandler) ListShares(w http.ResponseWriter, r *http.Request) {
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	shares, err := h.paymentService.ListShares(r.Context(), courtID, reservationID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "user does not play in this reservation"})
+		default:
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to list shares")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newPaymentShareResponses(shares))
+}
+
+// PayShare godoc
+// @Summary Pay a share
+// @Description Opens a payment for the share the current user owes on a split reservation. A pending payment
+// @Description of the share is returned as is.
+// @Tags payments
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param shareID path string true "Share ID"
+// @Produce json
+// @Success 201 {object} PaymentResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/shares/{shareID}/payment [post]
+func (h *PaymentHandler) PayShare(w http.ResponseWriter, r *http.Request) {
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+	shareID := chi.URLParam(r, "shareID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	payment, err := h.paymentService.PayShare(r.Context(), courtID, reservationID, shareID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "share not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "share is owed by another user"})
+		case errors.Is(err, entities.ErrReservationHoldExpired):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation hold has expired"})
+		case errors.Is(err, entities.ErrReservationNotPending):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is not pending"})
+		case errors.Is(err, entities.ErrNothingToPay):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "share has nothing to pay"})
+		case errors.Is(err, entities.ErrPaymentAlreadyExist):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "booker is covering the reservation"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Str("share_id", shareID).
+				Msg("failed to pay share")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newPaymentResponse(*payment))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("share_id", shareID).
+		Str("payment_id", payment.ID).
+		Msg("share payment opened")
+}
@@ -0,0 +1,301 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakePayments struct {
+	payment *entities.Payment
+	players []entities.CoPlayer
+	err     error
+}
+
+func (f *fakePayments) PayReservation(context.Context, string, string, string) (*entities.Payment, error) {
+	return f.payment, f.err
+}
+
+func (f *fakePayments) PaySeries(context.Context, string, string, string) (*entities.Payment, error) {
+	return f.payment, f.err
+}
+
+func (f *fakePayments) GetPayment(_ context.Context, paymentID, userID string) (*entities.Payment, error) {
+	if f.payment == nil || f.payment.ID != paymentID || f.payment.UserID != userID {
+		return nil, entities.ErrNotFound
+	}
+	return f.payment, nil
+}
+
+func (f *fakePayments) HandleWebhook(context.Context, []byte, string) error {
+	return f.err
+}
+
+func (f *fakePayments) SplitReservation(
+	_ context.Context,
+	_, reservationID string,
+	userID string,
+	players []entities.CoPlayer,
+) ([]entities.PaymentShare, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.players = players
+
+	userIDs := []string{userID}
+	for i := range players {
+		userIDs = append(userIDs, fmt.Sprintf("player-%d", i+2))
+	}
+
+	rsv := &entities.Reservation{ID: reservationID, Price: entities.Price{Amount: 3000, Currency: "EUR"}}
+	return entities.SplitPrice(rsv, userIDs, time.Now()), nil
+}
+
+func (f *fakePayments) ListShares(context.Context, string, string, string) ([]entities.PaymentShare, error) {
+	return nil, f.err
+}
+
+func (f *fakePayments) PayShare(context.Context, string, string, string, string) (*entities.Payment, error) {
+	return f.payment, f.err
+}
+
+func newPaymentRouter(payments *fakePayments) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Payment: httpPkg.NewPaymentHandler(payments)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+		},
+		fakeRoles{},
+	)
+}
+
+func TestPaymentHandler_GetPayment(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           entities.PaymentStatus
+		wantClientSecret string
+	}{
+		{
+			name:             "pending payment exposes the client secret",
+			status:           entities.PendingPaymentStatus,
+			wantClientSecret: "fake_pi_pay-1_secret",
+		},
+		{
+			name:   "settled payment hides the client secret",
+			status: entities.SucceededPaymentStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := &fakePayments{payment: &entities.Payment{
+				ID:           "pay-1",
+				UserID:       "player-1",
+				Status:       tt.status,
+				ClientSecret: "fake_pi_pay-1_secret",
+				History:      []entities.PaymentEvent{{Status: tt.status}},
+			}}
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/payments/pay-1", nil)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newPaymentRouter(payments).ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var resp httpPkg.PaymentResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, string(tt.status), resp.Status)
+			require.Equal(t, tt.wantClientSecret, resp.ClientSecret)
+			require.Len(t, resp.History, 1)
+		})
+	}
+}
+
+func TestPaymentHandler_PaySeries(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "opened",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "another user",
+			err:        entities.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "no holds left",
+			err:        entities.ErrNothingToPay,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := &fakePayments{err: tt.err}
+			if tt.err == nil {
+				payments.payment = &entities.Payment{
+					ID:       "pay-1",
+					SeriesID: "series-1",
+					UserID:   "player-1",
+					Amount:   6000,
+					Status:   entities.PendingPaymentStatus,
+				}
+			}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/series/series-1/payment",
+				nil,
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newPaymentRouter(payments).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.err == nil {
+				var resp httpPkg.PaymentResponse
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				require.Equal(t, "series-1", resp.SeriesID)
+				require.Empty(t, resp.ReservationID)
+				require.Equal(t, int64(6000), resp.Amount)
+			}
+		})
+	}
+}
+
+func TestPaymentHandler_PaymentWebhook(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "handled",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "invalid signature",
+			err:        fmt.Errorf("%w: signature mismatch", entities.ErrInvalidWebhook),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown payment",
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "already settled",
+			err:        entities.ErrInvalidPaymentTransition,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/payments/webhook",
+				strings.NewReader(`{"intentId": "fake_pi_pay-1", "status": "succeeded"}`),
+			)
+			req.Header.Set(httpPkg.PaymentSignatureHeader, "sig")
+
+			rec := httptest.NewRecorder()
+			newPaymentRouter(&fakePayments{err: tt.err}).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestPaymentHandler_SplitReservation(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		err         error
+		wantStatus  int
+		wantPlayers []entities.CoPlayer
+	}{
+		{
+			name:       "split",
+			body:       `{"players": [{"nickname": "bob"}, {"phoneNumber": "+34600000000"}]}`,
+			wantStatus: http.StatusCreated,
+			wantPlayers: []entities.CoPlayer{
+				{Nickname: "bob"},
+				{PhoneNumber: "+34600000000"},
+			},
+		},
+		{
+			name:       "invalid json",
+			body:       `{"players": `,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid split",
+			body:       `{"players": []}`,
+			err:        fmt.Errorf("%w: between 1 and 3 players can be invited", entities.ErrInvalidSplit),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown player",
+			body:       `{"players": [{"nickname": "ghost"}]}`,
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "already split",
+			body:       `{"players": [{"nickname": "bob"}]}`,
+			err:        entities.ErrReservationAlreadySplit,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := &fakePayments{err: tt.err}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/reservations/res-1/split",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newPaymentRouter(payments).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			require.Equal(t, tt.wantPlayers, payments.players)
+
+			var resp []httpPkg.PaymentShareResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Len(t, resp, 3)
+			require.Equal(t, "player-1", resp[0].UserID)
+			require.Equal(t, string(entities.UnpaidShareStatus), resp[0].Status)
+			require.Equal(t, int64(1000), resp[0].Amount)
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type PolicyService interface {
+	GetCancellationPolicy(ctx context.Context, organizationID string) (*entities.CancellationPolicy, error)
+	SetCancellationPolicy(ctx context.Context, policy *entities.CancellationPolicy) error
+	GetBookingRules(ctx context.Context, organizationID, courtID string) (*entities.BookingRules, error)
+	SetBookingRules(ctx context.Context, rules *entities.BookingRules) error
+}
+
+type PolicyHandler struct {
+	policyService PolicyService
+}
+
+func NewPolicyHandler(service PolicyService) *PolicyHandler {
+	return &PolicyHandler{
+		policyService: service,
+	}
+}
+
+// CancellationTierWindow refunds refundPercent of the price when the reservation is cancelled at least
+// noticeMinutes before it starts.
+// swagger:model CancellationTierWindow
+type CancellationTierWindow struct {
+	NoticeMinutes int64 `json:"noticeMinutes" example:"1440"`
+	RefundPercent int   `json:"refundPercent" example:"100"`
+}
+
+// swagger:model CancellationPolicyRequest
+type CancellationPolicyRequest struct {
+	// Tiers are applied from the longest notice down, cancellations with less notice than every tier
+	// are not refunded
+	Tiers []CancellationTierWindow `json:"tiers"`
+}
+
+// swagger:model CancellationPolicyResponse
+type CancellationPolicyResponse struct {
+	OrganizationID string                   `json:"organizationId" example:"org-456"`
+	Tiers          []CancellationTierWindow `json:"tiers"`
+	UpdatedAt      time.Time                `json:"updatedAt"      example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newCancellationPolicyResponse(policy entities.CancellationPolicy) CancellationPolicyResponse {
+	resp := CancellationPolicyResponse{
+		OrganizationID: policy.OrganizationID,
+		Tiers:          make([]CancellationTierWindow, 0, len(policy.Tiers)),
+		UpdatedAt:      policy.UpdatedAt,
+	}
+
+	for _, t := range policy.Tiers {
+		resp.Tiers = append(resp.Tiers, CancellationTierWindow{
+			NoticeMinutes: int64(t.Notice / time.Minute),
+			RefundPercent: t.RefundPercent,
+		})
+	}
+
+	return resp
+}
+
+// GetCancellationPolicy godoc
+// @Summary Get cancellation policy
+// @Description Returns the cancellation policy of the organization. Organizations without a policy refund
+// @Description the whole price of cancelled reservations
+// @Tags policies
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Produce json
+// @Success 200 {object} CancellationPolicyResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/cancellation-policy [get]
+func (h *PolicyHandler) GetCancellationPolicy(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	policy, err := h.policyService.GetCancellationPolicy(r.Context(), orgID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "cancellation policy not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("orgID", orgID).Msg("failed to get cancellation policy")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newCancellationPolicyResponse(*policy))
+}
+
+// SetCancellationPolicy godoc
+// @Summary Set cancellation policy
+// @Description Replaces the cancellation policy of the organization. The policy is evaluated when a
+// @Description reservation is cancelled, reservations cancelled before keep the terms they were cancelled on
+// @Tags policies
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Accept json
+// @Produce json
+// @Param policy body CancellationPolicyRequest true "Cancellation policy payload"
+// @Success 200 {object} CancellationPolicyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/cancellation-policy [put]
+func (h *PolicyHandler) SetCancellationPolicy(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	var req CancellationPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	policy := &entities.CancellationPolicy{OrganizationID: orgID}
+	for _, t := range req.Tiers {
+		policy.Tiers = append(policy.Tiers, entities.CancellationTier{
+			Notice:        time.Duration(t.NoticeMinutes) * time.Minute,
+			RefundPercent: t.RefundPercent,
+		})
+	}
+
+	if err := h.policyService.SetCancellationPolicy(r.Context(), policy); err != nil {
+		if errors.Is(err, entities.ErrInvalidCancellationPolicy) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		log.Error().Err(err).Str("orgID", orgID).Msg("failed to set cancellation policy")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newCancellationPolicyResponse(*policy))
+
+	log.Info().Str("orgID", orgID).Msg("cancellation policy updated successfully")
+}
+
+// BookingRulesRequest restricts the reservations players can make. Zero values leave the matching
+// restriction off.
+// swagger:model BookingRulesRequest
+type BookingRulesRequest struct {
+	MinDurationMinutes int64 `json:"minDurationMinutes,omitempty" example:"60"`
+	MaxDurationMinutes int64 `json:"maxDurationMinutes,omitempty" example:"120"`
+	// DurationsMinutes are the only durations allowed when set
+	DurationsMinutes []int64 `json:"durationsMinutes,omitempty" example:"60,90,120"`
+	// SlotIntervalMinutes aligns the start and the end of reservations to multiples of it from midnight
+	SlotIntervalMinutes int64 `json:"slotIntervalMinutes,omitempty" example:"30"`
+	// MaxAdvanceMinutes is how long before its start a reservation may be made at the earliest
+	MaxAdvanceMinutes int64 `json:"maxAdvanceMinutes,omitempty" example:"20160"`
+	// MinNoticeMinutes is how long before its start a reservation has to be made at the latest
+	MinNoticeMinutes int64 `json:"minNoticeMinutes,omitempty" example:"60"`
+	// MaxActiveBookings caps the upcoming reservations a player holds in the organization
+	MaxActiveBookings int `json:"maxActiveBookings,omitempty" example:"3"`
+}
+
+// swagger:model BookingRulesResponse
+type BookingRulesResponse struct {
+	OrganizationID string `json:"organizationId"    example:"org-456"`
+	// CourtID is empty for the organization default
+	CourtID             string    `json:"courtId,omitempty"   example:"court-123"`
+	MinDurationMinutes  int64     `json:"minDurationMinutes"  example:"60"`
+	MaxDurationMinutes  int64     `json:"maxDurationMinutes"  example:"120"`
+	DurationsMinutes    []int64   `json:"durationsMinutes"`
+	SlotIntervalMinutes int64     `json:"slotIntervalMinutes" example:"30"`
+	MaxAdvanceMinutes   int64     `json:"maxAdvanceMinutes"   example:"20160"`
+	MinNoticeMinutes    int64     `json:"minNoticeMinutes"    example:"60"`
+	MaxActiveBookings   int       `json:"maxActiveBookings"   example:"3"`
+	UpdatedAt           time.Time `json:"updatedAt"           example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newBookingRulesResponse(rules entities.BookingRules) BookingRulesResponse {
+	resp := BookingRulesResponse{
+		OrganizationID:      rules.OrganizationID,
+		CourtID:             rules.CourtID,
+		MinDurationMinutes:  int64(rules.MinDuration / time.Minute),
+		MaxDurationMinutes:  int64(rules.MaxDuration / time.Minute),
+		DurationsMinutes:    make([]int64, 0, len(rules.Durations)),
+		SlotIntervalMinutes: int64(rules.SlotInterval / time.Minute),
+		MaxAdvanceMinutes:   int64(rules.MaxAdvance / time.Minute),
+		MinNoticeMinutes:    int64(rules.MinNotice / time.Minute),
+		MaxActiveBookings:   rules.MaxActiveBookings,
+		UpdatedAt:           rules.UpdatedAt,
+	}
+
+	for _, d := range rules.Durations {
+		resp.DurationsMinutes = append(resp.DurationsMinutes, int64(d/time.Minute))
+	}
+
+	return resp
+}
+
+// GetOrganizationBookingRules godoc
+// @Summary Get organization booking rules
+// @Description Returns the default booking rules of the organization, used by courts without rules of their own
+// @Tags policies
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Produce json
+// @Success 200 {object} BookingRulesResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/booking-rules [get]
+func (h *PolicyHandler) GetOrganizationBookingRules(w http.ResponseWriter, r *http.Request) {
+	h.getBookingRules(w, r, chi.URLParam(r, "orgID"), "")
+}
+
+// GetCourtBookingRules godoc
+// @Summary Get court booking rules
+// @Description Returns the booking rules of the court, or the organization default when the court has none
+// @Tags policies
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Produce json
+// @Success 200 {object} BookingRulesResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/booking-rules [get]
+func (h *PolicyHandler) GetCourtBookingRules(w http.ResponseWriter, r *http.Request) {
+	h.getBookingRules(w, r, chi.URLParam(r, "orgID"), chi.URLParam(r, "courtID"))
+}
+
+func (h *PolicyHandler) getBookingRules(w http.ResponseWriter, r *http.Request, orgID, courtID string) {
+	rules, err := h.policyService.GetBookingRules(r.Context(), orgID, courtID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "booking rules not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Str("courtID", courtID).
+			Msg("failed to get booking rules")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newBookingRulesResponse(*rules))
+}
+
+// SetOrganizationBookingRules godoc
+// @Summary Set organization booking rules
+// @Description Replaces the default booking rules of the organization. Existing reservations are kept
+// @Tags policies
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Accept json
+// @Produce json
+// @Param rules body BookingRulesRequest true "Booking rules payload"
+// @Success 200 {object} BookingRulesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/booking-rules [put]
+func (h *PolicyHandler) SetOrganizationBookingRules(w http.ResponseWriter, r *http.Request) {
+	h.setBookingRules(w, r, chi.URLParam(r, "orgID"), "")
+}
+
+// SetCourtBookingRules godoc
+// @Summary Set court booking rules
+// @Description Replaces the booking rules of the court, overriding the organization default.
+// @Description Existing reservations are kept
+// @Tags policies
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Accept json
+// @Produce json
+// @Param rules body BookingRulesRequest true "Booking rules payload"
+// @Success 200 {object} BookingRulesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/booking-rules [put]
+func (h *PolicyHandler) SetCourtBookingRules(w http.ResponseWriter, r *http.Request) {
+	h.setBookingRules(w, r, chi.URLParam(r, "orgID"), chi.URLParam(r, "courtID"))
+}
+
+func (h *PolicyHandler) setBookingRules(w http.ResponseWriter, r *http.Request, orgID, courtID string) {
+	var req BookingRulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	rules := &entities.BookingRules{
+		OrganizationID:    orgID,
+		CourtID:           courtID,
+		MinDuration:       time.Duration(req.MinDurationMinutes) * time.Minute,
+		MaxDuration:       time.Duration(req.MaxDurationMinutes) * time.Minute,
+		SlotInterval:      time.Duration(req.SlotIntervalMinutes) * time.Minute,
+		MaxAdvance:        time.Duration(req.MaxAdvanceMinutes) * time.Minute,
+		MinNotice:         time.Duration(req.MinNoticeMinutes) * time.Minute,
+		MaxActiveBookings: req.MaxActiveBookings,
+	}
+
+	for _, d := range req.DurationsMinutes {
+		rules.Durations = append(rules.Durations, time.Duration(d)*time.Minute)
+	}
+
+	if err := h.policyService.SetBookingRules(r.Context(), rules); err != nil {
+		if errors.Is(err, entities.ErrInvalidBookingRules) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "court not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Str("courtID", courtID).
+			Msg("failed to set booking rules")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newBookingRulesResponse(*rules))
+
+	log.Info().
+		Str("orgID", orgID).
+		Str("courtID", courtID).
+		Msg("booking rules updated successfully")
+}
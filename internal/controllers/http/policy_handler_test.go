@@ -0,0 +1,197 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakePolicies struct {
+	cancellation []*entities.CancellationPolicy
+	booking      []*entities.BookingRules
+}
+
+func (f *fakePolicies) GetCancellationPolicy(context.Context, string) (*entities.CancellationPolicy, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakePolicies) SetCancellationPolicy(_ context.Context, policy *entities.CancellationPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	f.cancellation = append(f.cancellation, policy)
+	return nil
+}
+
+func (f *fakePolicies) GetBookingRules(context.Context, string, string) (*entities.BookingRules, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakePolicies) SetBookingRules(_ context.Context, rules *entities.BookingRules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	f.booking = append(f.booking, rules)
+	return nil
+}
+
+func newPolicyRouter(policies *fakePolicies) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Policy: httpPkg.NewPolicyHandler(policies)},
+		fakeVerifier{
+			"player-token":  {UserID: "player-1"},
+			"manager-token": {UserID: "manager-1"},
+		},
+		fakeRoles{
+			"club-a/player-1":  entities.PlayerRole,
+			"club-a/manager-1": entities.ManagerRole,
+		},
+	)
+}
+
+func TestPolicyHandler_SetCancellationPolicy(t *testing.T) {
+	body := `{"tiers": [{"noticeMinutes": 1440, "refundPercent": 100}, {"noticeMinutes": 360, "refundPercent": 50}]}`
+
+	tests := []struct {
+		name       string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "manager sets the policy",
+			token:      "manager-token",
+			body:       body,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "player cannot set the policy",
+			token:      "player-token",
+			body:       body,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "refund above the price",
+			token:      "manager-token",
+			body:       `{"tiers": [{"noticeMinutes": 60, "refundPercent": 150}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := &fakePolicies{}
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/v1/organizations/club-a/cancellation-policy",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newPolicyRouter(policies).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				require.Empty(t, policies.cancellation)
+				return
+			}
+
+			require.Len(t, policies.cancellation, 1)
+			require.Equal(t, "club-a", policies.cancellation[0].OrganizationID)
+			require.Equal(t, []entities.CancellationTier{
+				{Notice: 24 * time.Hour, RefundPercent: 100},
+				{Notice: 6 * time.Hour, RefundPercent: 50},
+			}, policies.cancellation[0].Tiers)
+
+			var resp httpPkg.CancellationPolicyResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Len(t, resp.Tiers, 2)
+			require.Equal(t, int64(360), resp.Tiers[1].NoticeMinutes)
+		})
+	}
+}
+
+func TestPolicyHandler_SetCourtBookingRules(t *testing.T) {
+	body := `{
+		"durationsMinutes": [60, 90, 120],
+		"slotIntervalMinutes": 30,
+		"maxAdvanceMinutes": 20160,
+		"minNoticeMinutes": 60,
+		"maxActiveBookings": 3
+	}`
+
+	tests := []struct {
+		name       string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "manager sets the rules",
+			token:      "manager-token",
+			body:       body,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "player cannot set the rules",
+			token:      "player-token",
+			body:       body,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "min duration longer than max",
+			token:      "manager-token",
+			body:       `{"minDurationMinutes": 120, "maxDurationMinutes": 60}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := &fakePolicies{}
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/v1/organizations/club-a/courts/court-1/booking-rules",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newPolicyRouter(policies).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				require.Empty(t, policies.booking)
+				return
+			}
+
+			require.Len(t, policies.booking, 1)
+			rules := policies.booking[0]
+			require.Equal(t, "club-a", rules.OrganizationID)
+			require.Equal(t, "court-1", rules.CourtID)
+			require.Equal(t, []time.Duration{time.Hour, 90 * time.Minute, 2 * time.Hour}, rules.Durations)
+			require.Equal(t, 14*24*time.Hour, rules.MaxAdvance)
+
+			var resp httpPkg.BookingRulesResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, []int64{60, 90, 120}, resp.DurationsMinutes)
+			require.Equal(t, 3, resp.MaxActiveBookings)
+		})
+	}
+}
@@ -0,0 +1,417 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type PricingService interface {
+	GetRule(ctx context.Context, organizationID, courtID string) (*entities.PricingRule, error)
+	SetRule(ctx context.Context, rule *entities.PricingRule) error
+	Quote(ctx context.Context, organizationID, courtID string, from, to time.Time) (*entities.Quote, error)
+	CourtLocation(ctx context.Context, courtID string) (*time.Location, error)
+}
+
+type PricingHandler struct {
+	pricingService PricingService
+}
+
+func NewPricingHandler(service PricingService) *PricingHandler {
+	return &PricingHandler{
+		pricingService: service,
+	}
+}
+
+// PriceBandWindow overrides the base rate between from and to on the listed weekdays.
+// swagger:model PriceBandWindow
+type PriceBandWindow struct {
+	// Weekdays are days of week, 0 is Sunday. The band applies every day when empty
+	Weekdays   []int  `json:"weekdays,omitempty" example:"1,2,3,4,5"`
+	From       string `json:"from"               example:"18:00"`
+	To         string `json:"to"                 example:"22:00"`
+	HourlyRate int64  `json:"hourlyRate"         example:"3000"`
+}
+
+// swagger:model PricingRuleRequest
+type PricingRuleRequest struct {
+	// Currency is an ISO 4217 code, all amounts are in its minor units
+	Currency       string            `json:"currency"       example:"EUR"`
+	BaseHourlyRate int64             `json:"baseHourlyRate" example:"2000"`
+	Bands          []PriceBandWindow `json:"bands,omitempty"`
+	// Holidays are dates in YYYY-MM-DD format billed at holidayHourlyRate
+	Holidays          []string `json:"holidays,omitempty"          example:"2025-12-25"`
+	HolidayHourlyRate int64    `json:"holidayHourlyRate,omitempty" example:"4000"`
+	MinimumCharge     int64    `json:"minimumCharge,omitempty"     example:"1500"`
+}
+
+// swagger:model PricingRuleResponse
+type PricingRuleResponse struct {
+	OrganizationID string `json:"organizationId"    example:"org-456"`
+	// CourtID is empty for the organization default
+	CourtID           string            `json:"courtId,omitempty" example:"court-123"`
+	Currency          string            `json:"currency"          example:"EUR"`
+	BaseHourlyRate    int64             `json:"baseHourlyRate"    example:"2000"`
+	Bands             []PriceBandWindow `json:"bands"`
+	Holidays          []string          `json:"holidays"`
+	HolidayHourlyRate int64             `json:"holidayHourlyRate" example:"4000"`
+	MinimumCharge     int64             `json:"minimumCharge"     example:"1500"`
+	UpdatedAt         time.Time         `json:"updatedAt"         example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newPricingRuleResponse(rule entities.PricingRule) PricingRuleResponse {
+	resp := PricingRuleResponse{
+		OrganizationID:    rule.OrganizationID,
+		CourtID:           rule.CourtID,
+		Currency:          rule.Currency,
+		BaseHourlyRate:    rule.BaseHourlyRate,
+		Bands:             make([]PriceBandWindow, 0, len(rule.Bands)),
+		Holidays:          make([]string, 0, len(rule.Holidays)),
+		HolidayHourlyRate: rule.HolidayHourlyRate,
+		MinimumCharge:     rule.MinimumCharge,
+		UpdatedAt:         rule.UpdatedAt,
+	}
+
+	for _, b := range rule.Bands {
+		weekdays := make([]int, 0, len(b.Weekdays))
+		for _, wd := range b.Weekdays {
+			weekdays = append(weekdays, int(wd))
+		}
+
+		resp.Bands = append(resp.Bands, PriceBandWindow{
+			Weekdays:   weekdays,
+			From:       b.From.String(),
+			To:         b.To.String(),
+			HourlyRate: b.HourlyRate,
+		})
+	}
+
+	for _, h := range rule.Holidays {
+		resp.Holidays = append(resp.Holidays, h.Format(time.DateOnly))
+	}
+
+	return resp
+}
+
+// swagger:model PriceResponse
+type PriceResponse struct {
+	// Amount is in the minor units of the currency
+	Amount   int64  `json:"amount"   example:"3000"`
+	Currency string `json:"currency" example:"EUR"`
+}
+
+func newPriceResponse(p entities.Price) *PriceResponse {
+	if p.IsZero() {
+		return nil
+	}
+
+	return &PriceResponse{Amount: p.Amount, Currency: p.Currency}
+}
+
+// swagger:model QuoteLineResponse
+type QuoteLineResponse struct {
+	From       time.Time `json:"from"       example:"2025-11-01T18:00:00Z" format:"date-time"`
+	To         time.Time `json:"to"         example:"2025-11-01T19:00:00Z" format:"date-time"`
+	HourlyRate int64     `json:"hourlyRate" example:"3000"`
+	Amount     int64     `json:"amount"     example:"3000"`
+}
+
+// swagger:model QuoteResponse
+type QuoteResponse struct {
+	CourtID              string              `json:"courtId"              example:"court-123"`
+	From                 time.Time           `json:"from"                 example:"2025-11-01T17:30Z" format:"date-time"`
+	To                   time.Time           `json:"to"                   example:"2025-11-01T19:00Z" format:"date-time"`
+	Currency             string              `json:"currency"             example:"EUR"`
+	Lines                []QuoteLineResponse `json:"lines"`
+	Subtotal             int64               `json:"subtotal"             example:"4000"`
+	MinimumChargeApplied bool                `json:"minimumChargeApplied" example:"false"`
+	Total                int64               `json:"total"                example:"4000"`
+}
+
+func newQuoteResponse(q entities.Quote) QuoteResponse {
+	resp := QuoteResponse{
+		CourtID:              q.CourtID,
+		From:                 q.From,
+		To:                   q.To,
+		Currency:             q.Currency,
+		Lines:                make([]QuoteLineResponse, 0, len(q.Lines)),
+		Subtotal:             q.Subtotal,
+		MinimumChargeApplied: q.MinimumChargeApplied,
+		Total:                q.Total,
+	}
+
+	for _, l := range q.Lines {
+		resp.Lines = append(resp.Lines, QuoteLineResponse{
+			From:       l.From,
+			To:         l.To,
+			HourlyRate: l.HourlyRate,
+			Amount:     l.Amount,
+		})
+	}
+
+	return resp
+}
+
+// GetOrganizationPricing godoc
+// @Summary Get organization pricing
+// @Description Returns the default pricing rule of the organization, used by courts without a rule of their own
+// @Tags pricing
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Produce json
+// @Success 200 {object} PricingRuleResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/pricing [get]
+func (h *PricingHandler) GetOrganizationPricing(w http.ResponseWriter, r *http.Request) {
+	h.getRule(w, r, chi.URLParam(r, "orgID"), "")
+}
+
+// GetCourtPricing godoc
+// @Summary Get court pricing
+// @Description Returns the pricing rule of the court, or the organization default when the court has none
+// @Tags pricing
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Produce json
+// @Success 200 {object} PricingRuleResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/pricing [get]
+func (h *PricingHandler) GetCourtPricing(w http.ResponseWriter, r *http.Request) {
+	h.getRule(w, r, chi.URLParam(r, "orgID"), chi.URLParam(r, "courtID"))
+}
+
+func (h *PricingHandler) getRule(w http.ResponseWriter, r *http.Request, orgID, courtID string) {
+	rule, err := h.pricingService.GetRule(r.Context(), orgID, courtID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "pricing not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Str("courtID", courtID).
+			Msg("failed to get pricing rule")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newPricingRuleResponse(*rule))
+}
+
+// SetOrganizationPricing godoc
+// @Summary Set organization pricing
+// @Description Replaces the default pricing rule of the organization.
+// @Description Existing reservations keep the price they were booked at
+// @Tags pricing
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Accept json
+// @Produce json
+// @Param pricing body PricingRuleRequest true "Pricing rule payload"
+// @Success 200 {object} PricingRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/pricing [put]
+func (h *PricingHandler) SetOrganizationPricing(w http.ResponseWriter, r *http.Request) {
+	h.setRule(w, r, chi.URLParam(r, "orgID"), "")
+}
+
+// SetCourtPricing godoc
+// @Summary Set court pricing
+// @Description Replaces the pricing rule of the court, overriding the organization default.
+// @Description Existing reservations keep the price they were booked at
+// @Tags pricing
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Accept json
+// @Produce json
+// @Param pricing body PricingRuleRequest true "Pricing rule payload"
+// @Success 200 {object} PricingRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/pricing [put]
+func (h *PricingHandler) SetCourtPricing(w http.ResponseWriter, r *http.Request) {
+	h.setRule(w, r, chi.URLParam(r, "orgID"), chi.URLParam(r, "courtID"))
+}
+
+func (h *PricingHandler) setRule(w http.ResponseWriter, r *http.Request, orgID, courtID string) {
+	var req PricingRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().Err(err).Str("orgID", orgID).Msg("failed to decode pricing rule request")
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	rule, err := parsePricingRule(req)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	rule.OrganizationID = orgID
+	rule.CourtID = courtID
+
+	if err := h.pricingService.SetRule(r.Context(), rule); err != nil {
+		if errors.Is(err, entities.ErrInvalidPricing) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "court not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Str("courtID", courtID).
+			Msg("failed to set pricing rule")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newPricingRuleResponse(*rule))
+
+	log.Info().
+		Str("orgID", orgID).
+		Str("courtID", courtID).
+		Msg("pricing rule updated successfully")
+}
+
+func parsePricingRule(req PricingRuleRequest) (*entities.PricingRule, error) {
+	rule := &entities.PricingRule{
+		Currency:          req.Currency,
+		BaseHourlyRate:    req.BaseHourlyRate,
+		HolidayHourlyRate: req.HolidayHourlyRate,
+		MinimumCharge:     req.MinimumCharge,
+	}
+
+	for _, b := range req.Bands {
+		from, err := entities.ParseTimeOfDay(b.From)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := entities.ParseTimeOfDay(b.To)
+		if err != nil {
+			return nil, err
+		}
+
+		var weekdays []time.Weekday
+		for _, wd := range b.Weekdays {
+			weekdays = append(weekdays, time.Weekday(wd))
+		}
+
+		rule.Bands = append(rule.Bands, entities.PriceBand{
+			Weekdays:   weekdays,
+			From:       from,
+			To:         to,
+			HourlyRate: b.HourlyRate,
+		})
+	}
+
+	for _, h := range req.Holidays {
+		day, err := httputil.ParseDate(h)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %q, expected YYYY-MM-DD", h)
+		}
+
+		rule.Holidays = append(rule.Holidays, day)
+	}
+
+	return rule, nil
+}
+
+// QuoteCourt godoc
+// @Summary Quote a court slot
+// @Description Prices the slot on the court before booking it, broken down by the rates that apply
+// @Tags pricing
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param from query string true "Start time in RFC3339 format" format:"date-time"
+// @Param to query string true "End time in RFC3339 format, at most 24 hours after from" format:"date-time"
+// @Produce json
+// @Success 200 {object} QuoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/quote [get]
+func (h *PricingHandler) QuoteCourt(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+
+	loc, ok := resolveLocation(w, r, h.pricingService.CourtLocation, "court", courtID)
+	if !ok {
+		return
+	}
+
+	from, err := httputil.ParseTimeIn(r.URL.Query().Get("from"), loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "invalid from time format, expected RFC3339",
+		})
+		return
+	}
+
+	to, err := httputil.ParseTimeIn(r.URL.Query().Get("to"), loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "invalid to time format, expected RFC3339",
+		})
+		return
+	}
+
+	if !from.Before(to) {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "from must be before to",
+		})
+		return
+	}
+
+	if to.Sub(from) > entities.MaxQuoteDuration {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: fmt.Sprintf("a quote covers at most %s", entities.MaxQuoteDuration),
+		})
+		return
+	}
+
+	quote, err := h.pricingService.Quote(r.Context(), orgID, courtID, from, to)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "pricing not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("orgID", orgID).
+			Str("courtID", courtID).
+			Msg("failed to quote court")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newQuoteResponse(*quote))
+}
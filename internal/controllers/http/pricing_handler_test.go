@@ -0,0 +1,241 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakePricing struct {
+	rules  []*entities.PricingRule
+	loc    *time.Location
+	quoted time.Time
+}
+
+func (f *fakePricing) GetRule(context.Context, string, string) (*entities.PricingRule, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakePricing) SetRule(_ context.Context, rule *entities.PricingRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	f.rules = append(f.rules, rule)
+	return nil
+}
+
+func (f *fakePricing) Quote(
+	_ context.Context,
+	organizationID, courtID string,
+	from, to time.Time,
+) (*entities.Quote, error) {
+	f.quoted = from
+	rule := entities.PricingRule{OrganizationID: organizationID, Currency: "EUR", BaseHourlyRate: 2000}
+	q := rule.Quote(courtID, from, to, time.UTC)
+	return &q, nil
+}
+
+func (f *fakePricing) CourtLocation(context.Context, string) (*time.Location, error) {
+	if f.loc == nil {
+		return nil, entities.ErrNotFound
+	}
+
+	return f.loc, nil
+}
+
+func newPricingRouter(pricing *fakePricing) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Pricing: httpPkg.NewPricingHandler(pricing)},
+		fakeVerifier{
+			"player-token":  {UserID: "player-1"},
+			"manager-token": {UserID: "manager-1"},
+		},
+		fakeRoles{
+			"club-a/player-1":  entities.PlayerRole,
+			"club-a/manager-1": entities.ManagerRole,
+		},
+	)
+}
+
+func TestPricingHandler_SetCourtPricing(t *testing.T) {
+	body := `{
+		"currency": "EUR",
+		"baseHourlyRate": 2000,
+		"bands": [{"weekdays": [1, 2, 3, 4, 5], "from": "18:00", "to": "22:00", "hourlyRate": 3000}],
+		"holidays": ["2025-12-25"],
+		"holidayHourlyRate": 4000,
+		"minimumCharge": 1500
+	}`
+
+	tests := []struct {
+		name       string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "manager sets the rule",
+			token:      "manager-token",
+			body:       body,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "player cannot set the rule",
+			token:      "player-token",
+			body:       body,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid band time",
+			token:      "manager-token",
+			body:       `{"currency": "EUR", "bands": [{"from": "18", "to": "22:00"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid holiday",
+			token:      "manager-token",
+			body:       `{"currency": "EUR", "holidays": ["25.12.2025"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid currency",
+			token:      "manager-token",
+			body:       `{"currency": "euro", "baseHourlyRate": 2000}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pricing := &fakePricing{}
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/v1/organizations/club-a/courts/court-1/pricing",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newPricingRouter(pricing).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				require.Empty(t, pricing.rules)
+				return
+			}
+
+			require.Len(t, pricing.rules, 1)
+			rule := pricing.rules[0]
+			require.Equal(t, "club-a", rule.OrganizationID)
+			require.Equal(t, "court-1", rule.CourtID)
+			require.Equal(t, []entities.PriceBand{{
+				Weekdays:   []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+				From:       18 * 60,
+				To:         22 * 60,
+				HourlyRate: 3000,
+			}}, rule.Bands)
+			require.Equal(t, []time.Time{time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)}, rule.Holidays)
+
+			var resp httpPkg.PricingRuleResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, []string{"2025-12-25"}, resp.Holidays)
+			require.Equal(t, "18:00", resp.Bands[0].From)
+		})
+	}
+}
+
+func TestPricingHandler_QuoteCourt(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		loc        *time.Location
+		query      string
+		wantStatus int
+		wantTotal  int64
+		wantFrom   time.Time
+	}{
+		{
+			name:       "quote",
+			loc:        madrid,
+			query:      "?from=2025-11-03T10:00:00Z&to=2025-11-03T11:30:00Z",
+			wantStatus: http.StatusOK,
+			wantTotal:  3000,
+			wantFrom:   time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "local time of the club",
+			loc:        madrid,
+			query:      "?from=2025-11-03T19:00&to=2025-11-03T20:00",
+			wantStatus: http.StatusOK,
+			wantTotal:  2000,
+			wantFrom:   time.Date(2025, 11, 3, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "missing to",
+			loc:        madrid,
+			query:      "?from=2025-11-03T10:00:00Z",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "empty slot",
+			loc:        madrid,
+			query:      "?from=2025-11-03T10:00:00Z&to=2025-11-03T10:00:00Z",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "longer than a day",
+			loc:        madrid,
+			query:      "?from=2025-11-03T10:00:00Z&to=2025-11-04T10:01:00Z",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown court",
+			query:      "?from=2025-11-03T10:00:00Z&to=2025-11-03T11:30:00Z",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/v1/organizations/club-a/courts/court-1/quote"+tt.query,
+				nil,
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			pricing := &fakePricing{loc: tt.loc}
+			rec := httptest.NewRecorder()
+			newPricingRouter(pricing).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			require.True(t, tt.wantFrom.Equal(pricing.quoted), pricing.quoted)
+
+			var resp httpPkg.QuoteResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, "court-1", resp.CourtID)
+			require.Equal(t, "EUR", resp.Currency)
+			require.Equal(t, tt.wantTotal, resp.Total)
+			require.Len(t, resp.Lines, 1)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -17,8 +18,23 @@ import (
 type ReservationService interface {
 	ReserveCourt(ctx context.Context, courtID string, reservation *entities.Reservation) error
 	ListReservations(ctx context.Context, courtID string, from, to time.Time) ([]entities.Reservation, error)
-	CancelReservation(ctx context.Context, reservationID string, cancelledBy string) error
+	CancelReservation(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		actor entities.Actor,
+		waivePolicy bool,
+	) (*entities.Reservation, error)
 	GetReservation(ctx context.Context, courtID, reservstionID string) (*entities.Reservation, error)
+	ConfirmReservation(ctx context.Context, courtID, reservationID, userID string) (*entities.Reservation, error)
+	RescheduleReservation(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		actor entities.Actor,
+		targetCourtID string,
+		from, to time.Time,
+	) (*entities.Reservation, error)
+	ListReservationChanges(ctx context.Context, courtID, reservationID string) ([]entities.ReservationChange, error)
+	CourtLocation(ctx context.Context, courtID string) (*time.Location, error)
 }
 
 type ReservationHandler struct {
@@ -32,43 +48,65 @@ func NewReservationHandler(service ReservationService) *ReservationHandler {
 }
 
 type ReserveCourtRequest struct {
-	// StartTime is the reservation start timestamp in RFC3339 format
+	// StartTime is the reservation start timestamp, in the time zone of the organization unless it has an offset
 	// example: 2025-11-04T18:30
 	// format: date-time
-	StartTime time.Time `json:"startTime" example:"2025-11-04T18:30" format:"date-time"`
+	StartTime string `json:"startTime" example:"2025-11-04T18:30" format:"date-time"`
 
-	// EndTime is the reservation end timestamp in RFC3339 format
+	// EndTime is the reservation end timestamp, in the time zone of the organization unless it has an offset
 	// example: 2025-11-04T19:45
 	// format: date-time
-	EndTime time.Time `json:"endTime" example:"2025-11-04T19:45" format:"date-time"`
+	EndTime string `json:"endTime" example:"2025-11-04T19:45" format:"date-time"`
 }
 
-func (r *ReserveCourtRequest) UnmarshalJSON(data []byte) error {
-	// Define a temporary structure with strings
-	var raw struct {
-		StartTime string `json:"startTime"`
-		EndTime   string `json:"endTime"`
-	}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return fmt.Errorf("decode request: %w", err)
+// parseSlot parses the start and the end of a slot, times without an offset are read in loc.
+func parseSlot(startTime, endTime string, loc *time.Location) (time.Time, time.Time, error) {
+	from, err := httputil.ParseTimeIn(startTime, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("parse startTime: %w", err)
 	}
 
-	var err error
-	r.StartTime, err = httputil.ParseTime(raw.StartTime)
+	to, err := httputil.ParseTimeIn(endTime, loc)
 	if err != nil {
-		return fmt.Errorf("parse startTime: %w", err)
+		return time.Time{}, time.Time{}, fmt.Errorf("parse endTime: %w", err)
 	}
 
-	r.EndTime, err = httputil.ParseTime(raw.EndTime)
+	return from, to, nil
+}
+
+// courtLocation resolves the time zone of the organization of the court and writes the error response when
+// it can't.
+func (h *ReservationHandler) courtLocation(
+	w http.ResponseWriter,
+	r *http.Request,
+	courtID string,
+) (*time.Location, bool) {
+	return resolveLocation(w, r, h.rsvService.CourtLocation, "court", courtID)
+}
+
+// resolveLocation looks up the time zone of the court or organization with the given id and writes the error
+// response when it can't. kind names what is looked up in the response and the log.
+func resolveLocation(
+	w http.ResponseWriter,
+	r *http.Request,
+	lookup func(ctx context.Context, id string) (*time.Location, error),
+	kind, id string,
+) (*time.Location, bool) {
+	loc, err := lookup(r.Context(), id)
 	if err != nil {
-		return fmt.Errorf("parse endTime: %w", err)
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: kind + " not found"})
+			return nil, false
+		}
+
+		log.Error().Err(err).Str(kind+" id", id).Msg("failed to get " + kind + " time zone")
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
 	}
 
-	return nil
+	return loc, true
 }
 
-type ReserveCourtResponse struct{}
-
 // ErrorResponse represents a standard error body.
 // swagger:model ErrorResponse
 type ErrorResponse struct {
@@ -78,17 +116,26 @@ type ErrorResponse struct {
 
 // ReserveCourt godoc
 // @Summary Reserve a court
-// @Description Creates a reservation for the specified organization and court.
+// @Description Places a pending hold on the slot of the specified court. The hold has to be confirmed
+// @Description before expiresAt, otherwise the slot is released. When the slot is taken, the user may join
+// @Description the waitlist of the organization to be offered it if it is freed. Times without an offset are
+// @Description read in the time zone of the organization. Bookings that break the booking rules of the court
+// @Description or fall outside its opening hours are rejected with 422.
 // @Tags reservations
 // @Security BearerAuth
 // @Param orgID path string true "Organization ID"
 // @Param courtID path string true "Court ID"
 // @Accept json
+// @Produce json
 // @Param reservation body ReserveCourtRequest true "Reservation payload"
-// @Success 200
+// @Success 201 {object} ReservationResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500
+// @Failure 503 {object} ErrorResponse
 // @Router /v1/organizations/{orgID}/courts/{courtID}/reservations [post]
 func (h *ReservationHandler) ReserveCourt(w http.ResponseWriter, r *http.Request) {
 	orgID := chi.URLParam(r, "orgID")
@@ -101,6 +148,11 @@ func (h *ReservationHandler) ReserveCourt(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
 	var req ReserveCourtRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -114,27 +166,51 @@ func (h *ReservationHandler) ReserveCourt(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if req.StartTime.IsZero() || req.EndTime.IsZero() {
+	if req.StartTime == "" || req.EndTime == "" {
 		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
 			Message: "startTime and endTime are required",
 		})
 		return
 	}
 
-	if !req.StartTime.Before(req.EndTime) {
+	loc, ok := h.courtLocation(w, r, courtID)
+	if !ok {
+		return
+	}
+
+	startTime, endTime, err := parseSlot(req.StartTime, req.EndTime, loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if !startTime.Before(endTime) {
 		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
 			Message: "startTime must be before endTime",
 		})
 		return
 	}
 
-	// TODO: add user_id after auth service implementation
-	reservation := entities.NewReservation(courtID, req.StartTime, req.EndTime, "")
+	reservation := entities.NewReservation(courtID, startTime, endTime, claims.UserID)
 
 	if err := h.rsvService.ReserveCourt(r.Context(), courtID, reservation); err != nil {
+		if isBookingRuleViolation(err) {
+			httputil.JSON(w, http.StatusUnprocessableEntity, ErrorResponse{Message: err.Error()})
+			return
+		}
 		if errors.Is(err, entities.ErrCourtAlreadyReserved) {
 			httputil.JSON(w, http.StatusConflict, ErrorResponse{
-				Message: "court is already reserved for this time slot",
+				Message: "court is already reserved for this time slot, join the waitlist to be offered it if freed",
+			})
+			return
+		}
+		if errors.Is(err, entities.ErrCourtBlackedOut) {
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, entities.ErrLockTimeout) {
+			httputil.JSON(w, http.StatusServiceUnavailable, ErrorResponse{
+				Message: "court is busy, please retry",
 			})
 			return
 		}
@@ -148,35 +224,122 @@ func (h *ReservationHandler) ReserveCourt(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	httputil.JSON(w, http.StatusCreated, newReservationResponse(*reservation))
+
 	log.Info().
 		Str("organization id", orgID).
 		Str("court id", courtID).
-		Time("start time", req.StartTime).
-		Time("end time", req.EndTime).
-		Msg("court was reserved")
+		Str("reserved_by", claims.UserID).
+		Time("start time", startTime).
+		Time("end time", endTime).
+		Time("expires at", reservation.ExpiresAt).
+		Msg("court was held")
 }
 
-// CancelReservationRequest represents the payload for cancelling a reservation
-// swagger:model CancelReservationRequest
-type CancelReservationRequest struct {
-	// CancelledBy is the ID or name of the user performing the cancellation
-	// example: "user_123"
-	CancelledBy string `json:"cancelledBy"`
+// isBookingRuleViolation reports whether the reservation was rejected by the booking rules of the court.
+func isBookingRuleViolation(err error) bool {
+	for _, target := range []error{
+		entities.ErrBookingDurationNotAllowed,
+		entities.ErrBookingNotAligned,
+		entities.ErrBookingTooFarAhead,
+		entities.ErrBookingNoticeTooShort,
+		entities.ErrTooManyActiveBookings,
+		entities.ErrOutsideOpeningHours,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfirmReservation godoc
+// @Summary Confirm a reservation
+// @Description Confirms a pending hold so the slot stays reserved. Only the user who placed the hold may confirm it.
+// @Description Holds with a price are confirmed by paying them instead.
+// @Tags reservations
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Produce json
+// @Success 200 {object} ReservationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 402 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/confirm [post]
+func (h *ReservationHandler) ConfirmReservation(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	if orgID == "" || courtID == "" || reservationID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID, courtID and reservationID are required",
+		})
+		return
+	}
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	rsv, err := h.rsvService.ConfirmReservation(r.Context(), courtID, reservationID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationHoldExpired):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation hold has expired"})
+		case errors.Is(err, entities.ErrReservationNotPending):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is not pending"})
+		case errors.Is(err, entities.ErrPaymentRequired):
+			httputil.JSON(w, http.StatusPaymentRequired, ErrorResponse{Message: "reservation has to be paid"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to confirm reservation")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newReservationResponse(*rsv))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Msg("reservation confirmed")
 }
 
 // CancelReservation godoc
 // @Summary Cancel a reservation
-// @Description Cancels the reservation with the specified ID.
+// @Description Cancels the reservation with the specified ID. Users can cancel their own bookings,
+// @Description staff of the organization can cancel any booking on its courts. The cancellation policy of the
+// @Description organization decides how much of the price is refunded, the terms are returned with the
+// @Description reservation. Staff may waive the policy to refund the whole price.
 // @Tags reservations
 // @Security BearerAuth
+// @Produce json
 // @Param orgID path string true "Organization ID"
 // @Param courtID path string true "Court ID"
 // @Param reservationID path string true "Reservation ID"
-// @Accept json
-// @Param cancel body CancelReservationRequest true "Cancellation payload"
-// @Success 200
+// @Param waivePolicy query bool false "Cancel without applying the cancellation policy, staff only"
+// @Success 200 {object} ReservationResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500
 // @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID} [delete]
 func (h *ReservationHandler) CancelReservation(w http.ResponseWriter, r *http.Request) {
@@ -191,24 +354,43 @@ func (h *ReservationHandler) CancelReservation(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	var req CancelReservationRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error().Err(err).Msg("failed to decode request body")
-		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
-		return
+	var waivePolicy bool
+	if raw := r.URL.Query().Get("waivePolicy"); raw != "" {
+		var err error
+		if waivePolicy, err = strconv.ParseBool(raw); err != nil {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "waivePolicy must be a boolean"})
+			return
+		}
 	}
 
-	if req.CancelledBy == "" {
-		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "cancelledBy is required"})
+	claims, ok := requireClaims(w, r)
+	if !ok {
 		return
 	}
 
-	if err := h.rsvService.CancelReservation(r.Context(), reservationID, req.CancelledBy); err != nil {
+	actor := entities.Actor{UserID: claims.UserID, Role: RoleFromContext(r.Context())}
+
+	rsv, err := h.rsvService.CancelReservation(r.Context(), orgID, courtID, reservationID, actor, waivePolicy)
+	if err != nil {
 		if errors.Is(err, entities.ErrNotFound) {
 			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
 			return
 		}
 
+		if errors.Is(err, entities.ErrForbidden) {
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{
+				Message: "reservation was booked by another user or the policy may only be waived by staff",
+			})
+			return
+		}
+
+		if errors.Is(err, entities.ErrReservationNotActive) {
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{
+				Message: "reservation expired, was moved or cancelled in the meantime",
+			})
+			return
+		}
+
 		log.Error().Err(err).
 			Str("reservation_id", reservationID).
 			Msg("failed to cancel reservation")
@@ -217,22 +399,293 @@ func (h *ReservationHandler) CancelReservation(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
+	httputil.JSON(w, http.StatusOK, newReservationResponse(*rsv))
+
 	log.Info().
 		Str("reservation_id", reservationID).
-		Str("cancelled_by", req.CancelledBy).
+		Str("cancelled_by", claims.UserID).
+		Int("refund_percent", rsv.Cancellation.RefundPercent).
+		Bool("policy_waived", rsv.Cancellation.Waived).
 		Msg("reservation cancelled successfully")
 }
 
+type RescheduleReservationRequest struct {
+	// CourtID is the court of the organization to move the reservation to, the current court when empty
+	CourtID string `json:"courtId,omitempty" example:"court-457"`
+
+	// StartTime is the new start timestamp, in the time zone of the organization unless it has an offset
+	// example: 2025-11-04T19:30
+	// format: date-time
+	StartTime string `json:"startTime" example:"2025-11-04T19:30" format:"date-time"`
+
+	// EndTime is the new end timestamp, in the time zone of the organization unless it has an offset
+	// example: 2025-11-04T20:45
+	// format: date-time
+	EndTime string `json:"endTime" example:"2025-11-04T20:45" format:"date-time"`
+}
+
+// RescheduleReservation godoc
+// @Summary Reschedule a reservation
+// @Description Moves the reservation to a new time and/or another court of the organization, without releasing
+// @Description its slot in between. The reservation keeps its ID, roster, payments and price. Users can move
+// @Description their own bookings, staff of the organization any booking on its courts, as long as it has not
+// @Description started and the new slot fits the booking rules and the opening hours of the court and costs
+// @Description the same as the reservation was booked for. Times without an offset are read in the time zone
+// @Description of the organization. Every move is recorded in the history of the reservation.
+// @Tags reservations
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Accept json
+// @Produce json
+// @Param reschedule body RescheduleReservationRequest true "New slot"
+// @Success 200 {object} ReservationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500
+// @Failure 503 {object} ErrorResponse
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID} [patch]
+func (h *ReservationHandler) RescheduleReservation(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	if orgID == "" || courtID == "" || reservationID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID, courtID and reservationID are required",
+		})
+		return
+	}
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req RescheduleReservationRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	if req.StartTime == "" || req.EndTime == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "startTime and endTime are required",
+		})
+		return
+	}
+
+	loc, ok := h.courtLocation(w, r, courtID)
+	if !ok {
+		return
+	}
+
+	startTime, endTime, err := parseSlot(req.StartTime, req.EndTime, loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	actor := entities.Actor{UserID: claims.UserID, Role: RoleFromContext(r.Context())}
+
+	rsv, err := h.rsvService.RescheduleReservation(
+		r.Context(),
+		orgID,
+		courtID,
+		reservationID,
+		actor,
+		req.CourtID,
+		startTime,
+		endTime,
+	)
+	if err != nil {
+		if isBookingRuleViolation(err) {
+			httputil.JSON(w, http.StatusUnprocessableEntity, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		switch {
+		case errors.Is(err, entities.ErrInvalidReschedule):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation or court not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrCourtAlreadyReserved):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{
+				Message: "court is already reserved for this time slot",
+			})
+		case errors.Is(err, entities.ErrCourtBlackedOut):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{
+				Message: "reservation already started, is cancelled or was moved in the meantime",
+			})
+		case errors.Is(err, entities.ErrLockTimeout):
+			httputil.JSON(w, http.StatusServiceUnavailable, ErrorResponse{Message: "court is busy, please retry"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to reschedule reservation")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newReservationResponse(*rsv))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("changed_by", claims.UserID).
+		Str("court_id", rsv.CourtID).
+		Time("start time", rsv.ReservedFrom).
+		Time("end time", rsv.ReservedTo).
+		Msg("reservation rescheduled")
+}
+
+type ReservationChangeResponse struct {
+	ID              string    `json:"id"              example:"chg-123"`
+	PreviousCourtID string    `json:"previousCourtId" example:"court-456"`
+	PreviousFrom    time.Time `json:"previousFrom"    example:"2025-11-04T18:30Z" format:"date-time"`
+	PreviousTo      time.Time `json:"previousTo"      example:"2025-11-04T19:45Z" format:"date-time"`
+	CourtID         string    `json:"courtId"         example:"court-457"`
+	ReservedFrom    time.Time `json:"reservedFrom"    example:"2025-11-04T19:30Z" format:"date-time"`
+	ReservedTo      time.Time `json:"reservedTo"      example:"2025-11-04T20:45Z" format:"date-time"`
+	ChangedBy       string    `json:"changedBy"       example:"user-789"`
+	ChangedAt       time.Time `json:"changedAt"       example:"2025-11-02T09:00:00Z" format:"date-time"`
+}
+
+type ListReservationChangesResponse struct {
+	Changes []ReservationChangeResponse `json:"changes"`
+}
+
+// ListReservationChanges godoc
+// @Summary List reservation changes
+// @Description Returns the history of moves of the reservation, the earliest first.
+// @Tags reservations
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Produce json
+// @Success 200 {object} ListReservationChangesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/changes [get]
+func (h *ReservationHandler) ListReservationChanges(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	if orgID == "" || courtID == "" || reservationID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID, courtID and reservationID are required",
+		})
+		return
+	}
+
+	changes, err := h.rsvService.ListReservationChanges(r.Context(), courtID, reservationID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("reservation_id", reservationID).
+			Msg("failed to list reservation changes")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := ListReservationChangesResponse{Changes: make([]ReservationChangeResponse, 0, len(changes))}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, ReservationChangeResponse{
+			ID:              c.ID,
+			PreviousCourtID: c.PreviousCourtID,
+			PreviousFrom:    c.PreviousFrom,
+			PreviousTo:      c.PreviousTo,
+			CourtID:         c.CourtID,
+			ReservedFrom:    c.ReservedFrom,
+			ReservedTo:      c.ReservedTo,
+			ChangedBy:       c.ChangedBy,
+			ChangedAt:       c.ChangedAt,
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
 type ReservationResponse struct {
-	ID           string    `json:"id"                    example:"res-123"`
-	CourtID      string    `json:"courtId"               example:"court-456"`
-	Status       string    `json:"status"                example:"reserved"`
-	ReservedFrom time.Time `json:"reservedFrom"          example:"2025-11-04T18:30Z"    format:"date-time"`
-	ReservedTo   time.Time `json:"reservedTo"            example:"2025-11-04T19:45Z"    format:"date-time"`
-	ReservedBy   string    `json:"reservedBy"            example:"user-789"`
-	CancelledBy  string    `json:"cancelledBy,omitempty" example:""`
-	CreatedAt    time.Time `json:"createdAt"             example:"2025-11-01T10:00:00Z" format:"date-time"`
+	ID           string         `json:"id"                    example:"res-123"`
+	CourtID      string         `json:"courtId"               example:"court-456"`
+	Status       string         `json:"status"                example:"reserved"`
+	ReservedFrom time.Time      `json:"reservedFrom"          example:"2025-11-04T18:30Z"    format:"date-time"`
+	ReservedTo   time.Time      `json:"reservedTo"            example:"2025-11-04T19:45Z"    format:"date-time"`
+	ReservedBy   string         `json:"reservedBy"            example:"user-789"`
+	CancelledBy  string         `json:"cancelledBy,omitempty" example:""`
+	SeriesID     string         `json:"seriesId,omitempty"    example:""`
+	ExpiresAt    *time.Time     `json:"expiresAt,omitempty"   example:"2025-11-01T10:15:00Z" format:"date-time"`
+	Price        *PriceResponse `json:"price,omitempty"`
+	// Cancellation holds the terms a cancelled reservation was cancelled on
+	Cancellation *CancellationResponse `json:"cancellation,omitempty"`
+	CreatedAt    time.Time             `json:"createdAt"             example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+type CancellationResponse struct {
+	RefundPercent int `json:"refundPercent" example:"50"`
+	// Fee is the part of the price the club keeps, Refund the part given back, both in minor units
+	Fee    int64 `json:"fee"    example:"1500"`
+	Refund int64 `json:"refund" example:"1500"`
+	// Waived is set when staff cancelled without applying the cancellation policy
+	Waived bool `json:"waived" example:"false"`
+}
+
+func newCancellationResponse(r entities.Reservation) *CancellationResponse {
+	if r.Cancellation == nil {
+		return nil
+	}
+
+	return &CancellationResponse{
+		RefundPercent: r.Cancellation.RefundPercent,
+		Fee:           r.Cancellation.Fee,
+		Refund:        r.Cancellation.Refund(r.Price),
+		Waived:        r.Cancellation.Waived,
+	}
+}
+
+func newReservationResponse(r entities.Reservation) ReservationResponse {
+	var expiresAt *time.Time
+	if r.Status == entities.PendingReservationStatus && !r.ExpiresAt.IsZero() {
+		expiresAt = &r.ExpiresAt
+	}
+
+	return ReservationResponse{
+		ID:           r.ID,
+		CourtID:      r.CourtID,
+		Status:       string(r.Status),
+		ReservedFrom: r.ReservedFrom,
+		ReservedTo:   r.ReservedTo,
+		ReservedBy:   r.ReservedBy,
+		CancelledBy:  r.CancelledBy,
+		SeriesID:     r.SeriesID,
+		ExpiresAt:    expiresAt,
+		Price:        newPriceResponse(r.Price),
+		Cancellation: newCancellationResponse(r),
+		CreatedAt:    r.CreatedAt,
+	}
 }
 
 type ListReservationsResponse struct {
@@ -241,7 +694,8 @@ type ListReservationsResponse struct {
 
 // ListReservations godoc
 // @Summary List reservations
-// @Description Returns all reservations for a court within a time range
+// @Description Returns all reservations for a court within a time range. Times without an offset are read in
+// @Description the time zone of the organization.
 // @Tags reservations
 // @Security BearerAuth
 // @Param orgID path string true "Organization ID"
@@ -251,6 +705,7 @@ type ListReservationsResponse struct {
 // @Produce json
 // @Success 200 {object} ListReservationsResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
 // @Failure 500
 // @Router /v1/organizations/{orgID}/courts/{courtID}/reservations [get]
 func (h *ReservationHandler) ListReservations(w http.ResponseWriter, r *http.Request) {
@@ -274,7 +729,12 @@ func (h *ReservationHandler) ListReservations(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	from, err := httputil.ParseTime(fromStr)
+	loc, ok := h.courtLocation(w, r, courtID)
+	if !ok {
+		return
+	}
+
+	from, err := httputil.ParseTimeIn(fromStr, loc)
 	if err != nil {
 		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
 			Message: "invalid from time format, expected RFC3339",
@@ -282,7 +742,7 @@ func (h *ReservationHandler) ListReservations(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	to, err := httputil.ParseTime(toStr)
+	to, err := httputil.ParseTimeIn(toStr, loc)
 	if err != nil {
 		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
 			Message: "invalid to time format, expected RFC3339",
@@ -311,15 +771,7 @@ func (h *ReservationHandler) ListReservations(w http.ResponseWriter, r *http.Req
 
 	dtos := make([]ReservationResponse, 0, len(revs))
 	for _, res := range revs {
-		dtos = append(dtos, ReservationResponse{
-			ID:           res.ID,
-			CourtID:      res.CourtID,
-			ReservedBy:   res.ReservedBy,
-			Status:       string(res.Status),
-			ReservedFrom: res.ReservedFrom,
-			ReservedTo:   res.ReservedTo,
-			CreatedAt:    res.CreatedAt,
-		})
+		dtos = append(dtos, newReservationResponse(res))
 	}
 
 	httputil.JSON(w, http.StatusOK, ListReservationsResponse{Reservations: dtos})
@@ -374,16 +826,5 @@ func (h *ReservationHandler) GetReservation(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	resp := ReservationResponse{
-		ID:           rev.ID,
-		CourtID:      rev.CourtID,
-		ReservedBy:   rev.ReservedBy,
-		Status:       string(rev.Status),
-		ReservedFrom: rev.ReservedFrom,
-		ReservedTo:   rev.ReservedTo,
-		CancelledBy:  rev.CancelledBy,
-		CreatedAt:    rev.CreatedAt,
-	}
-
-	httputil.JSON(w, http.StatusOK, resp)
+	httputil.JSON(w, http.StatusOK, newReservationResponse(*rev))
 }
@@ -0,0 +1,276 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeReservations struct {
+	loc      *time.Location
+	reserved *entities.Reservation
+	err      error
+	// booked maps the reservations that can be cancelled to the user who booked them
+	booked    map[string]string
+	cancelled []string
+}
+
+func (f *fakeReservations) ReserveCourt(_ context.Context, _ string, rsv *entities.Reservation) error {
+	f.reserved = rsv
+	return f.err
+}
+
+func (f *fakeReservations) ListReservations(
+	context.Context,
+	string,
+	time.Time, time.Time,
+) ([]entities.Reservation, error) {
+	return nil, nil
+}
+
+func (f *fakeReservations) CancelReservation(
+	_ context.Context,
+	_, courtID, reservationID string,
+	actor entities.Actor,
+	_ bool,
+) (*entities.Reservation, error) {
+	reservedBy, ok := f.booked[reservationID]
+	if !ok {
+		return nil, entities.ErrNotFound
+	}
+
+	if actor.UserID != reservedBy {
+		return nil, entities.ErrForbidden
+	}
+
+	f.cancelled = append(f.cancelled, reservationID)
+
+	return &entities.Reservation{
+		ID:           reservationID,
+		CourtID:      courtID,
+		Status:       entities.CancelledReservationStatus,
+		ReservedBy:   reservedBy,
+		CancelledBy:  actor.UserID,
+		Cancellation: &entities.Cancellation{RefundPercent: 100},
+	}, nil
+}
+
+func (f *fakeReservations) GetReservation(context.Context, string, string) (*entities.Reservation, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakeReservations) ConfirmReservation(context.Context, string, string, string) (*entities.Reservation, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakeReservations) RescheduleReservation(
+	context.Context,
+	string, string, string,
+	entities.Actor,
+	string,
+	time.Time, time.Time,
+) (*entities.Reservation, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (f *fakeReservations) ListReservationChanges(
+	context.Context,
+	string, string,
+) ([]entities.ReservationChange, error) {
+	return nil, nil
+}
+
+func (f *fakeReservations) CourtLocation(context.Context, string) (*time.Location, error) {
+	if f.loc == nil {
+		return nil, entities.ErrNotFound
+	}
+
+	return f.loc, nil
+}
+
+func newReservationRouter(reservations *fakeReservations) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Reservation: httpPkg.NewReservationHandler(reservations)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+			"rival-token":  {UserID: "player-2"},
+		},
+		fakeRoles{},
+	)
+}
+
+func TestReservationHandler_ReserveCourt_TimeZone(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		loc        *time.Location
+		body       string
+		err        error
+		wantStatus int
+		wantFrom   time.Time
+	}{
+		{
+			name:       "local time of the club",
+			loc:        madrid,
+			body:       `{"startTime": "2025-11-04T19:00", "endTime": "2025-11-04T20:30"}`,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 11, 4, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "local summer time before the DST change",
+			loc:        madrid,
+			body:       `{"startTime": "2025-10-25T19:00", "endTime": "2025-10-25T20:30"}`,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 10, 25, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "explicit offset",
+			loc:        madrid,
+			body:       `{"startTime": "2025-11-04T19:00:00Z", "endTime": "2025-11-04T20:30:00Z"}`,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 11, 4, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "outside opening hours",
+			loc:        madrid,
+			body:       `{"startTime": "2025-11-04T23:00", "endTime": "2025-11-04T23:30"}`,
+			err:        entities.ErrOutsideOpeningHours,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "unknown court",
+			body:       `{"startTime": "2025-11-04T19:00", "endTime": "2025-11-04T20:30"}`,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reservations := &fakeReservations{loc: tt.loc, err: tt.err}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/reservations",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newReservationRouter(reservations).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			require.Equal(t, tt.wantFrom, reservations.reserved.ReservedFrom)
+			require.Equal(t, time.UTC, reservations.reserved.ReservedFrom.Location())
+		})
+	}
+}
+
+func TestReservationHandler_ReserveCourt_ReservedByCaller(t *testing.T) {
+	reservations := &fakeReservations{loc: time.UTC}
+
+	// the body cannot book in the name of another user, the booker is the user of the token
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/organizations/club-a/courts/court-1/reservations",
+		strings.NewReader(`{"startTime": "2025-11-04T19:00", "endTime": "2025-11-04T20:30", "reservedBy": "player-2"}`),
+	)
+	req.Header.Set("Authorization", "Bearer player-token")
+
+	rec := httptest.NewRecorder()
+	newReservationRouter(reservations).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.Equal(t, "player-1", reservations.reserved.ReservedBy)
+
+	var resp httpPkg.ReservationResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, "player-1", resp.ReservedBy)
+}
+
+func TestReservationHandler_ReserveCourt_NoToken(t *testing.T) {
+	reservations := &fakeReservations{loc: time.UTC}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/organizations/club-a/courts/court-1/reservations",
+		strings.NewReader(`{"startTime": "2025-11-04T19:00", "endTime": "2025-11-04T20:30", "reservedBy": "player-1"}`),
+	)
+
+	rec := httptest.NewRecorder()
+	newReservationRouter(reservations).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+	require.Nil(t, reservations.reserved)
+}
+
+func TestReservationHandler_CancelReservation(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		reservationID string
+		wantStatus    int
+		wantCancelled []string
+	}{
+		{
+			name:          "own reservation",
+			token:         "player-token",
+			reservationID: "rsv-1",
+			wantStatus:    http.StatusOK,
+			wantCancelled: []string{"rsv-1"},
+		},
+		{
+			name:          "reservation of another user",
+			token:         "rival-token",
+			reservationID: "rsv-1",
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:          "unknown reservation",
+			token:         "player-token",
+			reservationID: "rsv-2",
+			wantStatus:    http.StatusNotFound,
+		},
+		{
+			name:          "no token",
+			reservationID: "rsv-1",
+			wantStatus:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reservations := &fakeReservations{booked: map[string]string{"rsv-1": "player-1"}}
+
+			req := httptest.NewRequest(
+				http.MethodDelete,
+				"/v1/organizations/club-a/courts/court-1/reservations/"+tt.reservationID,
+				nil,
+			)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			newReservationRouter(reservations).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			require.Equal(t, tt.wantCancelled, reservations.cancelled)
+		})
+	}
+}
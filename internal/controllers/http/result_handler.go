@@ -0,0 +1,423 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type RatingService interface {
+	ReportResult(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+		teamA, teamB []string,
+		sets []entities.SetScore,
+	) (*entities.MatchResult, error)
+	GetResult(ctx context.Context, organizationID, courtID, reservationID string) (*entities.MatchResult, error)
+	ConfirmResult(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+	) (*entities.MatchResult, error)
+	DisputeResult(ctx context.Context, organizationID, courtID, reservationID, userID string) error
+	ResolveDispute(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+		teamA, teamB []string,
+		sets []entities.SetScore,
+	) (*entities.MatchResult, error)
+	Rating(ctx context.Context, userID string) (entities.PlayerRating, []entities.RatingChange, error)
+}
+
+type ResultHandler struct {
+	ratingService RatingService
+}
+
+func NewResultHandler(service RatingService) *ResultHandler {
+	return &ResultHandler{
+		ratingService: service,
+	}
+}
+
+// swagger:model SetScore
+type SetScore struct {
+	TeamA int `json:"teamA" example:"6"`
+	TeamB int `json:"teamB" example:"4"`
+}
+
+// swagger:model ReportResultRequest
+type ReportResultRequest struct {
+	// TeamA and TeamB hold the user IDs of the players, one or two per team
+	TeamA []string   `json:"teamA" example:"user-1,user-2"`
+	TeamB []string   `json:"teamB" example:"user-3,user-4"`
+	Sets  []SetScore `json:"sets"`
+}
+
+func parseSetScores(scores []SetScore) []entities.SetScore {
+	sets := make([]entities.SetScore, 0, len(scores))
+	for _, set := range scores {
+		sets = append(sets, entities.SetScore{TeamA: set.TeamA, TeamB: set.TeamB})
+	}
+
+	return sets
+}
+
+// swagger:model ResultResponse
+type ResultResponse struct {
+	ReservationID string     `json:"reservationId" example:"res-123"`
+	TeamA         []string   `json:"teamA"         example:"user-1,user-2"`
+	TeamB         []string   `json:"teamB"         example:"user-3,user-4"`
+	Sets          []SetScore `json:"sets"`
+	Status        string     `json:"status"        example:"pending"`
+	ReportedBy    string     `json:"reportedBy"    example:"user-1"`
+	AnsweredBy    string     `json:"answeredBy"    example:"user-3"`
+	UpdatedAt     time.Time  `json:"updatedAt"     example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newResultResponse(r entities.MatchResult) ResultResponse {
+	sets := make([]SetScore, 0, len(r.Sets))
+	for _, set := range r.Sets {
+		sets = append(sets, SetScore{TeamA: set.TeamA, TeamB: set.TeamB})
+	}
+
+	return ResultResponse{
+		ReservationID: r.ReservationID,
+		TeamA:         r.TeamA,
+		TeamB:         r.TeamB,
+		Sets:          sets,
+		Status:        string(r.Status),
+		ReportedBy:    r.ReportedBy,
+		AnsweredBy:    r.AnsweredBy,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// swagger:model RatingChangeResponse
+type RatingChangeResponse struct {
+	ReservationID string    `json:"reservationId" example:"res-123"`
+	Before        float64   `json:"before"        example:"1500"`
+	After         float64   `json:"after"         example:"1520.5"`
+	CreatedAt     time.Time `json:"createdAt"     example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+// swagger:model RatingResponse
+type RatingResponse struct {
+	Rating     float64                `json:"rating"     example:"1520.5"`
+	RatedGames int                    `json:"ratedGames" example:"1"`
+	History    []RatingChangeResponse `json:"history"`
+}
+
+// ReportResult godoc
+// @Summary Report a match result
+// @Description Records the teams and set scores of a reservation that is over. The reporter plays in one of
+// @Description the teams and every player must have been on the roster. The opposing team confirms the result.
+// @Tags results
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param result body ReportResultRequest true "Teams and set scores"
+// @Success 201 {object} ResultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result [post]
+func (h *ResultHandler) ReportResult(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req ReportResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	result, err := h.ratingService.ReportResult(
+		r.Context(),
+		orgID,
+		courtID,
+		reservationID,
+		claims.UserID,
+		req.TeamA,
+		req.TeamB,
+		parseSetScores(req.Sets),
+	)
+	if err != nil {
+		if !writeResultError(w, err) {
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to report result")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newResultResponse(*result))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("user_id", claims.UserID).
+		Msg("result reported")
+}
+
+// GetResult godoc
+// @Summary Get a match result
+// @Tags results
+// @Security BearerAuth
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Success 200 {object} ResultResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result [get]
+func (h *ResultHandler) GetResult(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	result, err := h.ratingService.GetResult(r.Context(), orgID, courtID, reservationID)
+	if err != nil {
+		if !writeResultError(w, err) {
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to get result")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newResultResponse(*result))
+}
+
+// ConfirmResult godoc
+// @Summary Confirm a match result
+// @Description Accepts the pending result on behalf of the opposing team and updates the ratings of the players.
+// @Tags results
+// @Security BearerAuth
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Success 200 {object} ResultResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/confirm [post]
+func (h *ResultHandler) ConfirmResult(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.ratingService.ConfirmResult(r.Context(), orgID, courtID, reservationID, claims.UserID)
+	if err != nil {
+		if !writeResultError(w, err) {
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to confirm result")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newResultResponse(*result))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("user_id", claims.UserID).
+		Msg("result confirmed")
+}
+
+// DisputeResult godoc
+// @Summary Dispute a match result
+// @Description Contests the pending result on behalf of the opposing team. The ratings of the players do not
+// @Description change until the club resolves the dispute.
+// @Tags results
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/dispute [post]
+func (h *ResultHandler) DisputeResult(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.ratingService.DisputeResult(r.Context(), orgID, courtID, reservationID, claims.UserID)
+	if err != nil {
+		if !writeResultError(w, err) {
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to dispute result")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("user_id", claims.UserID).
+		Msg("result disputed")
+}
+
+// ResolveDispute godoc
+// @Summary Resolve a disputed match result
+// @Description Settles a disputed result with the teams and set scores the club decided on, and updates the
+// @Description ratings of the players. Requires the staff role in the organization.
+// @Tags results
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param result body ReportResultRequest true "Teams and set scores"
+// @Success 200 {object} ResultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/resolve [post]
+func (h *ResultHandler) ResolveDispute(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req ReportResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	result, err := h.ratingService.ResolveDispute(
+		r.Context(),
+		orgID,
+		courtID,
+		reservationID,
+		claims.UserID,
+		req.TeamA,
+		req.TeamB,
+		parseSetScores(req.Sets),
+	)
+	if err != nil {
+		if !writeResultError(w, err) {
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to resolve dispute")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, newResultResponse(*result))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("user_id", claims.UserID).
+		Msg("dispute resolved")
+}
+
+// GetMyRating godoc
+// @Summary Get my rating
+// @Description Returns the rating of the current user, computed from the confirmed results of their matches,
+// @Description with the latest rating changes first.
+// @Tags results
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} RatingResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/me/rating [get]
+func (h *ResultHandler) GetMyRating(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	rating, history, err := h.ratingService.Rating(r.Context(), claims.UserID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", claims.UserID).Msg("failed to get rating")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := RatingResponse{
+		Rating:     rating.Rating,
+		RatedGames: rating.RatedGames,
+		History:    make([]RatingChangeResponse, 0, len(history)),
+	}
+	for _, change := range history {
+		resp.History = append(resp.History, RatingChangeResponse{
+			ReservationID: change.ReservationID,
+			Before:        change.Before,
+			After:         change.After,
+			CreatedAt:     change.CreatedAt,
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// writeResultError writes the response for the errors of the result flow, returning false for unexpected ones.
+func writeResultError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, entities.ErrInvalidResult):
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	case errors.Is(err, entities.ErrNotFound):
+		httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "result not found"})
+	case errors.Is(err, entities.ErrForbidden):
+		httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: err.Error()})
+	case errors.Is(err, entities.ErrReservationNotOver):
+		httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is not over yet"})
+	case errors.Is(err, entities.ErrReservationNotActive):
+		httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation was cancelled"})
+	case errors.Is(err, entities.ErrResultAlreadyReported):
+		httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "result is already reported"})
+	case errors.Is(err, entities.ErrInvalidResultTransition):
+		httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
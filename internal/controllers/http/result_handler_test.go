@@ -0,0 +1,235 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeResults struct {
+	sets     []entities.SetScore
+	resolved bool
+	err      error
+}
+
+func (f *fakeResults) ReportResult(
+	_ context.Context,
+	_, _, reservationID string,
+	userID string,
+	teamA, teamB []string,
+	sets []entities.SetScore,
+) (*entities.MatchResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.sets = sets
+	return entities.NewMatchResult(reservationID, userID, teamA, teamB, sets, time.Now()), nil
+}
+
+func (f *fakeResults) GetResult(context.Context, string, string, string) (*entities.MatchResult, error) {
+	return nil, f.err
+}
+
+func (f *fakeResults) ConfirmResult(context.Context, string, string, string, string) (*entities.MatchResult, error) {
+	return nil, f.err
+}
+
+func (f *fakeResults) DisputeResult(context.Context, string, string, string, string) error {
+	return f.err
+}
+
+func (f *fakeResults) ResolveDispute(
+	_ context.Context,
+	_, _, reservationID string,
+	userID string,
+	teamA, teamB []string,
+	sets []entities.SetScore,
+) (*entities.MatchResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.resolved = true
+
+	result := entities.NewMatchResult(reservationID, "player-1", teamA, teamB, sets, time.Now())
+	result.Status = entities.ConfirmedResultStatus
+	result.AnsweredBy = userID
+	return result, nil
+}
+
+func (f *fakeResults) Rating(context.Context, string) (entities.PlayerRating, []entities.RatingChange, error) {
+	history := []entities.RatingChange{{UserID: "player-1", ReservationID: "res-1", Before: 1500, After: 1520}}
+	return entities.PlayerRating{UserID: "player-1", Rating: 1520, RatedGames: 1}, history, f.err
+}
+
+func newResultRouter(results *fakeResults) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Result: httpPkg.NewResultHandler(results)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+			"staff-token":  {UserID: "staff-1"},
+		},
+		fakeRoles{
+			"club-a/staff-1": entities.StaffRole,
+		},
+	)
+}
+
+const resultBody = `{"teamA": ["player-1", "player-2"], "teamB": ["player-3", "player-4"],
+	"sets": [{"teamA": 6, "teamB": 4}, {"teamA": 7, "teamB": 5}]}`
+
+func TestResultHandler_ReportResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "reported",
+			body:       resultBody,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "invalid json",
+			body:       `{"teamA": `,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid result",
+			body:       resultBody,
+			err:        entities.ErrInvalidResult,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "reporter did not play",
+			body:       resultBody,
+			err:        entities.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "reservation is not over",
+			body:       resultBody,
+			err:        entities.ErrReservationNotOver,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "already reported",
+			body:       resultBody,
+			err:        entities.ErrResultAlreadyReported,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := &fakeResults{err: tt.err}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/reservations/res-1/result",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newResultRouter(results).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			require.Equal(t, []entities.SetScore{{TeamA: 6, TeamB: 4}, {TeamA: 7, TeamB: 5}}, results.sets)
+
+			var resp httpPkg.ResultResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, "player-1", resp.ReportedBy)
+			require.Equal(t, string(entities.PendingResultStatus), resp.Status)
+			require.Equal(t, []string{"player-3", "player-4"}, resp.TeamB)
+		})
+	}
+}
+
+func TestResultHandler_ResolveDispute(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "resolved by staff",
+			token:      "staff-token",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "players can not resolve",
+			token:      "player-token",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "result is not disputed",
+			token:      "staff-token",
+			err:        entities.ErrInvalidResultTransition,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := &fakeResults{err: tt.err}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/reservations/res-1/result/resolve",
+				strings.NewReader(resultBody),
+			)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+			newResultRouter(results).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			require.True(t, results.resolved)
+
+			var resp httpPkg.ResultResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, string(entities.ConfirmedResultStatus), resp.Status)
+			require.Equal(t, "staff-1", resp.AnsweredBy)
+		})
+	}
+}
+
+func TestResultHandler_GetMyRating(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/me/rating", nil)
+	req.Header.Set("Authorization", "Bearer player-token")
+
+	rec := httptest.NewRecorder()
+	newResultRouter(&fakeResults{}).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp httpPkg.RatingResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.InDelta(t, 1520, resp.Rating, 0.001)
+	require.Equal(t, 1, resp.RatedGames)
+	require.Len(t, resp.History, 1)
+	require.Equal(t, "res-1", resp.History[0].ReservationID)
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type RoleChecker interface {
+	GetMemberRole(ctx context.Context, organizationID, userID string) (entities.Role, error)
+}
+
+// RoleMiddleware authorizes requests by the role of the caller in the {orgID} organization.
+// It has to run after the auth middleware.
+type RoleMiddleware struct {
+	checker RoleChecker
+}
+
+func NewRoleMiddleware(checker RoleChecker) *RoleMiddleware {
+	return &RoleMiddleware{
+		checker: checker,
+	}
+}
+
+type roleContextKey struct{}
+
+// RoleFromContext returns the role of the caller in the organization of the request,
+// empty when the caller is not a member.
+func RoleFromContext(ctx context.Context) entities.Role {
+	role, _ := ctx.Value(roleContextKey{}).(entities.Role)
+	return role
+}
+
+// Require lets the request through only when the caller has at least the given role.
+func (m *RoleMiddleware) Require(minRole entities.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, ok := m.resolve(w, r)
+			if !ok {
+				return
+			}
+
+			if !role.AtLeast(minRole) {
+				httputil.JSON(w, http.StatusForbidden, ErrorResponse{
+					Message: "insufficient role in this organization",
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), roleContextKey{}, role)))
+		})
+	}
+}
+
+// Load puts the role of the caller on the request context without rejecting non-members,
+// for handlers that decide on their own what each role may do.
+func (m *RoleMiddleware) Load(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		role, ok := m.resolve(w, r)
+		if !ok {
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), roleContextKey{}, role)))
+	})
+}
+
+// resolve looks up the role of the caller, writing the error response and returning false on failure.
+func (m *RoleMiddleware) resolve(w http.ResponseWriter, r *http.Request) (entities.Role, bool) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return "", false
+	}
+
+	orgID := chi.URLParam(r, "orgID")
+	if orgID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "orgID is required"})
+		return "", false
+	}
+
+	role, err := m.checker.GetMemberRole(r.Context(), orgID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return "", true
+		}
+
+		log.Error().
+			Err(err).
+			Str("organization id", orgID).
+			Str("user id", claims.UserID).
+			Msg("failed to get member role")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return "", false
+	}
+
+	return role, true
+}
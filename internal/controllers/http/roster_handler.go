@@ -0,0 +1,322 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type RosterService interface {
+	Invite(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+		invitee entities.CoPlayer,
+	) (*entities.ReservationPlayer, error)
+	Accept(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error
+	Decline(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error
+	Leave(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error
+	ListRoster(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		userID string,
+	) ([]entities.ReservationPlayer, error)
+	UpcomingGames(ctx context.Context, userID string) ([]entities.Game, error)
+}
+
+type RosterHandler struct {
+	rosterService RosterService
+}
+
+func NewRosterHandler(service RosterService) *RosterHandler {
+	return &RosterHandler{
+		rosterService: service,
+	}
+}
+
+// swagger:model PlayerResponse
+type PlayerResponse struct {
+	UserID    string    `json:"userId"    example:"user-123"`
+	InvitedBy string    `json:"invitedBy" example:"user-789"`
+	Status    string    `json:"status"    example:"invited"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newPlayerResponse(p entities.ReservationPlayer) PlayerResponse {
+	return PlayerResponse{
+		UserID:    p.UserID,
+		InvitedBy: p.InvitedBy,
+		Status:    string(p.Status),
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// swagger:model GameResponse
+type GameResponse struct {
+	Reservation ReservationResponse `json:"reservation"`
+	// PlayerStatus is the answer of the current user to the invitation, the booker has accepted by booking
+	PlayerStatus string `json:"playerStatus" example:"accepted"`
+}
+
+// InvitePlayer godoc
+// @Summary Invite a player
+// @Description Invites a player to the roster of a reservation by nickname or by phone number. Only the booker
+// @Description invites, up to the number of players the court allows.
+// @Tags roster
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param player body CoPlayerRequest true "Invited player"
+// @Success 201 {object} PlayerResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players [post]
+func (h *RosterHandler) InvitePlayer(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req CoPlayerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	invitee := entities.CoPlayer{Nickname: req.Nickname, PhoneNumber: req.PhoneNumber}
+
+	player, err := h.rosterService.Invite(r.Context(), orgID, courtID, reservationID, claims.UserID, invitee)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidInvitation):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation or player not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is over or cancelled"})
+		case errors.Is(err, entities.ErrRosterFull):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "roster is full"})
+		case errors.Is(err, entities.ErrPlayerAlreadyInvited):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "player is already invited"})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Msg("failed to invite player")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newPlayerResponse(*player))
+
+	log.Info().
+		Str("reservation_id", reservationID).
+		Str("user_id", player.UserID).
+		Msg("player invited")
+}
+
+// ListPlayers godoc
+// @Summary List the players of a reservation
+// @Description Returns the roster of a reservation, the booker first. Players who declined or left are
+// @Description listed with their status. Only the booker and the invited players may see it.
+// @Tags roster
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Produce json
+// @Success 200 {array} PlayerResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players [get]
+func (h *RosterHandler) ListPlayers(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	players, err := h.rosterService.ListRoster(r.Context(), orgID, courtID, reservationID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "user does not play in this reservation"})
+		default:
+			log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to list players")
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	resp := make([]PlayerResponse, 0, len(players))
+	for _, p := range players {
+		resp = append(resp, newPlayerResponse(p))
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an invitation
+// @Description Takes the spot the current user was invited to
+// @Tags roster
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param userID path string true "User ID of the invited player"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}/accept [post]
+func (h *RosterHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	h.changePlayer(w, r, h.rosterService.Accept, "accept invitation")
+}
+
+// DeclineInvitation godoc
+// @Summary Decline an invitation
+// @Description Turns the invitation of the current user down and frees the spot
+// @Tags roster
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param userID path string true "User ID of the invited player"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}/decline [post]
+func (h *RosterHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	h.changePlayer(w, r, h.rosterService.Decline, "decline invitation")
+}
+
+// RemovePlayer godoc
+// @Summary Leave a reservation
+// @Description Takes the player off the roster. Players leave on their own, the booker may remove any of them.
+// @Tags roster
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param reservationID path string true "Reservation ID"
+// @Param userID path string true "User ID of the player"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID} [delete]
+func (h *RosterHandler) RemovePlayer(w http.ResponseWriter, r *http.Request) {
+	h.changePlayer(w, r, h.rosterService.Leave, "remove player")
+}
+
+func (h *RosterHandler) changePlayer(
+	w http.ResponseWriter,
+	r *http.Request,
+	change func(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error,
+	action string,
+) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	reservationID := chi.URLParam(r, "reservationID")
+	playerID := chi.URLParam(r, "userID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	err := change(r.Context(), orgID, courtID, reservationID, playerID, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation or player not found"})
+		case errors.Is(err, entities.ErrForbidden):
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "not allowed to " + action})
+		case errors.Is(err, entities.ErrReservationNotActive):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: "reservation is over or cancelled"})
+		case errors.Is(err, entities.ErrInvalidPlayerTransition):
+			httputil.JSON(w, http.StatusConflict, ErrorResponse{Message: err.Error()})
+		default:
+			log.Error().
+				Err(err).
+				Str("reservation_id", reservationID).
+				Str("user_id", playerID).
+				Msg("failed to " + action)
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListUpcomingGames godoc
+// @Summary List upcoming games
+// @Description Returns the reservations the current user booked, was invited to or accepted which did not end yet
+// @Tags roster
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} GameResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/me/games [get]
+func (h *RosterHandler) ListUpcomingGames(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	games, err := h.rosterService.UpcomingGames(r.Context(), claims.UserID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", claims.UserID).Msg("failed to list upcoming games")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]GameResponse, 0, len(games))
+	for _, g := range games {
+		resp = append(resp, GameResponse{
+			Reservation:  newReservationResponse(g.Reservation),
+			PlayerStatus: string(g.Status),
+		})
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
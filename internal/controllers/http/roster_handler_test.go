@@ -0,0 +1,235 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeRoster struct {
+	invitee  entities.CoPlayer
+	playerID string
+	games    []entities.Game
+	err      error
+}
+
+func (f *fakeRoster) Invite(
+	_ context.Context,
+	_, _, reservationID string,
+	userID string,
+	invitee entities.CoPlayer,
+) (*entities.ReservationPlayer, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.invitee = invitee
+	return entities.NewReservationPlayer(reservationID, "player-2", userID, time.Now()), nil
+}
+
+func (f *fakeRoster) Accept(_ context.Context, _, _, _, playerID, _ string) error {
+	f.playerID = playerID
+	return f.err
+}
+
+func (f *fakeRoster) Decline(_ context.Context, _, _, _, playerID, _ string) error {
+	f.playerID = playerID
+	return f.err
+}
+
+func (f *fakeRoster) Leave(_ context.Context, _, _, _, playerID, _ string) error {
+	f.playerID = playerID
+	return f.err
+}
+
+func (f *fakeRoster) ListRoster(context.Context, string, string, string, string) ([]entities.ReservationPlayer, error) {
+	return nil, f.err
+}
+
+func (f *fakeRoster) UpcomingGames(context.Context, string) ([]entities.Game, error) {
+	return f.games, f.err
+}
+
+func newRosterRouter(roster *fakeRoster) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Roster: httpPkg.NewRosterHandler(roster)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+		},
+		fakeRoles{},
+	)
+}
+
+func TestRosterHandler_InvitePlayer(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "invited",
+			body:       `{"nickname": "bob"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "invalid json",
+			body:       `{"nickname": `,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid invitation",
+			body:       `{}`,
+			err:        fmt.Errorf("%w: a player needs a nickname or a phone number", entities.ErrInvalidInvitation),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not the booker",
+			body:       `{"nickname": "bob"}`,
+			err:        entities.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "roster is full",
+			body:       `{"nickname": "bob"}`,
+			err:        entities.ErrRosterFull,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "already invited",
+			body:       `{"nickname": "bob"}`,
+			err:        entities.ErrPlayerAlreadyInvited,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roster := &fakeRoster{err: tt.err}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/courts/court-1/reservations/res-1/players",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newRosterRouter(roster).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			require.Equal(t, entities.CoPlayer{Nickname: "bob"}, roster.invitee)
+
+			var resp httpPkg.PlayerResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, "player-2", resp.UserID)
+			require.Equal(t, "player-1", resp.InvitedBy)
+			require.Equal(t, string(entities.InvitedPlayerStatus), resp.Status)
+		})
+	}
+}
+
+func TestRosterHandler_ChangePlayer(t *testing.T) {
+	const path = "/v1/organizations/club-a/courts/court-1/reservations/res-1/players/player-2"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "accept",
+			method:     http.MethodPost,
+			path:       path + "/accept",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "decline",
+			method:     http.MethodPost,
+			path:       path + "/decline",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "leave",
+			method:     http.MethodDelete,
+			path:       path,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "invitation of another user",
+			method:     http.MethodPost,
+			path:       path + "/accept",
+			err:        entities.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "already answered",
+			method:     http.MethodPost,
+			path:       path + "/decline",
+			err:        entities.ErrInvalidPlayerTransition,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "unknown player",
+			method:     http.MethodDelete,
+			path:       path,
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roster := &fakeRoster{err: tt.err}
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newRosterRouter(roster).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			require.Equal(t, "player-2", roster.playerID)
+		})
+	}
+}
+
+func TestRosterHandler_ListUpcomingGames(t *testing.T) {
+	roster := &fakeRoster{games: []entities.Game{
+		{
+			Reservation: entities.Reservation{ID: "res-1", Status: entities.ReservedReservationStatus},
+			Status:      entities.InvitedPlayerStatus,
+		},
+	}}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/me/games", nil)
+	req.Header.Set("Authorization", "Bearer player-token")
+
+	rec := httptest.NewRecorder()
+	newRosterRouter(roster).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp []httpPkg.GameResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	require.Equal(t, "res-1", resp[0].Reservation.ID)
+	require.Equal(t, string(entities.InvitedPlayerStatus), resp[0].PlayerStatus)
+}
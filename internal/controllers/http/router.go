@@ -8,15 +8,34 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/httprate"
 	_ "github.com/lever-dev/padel-backend/docs"
+	"github.com/lever-dev/padel-backend/internal/entities"
 	swagger "github.com/swaggo/http-swagger"
 )
 
+// Handlers are the handlers the router serves. A handler left nil answers its routes with 500, so a test can
+// build a router around the single handler it exercises.
+type Handlers struct {
+	Reservation  *ReservationHandler
+	Organization *OrganizationHandler
+	Court        *CourtHandler
+	Availability *AvailabilityHandler
+	Series       *SeriesHandler
+	Auth         *AuthHandler
+	Member       *MemberHandler
+	Pricing      *PricingHandler
+	Policy       *PolicyHandler
+	Payment      *PaymentHandler
+	Roster       *RosterHandler
+	Match        *MatchHandler
+	Result       *ResultHandler
+	Waitlist     *WaitlistHandler
+	Blackout     *BlackoutHandler
+}
+
 func NewRouter(
-	reservationHandler *ReservationHandler,
-	organizationHandler *OrganizationHandler,
-	courtHandler *CourtHandler,
-	authHandler *AuthHandler,
+	handlers Handlers,
 	authMiddleware func(http.Handler) http.Handler,
+	roleMiddleware *RoleMiddleware,
 ) http.Handler {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID, middleware.RealIP, middleware.Recoverer)
@@ -29,36 +48,208 @@ func NewRouter(
 
 	r.Get("/_docs/*", swagger.Handler())
 
+	r.Get("/.well-known/jwks.json", handlers.Auth.JWKS)
+
 	r.Route("/v1", func(r chi.Router) {
 		r.Group(func(r chi.Router) {
 			if authMiddleware != nil {
 				r.Use(authMiddleware)
 			}
 
-			r.Post("/organizations", organizationHandler.CreateOrganization)
-			r.Get("/organizations/{orgID}", organizationHandler.GetOrganization)
-			r.Get("/organizations", organizationHandler.GetOrganizationsByCity)
-			r.Put("/organizations/{orgID}", organizationHandler.UpdateOrganization)
+			r.Post("/auth/logout", handlers.Auth.Logout)
+			r.Get("/auth/sessions", handlers.Auth.ListSessions)
+			r.Delete("/auth/sessions/{sessionID}", handlers.Auth.RevokeSession)
 
-			r.Post("/organizations/{orgID}/courts/{courtID}/reservations", reservationHandler.ReserveCourt)
-			r.Delete(
+			r.Post("/organizations", handlers.Organization.CreateOrganization)
+			r.Get("/organizations/{orgID}", handlers.Organization.GetOrganization)
+			r.Get("/organizations", handlers.Organization.GetOrganizationsByCity)
+			r.Get("/organizations/nearby", handlers.Organization.NearbyOrganizations)
+			r.With(roleMiddleware.Require(entities.ManagerRole)).
+				Put("/organizations/{orgID}", handlers.Organization.UpdateOrganization)
+
+			r.With(roleMiddleware.Require(entities.StaffRole)).
+				Get("/organizations/{orgID}/members", handlers.Member.ListMembers)
+			r.With(roleMiddleware.Require(entities.ManagerRole)).
+				Post("/organizations/{orgID}/members", handlers.Member.InviteMember)
+			r.Delete("/organizations/{orgID}/members/{userID}", handlers.Member.RemoveMember)
+
+			r.Post("/organizations/{orgID}/courts/{courtID}/reservations", handlers.Reservation.ReserveCourt)
+			r.With(roleMiddleware.Load).Delete(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}",
+				handlers.Reservation.CancelReservation,
+			)
+			r.With(roleMiddleware.Load).Patch(
 				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}",
-				reservationHandler.CancelReservation,
+				handlers.Reservation.RescheduleReservation,
+			)
+			r.Get(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/changes",
+				handlers.Reservation.ListReservationChanges,
 			)
-			r.Get("/organizations/{orgID}/courts/{courtID}/reservations", reservationHandler.ListReservations)
+			r.Get("/organizations/{orgID}/courts/{courtID}/reservations", handlers.Reservation.ListReservations)
 			r.Get(
 				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}",
-				reservationHandler.GetReservation,
+				handlers.Reservation.GetReservation,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/confirm",
+				handlers.Reservation.ConfirmReservation,
 			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/payment",
+				handlers.Payment.PayReservation,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/split",
+				handlers.Payment.SplitReservation,
+			)
+			r.Get(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/shares",
+				handlers.Payment.ListShares,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/shares/{shareID}/payment",
+				handlers.Payment.PayShare,
+			)
+			r.Get("/payments/{paymentID}", handlers.Payment.GetPayment)
 
-			r.Post("/organizations/{orgID}/courts", courtHandler.CreateCourt)
-			r.Get("/organizations/{orgID}/courts", courtHandler.ListCourts)
-			r.Get("/organizations/{orgID}/courts/{courtID}", courtHandler.GetCourt)
-			r.Put("/organizations/{orgID}/courts/{courtID}", courtHandler.UpdateCourt)
+			r.Get(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players",
+				handlers.Roster.ListPlayers,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players",
+				handlers.Roster.InvitePlayer,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}/accept",
+				handlers.Roster.AcceptInvitation,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}/decline",
+				handlers.Roster.DeclineInvitation,
+			)
+			r.Delete(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/players/{userID}",
+				handlers.Roster.RemovePlayer,
+			)
+			r.Get("/me/games", handlers.Roster.ListUpcomingGames)
+			r.Put("/me/level", handlers.Auth.SetLevel)
+
+			r.Put(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match",
+				handlers.Match.OpenMatch,
+			)
+			r.Delete(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match",
+				handlers.Match.CloseMatch,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/join",
+				handlers.Match.JoinMatch,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/requests/{userID}/accept",
+				handlers.Match.ApproveJoinRequest,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/match/requests/{userID}/reject",
+				handlers.Match.RejectJoinRequest,
+			)
+			r.Get("/matches", handlers.Match.SearchMatches)
+
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result",
+				handlers.Result.ReportResult,
+			)
+			r.Get(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result",
+				handlers.Result.GetResult,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/confirm",
+				handlers.Result.ConfirmResult,
+			)
+			r.Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/dispute",
+				handlers.Result.DisputeResult,
+			)
+			r.With(roleMiddleware.Require(entities.StaffRole)).Post(
+				"/organizations/{orgID}/courts/{courtID}/reservations/{reservationID}/result/resolve",
+				handlers.Result.ResolveDispute,
+			)
+			r.Get("/me/rating", handlers.Result.GetMyRating)
+
+			r.Post("/organizations/{orgID}/waitlist", handlers.Waitlist.JoinWaitlist)
+			r.Get("/me/waitlist", handlers.Waitlist.ListMyWaitlist)
+			r.Delete("/me/waitlist/{entryID}", handlers.Waitlist.LeaveWaitlist)
+
+			r.Post("/organizations/{orgID}/courts/{courtID}/series", handlers.Series.CreateSeries)
+			r.Get("/organizations/{orgID}/courts/{courtID}/series/{seriesID}", handlers.Series.GetSeries)
+			r.Post("/organizations/{orgID}/courts/{courtID}/series/{seriesID}/payment", handlers.Payment.PaySeries)
+			r.With(roleMiddleware.Load).
+				Delete("/organizations/{orgID}/courts/{courtID}/series/{seriesID}", handlers.Series.CancelSeries)
+			r.With(roleMiddleware.Load).Delete(
+				"/organizations/{orgID}/courts/{courtID}/series/{seriesID}/reservations/{reservationID}",
+				handlers.Series.CancelSeriesOccurrence,
+			)
+
+			r.Get("/organizations/{orgID}/blackouts", handlers.Blackout.ListBlackouts)
+			r.Get("/organizations/{orgID}/blackouts/{blackoutID}", handlers.Blackout.GetBlackout)
+			r.Group(func(r chi.Router) {
+				r.Use(roleMiddleware.Require(entities.StaffRole))
+
+				r.Post("/organizations/{orgID}/blackouts", handlers.Blackout.CreateBlackout)
+				r.Put("/organizations/{orgID}/blackouts/{blackoutID}", handlers.Blackout.UpdateBlackout)
+				r.Delete("/organizations/{orgID}/blackouts/{blackoutID}", handlers.Blackout.DeleteBlackout)
+			})
+
+			r.Get("/courts", handlers.Court.SearchCourts)
+			r.Get("/search/availability", handlers.Court.SearchAvailability)
+			r.Get("/organizations/{orgID}/courts", handlers.Court.ListCourts)
+			r.Get("/organizations/{orgID}/courts/{courtID}", handlers.Court.GetCourt)
+
+			r.Group(func(r chi.Router) {
+				r.Use(roleMiddleware.Require(entities.ManagerRole))
+
+				r.Post("/organizations/{orgID}/courts", handlers.Court.CreateCourt)
+				r.Put("/organizations/{orgID}/courts/{courtID}", handlers.Court.UpdateCourt)
+				r.Put("/organizations/{orgID}/courts/{courtID}/opening-hours", handlers.Court.UpdateOpeningHours)
+				r.Put("/organizations/{orgID}/opening-hours", handlers.Organization.SetOpeningHours)
+				r.Put("/organizations/{orgID}/special-hours", handlers.Organization.SetSpecialHours)
+
+				r.Put("/organizations/{orgID}/pricing", handlers.Pricing.SetOrganizationPricing)
+				r.Put("/organizations/{orgID}/courts/{courtID}/pricing", handlers.Pricing.SetCourtPricing)
+
+				r.Put("/organizations/{orgID}/cancellation-policy", handlers.Policy.SetCancellationPolicy)
+				r.Put("/organizations/{orgID}/booking-rules", handlers.Policy.SetOrganizationBookingRules)
+				r.Put("/organizations/{orgID}/courts/{courtID}/booking-rules", handlers.Policy.SetCourtBookingRules)
+			})
+
+			r.Get("/organizations/{orgID}/pricing", handlers.Pricing.GetOrganizationPricing)
+			r.Get("/organizations/{orgID}/courts/{courtID}/pricing", handlers.Pricing.GetCourtPricing)
+			r.Get("/organizations/{orgID}/courts/{courtID}/quote", handlers.Pricing.QuoteCourt)
+
+			r.Get("/organizations/{orgID}/cancellation-policy", handlers.Policy.GetCancellationPolicy)
+			r.Get("/organizations/{orgID}/booking-rules", handlers.Policy.GetOrganizationBookingRules)
+			r.Get("/organizations/{orgID}/courts/{courtID}/booking-rules", handlers.Policy.GetCourtBookingRules)
+
+			r.Get("/organizations/{orgID}/availability", handlers.Availability.GetOrganizationAvailability)
+			r.Get(
+				"/organizations/{orgID}/courts/{courtID}/availability",
+				handlers.Availability.GetCourtAvailability,
+			)
 		})
 
-		r.Post("/auth/register", authHandler.RegisterUser)
-		r.Post("/auth/login", authHandler.Login)
+		r.Post("/auth/register", handlers.Auth.RegisterUser)
+		r.Post("/auth/login", handlers.Auth.Login)
+		r.Post("/auth/refresh", handlers.Auth.Refresh)
+		r.Post("/auth/otp", handlers.Auth.RequestOTP)
+		r.Post("/auth/phone/verify", handlers.Auth.VerifyPhone)
+		r.Post("/auth/login/otp", handlers.Auth.LoginViaOTP)
+		r.Post("/auth/password/reset", handlers.Auth.ResetPassword)
+
+		r.Post("/payments/webhook", handlers.Payment.PaymentWebhook)
 	})
 
 	return r
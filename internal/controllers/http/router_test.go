@@ -0,0 +1,197 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeVerifier map[string]*entities.Claims
+
+func (f fakeVerifier) VerifyToken(_ context.Context, token string) (*entities.Claims, error) {
+	claims, ok := f[token]
+	if !ok {
+		return nil, entities.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// fakeRoles maps "orgID/userID" to the role of the user in the organization.
+type fakeRoles map[string]entities.Role
+
+func (f fakeRoles) GetMemberRole(_ context.Context, organizationID, userID string) (entities.Role, error) {
+	role, ok := f[organizationID+"/"+userID]
+	if !ok {
+		return "", fmt.Errorf("member: %w", entities.ErrNotFound)
+	}
+	return role, nil
+}
+
+// newTestRouter serves the handlers to the users of the tokens, with the roles they hold in the organizations.
+func newTestRouter(handlers httpPkg.Handlers, tokens fakeVerifier, roles fakeRoles) http.Handler {
+	return httpPkg.NewRouter(handlers, httpPkg.NewAuthMiddleware(tokens), httpPkg.NewRoleMiddleware(roles))
+}
+
+type fakeCourts struct {
+	updated []string
+	created *entities.Court
+	filter  entities.CourtFilter
+	found   []entities.OrganizationCourts
+	search  entities.AvailabilitySearch
+	free    entities.FreeCourtPage
+	loc     *time.Location
+}
+
+func (f *fakeCourts) Create(_ context.Context, court *entities.Court) error {
+	if err := court.CourtAttributes.Validate(); err != nil {
+		return err
+	}
+
+	f.created = court
+	return nil
+}
+
+func (f *fakeCourts) GetByID(_ context.Context, organizationID, courtID string) (*entities.Court, error) {
+	return &entities.Court{ID: courtID, OrganizationID: organizationID}, nil
+}
+
+func (f *fakeCourts) ListByOrganizationID(
+	_ context.Context,
+	_ string,
+	filter entities.CourtFilter,
+) ([]entities.Court, error) {
+	f.filter = filter
+	return nil, filter.Validate()
+}
+
+func (f *fakeCourts) SearchCourts(
+	_ context.Context,
+	_ string,
+	filter entities.CourtFilter,
+) ([]entities.OrganizationCourts, error) {
+	f.filter = filter
+	return f.found, filter.Validate()
+}
+
+func (f *fakeCourts) SearchAvailability(
+	_ context.Context,
+	search entities.AvailabilitySearch,
+) (entities.FreeCourtPage, error) {
+	f.search = search
+	return f.free, search.Validate()
+}
+
+func (f *fakeCourts) CityLocation(context.Context, string) (*time.Location, error) {
+	if f.loc == nil {
+		return time.UTC, nil
+	}
+
+	return f.loc, nil
+}
+
+func (f *fakeCourts) UpdateDetails(
+	_ context.Context,
+	organizationID, courtID string,
+	details entities.CourtDetails,
+) (*entities.Court, error) {
+	f.updated = append(f.updated, courtID)
+	return &entities.Court{ID: courtID, OrganizationID: organizationID, Name: details.Name}, nil
+}
+
+func (f *fakeCourts) UpdateOpeningHours(
+	_ context.Context,
+	organizationID, courtID string,
+	_ []entities.OpeningHours,
+	_ time.Duration,
+) (*entities.Court, error) {
+	f.updated = append(f.updated, courtID)
+	return &entities.Court{ID: courtID, OrganizationID: organizationID}, nil
+}
+
+func TestRouter_CourtEditAuthorization(t *testing.T) {
+	verifier := fakeVerifier{
+		"player-token":  {UserID: "player-1"},
+		"manager-token": {UserID: "manager-1"},
+	}
+	roles := fakeRoles{
+		"club-a/player-1":  entities.PlayerRole,
+		"club-a/manager-1": entities.ManagerRole,
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		orgID      string
+		wantStatus int
+	}{
+		{
+			name:       "player edits another club's court",
+			token:      "player-token",
+			orgID:      "club-b",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "player edits own club's court",
+			token:      "player-token",
+			orgID:      "club-a",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "manager edits another club's court",
+			token:      "manager-token",
+			orgID:      "club-b",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "manager edits own club's court",
+			token:      "manager-token",
+			orgID:      "club-a",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "no token",
+			orgID:      "club-a",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courts := &fakeCourts{}
+			router := newTestRouter(
+				httpPkg.Handlers{Court: httpPkg.NewCourtHandler(courts)},
+				verifier,
+				roles,
+			)
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/v1/organizations/"+tt.orgID+"/courts/court-1",
+				strings.NewReader(`{"name":"Center court"}`),
+			)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus == http.StatusOK {
+				require.Equal(t, []string{"court-1"}, courts.updated)
+			} else {
+				require.Empty(t, courts.updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type SeriesService interface {
+	CreateSeries(
+		ctx context.Context,
+		organizationID string,
+		series *entities.ReservationSeries,
+	) ([]entities.SeriesOccurrence, error)
+	GetSeries(ctx context.Context, courtID, seriesID string) (*entities.ReservationSeries, []entities.Reservation, error)
+	CancelSeries(
+		ctx context.Context,
+		organizationID, courtID, seriesID string,
+		from time.Time,
+		actor entities.Actor,
+	) (int64, error)
+	CancelSeriesOccurrence(
+		ctx context.Context,
+		organizationID, courtID, seriesID, reservationID string,
+		actor entities.Actor,
+	) error
+	CourtLocation(ctx context.Context, courtID string) (*time.Location, error)
+}
+
+type SeriesHandler struct {
+	seriesService SeriesService
+}
+
+func NewSeriesHandler(service SeriesService) *SeriesHandler {
+	return &SeriesHandler{
+		seriesService: service,
+	}
+}
+
+const (
+	seriesCancelScopeAll    = "all"
+	seriesCancelScopeFuture = "future"
+)
+
+// CreateSeriesRequest describes a recurring booking.
+// swagger:model CreateSeriesRequest
+type CreateSeriesRequest struct {
+	// StartDate is the first day of the series, inclusive
+	StartDate string `json:"startDate"       example:"2025-09-02"`
+	// EndDate is the last day of the series, inclusive
+	EndDate string `json:"endDate"         example:"2026-05-26"`
+	// StartTime is the time of day every occurrence starts at, in the time zone of the organization
+	StartTime       string `json:"startTime"       example:"19:00"`
+	DurationMinutes int    `json:"durationMinutes" example:"90"`
+	// Frequency is either "daily" or "weekly"
+	Frequency string `json:"frequency"       example:"weekly"`
+	// Interval is the number of days or weeks between occurrences, 1 by default
+	Interval int `json:"interval,omitempty" example:"1"`
+	// Weekdays of a weekly series, 0 is Sunday. Defaults to the weekday of startDate
+	Weekdays []int `json:"weekdays,omitempty" example:"2"`
+}
+
+// swagger:model SeriesOccurrenceResponse
+type SeriesOccurrenceResponse struct {
+	ReservationID string    `json:"reservationId,omitempty" example:"res-123"`
+	StartTime     time.Time `json:"startTime"               example:"2025-09-02T19:00:00Z" format:"date-time"`
+	EndTime       time.Time `json:"endTime"                 example:"2025-09-02T20:30:00Z" format:"date-time"`
+	// Status is "pending" for priced occurrences waiting to be paid, "reserved" for free ones and "conflict"
+	// for occurrences clashing with another booking
+	Status string `json:"status" example:"reserved"`
+}
+
+// swagger:model CreateSeriesResponse
+type CreateSeriesResponse struct {
+	ID          string                     `json:"id"          example:"series-123"`
+	Conflicts   int                        `json:"conflicts"   example:"1"`
+	Occurrences []SeriesOccurrenceResponse `json:"occurrences"`
+}
+
+// swagger:model SeriesResponse
+type SeriesResponse struct {
+	ID              string                `json:"id"              example:"series-123"`
+	CourtID         string                `json:"courtId"         example:"court-456"`
+	Frequency       string                `json:"frequency"       example:"weekly"`
+	Interval        int                   `json:"interval"        example:"1"`
+	Weekdays        []int                 `json:"weekdays"        example:"2"`
+	StartDate       string                `json:"startDate"       example:"2025-09-02"`
+	EndDate         string                `json:"endDate"         example:"2026-05-26"`
+	StartTime       string                `json:"startTime"       example:"19:00"`
+	DurationMinutes int                   `json:"durationMinutes" example:"90"`
+	ReservedBy      string                `json:"reservedBy"      example:"user-789"`
+	CreatedAt       time.Time             `json:"createdAt"       example:"2025-08-01T10:00:00Z" format:"date-time"`
+	Reservations    []ReservationResponse `json:"reservations"`
+}
+
+// swagger:model CancelSeriesResponse
+type CancelSeriesResponse struct {
+	Cancelled int64 `json:"cancelled" example:"12"`
+}
+
+// CreateSeries godoc
+// @Summary Create a recurring reservation
+// @Description Books every occurrence of a recurrence rule between two dates.
+// @Description Occurrences clashing with existing bookings are skipped and reported as conflicts.
+// @Description Priced occurrences are held together and paid at once through the payment of the series
+// @Description before the hold expires.
+// @Tags series
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Accept json
+// @Produce json
+// @Param series body CreateSeriesRequest true "Series payload"
+// @Success 201 {object} CreateSeriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/series [post]
+func (h *SeriesHandler) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+
+	if orgID == "" || courtID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID and courtID are required",
+		})
+		return
+	}
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateSeriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().Err(err).Str("courtID", courtID).Msg("failed to decode create series request")
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	loc, ok := resolveLocation(w, r, h.seriesService.CourtLocation, "court", courtID)
+	if !ok {
+		return
+	}
+
+	startDate, err := httputil.ParseDateIn(req.StartDate, loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid startDate, expected YYYY-MM-DD"})
+		return
+	}
+
+	endDate, err := httputil.ParseDateIn(req.EndDate, loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid endDate, expected YYYY-MM-DD"})
+		return
+	}
+
+	startTime, err := entities.ParseTimeOfDay(req.StartTime)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid startTime, expected HH:MM"})
+		return
+	}
+
+	interval := req.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	weekdays := make([]time.Weekday, 0, len(req.Weekdays))
+	for _, wd := range req.Weekdays {
+		weekdays = append(weekdays, time.Weekday(wd))
+	}
+
+	series := entities.NewReservationSeries(
+		courtID,
+		entities.RecurrenceRule{
+			Frequency: entities.RecurrenceFrequency(req.Frequency),
+			Interval:  interval,
+			Weekdays:  weekdays,
+		},
+		startDate,
+		endDate,
+		startTime,
+		time.Duration(req.DurationMinutes)*time.Minute,
+		claims.UserID,
+	)
+
+	occurrences, err := h.seriesService.CreateSeries(r.Context(), orgID, series)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidRecurrence) {
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "court not found"})
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("organization id", orgID).
+			Str("court id", courtID).
+			Msg("failed to create series")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := CreateSeriesResponse{
+		ID:          series.ID,
+		Occurrences: make([]SeriesOccurrenceResponse, 0, len(occurrences)),
+	}
+
+	for _, occ := range occurrences {
+		item := SeriesOccurrenceResponse{
+			StartTime: occ.Reservation.ReservedFrom,
+			EndTime:   occ.Reservation.ReservedTo,
+			Status:    string(occ.Reservation.Status),
+		}
+
+		if occ.Conflict {
+			item.Status = "conflict"
+			resp.Conflicts++
+		} else {
+			item.ReservationID = occ.Reservation.ID
+		}
+
+		resp.Occurrences = append(resp.Occurrences, item)
+	}
+
+	httputil.JSON(w, http.StatusCreated, resp)
+
+	log.Info().
+		Str("organization id", orgID).
+		Str("court id", courtID).
+		Str("series id", series.ID).
+		Int("occurrences", len(occurrences)).
+		Int("conflicts", resp.Conflicts).
+		Msg("series was created")
+}
+
+// GetSeries godoc
+// @Summary Get a recurring reservation
+// @Description Returns the series and all of its occurrences.
+// @Tags series
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param seriesID path string true "Series ID"
+// @Produce json
+// @Success 200 {object} SeriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/series/{seriesID} [get]
+func (h *SeriesHandler) GetSeries(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	seriesID := chi.URLParam(r, "seriesID")
+
+	if orgID == "" || courtID == "" || seriesID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID, courtID and seriesID are required",
+		})
+		return
+	}
+
+	series, reservations, err := h.seriesService.GetSeries(r.Context(), courtID, seriesID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "series not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("series id", seriesID).Msg("failed to get series")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := SeriesResponse{
+		ID:              series.ID,
+		CourtID:         series.CourtID,
+		Frequency:       string(series.Rule.Frequency),
+		Interval:        series.Rule.Interval,
+		Weekdays:        make([]int, 0, len(series.Rule.Weekdays)),
+		StartDate:       series.StartDate.Format(time.DateOnly),
+		EndDate:         series.EndDate.Format(time.DateOnly),
+		StartTime:       series.StartTime.String(),
+		DurationMinutes: int(series.Duration / time.Minute),
+		ReservedBy:      series.ReservedBy,
+		CreatedAt:       series.CreatedAt,
+		Reservations:    make([]ReservationResponse, 0, len(reservations)),
+	}
+
+	for _, wd := range series.Rule.Weekdays {
+		resp.Weekdays = append(resp.Weekdays, int(wd))
+	}
+
+	for _, rsv := range reservations {
+		resp.Reservations = append(resp.Reservations, newReservationResponse(rsv))
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// CancelSeries godoc
+// @Summary Cancel a recurring reservation
+// @Description Cancels all occurrences of the series, or only the ones that have not started yet.
+// @Tags series
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param seriesID path string true "Series ID"
+// @Param scope query string false "Either 'all' (default) or 'future'"
+// @Produce json
+// @Success 200 {object} CancelSeriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/series/{seriesID} [delete]
+func (h *SeriesHandler) CancelSeries(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	seriesID := chi.URLParam(r, "seriesID")
+
+	if orgID == "" || courtID == "" || seriesID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID, courtID and seriesID are required",
+		})
+		return
+	}
+
+	var from time.Time
+
+	switch scope := r.URL.Query().Get("scope"); scope {
+	case "", seriesCancelScopeAll:
+	case seriesCancelScopeFuture:
+		from = time.Now().UTC()
+	default:
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "scope must be either all or future"})
+		return
+	}
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	actor := entities.Actor{UserID: claims.UserID, Role: RoleFromContext(r.Context())}
+
+	cancelled, err := h.seriesService.CancelSeries(r.Context(), orgID, courtID, seriesID, from, actor)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "series not found"})
+			return
+		}
+
+		if errors.Is(err, entities.ErrForbidden) {
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "series was booked by another user"})
+			return
+		}
+
+		log.Error().Err(err).Str("series id", seriesID).Msg("failed to cancel series")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, CancelSeriesResponse{Cancelled: cancelled})
+
+	log.Info().
+		Str("series id", seriesID).
+		Str("cancelled_by", claims.UserID).
+		Int64("cancelled", cancelled).
+		Msg("series cancelled successfully")
+}
+
+// CancelSeriesOccurrence godoc
+// @Summary Cancel one occurrence of a recurring reservation
+// @Tags series
+// @Security BearerAuth
+// @Param orgID path string true "Organization ID"
+// @Param courtID path string true "Court ID"
+// @Param seriesID path string true "Series ID"
+// @Param reservationID path string true "Reservation ID"
+// @Success 200
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/courts/{courtID}/series/{seriesID}/reservations/{reservationID} [delete]
+func (h *SeriesHandler) CancelSeriesOccurrence(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	courtID := chi.URLParam(r, "courtID")
+	seriesID := chi.URLParam(r, "seriesID")
+	reservationID := chi.URLParam(r, "reservationID")
+
+	if orgID == "" || courtID == "" || seriesID == "" || reservationID == "" {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{
+			Message: "orgID, courtID, seriesID and reservationID are required",
+		})
+		return
+	}
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	actor := entities.Actor{UserID: claims.UserID, Role: RoleFromContext(r.Context())}
+
+	err := h.seriesService.CancelSeriesOccurrence(r.Context(), orgID, courtID, seriesID, reservationID, actor)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "reservation not found"})
+			return
+		}
+
+		if errors.Is(err, entities.ErrForbidden) {
+			httputil.JSON(w, http.StatusForbidden, ErrorResponse{Message: "reservation was booked by another user"})
+			return
+		}
+
+		log.Error().Err(err).
+			Str("series id", seriesID).
+			Str("reservation_id", reservationID).
+			Msg("failed to cancel series occurrence")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info().
+		Str("series id", seriesID).
+		Str("reservation_id", reservationID).
+		Str("cancelled_by", claims.UserID).
+		Msg("series occurrence cancelled successfully")
+}
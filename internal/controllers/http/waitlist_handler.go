@@ -0,0 +1,206 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/httputil"
+	"github.com/rs/zerolog/log"
+)
+
+type WaitlistService interface {
+	JoinWaitlist(ctx context.Context, entry *entities.WaitlistEntry) error
+	ListWaitlist(ctx context.Context, userID string) ([]entities.WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, userID, entryID string) error
+	OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error)
+}
+
+type WaitlistHandler struct {
+	waitlistService WaitlistService
+}
+
+func NewWaitlistHandler(service WaitlistService) *WaitlistHandler {
+	return &WaitlistHandler{
+		waitlistService: service,
+	}
+}
+
+// swagger:model JoinWaitlistRequest
+type JoinWaitlistRequest struct {
+	// CourtID is the court waited for, any court of the organization when empty
+	CourtID string `json:"courtId"   example:"court-456"`
+	// StartTime is the start of the window, in the time zone of the organization unless it has an offset
+	StartTime string `json:"startTime" example:"2025-11-04T18:30" format:"date-time"`
+	// EndTime is the end of the window, in the time zone of the organization unless it has an offset
+	EndTime string `json:"endTime"   example:"2025-11-04T19:45" format:"date-time"`
+}
+
+// swagger:model WaitlistEntryResponse
+type WaitlistEntryResponse struct {
+	ID             string    `json:"id"                      example:"wl-123"`
+	OrganizationID string    `json:"organizationId"          example:"org-123"`
+	CourtID        string    `json:"courtId,omitempty"       example:"court-456"`
+	ReservedFrom   time.Time `json:"reservedFrom"            example:"2025-11-04T18:30Z" format:"date-time"`
+	ReservedTo     time.Time `json:"reservedTo"              example:"2025-11-04T19:45Z" format:"date-time"`
+	// Status is "waiting", "offered" once a hold was handed to the user, or "expired" when the window started
+	// before a slot was freed for it
+	Status string `json:"status"                  example:"waiting"`
+	// ReservationID is the pending hold offered to the user, it has to be confirmed before it expires
+	ReservationID string    `json:"reservationId,omitempty" example:"res-123"`
+	CreatedAt     time.Time `json:"createdAt"               example:"2025-11-01T10:00:00Z" format:"date-time"`
+}
+
+func newWaitlistEntryResponse(e entities.WaitlistEntry) WaitlistEntryResponse {
+	return WaitlistEntryResponse{
+		ID:             e.ID,
+		OrganizationID: e.OrganizationID,
+		CourtID:        e.CourtID,
+		ReservedFrom:   e.From,
+		ReservedTo:     e.To,
+		Status:         string(e.Status),
+		ReservationID:  e.ReservationID,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+// JoinWaitlist godoc
+// @Summary Join the waitlist
+// @Description Queues the current user for a fully booked window, on a court or on any court of the organization.
+// @Description When a matching reservation is cancelled or its hold expires, the first user in the queue is
+// @Description offered a pending hold on the window, which they have to confirm before it expires.
+// @Tags waitlist
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param orgID path string true "Organization ID"
+// @Param entry body JoinWaitlistRequest true "Waited window"
+// @Success 201 {object} WaitlistEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/organizations/{orgID}/waitlist [post]
+func (h *WaitlistHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var req JoinWaitlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid json"})
+		return
+	}
+
+	loc, ok := resolveLocation(w, r, h.waitlistService.OrganizationLocation, "organization", orgID)
+	if !ok {
+		return
+	}
+
+	from, to, err := parseSlot(req.StartTime, req.EndTime, loc)
+	if err != nil {
+		httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	entry := entities.NewWaitlistEntry(orgID, req.CourtID, claims.UserID, from, to, time.Now().UTC())
+
+	if err := h.waitlistService.JoinWaitlist(r.Context(), entry); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidWaitlistEntry):
+			httputil.JSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		case errors.Is(err, entities.ErrNotFound):
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "court not found"})
+		default:
+			log.Error().
+				Err(err).
+				Str("organization_id", orgID).
+				Str("court_id", req.CourtID).
+				Msg("failed to join waitlist")
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, newWaitlistEntryResponse(*entry))
+
+	log.Info().
+		Str("waitlist_entry_id", entry.ID).
+		Str("organization_id", orgID).
+		Str("user_id", claims.UserID).
+		Msg("waitlist joined")
+}
+
+// ListMyWaitlist godoc
+// @Summary List my waitlist entries
+// @Description Returns the windows the current user waits for, the earliest first. Entries that were offered
+// @Description a hold carry the reservation to confirm.
+// @Tags waitlist
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} WaitlistEntryResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/me/waitlist [get]
+func (h *WaitlistHandler) ListMyWaitlist(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	entries, err := h.waitlistService.ListWaitlist(r.Context(), claims.UserID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", claims.UserID).Msg("failed to list waitlist")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]WaitlistEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		resp = append(resp, newWaitlistEntryResponse(e))
+	}
+
+	httputil.JSON(w, http.StatusOK, resp)
+}
+
+// LeaveWaitlist godoc
+// @Summary Leave the waitlist
+// @Description Takes the current user off the waitlist. Entries that were offered a hold can not be left,
+// @Description the hold is cancelled instead.
+// @Tags waitlist
+// @Security BearerAuth
+// @Param entryID path string true "Waitlist entry ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500
+// @Router /v1/me/waitlist/{entryID} [delete]
+func (h *WaitlistHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	entryID := chi.URLParam(r, "entryID")
+
+	claims, ok := requireClaims(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.waitlistService.LeaveWaitlist(r.Context(), claims.UserID, entryID); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			httputil.JSON(w, http.StatusNotFound, ErrorResponse{Message: "waitlist entry not found"})
+			return
+		}
+
+		log.Error().Err(err).Str("waitlist_entry_id", entryID).Msg("failed to leave waitlist")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,166 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/lever-dev/padel-backend/internal/controllers/http"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type fakeWaitlist struct {
+	joined *entities.WaitlistEntry
+	left   string
+	loc    *time.Location
+	err    error
+}
+
+func (f *fakeWaitlist) JoinWaitlist(_ context.Context, entry *entities.WaitlistEntry) error {
+	f.joined = entry
+	return f.err
+}
+
+func (f *fakeWaitlist) ListWaitlist(context.Context, string) ([]entities.WaitlistEntry, error) {
+	return nil, f.err
+}
+
+func (f *fakeWaitlist) LeaveWaitlist(_ context.Context, _, entryID string) error {
+	f.left = entryID
+	return f.err
+}
+
+func (f *fakeWaitlist) OrganizationLocation(context.Context, string) (*time.Location, error) {
+	if f.loc == nil {
+		return time.UTC, nil
+	}
+
+	return f.loc, nil
+}
+
+func newWaitlistRouter(waitlist *fakeWaitlist) http.Handler {
+	return newTestRouter(
+		httpPkg.Handlers{Waitlist: httpPkg.NewWaitlistHandler(waitlist)},
+		fakeVerifier{
+			"player-token": {UserID: "player-1"},
+		},
+		fakeRoles{},
+	)
+}
+
+func TestWaitlistHandler_JoinWaitlist(t *testing.T) {
+	const body = `{"courtId": "court-1", "startTime": "2025-11-04T18:30", "endTime": "2025-11-04T19:30"}`
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		body       string
+		loc        *time.Location
+		err        error
+		wantStatus int
+		wantFrom   time.Time
+	}{
+		{
+			name:       "joined",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 11, 4, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "local time of the club",
+			body:       body,
+			loc:        madrid,
+			wantStatus: http.StatusCreated,
+			wantFrom:   time.Date(2025, 11, 4, 17, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "invalid json",
+			body:       `{"startTime": `,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid window",
+			body:       body,
+			err:        entities.ErrInvalidWaitlistEntry,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "court of another organization",
+			body:       body,
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waitlist := &fakeWaitlist{loc: tt.loc, err: tt.err}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/organizations/club-a/waitlist",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newWaitlistRouter(waitlist).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			require.Equal(t, "club-a", waitlist.joined.OrganizationID)
+			require.Equal(t, "player-1", waitlist.joined.UserID)
+			require.True(t, tt.wantFrom.Equal(waitlist.joined.From), waitlist.joined.From)
+
+			var resp httpPkg.WaitlistEntryResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, "court-1", resp.CourtID)
+			require.Equal(t, string(entities.WaitingWaitlistStatus), resp.Status)
+		})
+	}
+}
+
+func TestWaitlistHandler_LeaveWaitlist(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "left",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "entry not found or already offered",
+			err:        entities.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waitlist := &fakeWaitlist{err: tt.err}
+
+			req := httptest.NewRequest(http.MethodDelete, "/v1/me/waitlist/wl-1", nil)
+			req.Header.Set("Authorization", "Bearer player-token")
+
+			rec := httptest.NewRecorder()
+			newWaitlistRouter(waitlist).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			require.Equal(t, "wl-1", waitlist.left)
+		})
+	}
+}
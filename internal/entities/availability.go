@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+type SlotStatus string
+
+const (
+	FreeSlotStatus   SlotStatus = "free"
+	BookedSlotStatus SlotStatus = "booked"
+	// BlockedSlotStatus is a slot closed by a blackout
+	BlockedSlotStatus SlotStatus = "blocked"
+)
+
+type Slot struct {
+	From   time.Time
+	To     time.Time
+	Status SlotStatus
+}
+
+type CourtAvailability struct {
+	CourtID string
+	Date    time.Time
+	Slots   []Slot
+}
+
+const (
+	// DefaultSearchWindow is how far after its earliest start an availability search looks for free courts
+	// when the window is not given.
+	DefaultSearchWindow = 2 * time.Hour
+	MaxSearchWindow     = 12 * time.Hour
+	MaxSearchDuration   = 6 * time.Hour
+	// DefaultSearchLimit is how many free courts an availability search returns at most when the limit is
+	// not given.
+	DefaultSearchLimit = 50
+	MaxSearchLimit     = 200
+)
+
+// AvailabilitySearch looks for the courts of a city that are free for Duration from a start anywhere between
+// From and From plus Window. It returns at most Limit of them, those ranked after the cursor when After is set.
+type AvailabilitySearch struct {
+	City     string
+	From     time.Time
+	Duration time.Duration
+	Window   time.Duration
+	Filter   CourtFilter
+	// Near ranks the courts with the same start by their distance from the point, when it is set
+	Near  *GeoPoint
+	Limit int
+	After *SearchCursor
+}
+
+// SearchCursor is the rank of the last free court of a page of an availability search. The next page starts
+// with the court ranked right after it.
+type SearchCursor struct {
+	From           time.Time
+	Distance       *float64
+	OrganizationID string
+	CourtName      string
+	CourtID        string
+}
+
+func (s AvailabilitySearch) Validate() error {
+	if s.City == "" {
+		return fmt.Errorf("%w: city is required", ErrInvalidAvailabilitySearch)
+	}
+
+	if s.Duration <= 0 || s.Duration > MaxSearchDuration {
+		return fmt.Errorf("%w: duration must be positive and at most %s", ErrInvalidAvailabilitySearch, MaxSearchDuration)
+	}
+
+	if s.Window < 0 || s.Window > MaxSearchWindow {
+		return fmt.Errorf("%w: window must be at most %s", ErrInvalidAvailabilitySearch, MaxSearchWindow)
+	}
+
+	if s.Limit <= 0 || s.Limit > MaxSearchLimit {
+		return fmt.Errorf("%w: limit must be positive and at most %d", ErrInvalidAvailabilitySearch, MaxSearchLimit)
+	}
+
+	if s.Near != nil {
+		if err := s.Near.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return s.Filter.Validate()
+}
+
+// FreeCourt is a court found free for the time range by an availability search.
+type FreeCourt struct {
+	Organization Organization
+	Court        Court
+	From         time.Time
+	To           time.Time
+	// Distance from the point the search was made near in meters, nil when either is not placed on the map
+	Distance *float64
+}
+
+// Cursor returns the rank of the free court, to search for the courts ranked after it.
+func (f FreeCourt) Cursor() SearchCursor {
+	return SearchCursor{
+		From:           f.From,
+		Distance:       f.Distance,
+		OrganizationID: f.Court.OrganizationID,
+		CourtName:      f.Court.Name,
+		CourtID:        f.Court.ID,
+	}
+}
+
+// FreeCourtPage is a page of the free courts found by an availability search. Next is set when more courts
+// are ranked after the last of them.
+type FreeCourtPage struct {
+	Courts []FreeCourt
+	Next   *SearchCursor
+}
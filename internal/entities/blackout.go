@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBlackoutReasonLength bounds the reason shown to players whose bookings a blackout cancels.
+const MaxBlackoutReasonLength = 200
+
+// Blackout closes a court for maintenance, an event or a private hire without booking it. Blackouts without
+// a court close every court of the organization.
+type Blackout struct {
+	ID             string
+	OrganizationID string
+	CourtID        string
+	From           time.Time
+	To             time.Time
+	Reason         string
+	CreatedBy      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewBlackout(
+	organizationID, courtID string,
+	from, to time.Time,
+	reason, createdBy string,
+	now time.Time,
+) *Blackout {
+	return &Blackout{
+		ID:             uuid.New().String(),
+		OrganizationID: organizationID,
+		CourtID:        courtID,
+		From:           from,
+		To:             to,
+		Reason:         reason,
+		CreatedBy:      createdBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+func (b Blackout) Validate() error {
+	if !b.From.Before(b.To) {
+		return fmt.Errorf("%w: the blackout must end after it starts", ErrInvalidBlackout)
+	}
+
+	if b.Reason == "" {
+		return fmt.Errorf("%w: a reason is required", ErrInvalidBlackout)
+	}
+
+	if len(b.Reason) > MaxBlackoutReasonLength {
+		return fmt.Errorf("%w: the reason is longer than %d characters", ErrInvalidBlackout, MaxBlackoutReasonLength)
+	}
+
+	return nil
+}
+
+// IsOrganizationWide tells whether the blackout closes every court of the organization.
+func (b Blackout) IsOrganizationWide() bool {
+	return b.CourtID == ""
+}
+
+// Overlaps tells whether the blackout closes any part of the slot between from and to.
+func (b Blackout) Overlaps(from, to time.Time) bool {
+	return b.From.Before(to) && b.To.After(from)
+}
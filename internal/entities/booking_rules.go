@@ -0,0 +1,150 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// BookingRules restrict the reservations players can make on the courts of an organization. Rules without
+// a court are the organization default and apply to every court that has no rules of its own. A zero value
+// leaves the matching restriction off.
+type BookingRules struct {
+	OrganizationID string
+	CourtID        string
+	MinDuration    time.Duration
+	MaxDuration    time.Duration
+	// Durations are the only durations allowed when set, e.g. 60, 90 and 120 minutes
+	Durations []time.Duration
+	// SlotInterval aligns the start and the end of reservations to multiples of it counted from midnight
+	SlotInterval time.Duration
+	// MaxAdvance is how long before its start a reservation may be made at the earliest
+	MaxAdvance time.Duration
+	// MinNotice is how long before its start a reservation has to be made at the latest
+	MinNotice time.Duration
+	// MaxActiveBookings caps the reservations of the organization a user holds that have not started yet
+	MaxActiveBookings int
+	UpdatedAt         time.Time
+}
+
+func (r BookingRules) Validate() error {
+	limits := []time.Duration{r.MinDuration, r.MaxDuration, r.SlotInterval, r.MaxAdvance, r.MinNotice}
+	limits = append(limits, r.Durations...)
+
+	for _, d := range limits {
+		if d < 0 {
+			return fmt.Errorf("%w: durations cannot be negative", ErrInvalidBookingRules)
+		}
+
+		if d%time.Minute != 0 {
+			return fmt.Errorf("%w: %s is not a whole number of minutes", ErrInvalidBookingRules, d)
+		}
+	}
+
+	if r.MaxActiveBookings < 0 {
+		return fmt.Errorf("%w: max active bookings cannot be negative", ErrInvalidBookingRules)
+	}
+
+	if r.MinDuration > 0 && r.MaxDuration > 0 && r.MinDuration > r.MaxDuration {
+		return fmt.Errorf("%w: min duration %s is longer than max duration %s",
+			ErrInvalidBookingRules, r.MinDuration, r.MaxDuration)
+	}
+
+	if r.MinNotice > 0 && r.MaxAdvance > 0 && r.MinNotice > r.MaxAdvance {
+		return fmt.Errorf("%w: min notice %s is longer than max advance %s",
+			ErrInvalidBookingRules, r.MinNotice, r.MaxAdvance)
+	}
+
+	if r.SlotInterval > 0 && (24*time.Hour)%r.SlotInterval != 0 {
+		return fmt.Errorf("%w: slot interval %s does not divide a day", ErrInvalidBookingRules, r.SlotInterval)
+	}
+
+	for i, d := range r.Durations {
+		if d == 0 {
+			return fmt.Errorf("%w: allowed durations must be positive", ErrInvalidBookingRules)
+		}
+
+		if slices.Contains(r.Durations[i+1:], d) {
+			return fmt.Errorf("%w: duration %s is listed twice", ErrInvalidBookingRules, d)
+		}
+	}
+
+	return nil
+}
+
+// CheckSlot tells whether a reservation of the slot may be made at the moment now. Slots are aligned to the
+// wall clock in loc, the time zone of the club.
+func (r BookingRules) CheckSlot(from, to, now time.Time, loc *time.Location) error {
+	duration := to.Sub(from)
+
+	if len(r.Durations) > 0 && !slices.Contains(r.Durations, duration) {
+		allowed := make([]string, 0, len(r.Durations))
+		for _, d := range r.Durations {
+			allowed = append(allowed, shortDuration(d))
+		}
+
+		return fmt.Errorf("%w: %s is not one of %s",
+			ErrBookingDurationNotAllowed, shortDuration(duration), strings.Join(allowed, ", "))
+	}
+
+	if r.MinDuration > 0 && duration < r.MinDuration {
+		return fmt.Errorf("%w: %s is shorter than %s",
+			ErrBookingDurationNotAllowed, shortDuration(duration), shortDuration(r.MinDuration))
+	}
+
+	if r.MaxDuration > 0 && duration > r.MaxDuration {
+		return fmt.Errorf("%w: %s is longer than %s",
+			ErrBookingDurationNotAllowed, shortDuration(duration), shortDuration(r.MaxDuration))
+	}
+
+	if r.SlotInterval > 0 && (!r.isAligned(from.In(loc)) || !r.isAligned(to.In(loc))) {
+		return fmt.Errorf("%w: reservations start and end every %s", ErrBookingNotAligned, shortDuration(r.SlotInterval))
+	}
+
+	lead := from.Sub(now)
+
+	if r.MinNotice > 0 && lead < r.MinNotice {
+		return fmt.Errorf("%w: reservations have to be made %s in advance",
+			ErrBookingNoticeTooShort, shortDuration(r.MinNotice))
+	}
+
+	if r.MaxAdvance > 0 && lead > r.MaxAdvance {
+		return fmt.Errorf("%w: reservations open %s in advance", ErrBookingTooFarAhead, shortDuration(r.MaxAdvance))
+	}
+
+	return nil
+}
+
+// CheckActiveBookings tells whether a user who holds active reservations may make one more.
+func (r BookingRules) CheckActiveBookings(active int) error {
+	if r.MaxActiveBookings > 0 && active >= r.MaxActiveBookings {
+		return fmt.Errorf("%w: a user may hold %d upcoming reservations",
+			ErrTooManyActiveBookings, r.MaxActiveBookings)
+	}
+
+	return nil
+}
+
+// isAligned counts from midnight on the wall clock of t, so a day with a DST change keeps its slots.
+func (r BookingRules) isAligned(t time.Time) bool {
+	sinceMidnight := time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+	return sinceMidnight%r.SlotInterval == 0
+}
+
+// shortDuration drops the zero units time.Duration prints, 1h30m0s reads 1h30m and 2h0m0s reads 2h.
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-len("0s")]
+	}
+
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-len("0m")]
+	}
+
+	return s
+}
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const maxCancellationTiers = 10
+
+// CancellationTier refunds RefundPercent of the price of reservations cancelled at least Notice before they start.
+type CancellationTier struct {
+	Notice        time.Duration
+	RefundPercent int
+}
+
+// CancellationPolicy decides how much of the price is refunded when a reservation of the organization is
+// cancelled. Cancellations with less notice than every tier are not refunded at all.
+type CancellationPolicy struct {
+	OrganizationID string
+	Tiers          []CancellationTier
+	UpdatedAt      time.Time
+}
+
+func (p CancellationPolicy) Validate() error {
+	if len(p.Tiers) > maxCancellationTiers {
+		return fmt.Errorf("%w: a policy has at most %d tiers", ErrInvalidCancellationPolicy, maxCancellationTiers)
+	}
+
+	for i, t := range p.Tiers {
+		if t.Notice < 0 {
+			return fmt.Errorf("%w: notice cannot be negative", ErrInvalidCancellationPolicy)
+		}
+
+		if t.RefundPercent < 0 || t.RefundPercent > 100 {
+			return fmt.Errorf("%w: refund of %d%% is not between 0 and 100",
+				ErrInvalidCancellationPolicy, t.RefundPercent)
+		}
+
+		for _, other := range p.Tiers[i+1:] {
+			if other.Notice == t.Notice {
+				return fmt.Errorf("%w: two tiers with a notice of %s", ErrInvalidCancellationPolicy, t.Notice)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RefundPercentFor returns the refund of the tier with the longest notice that was respected.
+func (p CancellationPolicy) RefundPercentFor(notice time.Duration) int {
+	tiers := slices.Clone(p.Tiers)
+	slices.SortFunc(tiers, func(a, b CancellationTier) int {
+		return cmp.Compare(b.Notice, a.Notice)
+	})
+
+	for _, t := range tiers {
+		if notice >= t.Notice {
+			return t.RefundPercent
+		}
+	}
+
+	return 0
+}
+
+// Cancellation records the terms a reservation was cancelled on.
+type Cancellation struct {
+	RefundPercent int
+	// Fee is the part of the price the club keeps, in the currency of the price
+	Fee int64
+	// Waived is set when staff cancelled without applying the policy
+	Waived bool
+}
+
+// Refund is the part of the price given back to the players.
+func (c Cancellation) Refund(price Price) int64 {
+	return price.Amount - c.Fee
+}
+
+// FreeCancellation refunds the whole price.
+func FreeCancellation(waived bool) Cancellation {
+	return Cancellation{RefundPercent: 100, Waived: waived}
+}
+
+// NewCancellation applies the policy to a reservation cancelled at the moment now.
+func NewCancellation(policy CancellationPolicy, reservation Reservation, now time.Time) Cancellation {
+	percent := policy.RefundPercentFor(reservation.ReservedFrom.Sub(now))
+
+	return Cancellation{
+		RefundPercent: percent,
+		Fee:           reservation.Price.Amount - reservation.Price.Amount*int64(percent)/100,
+	}
+}
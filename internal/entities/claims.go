@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"crypto"
+	"time"
+)
+
+// Claims identify the user behind a verified access token.
+type Claims struct {
+	UserID    string
+	Nickname  string
+	SessionID string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// PublicKey is a key other services can use to verify access tokens on their own.
+type PublicKey struct {
+	ID        string
+	Algorithm string
+	Key       crypto.PublicKey
+}
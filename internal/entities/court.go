@@ -1,17 +1,29 @@
 package entities
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const DefaultSlotDuration = 30 * time.Minute
+
+// DefaultMaxPlayers is the size of the roster of a reservation, a regular padel game is played by four.
+const DefaultMaxPlayers = 4
+
 type Court struct {
 	ID             string
 	OrganizationID string
 	Name           string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	CourtAttributes
+	OpeningHours []OpeningHours
+	SlotDuration time.Duration
+	// MaxPlayers caps the roster of the reservations on the court, the booker included
+	MaxPlayers int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 func NewCourt(orgID, name string) *Court {
@@ -20,6 +32,28 @@ func NewCourt(orgID, name string) *Court {
 		ID:             uuid.New().String(),
 		OrganizationID: orgID,
 		Name:           name,
-		CreatedAt:      now,
+		CourtAttributes: CourtAttributes{
+			Format: DoublesCourtFormat,
+		},
+		SlotDuration: DefaultSlotDuration,
+		MaxPlayers:   DefaultMaxPlayers,
+		CreatedAt:    now,
+	}
+}
+
+// OpeningHoursOn returns the opening windows of the court on the weekday of date, ordered by opening time.
+func (c Court) OpeningHoursOn(date time.Time) []OpeningHours {
+	var windows []OpeningHours
+
+	for _, h := range c.OpeningHours {
+		if h.Weekday == date.Weekday() {
+			windows = append(windows, h)
+		}
 	}
+
+	slices.SortFunc(windows, func(a, b OpeningHours) int {
+		return cmp.Compare(a.OpensAt, b.OpensAt)
+	})
+
+	return windows
 }
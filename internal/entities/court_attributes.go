@@ -0,0 +1,123 @@
+package entities
+
+import "fmt"
+
+type CourtEnvironment string
+
+const (
+	IndoorCourtEnvironment  CourtEnvironment = "indoor"
+	OutdoorCourtEnvironment CourtEnvironment = "outdoor"
+)
+
+type CourtSurface string
+
+const (
+	ArtificialGrassCourtSurface CourtSurface = "artificial_grass"
+	ConcreteCourtSurface        CourtSurface = "concrete"
+	AcrylicCourtSurface         CourtSurface = "acrylic"
+)
+
+// CourtFormat tells whether the court is sized for singles or for doubles.
+type CourtFormat string
+
+const (
+	SinglesCourtFormat CourtFormat = "singles"
+	DoublesCourtFormat CourtFormat = "doubles"
+)
+
+// Players is the size of a full game on a court of the format.
+func (f CourtFormat) Players() int {
+	if f == SinglesCourtFormat {
+		return 2
+	}
+
+	return DefaultMaxPlayers
+}
+
+// CourtAttributes describe a court to the players looking for one. Empty values are not specified.
+type CourtAttributes struct {
+	Environment CourtEnvironment
+	Surface     CourtSurface
+	Format      CourtFormat
+	// Panoramic courts are walled with glass only, without a mesh on the sides
+	Panoramic bool
+	// Lighting tells whether the court is lit for play after dark
+	Lighting bool
+}
+
+func (a CourtAttributes) Validate() error {
+	switch a.Environment {
+	case "", IndoorCourtEnvironment, OutdoorCourtEnvironment:
+	default:
+		return fmt.Errorf("%w: unknown environment %q", ErrInvalidCourtAttributes, a.Environment)
+	}
+
+	switch a.Surface {
+	case "", ArtificialGrassCourtSurface, ConcreteCourtSurface, AcrylicCourtSurface:
+	default:
+		return fmt.Errorf("%w: unknown surface %q", ErrInvalidCourtAttributes, a.Surface)
+	}
+
+	switch a.Format {
+	case "", SinglesCourtFormat, DoublesCourtFormat:
+	default:
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidCourtAttributes, a.Format)
+	}
+
+	return nil
+}
+
+// CourtDetails are the changes to the description of a court. Zero values and nil flags keep the current
+// value.
+type CourtDetails struct {
+	Name        string
+	MaxPlayers  int
+	Environment CourtEnvironment
+	Surface     CourtSurface
+	Format      CourtFormat
+	Panoramic   *bool
+	Lighting    *bool
+}
+
+func (d CourtDetails) Apply(c *Court) {
+	if d.Name != "" {
+		c.Name = d.Name
+	}
+	if d.MaxPlayers > 0 {
+		c.MaxPlayers = d.MaxPlayers
+	}
+	if d.Environment != "" {
+		c.Environment = d.Environment
+	}
+	if d.Surface != "" {
+		c.Surface = d.Surface
+	}
+	if d.Format != "" {
+		c.Format = d.Format
+	}
+	if d.Panoramic != nil {
+		c.Panoramic = *d.Panoramic
+	}
+	if d.Lighting != nil {
+		c.Lighting = *d.Lighting
+	}
+}
+
+// CourtFilter selects courts by their attributes. Empty values and nil flags match any court.
+type CourtFilter struct {
+	Environment CourtEnvironment
+	Surface     CourtSurface
+	Format      CourtFormat
+	Panoramic   *bool
+	Lighting    *bool
+}
+
+func (f CourtFilter) Validate() error {
+	return CourtAttributes{Environment: f.Environment, Surface: f.Surface, Format: f.Format}.Validate()
+}
+
+// OrganizationCourts are the courts of an organization that match a search.
+type OrganizationCourts struct {
+	Organization Organization
+	Courts       []Court
+}
@@ -3,11 +3,61 @@ package entities
 import "errors"
 
 var (
-	ErrNotFound                 = errors.New("not found")
-	ErrCourtAlreadyReserved     = errors.New("court is already reserved for this time slot")
-	ErrOrganizationAlreadyExist = errors.New("organization already exist")
+	ErrNotFound                  = errors.New("not found")
+	ErrCourtAlreadyReserved      = errors.New("court is already reserved for this time slot")
+	ErrOrganizationAlreadyExist  = errors.New("organization already exist")
+	ErrInvalidOpeningHours       = errors.New("invalid opening hours")
+	ErrInvalidRecurrence         = errors.New("invalid recurrence")
+	ErrReservationNotPending     = errors.New("reservation is not pending")
+	ErrReservationHoldExpired    = errors.New("reservation hold has expired")
+	ErrLockTimeout               = errors.New("timed out waiting for lock")
+	ErrForbidden                 = errors.New("forbidden")
+	ErrInvalidRole               = errors.New("invalid role")
+	ErrMemberAlreadyExist        = errors.New("member already exist")
+	ErrLastOwner                 = errors.New("organization must keep at least one owner")
+	ErrInvalidPricing            = errors.New("invalid pricing")
+	ErrPaymentRequired           = errors.New("reservation has to be paid")
+	ErrNothingToPay              = errors.New("reservation has nothing to pay")
+	ErrPaymentAlreadyExist       = errors.New("payment already exist")
+	ErrInvalidPaymentTransition  = errors.New("invalid payment status transition")
+	ErrPaidWithSeries            = errors.New("reservation is paid with its series")
+	ErrInvalidWebhook            = errors.New("invalid webhook")
+	ErrInvalidSplit              = errors.New("invalid split")
+	ErrReservationAlreadySplit   = errors.New("reservation is already split")
+	ErrReservationNotActive      = errors.New("reservation is over or cancelled")
+	ErrInvalidInvitation         = errors.New("invalid invitation")
+	ErrRosterFull                = errors.New("roster is full")
+	ErrPlayerAlreadyInvited      = errors.New("player is already invited")
+	ErrInvalidPlayerTransition   = errors.New("invalid player status transition")
+	ErrInvalidSkillLevel         = errors.New("invalid skill level")
+	ErrInvalidMatch              = errors.New("invalid open match")
+	ErrMatchFull                 = errors.New("match is full")
+	ErrLevelOutOfRange           = errors.New("level is out of the range of the match")
+	ErrReservationNotOver        = errors.New("reservation is not over")
+	ErrInvalidResult             = errors.New("invalid match result")
+	ErrResultAlreadyReported     = errors.New("match result is already reported")
+	ErrInvalidResultTransition   = errors.New("invalid match result status transition")
+	ErrInvalidWaitlistEntry      = errors.New("invalid waitlist entry")
+	ErrInvalidCancellationPolicy = errors.New("invalid cancellation policy")
+	ErrInvalidReschedule         = errors.New("invalid reschedule")
+	ErrInvalidBookingRules       = errors.New("invalid booking rules")
+	ErrBookingDurationNotAllowed = errors.New("booking duration is not allowed")
+	ErrBookingNotAligned         = errors.New("booking is not aligned to the slots of the court")
+	ErrBookingTooFarAhead        = errors.New("booking is too far in advance")
+	ErrBookingNoticeTooShort     = errors.New("booking is too close to its start")
+	ErrTooManyActiveBookings     = errors.New("too many active bookings")
+	ErrInvalidBlackout           = errors.New("invalid blackout")
+	ErrCourtBlackedOut           = errors.New("court is closed for this time slot")
+	ErrInvalidTimeZone           = errors.New("invalid time zone")
+	ErrOutsideOpeningHours       = errors.New("court is closed at this time")
+	ErrInvalidCourtAttributes    = errors.New("invalid court attributes")
+	ErrInvalidAvailabilitySearch = errors.New("invalid availability search")
+	ErrInvalidCoordinates        = errors.New("invalid coordinates")
 
 	ErrInvalidToken       = errors.New("invalid token")
 	ErrExpiredToken       = errors.New("token has expired")
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidOTP         = errors.New("invalid or expired code")
+	ErrInvalidOTPPurpose  = errors.New("invalid code purpose")
+	ErrTooManyRequests    = errors.New("too many requests")
 )
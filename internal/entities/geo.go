@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// EarthRadius is the mean radius of the Earth in meters
+	EarthRadius = 6371000.0
+	// MaxNearbyRadius bounds the radius of a nearby search, in meters
+	MaxNearbyRadius = 100000.0
+	// DefaultNearbyLimit is how many organizations a nearby search returns at most when the limit is not given
+	DefaultNearbyLimit = 20
+	MaxNearbyLimit     = 100
+)
+
+// GeoPoint is a position on the Earth in decimal degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (p GeoPoint) Validate() error {
+	if math.IsNaN(p.Latitude) || p.Latitude < -90 || p.Latitude > 90 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidCoordinates)
+	}
+
+	if math.IsNaN(p.Longitude) || p.Longitude < -180 || p.Longitude > 180 {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidCoordinates)
+	}
+
+	return nil
+}
+
+// ValidateNearbyRadius accepts radii in meters up to MaxNearbyRadius.
+func ValidateNearbyRadius(radius float64) error {
+	if math.IsNaN(radius) || radius <= 0 || radius > MaxNearbyRadius {
+		return fmt.Errorf("%w: radius must be positive and at most %.0f meters", ErrInvalidCoordinates, MaxNearbyRadius)
+	}
+
+	return nil
+}
+
+// ValidateNearbyLimit accepts limits up to MaxNearbyLimit.
+func ValidateNearbyLimit(limit int) error {
+	if limit <= 0 || limit > MaxNearbyLimit {
+		return fmt.Errorf("%w: limit must be positive and at most %d", ErrInvalidCoordinates, MaxNearbyLimit)
+	}
+
+	return nil
+}
+
+// NearbyOrganization is an organization found by a nearby search.
+type NearbyOrganization struct {
+	Organization Organization
+	// Distance from the searched point in meters
+	Distance float64
+}
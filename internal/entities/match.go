@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// Skill levels follow the usual padel scale, from 0 for a beginner to 7 for a professional.
+const (
+	MinSkillLevel = 0.0
+	MaxSkillLevel = 7.0
+)
+
+func ValidateSkillLevel(level float64) error {
+	if level < MinSkillLevel || level > MaxSkillLevel {
+		return fmt.Errorf("%w: level %.2f must be between %.0f and %.0f",
+			ErrInvalidSkillLevel, level, MinSkillLevel, MaxSkillLevel)
+	}
+
+	return nil
+}
+
+// OpenMatch publishes a reservation that misses players, so other users of the club can join it.
+type OpenMatch struct {
+	ReservationID string
+	MinLevel      float64
+	MaxLevel      float64
+	// MissingPlayers is the number of spots still open, every player who joins takes one
+	MissingPlayers int
+	// AutoAccept lets players join right away, otherwise the booker approves every request to join
+	AutoAccept bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (m OpenMatch) Validate() error {
+	if err := ValidateSkillLevel(m.MinLevel); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMatch, err)
+	}
+
+	if err := ValidateSkillLevel(m.MaxLevel); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMatch, err)
+	}
+
+	if m.MinLevel > m.MaxLevel {
+		return fmt.Errorf("%w: minimum level %.2f is above maximum level %.2f", ErrInvalidMatch, m.MinLevel, m.MaxLevel)
+	}
+
+	if m.MissingPlayers <= 0 {
+		return fmt.Errorf("%w: at least one player must be missing", ErrInvalidMatch)
+	}
+
+	return nil
+}
+
+// Admits reports whether a player of the level may join the match. Players who did not set their level
+// only join matches open to every level.
+func (m OpenMatch) Admits(level *float64) bool {
+	if level == nil {
+		return m.MinLevel == MinSkillLevel && m.MaxLevel == MaxSkillLevel
+	}
+
+	return *level >= m.MinLevel && *level <= m.MaxLevel
+}
+
+// MatchListing is an open match found by a search, with the reservation it fills.
+type MatchListing struct {
+	Match          OpenMatch
+	Reservation    Reservation
+	OrganizationID string
+}
+
+// MatchFilter narrows the search of open matches to the courts of the organizations, the reservations
+// starting between From and To, and the matches admitting the level when it is set.
+type MatchFilter struct {
+	OrganizationIDs []string
+	From            time.Time
+	To              time.Time
+	Level           *float64
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Role is the role of a user within an organization.
+type Role string
+
+const (
+	OwnerRole   Role = "owner"
+	ManagerRole Role = "manager"
+	StaffRole   Role = "staff"
+	PlayerRole  Role = "player"
+)
+
+// roleRanks orders roles from the least to the most privileged.
+var roleRanks = []Role{PlayerRole, StaffRole, ManagerRole, OwnerRole}
+
+func (r Role) Valid() bool {
+	return slices.Contains(roleRanks, r)
+}
+
+// AtLeast reports whether r grants every permission of other. An empty role, meaning
+// the user is not a member, is below every role.
+func (r Role) AtLeast(other Role) bool {
+	return slices.Index(roleRanks, r) >= slices.Index(roleRanks, other) && r.Valid()
+}
+
+// Membership links a user to an organization with a role.
+type Membership struct {
+	OrganizationID string
+	UserID         string
+	Role           Role
+	InvitedBy      string
+	CreatedAt      time.Time
+}
+
+func NewMembership(organizationID, userID string, role Role, invitedBy string) *Membership {
+	return &Membership{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+		InvitedBy:      invitedBy,
+		CreatedAt:      time.Now().UTC(),
+	}
+}
+
+// Actor is the authenticated user acting within an organization. Role is empty for non-members.
+type Actor struct {
+	UserID string
+	Role   Role
+}
+
+// IsStaff reports whether the actor may manage bookings of other users.
+func (a Actor) IsStaff() bool {
+	return a.Role.AtLeast(StaffRole)
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeOfDay is a wall-clock time expressed as minutes since midnight.
+type TimeOfDay int
+
+const minutesInDay = 24 * 60
+
+// ParseTimeOfDay parses "HH:MM". "24:00" is accepted to express the end of the day.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	var hours, minutes int
+
+	if _, err := fmt.Sscanf(s, "%02d:%02d", &hours, &minutes); err != nil || len(s) != len("15:04") {
+		return 0, fmt.Errorf("%w: time of day %q must be in HH:MM format", ErrInvalidOpeningHours, s)
+	}
+
+	t := TimeOfDay(hours*60 + minutes)
+	if hours < 0 || minutes < 0 || minutes >= 60 || t > minutesInDay {
+		return 0, fmt.Errorf("%w: time of day %q is out of range", ErrInvalidOpeningHours, s)
+	}
+
+	return t, nil
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// On returns the moment of the time of day on the calendar day of date, in the location of date.
+func (t TimeOfDay) On(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, int(t)/60, int(t)%60, 0, 0, date.Location())
+}
+
+// OpeningHours is a single opening window on a weekday. A court may have several windows per day.
+type OpeningHours struct {
+	Weekday  time.Weekday
+	OpensAt  TimeOfDay
+	ClosesAt TimeOfDay
+}
+
+func (h OpeningHours) Validate() error {
+	if h.Weekday < time.Sunday || h.Weekday > time.Saturday {
+		return fmt.Errorf("%w: unknown weekday %d", ErrInvalidOpeningHours, h.Weekday)
+	}
+
+	if h.OpensAt < 0 || h.ClosesAt > minutesInDay || h.OpensAt >= h.ClosesAt {
+		return fmt.Errorf(
+			"%w: %s must open before it closes (%s-%s)",
+			ErrInvalidOpeningHours, h.Weekday, h.OpensAt, h.ClosesAt,
+		)
+	}
+
+	return nil
+}
+
+// ValidateOpeningHours checks every window and rejects overlapping windows on the same weekday.
+func ValidateOpeningHours(hours []OpeningHours) error {
+	for i, h := range hours {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+
+		for _, other := range hours[i+1:] {
+			if other.Weekday == h.Weekday && other.OpensAt < h.ClosesAt && h.OpensAt < other.ClosesAt {
+				return fmt.Errorf("%w: overlapping windows on %s", ErrInvalidOpeningHours, h.Weekday)
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,17 +1,36 @@
 package entities
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Organization struct {
-	ID        string
-	Name      string
-	City      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID   string
+	Name string
+	City string
+	// Address is the street address of the organization, for display only
+	Address string
+	// Coordinates place the organization on the map, nil when they are not known
+	Coordinates *GeoPoint
+	// TimeZone is the IANA time zone the organization books in, UTC when empty
+	TimeZone string
+	// OpeningHours apply to the courts of the organization that have no opening hours of their own
+	OpeningHours []OpeningHours
+	SpecialHours []SpecialHours
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// OrganizationUpdate changes the name, the city, the time zone and the location of an organization. The time
+// zone is kept when it is empty. The address and the coordinates are only changed when SetAddress and
+// SetCoordinates are set, an empty address and nil coordinates then remove them.
+type OrganizationUpdate struct {
+	Organization
+	SetAddress     bool
+	SetCoordinates bool
 }
 
 func NewOrganization(name, city string) *Organization {
@@ -22,3 +41,36 @@ func NewOrganization(name, city string) *Organization {
 		CreatedAt: time.Now().UTC(),
 	}
 }
+
+// Location returns the time zone of the organization. Zones that fail to load fall back to UTC, they are
+// validated with ValidateTimeZone before they are stored.
+func (o Organization) Location() *time.Location {
+	if o.TimeZone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(o.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// ValidateTimeZone accepts IANA time zone names such as "Europe/Madrid". The empty zone stands for UTC.
+func ValidateTimeZone(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	// "Local" would follow the zone of the server rather than the one of the club
+	if name == "Local" {
+		return fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OTPPurpose tells what a one-time code sent by SMS may be used for.
+type OTPPurpose string
+
+const (
+	VerifyPhoneOTPPurpose   OTPPurpose = "verify_phone"
+	LoginOTPPurpose         OTPPurpose = "login"
+	ResetPasswordOTPPurpose OTPPurpose = "reset_password"
+)
+
+func (p OTPPurpose) Valid() bool {
+	switch p {
+	case VerifyPhoneOTPPurpose, LoginOTPPurpose, ResetPasswordOTPPurpose:
+		return true
+	}
+	return false
+}
+
+// OTPChallenge is a one-time code sent to a phone number. Only the hash of the code is stored.
+type OTPChallenge struct {
+	ID          string
+	PhoneNumber string
+	Purpose     OTPPurpose
+	CodeHash    string
+	Attempts    int
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	ConsumedAt  *time.Time
+}
+
+func NewOTPChallenge(
+	phoneNumber string,
+	purpose OTPPurpose,
+	codeHash string,
+	now time.Time,
+	ttl time.Duration,
+) *OTPChallenge {
+	return &OTPChallenge{
+		ID:          uuid.New().String(),
+		PhoneNumber: phoneNumber,
+		Purpose:     purpose,
+		CodeHash:    codeHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+// IsUsableAt reports whether the code can still be checked.
+func (c OTPChallenge) IsUsableAt(now time.Time, maxAttempts int) bool {
+	return c.ConsumedAt == nil && now.Before(c.ExpiresAt) && c.Attempts < maxAttempts
+}
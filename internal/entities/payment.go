@@ -0,0 +1,143 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentStatus string
+
+const (
+	PendingPaymentStatus   PaymentStatus = "pending"
+	SucceededPaymentStatus PaymentStatus = "succeeded"
+	FailedPaymentStatus    PaymentStatus = "failed"
+	RefundedPaymentStatus  PaymentStatus = "refunded"
+	// PartiallyRefundedPaymentStatus is a settled payment of which only part was given back, e.g. under the
+	// cancellation policy of the organization
+	PartiallyRefundedPaymentStatus PaymentStatus = "partially_refunded"
+)
+
+// CanTransitionTo reports whether a payment in status s may move to next. Pending payments are settled
+// by the provider, only settled payments can be refunded, once, in full or in part. A series payment is
+// refunded occurrence by occurrence, so a partially refunded payment may be refunded further.
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	switch s {
+	case PendingPaymentStatus:
+		return next == SucceededPaymentStatus || next == FailedPaymentStatus
+	case SucceededPaymentStatus, PartiallyRefundedPaymentStatus:
+		return next == RefundedPaymentStatus || next == PartiallyRefundedPaymentStatus
+	}
+	return false
+}
+
+// Payment collects the price of a pending reservation, or of every priced occurrence of a series, through a
+// payment provider.
+type Payment struct {
+	ID            string
+	ReservationID string
+	// ShareID is set when the payment pays one share of a split reservation
+	ShareID string
+	// SeriesID is set instead of the ReservationID when the payment pays the holds of a series at once
+	SeriesID string
+	UserID   string
+	Amount   int64
+	Currency string
+	Status   PaymentStatus
+	// RefundedAmount is the part of the amount given back to the payer
+	RefundedAmount int64
+	// Provider is the name of the payment provider, ProviderRef the id of the payment intent there
+	Provider    string
+	ProviderRef string
+	// ClientSecret lets the client complete the payment intent directly with the provider
+	ClientSecret string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	// History lists every status the payment went through, oldest first
+	History []PaymentEvent
+}
+
+// PaymentEvent records a status change of a payment.
+type PaymentEvent struct {
+	Status    PaymentStatus
+	Reason    string
+	CreatedAt time.Time
+}
+
+func NewPayment(reservation *Reservation, provider string, now time.Time) *Payment {
+	return &Payment{
+		ID:            uuid.New().String(),
+		ReservationID: reservation.ID,
+		UserID:        reservation.ReservedBy,
+		Amount:        reservation.Price.Amount,
+		Currency:      reservation.Price.Currency,
+		Status:        PendingPaymentStatus,
+		Provider:      provider,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		History:       []PaymentEvent{{Status: PendingPaymentStatus, CreatedAt: now}},
+	}
+}
+
+// NewSharePayment opens a payment for one share of a split reservation, paid by the player who owes it.
+func NewSharePayment(share *PaymentShare, provider string, now time.Time) *Payment {
+	return &Payment{
+		ID:            uuid.New().String(),
+		ReservationID: share.ReservationID,
+		ShareID:       share.ID,
+		UserID:        share.UserID,
+		Amount:        share.Amount,
+		Currency:      share.Currency,
+		Status:        PendingPaymentStatus,
+		Provider:      provider,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		History:       []PaymentEvent{{Status: PendingPaymentStatus, CreatedAt: now}},
+	}
+}
+
+// NewSeriesPayment opens one payment for the price of every hold of the series, paid by its booker.
+func NewSeriesPayment(series *ReservationSeries, holds []Reservation, provider string, now time.Time) *Payment {
+	payment := &Payment{
+		ID:        uuid.New().String(),
+		SeriesID:  series.ID,
+		UserID:    series.ReservedBy,
+		Status:    PendingPaymentStatus,
+		Provider:  provider,
+		CreatedAt: now,
+		UpdatedAt: now,
+		History:   []PaymentEvent{{Status: PendingPaymentStatus, CreatedAt: now}},
+	}
+
+	for _, hold := range holds {
+		payment.Amount += hold.Price.Amount
+		payment.Currency = hold.Price.Currency
+	}
+
+	return payment
+}
+
+// PaymentIntent is the provider side of a payment, completed by the client with the client secret.
+type PaymentIntent struct {
+	Ref          string
+	ClientSecret string
+}
+
+// RefundKey is the idempotency key of the refund of the payment. A payment is refunded at most once, the
+// provider refunds a retried or concurrent request with the same key only once.
+func (p Payment) RefundKey() string {
+	return "refund_" + p.ID
+}
+
+// OccurrenceRefundKey is the idempotency key of the refund of one occurrence of a series payment, each
+// occurrence is refunded at most once.
+func (p Payment) OccurrenceRefundKey(reservationID string) string {
+	return "refund_" + p.ID + "_" + reservationID
+}
+
+// PaymentNotification is a verified webhook from the provider telling how a payment intent was settled.
+type PaymentNotification struct {
+	Ref    string
+	Status PaymentStatus
+	Reason string
+}
@@ -0,0 +1,237 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Price is an amount of money in the minor units of its currency, e.g. cents for EUR.
+// A price without a currency means the reservation was booked without a pricing rule.
+type Price struct {
+	Amount   int64
+	Currency string
+}
+
+func (p Price) IsZero() bool {
+	return p.Currency == ""
+}
+
+// PriceBand overrides the base hourly rate between From and To on the listed weekdays.
+// A band without weekdays applies to every day of the week.
+type PriceBand struct {
+	Weekdays   []time.Weekday
+	From       TimeOfDay
+	To         TimeOfDay
+	HourlyRate int64
+}
+
+func (b PriceBand) appliesOn(weekday time.Weekday) bool {
+	return len(b.Weekdays) == 0 || slices.Contains(b.Weekdays, weekday)
+}
+
+func (b PriceBand) sharesWeekday(other PriceBand) bool {
+	if len(b.Weekdays) == 0 || len(other.Weekdays) == 0 {
+		return true
+	}
+
+	for _, wd := range b.Weekdays {
+		if slices.Contains(other.Weekdays, wd) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PricingRule prices the courts of an organization. A rule without a court is the organization default
+// and applies to every court that has no rule of its own.
+type PricingRule struct {
+	OrganizationID string
+	CourtID        string
+	Currency       string
+	BaseHourlyRate int64
+	Bands          []PriceBand
+	// Holidays are calendar days billed at HolidayHourlyRate instead of the bands, when that rate is set
+	Holidays          []time.Time
+	HolidayHourlyRate int64
+	// MinimumCharge is the lowest total a reservation is billed, whatever its duration
+	MinimumCharge int64
+	UpdatedAt     time.Time
+}
+
+func (r PricingRule) Validate() error {
+	if len(r.Currency) != 3 {
+		return fmt.Errorf("%w: currency %q must be a three letter ISO 4217 code", ErrInvalidPricing, r.Currency)
+	}
+
+	for _, c := range r.Currency {
+		if c < 'A' || c > 'Z' {
+			return fmt.Errorf("%w: currency %q must be a three letter ISO 4217 code", ErrInvalidPricing, r.Currency)
+		}
+	}
+
+	if r.BaseHourlyRate < 0 || r.HolidayHourlyRate < 0 || r.MinimumCharge < 0 {
+		return fmt.Errorf("%w: rates and minimum charge cannot be negative", ErrInvalidPricing)
+	}
+
+	for i, b := range r.Bands {
+		if b.HourlyRate < 0 {
+			return fmt.Errorf("%w: band %s-%s has a negative rate", ErrInvalidPricing, b.From, b.To)
+		}
+
+		if b.From < 0 || b.To > minutesInDay || b.From >= b.To {
+			return fmt.Errorf("%w: band must start before it ends (%s-%s)", ErrInvalidPricing, b.From, b.To)
+		}
+
+		for _, wd := range b.Weekdays {
+			if wd < time.Sunday || wd > time.Saturday {
+				return fmt.Errorf("%w: unknown weekday %d", ErrInvalidPricing, wd)
+			}
+		}
+
+		for _, other := range r.Bands[i+1:] {
+			if b.sharesWeekday(other) && other.From < b.To && b.From < other.To {
+				return fmt.Errorf("%w: bands %s-%s and %s-%s overlap",
+					ErrInvalidPricing, b.From, b.To, other.From, other.To)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r PricingRule) isHoliday(t time.Time) bool {
+	y, m, d := t.Date()
+
+	for _, h := range r.Holidays {
+		hy, hm, hd := h.Date()
+		if hy == y && hm == m && hd == d {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HourlyRateAt returns the hourly rate that applies at the moment t, evaluated in the location of t.
+func (r PricingRule) HourlyRateAt(t time.Time) int64 {
+	if r.HolidayHourlyRate > 0 && r.isHoliday(t) {
+		return r.HolidayHourlyRate
+	}
+
+	minute := TimeOfDay(t.Hour()*60 + t.Minute())
+
+	for _, b := range r.Bands {
+		if b.appliesOn(t.Weekday()) && b.From <= minute && minute < b.To {
+			return b.HourlyRate
+		}
+	}
+
+	return r.BaseHourlyRate
+}
+
+// QuoteLine is a stretch of the slot billed at a single hourly rate.
+type QuoteLine struct {
+	From       time.Time
+	To         time.Time
+	HourlyRate int64
+	Amount     int64
+}
+
+// Quote is the price of a slot broken down by the rates that apply to it.
+type Quote struct {
+	CourtID              string
+	From                 time.Time
+	To                   time.Time
+	Currency             string
+	Lines                []QuoteLine
+	Subtotal             int64
+	MinimumChargeApplied bool
+	Total                int64
+}
+
+func (q Quote) Price() Price {
+	return Price{Amount: q.Total, Currency: q.Currency}
+}
+
+// MaxQuoteDuration is the longest slot that can be quoted on its own.
+const MaxQuoteDuration = 24 * time.Hour
+
+// Quote prices the slot on the court. The slot is cut wherever the rate may change, at the edges of the bands
+// and at midnight, and consecutive stretches at the same rate are merged into a single line. Bands and
+// holidays are read on the wall clock in loc, the time zone of the club.
+func (r PricingRule) Quote(courtID string, from, to time.Time, loc *time.Location) Quote {
+	q := Quote{
+		CourtID:  courtID,
+		From:     from,
+		To:       to,
+		Currency: r.Currency,
+	}
+
+	for t := from; t.Before(to); {
+		next := r.nextRateChange(t, loc)
+		if next.After(to) {
+			next = to
+		}
+
+		rate := r.HourlyRateAt(t.In(loc))
+
+		if n := len(q.Lines); n > 0 && q.Lines[n-1].HourlyRate == rate {
+			q.Lines[n-1].To = next
+		} else {
+			q.Lines = append(q.Lines, QuoteLine{From: t, To: next, HourlyRate: rate})
+		}
+
+		t = next
+	}
+
+	for i := range q.Lines {
+		q.Lines[i].Amount = amountFor(q.Lines[i].HourlyRate, q.Lines[i].To.Sub(q.Lines[i].From))
+		q.Subtotal += q.Lines[i].Amount
+	}
+
+	q.Total = q.Subtotal
+	if q.Total < r.MinimumCharge {
+		q.Total = r.MinimumCharge
+		q.MinimumChargeApplied = true
+	}
+
+	return q
+}
+
+// nextRateChange returns the first moment after t the rate may change at, the next edge of a band or the
+// next midnight on the wall clock in loc.
+func (r PricingRule) nextRateChange(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	y, m, d := local.Date()
+	minute := TimeOfDay(local.Hour()*60 + local.Minute())
+
+	next := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+
+	for _, b := range r.Bands {
+		for _, edge := range []TimeOfDay{b.From, b.To} {
+			if edge <= minute {
+				continue
+			}
+
+			at := time.Date(y, m, d, int(edge)/60, int(edge)%60, 0, 0, loc)
+			if at.After(t) && at.Before(next) {
+				next = at
+			}
+		}
+	}
+
+	// the wall clock repeats an hour when summer time ends, the walk must still move forward
+	if !next.After(t) {
+		next = t.Truncate(time.Minute).Add(time.Minute)
+	}
+
+	return next.In(t.Location())
+}
+
+// amountFor bills the duration at the hourly rate, rounded to the nearest minor unit.
+func amountFor(hourlyRate int64, d time.Duration) int64 {
+	seconds := int64(d / time.Second)
+	return (hourlyRate*seconds + 1800) / 3600
+}
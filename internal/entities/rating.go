@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// Ratings follow the Elo system: every player starts at InitialRating, and the winners of a match take
+// points from the losers in proportion to how unlikely their win was.
+const (
+	InitialRating = 1500.0
+
+	// players move faster until their first provisionalGames rated matches place them
+	provisionalGames = 20
+	provisionalK     = 40.0
+	establishedK     = 20.0
+)
+
+// PlayerRating is the rating of a player before a match is rated.
+type PlayerRating struct {
+	UserID     string
+	Rating     float64
+	RatedGames int
+}
+
+// RatingChange is an entry of the rating history of a player, left by one confirmed result.
+type RatingChange struct {
+	UserID        string
+	ReservationID string
+	Before        float64
+	After         float64
+	CreatedAt     time.Time
+}
+
+// RateResult computes the rating change of every player of the result. Each team plays with the average
+// rating of its players, every player of a team moves by the same share of their own K factor.
+func RateResult(result MatchResult, ratings map[string]PlayerRating, now time.Time) []RatingChange {
+	teamA := averageRating(result.TeamA, ratings)
+	teamB := averageRating(result.TeamB, ratings)
+
+	expectedA := 1 / (1 + math.Pow(10, (teamB-teamA)/400))
+
+	scoreA := 0.0
+	if result.TeamAWon() {
+		scoreA = 1
+	}
+
+	changes := make([]RatingChange, 0, len(result.TeamA)+len(result.TeamB))
+
+	rate := func(team []string, score, expected float64) {
+		for _, id := range team {
+			player := ratings[id]
+
+			k := establishedK
+			if player.RatedGames < provisionalGames {
+				k = provisionalK
+			}
+
+			changes = append(changes, RatingChange{
+				UserID:        id,
+				ReservationID: result.ReservationID,
+				Before:        player.Rating,
+				After:         math.Round((player.Rating+k*(score-expected))*100) / 100,
+				CreatedAt:     now,
+			})
+		}
+	}
+
+	rate(result.TeamA, scoreA, expectedA)
+	rate(result.TeamB, 1-scoreA, 1-expectedA)
+
+	return changes
+}
+
+func averageRating(team []string, ratings map[string]PlayerRating) float64 {
+	var sum float64
+	for _, id := range team {
+		sum += ratings[id].Rating
+	}
+
+	return sum / float64(len(team))
+}
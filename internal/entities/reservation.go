@@ -12,6 +12,7 @@ const (
 	PendingReservationStatus   ReservationStatus = "pending"
 	ReservedReservationStatus  ReservationStatus = "reserved"
 	CancelledReservationStatus ReservationStatus = "cancelled"
+	ExpiredReservationStatus   ReservationStatus = "expired"
 )
 
 type Reservation struct {
@@ -23,6 +24,13 @@ type Reservation struct {
 	ReservedTo   time.Time
 	ReservedBy   string
 	CancelledBy  string
+	SeriesID     string
+	// ExpiresAt is set for pending holds, the slot is released when the hold is not confirmed in time
+	ExpiresAt time.Time
+	// Price is the snapshot taken when the reservation was booked, later rule changes do not affect it
+	Price Price
+	// Cancellation is set on reservations cancelled under the cancellation policy of the organization
+	Cancellation *Cancellation
 
 	CreatedAt time.Time
 }
@@ -31,6 +39,7 @@ func NewReservation(courtID string, from, to time.Time, reservedBy string) *Rese
 	return &Reservation{
 		ID:           uuid.New().String(),
 		CourtID:      courtID,
+		Status:       ReservedReservationStatus,
 		ReservedFrom: from,
 		ReservedTo:   to,
 		ReservedBy:   reservedBy,
@@ -41,3 +50,13 @@ func NewReservation(courtID string, from, to time.Time, reservedBy string) *Rese
 func (r Reservation) IsReserved() bool {
 	return r.Status == ReservedReservationStatus || r.Status == PendingReservationStatus
 }
+
+// IsHoldExpired reports whether the reservation is a pending hold that was not confirmed before now.
+func (r Reservation) IsHoldExpired(now time.Time) bool {
+	return r.Status == PendingReservationStatus && !r.ExpiresAt.IsZero() && !now.Before(r.ExpiresAt)
+}
+
+// IsActiveAt reports whether the reservation still occupies its slot at the moment now.
+func (r Reservation) IsActiveAt(now time.Time) bool {
+	return r.IsReserved() && !r.IsHoldExpired(now)
+}
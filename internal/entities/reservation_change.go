@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservationChange records a reservation being moved from one slot to another, on the same or another court.
+type ReservationChange struct {
+	ID            string
+	ReservationID string
+
+	PreviousCourtID string
+	PreviousFrom    time.Time
+	PreviousTo      time.Time
+
+	CourtID      string
+	ReservedFrom time.Time
+	ReservedTo   time.Time
+
+	ChangedBy string
+	ChangedAt time.Time
+}
+
+// NewReservationChange moves the reservation to the slot on the court.
+func NewReservationChange(
+	r Reservation,
+	courtID string,
+	from, to time.Time,
+	changedBy string,
+	now time.Time,
+) *ReservationChange {
+	return &ReservationChange{
+		ID:              uuid.New().String(),
+		ReservationID:   r.ID,
+		PreviousCourtID: r.CourtID,
+		PreviousFrom:    r.ReservedFrom,
+		PreviousTo:      r.ReservedTo,
+		CourtID:         courtID,
+		ReservedFrom:    from,
+		ReservedTo:      to,
+		ChangedBy:       changedBy,
+		ChangedAt:       now,
+	}
+}
+
+func (c ReservationChange) Validate(now time.Time) error {
+	if !c.ReservedFrom.Before(c.ReservedTo) {
+		return fmt.Errorf("%w: the slot must end after it starts", ErrInvalidReschedule)
+	}
+
+	if !c.ReservedFrom.After(now) {
+		return fmt.Errorf("%w: the slot must start in the future", ErrInvalidReschedule)
+	}
+
+	if c.CourtID == c.PreviousCourtID && c.ReservedFrom.Equal(c.PreviousFrom) && c.ReservedTo.Equal(c.PreviousTo) {
+		return fmt.Errorf("%w: the reservation already has this slot", ErrInvalidReschedule)
+	}
+
+	return nil
+}
+
+// FreesPreviousSlot reports whether moving the reservation leaves its previous slot free for others. A slot
+// shifted on the same court still overlaps the previous one.
+func (c ReservationChange) FreesPreviousSlot() bool {
+	if c.CourtID != c.PreviousCourtID {
+		return true
+	}
+
+	return !c.ReservedFrom.Before(c.PreviousTo) || !c.ReservedTo.After(c.PreviousFrom)
+}
+
+// Apply moves the reservation to the new slot.
+func (c ReservationChange) Apply(r *Reservation) {
+	r.CourtID = c.CourtID
+	r.ReservedFrom = c.ReservedFrom
+	r.ReservedTo = c.ReservedTo
+}
@@ -0,0 +1,129 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+type ResultStatus string
+
+const (
+	// PendingResultStatus is a result reported by one team that waits for the opposing team to confirm it
+	PendingResultStatus   ResultStatus = "pending"
+	ConfirmedResultStatus ResultStatus = "confirmed"
+	// DisputedResultStatus is a result the opposing team contested, ratings stay frozen until the club
+	// resolves it
+	DisputedResultStatus ResultStatus = "disputed"
+)
+
+const maxSets = 5
+
+// SetScore is the number of games each team won in a set.
+type SetScore struct {
+	TeamA int
+	TeamB int
+}
+
+// MatchResult is the outcome of a completed reservation. Players are referenced by their user IDs.
+type MatchResult struct {
+	ReservationID string
+	TeamA         []string
+	TeamB         []string
+	Sets          []SetScore
+	Status        ResultStatus
+	ReportedBy    string
+	// AnsweredBy is the player of the opposing team who confirmed or disputed the result, or the staff
+	// member who resolved the dispute
+	AnsweredBy string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewMatchResult(
+	reservationID, reportedBy string,
+	teamA, teamB []string,
+	sets []SetScore,
+	now time.Time,
+) *MatchResult {
+	return &MatchResult{
+		ReservationID: reservationID,
+		TeamA:         teamA,
+		TeamB:         teamB,
+		Sets:          sets,
+		Status:        PendingResultStatus,
+		ReportedBy:    reportedBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (r MatchResult) Validate() error {
+	if len(r.TeamA) == 0 || len(r.TeamA) > 2 || len(r.TeamA) != len(r.TeamB) {
+		return fmt.Errorf("%w: teams must have one or two players each", ErrInvalidResult)
+	}
+
+	players := r.Players()
+	for i, id := range players {
+		if id == "" {
+			return fmt.Errorf("%w: player id is required", ErrInvalidResult)
+		}
+
+		if slices.Contains(players[:i], id) {
+			return fmt.Errorf("%w: player %s plays more than once", ErrInvalidResult, id)
+		}
+	}
+
+	if len(r.Sets) == 0 || len(r.Sets) > maxSets {
+		return fmt.Errorf("%w: a match has between 1 and %d sets", ErrInvalidResult, maxSets)
+	}
+
+	for i, set := range r.Sets {
+		if set.TeamA < 0 || set.TeamB < 0 {
+			return fmt.Errorf("%w: set %d has a negative score", ErrInvalidResult, i+1)
+		}
+
+		if set.TeamA == set.TeamB {
+			return fmt.Errorf("%w: set %d has no winner", ErrInvalidResult, i+1)
+		}
+	}
+
+	wonA, wonB := r.setsWon()
+	if wonA == wonB {
+		return fmt.Errorf("%w: both teams won %d sets", ErrInvalidResult, wonA)
+	}
+
+	return nil
+}
+
+// TeamAWon reports whether team A won more sets than team B.
+func (r MatchResult) TeamAWon() bool {
+	wonA, wonB := r.setsWon()
+	return wonA > wonB
+}
+
+func (r MatchResult) setsWon() (int, int) {
+	var wonA, wonB int
+	for _, set := range r.Sets {
+		if set.TeamA > set.TeamB {
+			wonA++
+		} else {
+			wonB++
+		}
+	}
+
+	return wonA, wonB
+}
+
+// Players returns the players of both teams, team A first.
+func (r MatchResult) Players() []string {
+	return slices.Concat(r.TeamA, r.TeamB)
+}
+
+// AreOpponents reports whether the two users played on different teams.
+func (r MatchResult) AreOpponents(userID, otherID string) bool {
+	inA, otherInA := slices.Contains(r.TeamA, userID), slices.Contains(r.TeamA, otherID)
+	inB, otherInB := slices.Contains(r.TeamB, userID), slices.Contains(r.TeamB, otherID)
+
+	return (inA && otherInB) || (inB && otherInA)
+}
@@ -0,0 +1,62 @@
+package entities
+
+import "time"
+
+type PlayerStatus string
+
+const (
+	InvitedPlayerStatus  PlayerStatus = "invited"
+	AcceptedPlayerStatus PlayerStatus = "accepted"
+	DeclinedPlayerStatus PlayerStatus = "declined"
+	LeftPlayerStatus     PlayerStatus = "left"
+	// RequestedPlayerStatus is a player who asked to join an open match and waits for the booker
+	RequestedPlayerStatus PlayerStatus = "requested"
+)
+
+// CanTransitionTo reports whether a player in status s may move to next. Invitations and requests to join
+// are answered once, players who declined or left come back only through a new invitation or request.
+func (s PlayerStatus) CanTransitionTo(next PlayerStatus) bool {
+	switch s {
+	case InvitedPlayerStatus, RequestedPlayerStatus:
+		return next == AcceptedPlayerStatus || next == DeclinedPlayerStatus || next == LeftPlayerStatus
+	case AcceptedPlayerStatus:
+		return next == LeftPlayerStatus
+	case DeclinedPlayerStatus, LeftPlayerStatus:
+		return next == InvitedPlayerStatus || next == RequestedPlayerStatus
+	}
+	return false
+}
+
+// TakesSpot reports whether a player in status s counts against the roster size of the court.
+func (s PlayerStatus) TakesSpot() bool {
+	return s == InvitedPlayerStatus || s == AcceptedPlayerStatus
+}
+
+// ReservationPlayer is a user on the roster of a reservation. The booker is always on the roster and
+// is not stored as a player.
+type ReservationPlayer struct {
+	ReservationID string
+	UserID        string
+	InvitedBy     string
+	Status        PlayerStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewReservationPlayer(reservationID, userID, invitedBy string, now time.Time) *ReservationPlayer {
+	return &ReservationPlayer{
+		ReservationID: reservationID,
+		UserID:        userID,
+		InvitedBy:     invitedBy,
+		Status:        InvitedPlayerStatus,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// Game is an upcoming reservation seen from one of its players.
+type Game struct {
+	Reservation Reservation
+	// Status is the answer of the player to the invitation, the booker has accepted by booking
+	Status PlayerStatus
+}
@@ -0,0 +1,143 @@
+package entities
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// SpecialHours replace the weekly opening hours of every court of the organization on a single date, for
+// a holiday or an event. A date without windows is closed all day.
+type SpecialHours struct {
+	// Date is the calendar date, at midnight UTC
+	Date    time.Time
+	Windows []OpeningHours
+	Reason  string
+}
+
+func (h SpecialHours) Validate() error {
+	for _, w := range h.Windows {
+		if w.Weekday != h.Date.Weekday() {
+			return fmt.Errorf("%w: window on %s for %s", ErrInvalidOpeningHours, w.Weekday, h.Date.Format(time.DateOnly))
+		}
+	}
+
+	return ValidateOpeningHours(h.Windows)
+}
+
+// ValidateSpecialHours checks every date and rejects dates given twice.
+func ValidateSpecialHours(hours []SpecialHours) error {
+	seen := make(map[string]bool, len(hours))
+
+	for _, h := range hours {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+
+		date := h.Date.Format(time.DateOnly)
+		if seen[date] {
+			return fmt.Errorf("%w: special hours for %s are given twice", ErrInvalidOpeningHours, date)
+		}
+
+		seen[date] = true
+	}
+
+	return nil
+}
+
+// Schedule tells when a court is open, in the time zone of its organization. The weekly hours of the court
+// apply, or those of the organization when the court has none, and the special hours of the organization
+// replace both on their date.
+type Schedule struct {
+	Location     *time.Location
+	OpeningHours []OpeningHours
+	SpecialHours []SpecialHours
+}
+
+func NewSchedule(org Organization, court Court) Schedule {
+	hours := court.OpeningHours
+	if len(hours) == 0 {
+		hours = org.OpeningHours
+	}
+
+	return Schedule{
+		Location:     org.Location(),
+		OpeningHours: hours,
+		SpecialHours: org.SpecialHours,
+	}
+}
+
+// Day returns the start of the calendar day of date, in the time zone of the schedule. The day is read from
+// date as it is, so a date parsed without a zone keeps its calendar day.
+func (s Schedule) Day(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, s.TimeZone())
+}
+
+// HoursOn returns the opening windows on the calendar day of date, ordered by opening time.
+func (s Schedule) HoursOn(date time.Time) []OpeningHours {
+	var windows []OpeningHours
+
+	if special, ok := s.specialHoursOn(date); ok {
+		windows = slices.Clone(special.Windows)
+	} else {
+		for _, h := range s.OpeningHours {
+			if h.Weekday == date.Weekday() {
+				windows = append(windows, h)
+			}
+		}
+	}
+
+	slices.SortFunc(windows, func(a, b OpeningHours) int {
+		return cmp.Compare(a.OpensAt, b.OpensAt)
+	})
+
+	return windows
+}
+
+// CheckOpen fails with ErrOutsideOpeningHours unless the slot from to lies within a single opening window.
+// Schedules without weekly hours are open at all times, except on the dates of their special hours.
+func (s Schedule) CheckOpen(from, to time.Time) error {
+	day := s.Day(from.In(s.TimeZone()))
+
+	special, isSpecial := s.specialHoursOn(day)
+	if !isSpecial && len(s.OpeningHours) == 0 {
+		return nil
+	}
+
+	for _, w := range s.HoursOn(day) {
+		if !from.Before(w.OpensAt.On(day)) && !to.After(w.ClosesAt.On(day)) {
+			return nil
+		}
+	}
+
+	if isSpecial && len(special.Windows) == 0 {
+		return fmt.Errorf("%w: closed on %s, %s", ErrOutsideOpeningHours, day.Format(time.DateOnly), special.Reason)
+	}
+
+	return fmt.Errorf("%w: %s to %s is outside the opening hours",
+		ErrOutsideOpeningHours, from.In(s.TimeZone()).Format(time.DateTime), to.In(s.TimeZone()).Format(time.TimeOnly))
+}
+
+func (s Schedule) specialHoursOn(date time.Time) (SpecialHours, bool) {
+	y, m, d := date.Date()
+
+	for _, h := range s.SpecialHours {
+		hy, hm, hd := h.Date.Date()
+		if hy == y && hm == m && hd == d {
+			return h, true
+		}
+	}
+
+	return SpecialHours{}, false
+}
+
+// TimeZone returns the location of the schedule, UTC when it has none.
+func (s Schedule) TimeZone() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+
+	return s.Location
+}
@@ -0,0 +1,169 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxSeriesOccurrences caps how many reservations a single series may expand into.
+const MaxSeriesOccurrences = 200
+
+type RecurrenceFrequency string
+
+const (
+	DailyRecurrence  RecurrenceFrequency = "daily"
+	WeeklyRecurrence RecurrenceFrequency = "weekly"
+)
+
+// RecurrenceRule describes how often a series repeats. Interval is counted in days for daily
+// series and in weeks for weekly ones. Weekdays only apply to weekly series and default to the
+// weekday of the series start date.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	Weekdays  []time.Weekday
+}
+
+// ReservationSeries is a recurring booking that is expanded into individual reservations.
+// StartDate and EndDate are calendar days, both inclusive.
+type ReservationSeries struct {
+	ID         string
+	CourtID    string
+	Rule       RecurrenceRule
+	StartDate  time.Time
+	EndDate    time.Time
+	StartTime  TimeOfDay
+	Duration   time.Duration
+	ReservedBy string
+	CreatedAt  time.Time
+}
+
+type SeriesOccurrence struct {
+	Reservation Reservation
+	Conflict    bool
+}
+
+func NewReservationSeries(
+	courtID string,
+	rule RecurrenceRule,
+	startDate, endDate time.Time,
+	startTime TimeOfDay,
+	duration time.Duration,
+	reservedBy string,
+) *ReservationSeries {
+	return &ReservationSeries{
+		ID:         uuid.New().String(),
+		CourtID:    courtID,
+		Rule:       rule,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		StartTime:  startTime,
+		Duration:   duration,
+		ReservedBy: reservedBy,
+		CreatedAt:  time.Now(),
+	}
+}
+
+func (s ReservationSeries) Validate() error {
+	switch s.Rule.Frequency {
+	case DailyRecurrence, WeeklyRecurrence:
+	default:
+		return fmt.Errorf("%w: unknown frequency %q", ErrInvalidRecurrence, s.Rule.Frequency)
+	}
+
+	if s.Rule.Interval < 1 {
+		return fmt.Errorf("%w: interval must be at least 1", ErrInvalidRecurrence)
+	}
+
+	for _, wd := range s.Rule.Weekdays {
+		if wd < time.Sunday || wd > time.Saturday {
+			return fmt.Errorf("%w: unknown weekday %d", ErrInvalidRecurrence, wd)
+		}
+	}
+
+	if s.EndDate.Before(s.StartDate) {
+		return fmt.Errorf("%w: end date is before start date", ErrInvalidRecurrence)
+	}
+
+	if s.StartTime < 0 || s.StartTime >= minutesInDay {
+		return fmt.Errorf("%w: start time is out of range", ErrInvalidRecurrence)
+	}
+
+	if s.Duration <= 0 {
+		return fmt.Errorf("%w: duration must be positive", ErrInvalidRecurrence)
+	}
+
+	return nil
+}
+
+// Occurrences returns the start of every occurrence of the series in chronological order. The days and the
+// start time are read in the location of StartDate.
+func (s ReservationSeries) Occurrences() ([]time.Time, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	weekdays := s.Rule.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []time.Weekday{s.StartDate.Weekday()}
+	}
+
+	first := truncateToDay(s.StartDate)
+	firstWeek := first.AddDate(0, 0, -int(first.Weekday()))
+	last := truncateToDay(s.EndDate)
+
+	var starts []time.Time
+
+	for day, n := first, 0; !day.After(last); day, n = day.AddDate(0, 0, 1), n+1 {
+		switch s.Rule.Frequency {
+		case DailyRecurrence:
+			if n%s.Rule.Interval != 0 {
+				continue
+			}
+		case WeeklyRecurrence:
+			// days are rounded, a day across a DST change is 23 or 25 hours long
+			week := int(math.Round(day.Sub(firstWeek).Hours()/24)) / 7
+			if week%s.Rule.Interval != 0 || !slices.Contains(weekdays, day.Weekday()) {
+				continue
+			}
+		}
+
+		if len(starts) == MaxSeriesOccurrences {
+			return nil, fmt.Errorf(
+				"%w: series expands to more than %d occurrences",
+				ErrInvalidRecurrence, MaxSeriesOccurrences,
+			)
+		}
+
+		starts = append(starts, s.StartTime.On(day))
+	}
+
+	return starts, nil
+}
+
+// Reservations expands the series into reservations linked to it.
+func (s ReservationSeries) Reservations() ([]*Reservation, error) {
+	starts, err := s.Occurrences()
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := make([]*Reservation, 0, len(starts))
+	for _, start := range starts {
+		rsv := NewReservation(s.CourtID, start.UTC(), start.Add(s.Duration).UTC(), s.ReservedBy)
+		rsv.SeriesID = s.ID
+
+		reservations = append(reservations, rsv)
+	}
+
+	return reservations, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Device describes the client a session was opened from.
+type Device struct {
+	Name      string
+	UserAgent string
+	IPAddress string
+}
+
+// Session is a login of a user on one device. It is kept alive by a refresh token that
+// rotates on every use, only the hash of the current and the previous token is stored.
+type Session struct {
+	ID                       string
+	UserID                   string
+	RefreshTokenHash         string
+	PreviousRefreshTokenHash string
+	Device                   Device
+	CreatedAt                time.Time
+	LastUsedAt               time.Time
+	ExpiresAt                time.Time
+	RevokedAt                *time.Time
+}
+
+func NewSession(userID, refreshTokenHash string, device Device, now time.Time, ttl time.Duration) *Session {
+	return &Session{
+		ID:               uuid.New().String(),
+		UserID:           userID,
+		RefreshTokenHash: refreshTokenHash,
+		Device:           device,
+		CreatedAt:        now,
+		LastUsedAt:       now,
+		ExpiresAt:        now.Add(ttl),
+	}
+}
+
+// IsActiveAt reports whether the session can still be used to authenticate.
+func (s Session) IsActiveAt(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// TokenPair is issued on login and on every refresh.
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	SessionID             string
+}
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShareStatus string
+
+const (
+	UnpaidShareStatus ShareStatus = "unpaid"
+	PaidShareStatus   ShareStatus = "paid"
+	// CoveredShareStatus is a share the booker paid on behalf of the player
+	CoveredShareStatus ShareStatus = "covered"
+)
+
+// PaymentShare is the part of the price of a split reservation one player owes.
+type PaymentShare struct {
+	ID            string
+	ReservationID string
+	UserID        string
+	Amount        int64
+	Currency      string
+	Status        ShareStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IsSettled reports whether nothing is owed on the share anymore.
+func (s PaymentShare) IsSettled() bool {
+	return s.Status == PaidShareStatus || s.Status == CoveredShareStatus
+}
+
+// CoPlayer identifies a player invited to split a reservation, either by nickname or by phone number.
+type CoPlayer struct {
+	Nickname    string
+	PhoneNumber string
+}
+
+// SplitPrice splits the price of the reservation into one share per user. The shares are as equal as
+// the smallest currency unit allows, the first users pay the leftover units.
+func SplitPrice(reservation *Reservation, userIDs []string, now time.Time) []PaymentShare {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	amount := reservation.Price.Amount / int64(len(userIDs))
+	leftover := reservation.Price.Amount % int64(len(userIDs))
+
+	shares := make([]PaymentShare, 0, len(userIDs))
+	for i, userID := range userIDs {
+		share := PaymentShare{
+			ID:            uuid.New().String(),
+			ReservationID: reservation.ID,
+			UserID:        userID,
+			Amount:        amount,
+			Currency:      reservation.Price.Currency,
+			Status:        UnpaidShareStatus,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+
+		if int64(i) < leftover {
+			share.Amount++
+		}
+
+		shares = append(shares, share)
+	}
+
+	return shares
+}
+
+// OutstandingAmount sums what is still owed on the shares.
+func OutstandingAmount(shares []PaymentShare) int64 {
+	var total int64
+	for _, share := range shares {
+		if !share.IsSettled() {
+			total += share.Amount
+		}
+	}
+
+	return total
+}
@@ -11,4 +11,11 @@ type User struct {
 	LastName       string
 	CreatedAt      time.Time
 	LastLoginAt    *time.Time
+	// PhoneVerifiedAt is set once the user proved they own the phone number with a code sent by SMS
+	PhoneVerifiedAt *time.Time
+	// Level is the skill level the player declared, nil until they set it
+	Level *float64
+	// Rating is computed from the confirmed results of the matches the user played, RatedGames counts them
+	Rating     float64
+	RatedGames int
 }
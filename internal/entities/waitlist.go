@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitingWaitlistStatus WaitlistStatus = "waiting"
+	// OfferedWaitlistStatus is an entry that was handed a pending hold on a freed slot
+	OfferedWaitlistStatus WaitlistStatus = "offered"
+	// ExpiredWaitlistStatus is an entry whose window started before it could be offered a slot
+	ExpiredWaitlistStatus WaitlistStatus = "expired"
+)
+
+// WaitlistEntry queues a user for a time window on a court that is fully booked. Entries without a court
+// wait for any court of the organization. Entries are served first come, first served.
+type WaitlistEntry struct {
+	ID             string
+	OrganizationID string
+	CourtID        string
+	UserID         string
+	From           time.Time
+	To             time.Time
+	Status         WaitlistStatus
+	// ReservationID is the pending hold the entry was offered
+	ReservationID string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewWaitlistEntry(organizationID, courtID, userID string, from, to time.Time, now time.Time) *WaitlistEntry {
+	return &WaitlistEntry{
+		ID:             uuid.New().String(),
+		OrganizationID: organizationID,
+		CourtID:        courtID,
+		UserID:         userID,
+		From:           from,
+		To:             to,
+		Status:         WaitingWaitlistStatus,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+func (e WaitlistEntry) Validate(now time.Time) error {
+	if !e.From.Before(e.To) {
+		return fmt.Errorf("%w: the window must end after it starts", ErrInvalidWaitlistEntry)
+	}
+
+	if !e.From.After(now) {
+		return fmt.Errorf("%w: the window must start in the future", ErrInvalidWaitlistEntry)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Package payments holds payment providers. The fake provider settles nothing by itself, payments are
+// completed by posting a signed webhook, which makes it deterministic for tests and local development.
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FakeProviderName = "fake"
+
+	fakeIntentPrefix = "fake_pi_"
+)
+
+// FakeWebhook is the body of a webhook accepted by the fake provider.
+type FakeWebhook struct {
+	IntentID string `json:"intentId"`
+	// Status is succeeded or failed
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// FakeProvider derives intent ids from payment ids and verifies webhooks with an HMAC-SHA256 of the body.
+// Like a real provider, it refunds a request only once per idempotency key.
+type FakeProvider struct {
+	webhookSecret []byte
+
+	mu      sync.Mutex
+	refunds map[string]fakeRefund
+}
+
+type fakeRefund struct {
+	ref    string
+	amount int64
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{
+		webhookSecret: []byte(webhookSecret),
+		refunds:       make(map[string]fakeRefund),
+	}
+}
+
+func (p *FakeProvider) Name() string {
+	return FakeProviderName
+}
+
+func (p *FakeProvider) CreateIntent(_ context.Context, payment *entities.Payment) (*entities.PaymentIntent, error) {
+	ref := fakeIntentPrefix + payment.ID
+
+	return &entities.PaymentIntent{
+		Ref:          ref,
+		ClientSecret: ref + "_secret",
+	}, nil
+}
+
+func (p *FakeProvider) Refund(_ context.Context, ref string, amount int64, idempotencyKey string) error {
+	if !strings.HasPrefix(ref, fakeIntentPrefix) {
+		return fmt.Errorf("unknown payment intent %q", ref)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	refund := fakeRefund{ref: ref, amount: amount}
+
+	if previous, ok := p.refunds[idempotencyKey]; ok {
+		if previous != refund {
+			return fmt.Errorf("idempotency key %q was used for another refund", idempotencyKey)
+		}
+		return nil
+	}
+
+	p.refunds[idempotencyKey] = refund
+
+	log.Info().Str("intent", ref).Int64("amount", amount).Str("key", idempotencyKey).Msg("fake refund")
+
+	return nil
+}
+
+// Sign returns the signature the fake provider expects for the webhook body.
+func (p *FakeProvider) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p *FakeProvider) ParseWebhook(payload []byte, signature string) (*entities.PaymentNotification, error) {
+	if !hmac.Equal([]byte(p.Sign(payload)), []byte(signature)) {
+		return nil, fmt.Errorf("%w: signature mismatch", entities.ErrInvalidWebhook)
+	}
+
+	var webhook FakeWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return nil, fmt.Errorf("%w: %w", entities.ErrInvalidWebhook, err)
+	}
+
+	status := entities.PaymentStatus(webhook.Status)
+	if status != entities.SucceededPaymentStatus && status != entities.FailedPaymentStatus {
+		return nil, fmt.Errorf("%w: unknown status %q", entities.ErrInvalidWebhook, webhook.Status)
+	}
+
+	return &entities.PaymentNotification{
+		Ref:    webhook.IntentID,
+		Status: status,
+		Reason: webhook.Reason,
+	}, nil
+}
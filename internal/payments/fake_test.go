@@ -0,0 +1,43 @@
+package payments_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/payments"
+)
+
+func TestFakeProvider(t *testing.T) {
+	provider := payments.NewFakeProvider("webhook-secret")
+
+	intent, err := provider.CreateIntent(context.Background(), &entities.Payment{ID: "pay-1"})
+	require.NoError(t, err)
+	require.Equal(t, "fake_pi_pay-1", intent.Ref)
+
+	payload := []byte(`{"intentId":"fake_pi_pay-1","status":"failed","reason":"card declined"}`)
+
+	notification, err := provider.ParseWebhook(payload, provider.Sign(payload))
+	require.NoError(t, err)
+	require.Equal(t, &entities.PaymentNotification{
+		Ref:    "fake_pi_pay-1",
+		Status: entities.FailedPaymentStatus,
+		Reason: "card declined",
+	}, notification)
+
+	_, err = provider.ParseWebhook(payload, payments.NewFakeProvider("other-secret").Sign(payload))
+	require.ErrorIs(t, err, entities.ErrInvalidWebhook)
+
+	refunded := []byte(`{"intentId":"fake_pi_pay-1","status":"refunded"}`)
+	_, err = provider.ParseWebhook(refunded, provider.Sign(refunded))
+	require.ErrorIs(t, err, entities.ErrInvalidWebhook)
+
+	require.NoError(t, provider.Refund(context.Background(), intent.Ref, 3000, "refund_pay-1"))
+	require.Error(t, provider.Refund(context.Background(), "pi_unknown", 3000, "refund_pay-2"))
+
+	// a retried refund is accepted once, the key cannot be reused for another amount
+	require.NoError(t, provider.Refund(context.Background(), intent.Ref, 3000, "refund_pay-1"))
+	require.Error(t, provider.Refund(context.Background(), intent.Ref, 1500, "refund_pay-1"))
+}
@@ -48,14 +48,25 @@ func (r *Repository) Create(ctx context.Context, court *entities.Court) error {
 		court.CreatedAt = time.Now().UTC()
 	}
 
-	d := newDTO(court)
+	d, err := newDTO(court)
+	if err != nil {
+		return err
+	}
 
-	_, err := r.pool.Exec(
+	_, err = r.pool.Exec(
 		ctx,
 		createCourtQuery,
 		d.ID,
 		d.OrganizationID,
 		d.Name,
+		d.OpeningHours,
+		d.SlotMinutes,
+		d.MaxPlayers,
+		d.Environment,
+		d.Surface,
+		d.Format,
+		d.Panoramic,
+		d.Lighting,
 		d.CreatedAt,
 		d.UpdatedAt,
 	)
@@ -71,9 +82,17 @@ const createCourtQuery = `
 		id,
 		organization_id,
 		name,
+		opening_hours,
+		slot_minutes,
+		max_players,
+		environment,
+		surface,
+		format,
+		panoramic,
+		lighting,
 		created_at,
 		updated_at
-	) VALUES ($1, $2, $3, $4, $5)
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 `
 
 func (r *Repository) GetByID(ctx context.Context, court_id string) (*entities.Court, error) {
@@ -97,6 +116,14 @@ const getCourtByIDQuery = `
 		id,
 		organization_id,
 		name,
+		opening_hours,
+		slot_minutes,
+		max_players,
+		environment,
+		surface,
+		format,
+		panoramic,
+		lighting,
 		created_at,
 		updated_at
 	FROM courts
@@ -135,6 +162,14 @@ const listCourtsByOrganizationIDQuery = `
 		id,
 		organization_id,
 		name,
+		opening_hours,
+		slot_minutes,
+		max_players,
+		environment,
+		surface,
+		format,
+		panoramic,
+		lighting,
 		created_at,
 		updated_at
 	FROM courts
@@ -142,6 +177,282 @@ const listCourtsByOrganizationIDQuery = `
 	ORDER BY name ASC
 `
 
+// Search lists the courts of the organizations that match the filter, ordered by organization and name.
+func (r *Repository) Search(
+	ctx context.Context,
+	organizationIDs []string,
+	filter entities.CourtFilter,
+) ([]entities.Court, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		searchCourtsQuery,
+		organizationIDs,
+		string(filter.Environment),
+		string(filter.Surface),
+		string(filter.Format),
+		filter.Panoramic,
+		filter.Lighting,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query courts: %w", err)
+	}
+	defer rows.Close()
+
+	var courts []entities.Court
+	for rows.Next() {
+		crt, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan court: %w", err)
+		}
+		courts = append(courts, crt)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows err: %w", rows.Err())
+	}
+
+	return courts, nil
+}
+
+const searchCourtsQuery = `
+	SELECT
+		id,
+		organization_id,
+		name,
+		opening_hours,
+		slot_minutes,
+		max_players,
+		environment,
+		surface,
+		format,
+		panoramic,
+		lighting,
+		created_at,
+		updated_at
+	FROM courts
+	WHERE organization_id = ANY($1)
+		AND ($2 = '' OR environment = $2)
+		AND ($3 = '' OR surface = $3)
+		AND ($4 = '' OR format = $4)
+		AND ($5::boolean IS NULL OR panoramic = $5)
+		AND ($6::boolean IS NULL OR lighting = $6)
+	ORDER BY organization_id, name ASC
+`
+
+// SearchFree returns the courts of the organizations matching the filter of the search that are free for its
+// duration, once for every start within its window that is not before now. The starts lie on the slot grid of
+// each court, counted from midnight in the time zone of its organization. A court is free when it is open for
+// the whole time range, its booking rules let players book the range at now, and no reservation active at now
+// and no blackout overlaps the range. The cap on the upcoming reservations of a player is left to the booking,
+// as it depends on the player.
+//
+// The earliest starts come first, and when the search is made near a point, courts with the same start are
+// ranked by the distance of their organization from it, the organizations without coordinates last. It returns
+// at most search.Limit courts, those ranked after search.After when it is set. The organization of the results
+// is left empty.
+func (r *Repository) SearchFree(
+	ctx context.Context,
+	organizationIDs []string,
+	search entities.AvailabilitySearch,
+	now time.Time,
+) ([]entities.FreeCourt, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var latitude, longitude *float64
+	if search.Near != nil {
+		latitude = &search.Near.Latitude
+		longitude = &search.Near.Longitude
+	}
+
+	var (
+		afterFrom *time.Time
+		after     entities.SearchCursor
+	)
+	if search.After != nil {
+		after = *search.After
+		afterFrom = &after.From
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		searchFreeCourtsQuery,
+		organizationIDs,
+		string(search.Filter.Environment),
+		string(search.Filter.Surface),
+		string(search.Filter.Format),
+		search.Filter.Panoramic,
+		search.Filter.Lighting,
+		search.From.UTC(),
+		search.From.Add(search.Window).UTC(),
+		int(search.Duration/time.Minute),
+		now.UTC(),
+		latitude,
+		longitude,
+		entities.EarthRadius,
+		afterFrom,
+		after.Distance,
+		after.OrganizationID,
+		after.CourtName,
+		after.CourtID,
+		search.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query free courts: %w", err)
+	}
+	defer rows.Close()
+
+	var free []entities.FreeCourt
+	for rows.Next() {
+		var (
+			startsAt time.Time
+			distance *float64
+		)
+
+		crt, err := scan(rows, &startsAt, &distance)
+		if err != nil {
+			return nil, fmt.Errorf("scan free court: %w", err)
+		}
+
+		free = append(free, entities.FreeCourt{
+			Court:    crt,
+			From:     startsAt.UTC(),
+			To:       startsAt.UTC().Add(search.Duration),
+			Distance: distance,
+		})
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows err: %w", rows.Err())
+	}
+
+	return free, nil
+}
+
+// The starts are generated on the wall clock of the organization, so the grid survives a DST change as the
+// booking rules expect. The weekly hours of the court apply, or those of the organization when the court has
+// none, and the special hours of the organization replace both on their date, as in entities.Schedule. The
+// booking rules of the court apply, or the organization default when the court has none, as in the policy
+// service. The reservation lookup repeats the predicate of the reservations_no_overlap constraint, so that the
+// GiST index behind it serves the lookup. The distance is NULL when either point is missing and ranks as
+// infinite, so that the cursor compares it.
+const searchFreeCourtsQuery = `
+	SELECT
+		c.id,
+		c.organization_id,
+		c.name,
+		c.opening_hours,
+		c.slot_minutes,
+		c.max_players,
+		c.environment,
+		c.surface,
+		c.format,
+		c.panoramic,
+		c.lighting,
+		c.created_at,
+		c.updated_at,
+		s.starts_at,
+		w.distance
+	FROM courts c
+	LEFT JOIN organizations o ON o.id = c.organization_id
+	LEFT JOIN LATERAL (
+		SELECT *
+		FROM booking_rules br
+		WHERE br.organization_id = c.organization_id
+			AND br.court_id IN (c.id, '')
+		ORDER BY br.court_id DESC
+		LIMIT 1
+	) br ON true
+	CROSS JOIN LATERAL (
+		SELECT
+			COALESCE(NULLIF(o.time_zone, ''), 'UTC') AS time_zone,
+			$7::timestamptz AT TIME ZONE COALESCE(NULLIF(o.time_zone, ''), 'UTC') AS local_from,
+			$8::timestamptz AT TIME ZONE COALESCE(NULLIF(o.time_zone, ''), 'UTC') AS local_to,
+			CASE
+				WHEN jsonb_array_length(c.opening_hours) > 0 THEN c.opening_hours
+				ELSE COALESCE(o.opening_hours, '[]'::jsonb)
+			END AS opening_hours,
+			geo_distance($11, $12, o.latitude, o.longitude, $13) AS distance
+	) w
+	CROSS JOIN LATERAL generate_series(
+		date_trunc('day', w.local_from) + make_interval(mins => c.slot_minutes * ceil(
+			extract(epoch FROM w.local_from - date_trunc('day', w.local_from)) / 60 / c.slot_minutes
+		)::integer),
+		w.local_to,
+		make_interval(mins => c.slot_minutes)
+	) AS l(local_start)
+	CROSS JOIN LATERAL (
+		SELECT
+			l.local_start AT TIME ZONE w.time_zone AS starts_at,
+			l.local_start AT TIME ZONE w.time_zone + make_interval(mins => $9) AS ends_at,
+			(l.local_start AT TIME ZONE w.time_zone + make_interval(mins => $9)) AT TIME ZONE w.time_zone AS local_end,
+			l.local_start::date AS day
+	) s
+	CROSS JOIN LATERAL (
+		SELECT (
+			SELECT sh->'windows'
+			FROM jsonb_array_elements(o.special_hours) sh
+			WHERE sh->>'date' = to_char(s.day, 'YYYY-MM-DD')
+		) AS windows
+	) sp
+	WHERE c.organization_id = ANY($1)
+		AND ($2 = '' OR c.environment = $2)
+		AND ($3 = '' OR c.surface = $3)
+		AND ($4 = '' OR c.format = $4)
+		AND ($5::boolean IS NULL OR c.panoramic = $5)
+		AND ($6::boolean IS NULL OR c.lighting = $6)
+		AND s.starts_at >= $10
+		AND (
+			(sp.windows IS NULL AND jsonb_array_length(w.opening_hours) = 0)
+			OR EXISTS (
+				SELECT 1
+				FROM jsonb_array_elements(COALESCE(sp.windows, w.opening_hours)) h
+				WHERE (sp.windows IS NOT NULL OR (h->>'weekday')::integer = extract(dow FROM s.day))
+					AND s.starts_at >= (s.day + (h->>'opensAt')::interval) AT TIME ZONE w.time_zone
+					AND s.ends_at <= (s.day + (h->>'closesAt')::interval) AT TIME ZONE w.time_zone
+			)
+		)
+		AND (br.organization_id IS NULL OR (
+			(cardinality(br.durations_minutes) = 0 OR $9 = ANY(br.durations_minutes))
+			AND (br.min_duration_minutes = 0 OR $9 >= br.min_duration_minutes)
+			AND (br.max_duration_minutes = 0 OR $9 <= br.max_duration_minutes)
+			AND (br.slot_interval_minutes = 0 OR (
+				(extract(hour FROM l.local_start) * 60 + extract(minute FROM l.local_start))::integer
+					% br.slot_interval_minutes = 0
+				AND (extract(hour FROM s.local_end) * 60 + extract(minute FROM s.local_end))::integer
+					% br.slot_interval_minutes = 0
+			))
+			AND (br.min_notice_minutes = 0 OR s.starts_at >= $10 + make_interval(mins => br.min_notice_minutes))
+			AND (br.max_advance_minutes = 0 OR s.starts_at <= $10 + make_interval(mins => br.max_advance_minutes))
+		))
+		AND NOT EXISTS (
+			SELECT 1
+			FROM reservations r
+			WHERE r.court_id = c.id
+				AND r.status IN ('pending', 'reserved')
+				AND tstzrange(r.reserved_from, r.reserved_to) && tstzrange(s.starts_at, s.ends_at)
+				AND (r.status = 'reserved' OR r.expires_at IS NULL OR r.expires_at > $10)
+		)
+		AND NOT EXISTS (
+			SELECT 1
+			FROM court_blackouts b
+			WHERE b.organization_id = c.organization_id
+				AND (b.court_id = c.id OR b.court_id IS NULL)
+				AND b.blackout_from < s.ends_at
+				AND b.blackout_to > s.starts_at
+		)
+		AND ($14::timestamptz IS NULL OR
+			(s.starts_at, COALESCE(w.distance, 'Infinity'), c.organization_id, c.name, c.id)
+				> ($14, COALESCE($15::double precision, 'Infinity'), $16::text, $17::text, $18::text))
+	ORDER BY s.starts_at ASC, COALESCE(w.distance, 'Infinity') ASC, c.organization_id, c.name, c.id
+	LIMIT $19
+`
+
 func (r *Repository) Update(ctx context.Context, crt *entities.Court) error {
 	if r.pool == nil {
 		return fmt.Errorf("not connected to pool")
@@ -149,13 +460,26 @@ func (r *Repository) Update(ctx context.Context, crt *entities.Court) error {
 
 	crt.UpdatedAt = time.Now().UTC()
 
+	d, err := newDTO(crt)
+	if err != nil {
+		return err
+	}
+
 	tag, err := r.pool.Exec(
 		ctx,
 		updateCourtQuery,
-		crt.Name,
-		crt.OrganizationID,
-		crt.UpdatedAt,
-		crt.ID,
+		d.Name,
+		d.OrganizationID,
+		d.OpeningHours,
+		d.SlotMinutes,
+		d.MaxPlayers,
+		d.Environment,
+		d.Surface,
+		d.Format,
+		d.Panoramic,
+		d.Lighting,
+		d.UpdatedAt,
+		d.ID,
 	)
 	if err != nil {
 		return fmt.Errorf("exec: %w", err)
@@ -173,21 +497,41 @@ const updateCourtQuery = `
 	SET 
     	name = $1,
     	organization_id = $2,
-		updated_at = $3
-	WHERE id = $4
+		opening_hours = $3,
+		slot_minutes = $4,
+		max_players = $5,
+		environment = $6,
+		surface = $7,
+		format = $8,
+		panoramic = $9,
+		lighting = $10,
+		updated_at = $11
+	WHERE id = $12
 `
 
-func (r *Repository) UpdateName(ctx context.Context, court *entities.Court) error {
+// UpdateDetails updates the name, the roster size and the attributes of the court.
+func (r *Repository) UpdateDetails(ctx context.Context, court *entities.Court) error {
 	if r.pool == nil {
 		return fmt.Errorf("not connected to pool")
 	}
 
+	d, err := newDTO(court)
+	if err != nil {
+		return err
+	}
+
 	row := r.pool.QueryRow(
 		ctx,
-		updateCourtNameQuery,
-		court.Name,
-		court.OrganizationID,
-		court.ID,
+		updateCourtDetailsQuery,
+		d.Name,
+		d.OrganizationID,
+		d.ID,
+		d.MaxPlayers,
+		d.Environment,
+		d.Surface,
+		d.Format,
+		d.Panoramic,
+		d.Lighting,
 	)
 
 	if err := row.Scan(&court.UpdatedAt); err != nil {
@@ -202,36 +546,94 @@ func (r *Repository) UpdateName(ctx context.Context, court *entities.Court) erro
 	return nil
 }
 
-const updateCourtNameQuery = `
+const updateCourtDetailsQuery = `
     UPDATE courts
     SET
         name = $1,
+        max_players = $4,
+        environment = $5,
+        surface = $6,
+        format = $7,
+        panoramic = $8,
+        lighting = $9,
         updated_at = NOW()
     WHERE id = $3
       AND organization_id = $2
     RETURNING updated_at
 `
 
+func (r *Repository) UpdateOpeningHours(ctx context.Context, court *entities.Court) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	d, err := newDTO(court)
+	if err != nil {
+		return err
+	}
+
+	row := r.pool.QueryRow(
+		ctx,
+		updateCourtOpeningHoursQuery,
+		d.OpeningHours,
+		d.SlotMinutes,
+		d.ID,
+		d.OrganizationID,
+	)
+
+	if err := row.Scan(&court.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("scan updated_at: %w", err)
+	}
+
+	court.UpdatedAt = court.UpdatedAt.UTC()
+
+	return nil
+}
+
+const updateCourtOpeningHoursQuery = `
+    UPDATE courts
+    SET
+        opening_hours = $1,
+        slot_minutes = $2,
+        updated_at = NOW()
+    WHERE id = $3
+      AND organization_id = $4
+    RETURNING updated_at
+`
+
 type rowScanner interface {
 	Scan(dest ...any) error
 }
 
-func scan(scanner rowScanner) (entities.Court, error) {
+// scan reads a court row, the columns after the ones of the court are read into extra.
+func scan(scanner rowScanner, extra ...any) (entities.Court, error) {
 	var d dto
 
-	err := scanner.Scan(
+	dest := []any{
 		&d.ID,
 		&d.OrganizationID,
 		&d.Name,
+		&d.OpeningHours,
+		&d.SlotMinutes,
+		&d.MaxPlayers,
+		&d.Environment,
+		&d.Surface,
+		&d.Format,
+		&d.Panoramic,
+		&d.Lighting,
 		&d.CreatedAt,
 		&d.UpdatedAt,
-	)
-	if err != nil {
+	}
+
+	if err := scanner.Scan(append(dest, extra...)...); err != nil {
 		return entities.Court{}, err
 	}
 
 	d.CreatedAt = d.CreatedAt.UTC()
 	d.UpdatedAt = d.UpdatedAt.UTC()
 
-	return d.toEntity(), nil
+	return d.toEntity()
 }
@@ -11,6 +11,9 @@ import (
 
 	"github.com/lever-dev/padel-backend/internal/entities"
 	court "github.com/lever-dev/padel-backend/internal/repositories/courts"
+	"github.com/lever-dev/padel-backend/internal/repositories/organization"
+	"github.com/lever-dev/padel-backend/internal/repositories/policies"
+	"github.com/lever-dev/padel-backend/internal/repositories/reservation"
 )
 
 type repositorySuite struct {
@@ -69,6 +72,7 @@ func (s *repositorySuite) TestCreateAndGetCourt() {
 	s.Equal(c.ID, cDb.ID)
 	s.Equal(c.OrganizationID, cDb.OrganizationID)
 	s.Equal(c.Name, cDb.Name)
+	s.Equal(entities.DefaultMaxPlayers, cDb.MaxPlayers)
 }
 
 func (s *repositorySuite) TestListByOrganizationID() {
@@ -101,6 +105,317 @@ func (s *repositorySuite) TestListByOrganizationID() {
 	s.Equal("Court B", list[1].Name)
 }
 
+func (s *repositorySuite) TestSearch() {
+	ctx := context.Background()
+
+	lit := true
+	indoor := &entities.Court{
+		ID:             "court-search-1",
+		OrganizationID: "org-search-1",
+		Name:           "Indoor",
+		CourtAttributes: entities.CourtAttributes{
+			Environment: entities.IndoorCourtEnvironment,
+			Surface:     entities.ArtificialGrassCourtSurface,
+			Format:      entities.DoublesCourtFormat,
+			Panoramic:   true,
+			Lighting:    true,
+		},
+	}
+	outdoor := &entities.Court{
+		ID:             "court-search-2",
+		OrganizationID: "org-search-2",
+		Name:           "Outdoor",
+		CourtAttributes: entities.CourtAttributes{
+			Environment: entities.OutdoorCourtEnvironment,
+			Format:      entities.SinglesCourtFormat,
+			Lighting:    true,
+		},
+	}
+	otherOrg := &entities.Court{
+		ID:              "court-search-3",
+		OrganizationID:  "org-search-3",
+		Name:            "Elsewhere",
+		CourtAttributes: entities.CourtAttributes{Environment: entities.IndoorCourtEnvironment},
+	}
+
+	s.seedCourts(ctx, []*entities.Court{indoor, outdoor, otherOrg})
+
+	orgs := []string{"org-search-1", "org-search-2"}
+
+	courts, err := s.repo.Search(ctx, orgs, entities.CourtFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(courts, 2)
+	s.Equal(indoor.CourtAttributes, courts[0].CourtAttributes)
+
+	courts, err = s.repo.Search(ctx, orgs, entities.CourtFilter{Environment: entities.IndoorCourtEnvironment})
+	s.Require().NoError(err)
+	s.Require().Len(courts, 1)
+	s.Equal("court-search-1", courts[0].ID)
+
+	courts, err = s.repo.Search(ctx, orgs, entities.CourtFilter{Format: entities.SinglesCourtFormat, Lighting: &lit})
+	s.Require().NoError(err)
+	s.Require().Len(courts, 1)
+	s.Equal("court-search-2", courts[0].ID)
+}
+
+func (s *repositorySuite) TestSearchFree() {
+	ctx := context.Background()
+
+	busy := &entities.Court{ID: "court-free-1", OrganizationID: "org-free-1", Name: "Busy", SlotDuration: time.Hour}
+	idle := &entities.Court{ID: "court-free-2", OrganizationID: "org-free-1", Name: "Idle", SlotDuration: time.Hour}
+	dark := &entities.Court{ID: "court-free-3", OrganizationID: "org-free-2", Name: "Dark", SlotDuration: time.Hour}
+
+	s.seedCourts(ctx, []*entities.Court{busy, idle, dark})
+
+	reservations := reservation.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(reservations.Connect(ctx))
+	defer reservations.Close()
+
+	from := time.Date(2031, 3, 4, 18, 0, 0, 0, time.UTC)
+	now := from.Add(-24 * time.Hour)
+
+	s.Require().NoError(reservations.Create(ctx, &entities.Reservation{
+		ID:           "rsv-free-1",
+		CourtID:      busy.ID,
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: from,
+		ReservedTo:   from.Add(time.Hour),
+		ReservedBy:   "user-1",
+	}))
+	s.Require().NoError(reservations.Create(ctx, &entities.Reservation{
+		ID:           "rsv-free-2",
+		CourtID:      idle.ID,
+		Status:       entities.PendingReservationStatus,
+		ReservedFrom: from,
+		ReservedTo:   from.Add(time.Hour),
+		ReservedBy:   "user-2",
+		ExpiresAt:    now.Add(-time.Minute),
+	}))
+	s.Require().NoError(reservations.CreateBlackout(ctx, &entities.Blackout{
+		ID:             "blackout-free-1",
+		OrganizationID: "org-free-2",
+		From:           from,
+		To:             from.Add(4 * time.Hour),
+		CreatedBy:      "manager-1",
+	}))
+
+	// starts from 17:07 to 19:07 lie on the hourly grid of the courts
+	free, err := s.repo.SearchFree(ctx, []string{"org-free-1", "org-free-2"}, entities.AvailabilitySearch{
+		From:     from.Add(-53 * time.Minute),
+		Duration: 90 * time.Minute,
+		Window:   2 * time.Hour,
+		Limit:    10,
+	}, now)
+	s.Require().NoError(err)
+
+	type start struct {
+		courtID string
+		from    time.Time
+	}
+
+	var got []start
+	for _, f := range free {
+		s.Equal(f.From.Add(90*time.Minute), f.To)
+		got = append(got, start{courtID: f.Court.ID, from: f.From})
+	}
+
+	s.Equal([]start{
+		{courtID: idle.ID, from: from},
+		{courtID: busy.ID, from: from.Add(time.Hour)},
+		{courtID: idle.ID, from: from.Add(time.Hour)},
+	}, got)
+}
+
+func (s *repositorySuite) TestSearchFree_Near() {
+	ctx := context.Background()
+
+	organizations := organization.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(organizations.Connect(ctx))
+	defer organizations.Close()
+
+	// the clubs are half an hour off UTC, so their slots start at half past the UTC hour
+	closeBy := entities.GeoPoint{Latitude: 43.2400, Longitude: 76.8897}
+	farAway := entities.GeoPoint{Latitude: 43.3000, Longitude: 76.8897}
+	for _, org := range []*entities.Organization{
+		{ID: "org-near-1", Name: "Close", City: "Kolkata", TimeZone: "Asia/Kolkata", Coordinates: &closeBy},
+		{ID: "org-near-2", Name: "Far", City: "Kolkata", TimeZone: "Asia/Kolkata", Coordinates: &farAway},
+		{ID: "org-near-3", Name: "Unplaced", City: "Kolkata", TimeZone: "Asia/Kolkata"},
+	} {
+		s.Require().NoError(organizations.Create(ctx, org))
+	}
+
+	s.seedCourts(ctx, []*entities.Court{
+		{ID: "court-near-1", OrganizationID: "org-near-3", Name: "A", SlotDuration: time.Hour},
+		{ID: "court-near-2", OrganizationID: "org-near-2", Name: "B", SlotDuration: time.Hour},
+		{ID: "court-near-3", OrganizationID: "org-near-1", Name: "C", SlotDuration: time.Hour},
+	})
+
+	me := entities.GeoPoint{Latitude: 43.2389, Longitude: 76.8897}
+
+	// 18:37 to 19:37 in Kolkata
+	from := time.Date(2031, 3, 4, 13, 7, 0, 0, time.UTC)
+
+	free, err := s.repo.SearchFree(ctx, []string{"org-near-1", "org-near-2", "org-near-3"}, entities.AvailabilitySearch{
+		From:     from,
+		Duration: time.Hour,
+		Window:   time.Hour,
+		Near:     &me,
+		Limit:    10,
+	}, from.Add(-24*time.Hour))
+	s.Require().NoError(err)
+
+	var ids []string
+	for _, f := range free {
+		s.Equal(time.Date(2031, 3, 4, 13, 30, 0, 0, time.UTC), f.From)
+		ids = append(ids, f.Court.ID)
+	}
+	s.Equal([]string{"court-near-3", "court-near-2", "court-near-1"}, ids)
+
+	s.Require().NotNil(free[0].Distance)
+	// along a meridian the distance is the arc of the difference in latitude, 0.0011 degrees
+	s.InDelta(122.31, *free[0].Distance, 0.01)
+	s.Nil(free[2].Distance)
+}
+
+func (s *repositorySuite) TestSearchFree_OpeningHours() {
+	ctx := context.Background()
+
+	organizations := organization.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(organizations.Connect(ctx))
+	defer organizations.Close()
+
+	// a Wednesday, the second club is closed for the day
+	from := time.Date(2031, 3, 5, 18, 0, 0, 0, time.UTC)
+
+	for _, org := range []*entities.Organization{
+		{
+			ID:           "org-open-1",
+			Name:         "Open",
+			City:         "Almaty",
+			OpeningHours: []entities.OpeningHours{{Weekday: time.Wednesday, OpensAt: 8 * 60, ClosesAt: 20 * 60}},
+		},
+		{
+			ID:           "org-open-2",
+			Name:         "Holiday",
+			City:         "Almaty",
+			SpecialHours: []entities.SpecialHours{{Date: time.Date(2031, 3, 5, 0, 0, 0, 0, time.UTC), Reason: "holiday"}},
+		},
+	} {
+		s.Require().NoError(organizations.Create(ctx, org))
+	}
+
+	s.seedCourts(ctx, []*entities.Court{
+		{ID: "court-open-1", OrganizationID: "org-open-1", Name: "A", SlotDuration: time.Hour},
+		{
+			ID:             "court-open-2",
+			OrganizationID: "org-open-1",
+			Name:           "B",
+			SlotDuration:   time.Hour,
+			OpeningHours:   []entities.OpeningHours{{Weekday: time.Wednesday, OpensAt: 18 * 60, ClosesAt: 24 * 60}},
+		},
+		{ID: "court-open-3", OrganizationID: "org-open-2", Name: "C", SlotDuration: time.Hour},
+	})
+
+	free, err := s.repo.SearchFree(ctx, []string{"org-open-1", "org-open-2"}, entities.AvailabilitySearch{
+		From:     from,
+		Duration: time.Hour,
+		Window:   2 * time.Hour,
+		Limit:    10,
+	}, from.Add(-24*time.Hour))
+	s.Require().NoError(err)
+
+	type start struct {
+		courtID string
+		from    time.Time
+	}
+
+	var got []start
+	for _, f := range free {
+		got = append(got, start{courtID: f.Court.ID, from: f.From})
+	}
+
+	// the first court takes the hours of its club, which closes at 20:00, the second has hours of its own
+	s.Equal([]start{
+		{courtID: "court-open-1", from: from},
+		{courtID: "court-open-2", from: from},
+		{courtID: "court-open-1", from: from.Add(time.Hour)},
+		{courtID: "court-open-2", from: from.Add(time.Hour)},
+		{courtID: "court-open-2", from: from.Add(2 * time.Hour)},
+	}, got)
+}
+
+func (s *repositorySuite) TestSearchFree_BookingRules() {
+	ctx := context.Background()
+
+	rules := policies.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(rules.Connect(ctx))
+	defer rules.Close()
+
+	s.seedCourts(ctx, []*entities.Court{
+		{ID: "court-rules-1", OrganizationID: "org-rules-1", Name: "A", SlotDuration: time.Hour},
+		{ID: "court-rules-2", OrganizationID: "org-rules-1", Name: "B", SlotDuration: time.Hour},
+	})
+
+	s.Require().NoError(rules.UpsertBookingRules(ctx, &entities.BookingRules{
+		OrganizationID: "org-rules-1",
+		SlotInterval:   2 * time.Hour,
+		MinNotice:      2 * time.Hour,
+		MaxAdvance:     5 * time.Hour,
+	}))
+	s.Require().NoError(rules.UpsertBookingRules(ctx, &entities.BookingRules{
+		OrganizationID: "org-rules-1",
+		CourtID:        "court-rules-2",
+		Durations:      []time.Duration{90 * time.Minute},
+	}))
+
+	now := time.Date(2031, 3, 4, 14, 0, 0, 0, time.UTC)
+
+	free, err := s.repo.SearchFree(ctx, []string{"org-rules-1"}, entities.AvailabilitySearch{
+		From:     now,
+		Duration: 2 * time.Hour,
+		Window:   6 * time.Hour,
+		Limit:    10,
+	}, now)
+	s.Require().NoError(err)
+
+	// the club takes bookings on even hours from two to five hours ahead, the second court only for 90 minutes
+	var starts []time.Time
+	for _, f := range free {
+		s.Equal("court-rules-1", f.Court.ID)
+		starts = append(starts, f.From)
+	}
+	s.Equal([]time.Time{now.Add(2 * time.Hour), now.Add(4 * time.Hour)}, starts)
+}
+
+func (s *repositorySuite) TestSearchFree_After() {
+	ctx := context.Background()
+
+	s.seedCourts(ctx, []*entities.Court{
+		{ID: "court-page-1", OrganizationID: "org-page-1", Name: "A", SlotDuration: time.Hour},
+		{ID: "court-page-2", OrganizationID: "org-page-1", Name: "B", SlotDuration: time.Hour},
+		{ID: "court-page-3", OrganizationID: "org-page-1", Name: "C", SlotDuration: time.Hour},
+	})
+
+	from := time.Date(2031, 3, 4, 18, 0, 0, 0, time.UTC)
+	search := entities.AvailabilitySearch{From: from, Duration: time.Hour, Window: time.Hour, Limit: 10}
+
+	all, err := s.repo.SearchFree(ctx, []string{"org-page-1"}, search, from)
+	s.Require().NoError(err)
+	s.Require().Len(all, 6)
+
+	search.Limit = 4
+	first, err := s.repo.SearchFree(ctx, []string{"org-page-1"}, search, from)
+	s.Require().NoError(err)
+	s.Require().Len(first, 4)
+
+	cursor := first[3].Cursor()
+	search.After = &cursor
+	second, err := s.repo.SearchFree(ctx, []string{"org-page-1"}, search, from)
+	s.Require().NoError(err)
+
+	s.Equal(all, append(first, second...))
+}
+
 func (s *repositorySuite) TestUpdateCourt() {
 	ctx := context.Background()
 
@@ -121,7 +436,7 @@ func (s *repositorySuite) TestUpdateCourt() {
 	s.Equal("Updated Name", cDb.Name)
 	s.False(cDb.UpdatedAt.IsZero(), "UpdatedAt must be set")
 }
-func (s *repositorySuite) TestUpdateName() {
+func (s *repositorySuite) TestUpdateDetails() {
 	ctx := context.Background()
 
 	c := &entities.Court{
@@ -133,8 +448,9 @@ func (s *repositorySuite) TestUpdateName() {
 	s.seedCourts(ctx, []*entities.Court{c})
 
 	c.Name = "After"
+	c.MaxPlayers = 2
 
-	err := s.repo.UpdateName(ctx, c)
+	err := s.repo.UpdateDetails(ctx, c)
 	s.Require().NoError(err)
 
 	s.Equal("court-updatename-1", c.ID)
@@ -145,6 +461,50 @@ func (s *repositorySuite) TestUpdateName() {
 	db, err := s.repo.GetByID(ctx, c.ID)
 	s.Require().NoError(err)
 	s.Equal("After", db.Name)
+	s.Equal(2, db.MaxPlayers)
 	s.Equal("org-7", db.OrganizationID)
 	s.False(db.UpdatedAt.IsZero(), "UpdatedAt in DB must be set")
 }
+
+func (s *repositorySuite) TestUpdateOpeningHours() {
+	ctx := context.Background()
+
+	c := &entities.Court{
+		ID:             "court-hours-1",
+		OrganizationID: "org-8",
+		Name:           "Hours Court",
+	}
+
+	s.seedCourts(ctx, []*entities.Court{c})
+
+	cDb, err := s.repo.GetByID(ctx, c.ID)
+	s.Require().NoError(err)
+	s.Empty(cDb.OpeningHours)
+	s.Equal(entities.DefaultSlotDuration, cDb.SlotDuration)
+
+	c.OpeningHours = []entities.OpeningHours{
+		{Weekday: time.Monday, OpensAt: 8 * 60, ClosesAt: 12 * 60},
+		{Weekday: time.Monday, OpensAt: 14 * 60, ClosesAt: 24 * 60},
+	}
+	c.SlotDuration = time.Hour
+
+	err = s.repo.UpdateOpeningHours(ctx, c)
+	s.Require().NoError(err)
+	s.False(c.UpdatedAt.IsZero(), "UpdatedAt must be set on update")
+
+	cDb, err = s.repo.GetByID(ctx, c.ID)
+	s.Require().NoError(err)
+	s.Equal(c.OpeningHours, cDb.OpeningHours)
+	s.Equal(time.Hour, cDb.SlotDuration)
+}
+
+func (s *repositorySuite) TestUpdateOpeningHours_NotFound() {
+	ctx := context.Background()
+
+	err := s.repo.UpdateOpeningHours(ctx, &entities.Court{
+		ID:             "court-hours-missing",
+		OrganizationID: "org-8",
+	})
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
@@ -1,6 +1,8 @@
 package court
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
@@ -10,26 +12,108 @@ type dto struct {
 	ID             string
 	OrganizationID string
 	Name           string
+	OpeningHours   []byte
+	SlotMinutes    int
+	MaxPlayers     int
+	Environment    string
+	Surface        string
+	Format         string
+	Panoramic      bool
+	Lighting       bool
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
 
-func newDTO(c *entities.Court) dto {
+type openingHoursDTO struct {
+	Weekday  int    `json:"weekday"`
+	OpensAt  string `json:"opensAt"`
+	ClosesAt string `json:"closesAt"`
+}
+
+func newDTO(c *entities.Court) (dto, error) {
+	hours := make([]openingHoursDTO, 0, len(c.OpeningHours))
+	for _, h := range c.OpeningHours {
+		hours = append(hours, openingHoursDTO{
+			Weekday:  int(h.Weekday),
+			OpensAt:  h.OpensAt.String(),
+			ClosesAt: h.ClosesAt.String(),
+		})
+	}
+
+	rawHours, err := json.Marshal(hours)
+	if err != nil {
+		return dto{}, fmt.Errorf("marshal opening hours: %w", err)
+	}
+
+	slotDuration := c.SlotDuration
+	if slotDuration <= 0 {
+		slotDuration = entities.DefaultSlotDuration
+	}
+
+	maxPlayers := c.MaxPlayers
+	if maxPlayers <= 0 {
+		maxPlayers = entities.DefaultMaxPlayers
+	}
+
 	return dto{
 		ID:             c.ID,
 		OrganizationID: c.OrganizationID,
 		Name:           c.Name,
+		OpeningHours:   rawHours,
+		SlotMinutes:    int(slotDuration / time.Minute),
+		MaxPlayers:     maxPlayers,
+		Environment:    string(c.Environment),
+		Surface:        string(c.Surface),
+		Format:         string(c.Format),
+		Panoramic:      c.Panoramic,
+		Lighting:       c.Lighting,
 		CreatedAt:      c.CreatedAt,
 		UpdatedAt:      c.UpdatedAt,
-	}
+	}, nil
 }
 
-func (d dto) toEntity() entities.Court {
+func (d dto) toEntity() (entities.Court, error) {
+	var hours []openingHoursDTO
+	if len(d.OpeningHours) > 0 {
+		if err := json.Unmarshal(d.OpeningHours, &hours); err != nil {
+			return entities.Court{}, fmt.Errorf("unmarshal opening hours: %w", err)
+		}
+	}
+
+	var openingHours []entities.OpeningHours
+	for _, h := range hours {
+		opensAt, err := entities.ParseTimeOfDay(h.OpensAt)
+		if err != nil {
+			return entities.Court{}, err
+		}
+
+		closesAt, err := entities.ParseTimeOfDay(h.ClosesAt)
+		if err != nil {
+			return entities.Court{}, err
+		}
+
+		openingHours = append(openingHours, entities.OpeningHours{
+			Weekday:  time.Weekday(h.Weekday),
+			OpensAt:  opensAt,
+			ClosesAt: closesAt,
+		})
+	}
+
 	return entities.Court{
 		ID:             d.ID,
 		OrganizationID: d.OrganizationID,
 		Name:           d.Name,
-		CreatedAt:      d.CreatedAt,
-		UpdatedAt:      d.UpdatedAt,
-	}
+		CourtAttributes: entities.CourtAttributes{
+			Environment: entities.CourtEnvironment(d.Environment),
+			Surface:     entities.CourtSurface(d.Surface),
+			Format:      entities.CourtFormat(d.Format),
+			Panoramic:   d.Panoramic,
+			Lighting:    d.Lighting,
+		},
+		OpeningHours: openingHours,
+		SlotDuration: time.Duration(d.SlotMinutes) * time.Minute,
+		MaxPlayers:   d.MaxPlayers,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}, nil
 }
@@ -0,0 +1,255 @@
+package locker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultTimeout bounds how long Lock waits for a key when no timeout is configured.
+	DefaultTimeout = 5 * time.Second
+
+	pollInterval = 50 * time.Millisecond
+)
+
+// Locker is a reservation locker backed by Postgres session advisory locks, so it serialises
+// callers across every replica sharing the database. Keys stay held on a pooled connection until
+// they are unlocked because advisory locks belong to the session that took them. Lock pins one
+// connection per key, LockAll holds all of its keys on a single connection.
+type Locker struct {
+	connectionURL string
+	pool          *pgxpool.Pool
+	timeout       time.Duration
+
+	mu    sync.Mutex
+	conns map[string]*session
+}
+
+// session is a pooled connection holding advisory locks. It goes back to the pool once the last
+// of its keys is unlocked.
+type session struct {
+	mu     sync.Mutex
+	conn   *pgxpool.Conn
+	keys   int
+	closed bool
+}
+
+func NewLocker(connectionURL string, timeout time.Duration) *Locker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Locker{
+		connectionURL: connectionURL,
+		timeout:       timeout,
+		conns:         make(map[string]*session),
+	}
+}
+
+func (l *Locker) Connect(ctx context.Context) error {
+	p, err := pgxpool.New(ctx, l.connectionURL)
+	if err != nil {
+		return fmt.Errorf("pgxpool new: %w", err)
+	}
+
+	l.pool = p
+
+	return nil
+}
+
+func (l *Locker) Close() {
+	if l.pool != nil {
+		l.pool.Close()
+	}
+}
+
+// Lock polls pg_try_advisory_lock until the key is free, ctx is done or the timeout passes.
+func (l *Locker) Lock(ctx context.Context, key string) error {
+	return l.LockAll(ctx, []string{key})
+}
+
+// LockAll takes every key on one pooled connection, so closing all the courts of a club doesn't
+// drain the pool. Keys are taken in sorted order so two callers locking overlapping keys can't
+// deadlock. Either every key is held once it returns, or none is.
+func (l *Locker) LockAll(ctx context.Context, keys []string) error {
+	if l.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", lockErr(err))
+	}
+
+	for i, key := range keys {
+		queryFailed, err := waitLock(ctx, conn, key)
+		if err == nil {
+			continue
+		}
+
+		switch {
+		case queryFailed:
+			// The connection may be left in an unknown state, drop it instead of returning it to the pool.
+			closeConn(conn)
+		case i == 0:
+			conn.Release()
+		default:
+			abandon(conn)
+		}
+
+		return err
+	}
+
+	s := &session{conn: conn, keys: len(keys)}
+
+	l.mu.Lock()
+	for _, key := range keys {
+		l.conns[key] = s
+	}
+	l.mu.Unlock()
+
+	return nil
+}
+
+// waitLock polls for the key on the connection and tells whether the query itself failed.
+func waitLock(ctx context.Context, conn *pgxpool.Conn, key string) (bool, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		var locked bool
+
+		if err := conn.QueryRow(ctx, tryLockQuery, key).Scan(&locked); err != nil {
+			return true, fmt.Errorf("try advisory lock: %w", lockErr(err))
+		}
+
+		if locked {
+			return false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("wait for lock %s: %w", key, lockErr(ctx.Err()))
+		case <-ticker.C:
+		}
+	}
+}
+
+const tryLockQuery = `SELECT pg_try_advisory_lock(hashtextextended($1, 0))`
+
+func (l *Locker) Unlock(ctx context.Context, key string) error {
+	l.mu.Lock()
+	s, exists := l.conns[key]
+	delete(l.conns, key)
+	l.mu.Unlock()
+
+	if !exists {
+		return fmt.Errorf("no lock found for key: %s", key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		// The session was closed on a failed unlock of another key, which released this one too.
+		return nil
+	}
+
+	// The lock has to be released even when the request that took it was cancelled.
+	var unlocked bool
+
+	err := s.conn.QueryRow(context.WithoutCancel(ctx), unlockQuery, key).Scan(&unlocked)
+	if err != nil || !unlocked {
+		// Closing the session releases every advisory lock it holds, the other keys taken with it included.
+		l.forget(s)
+		closeConn(s.conn)
+
+		if err != nil {
+			return fmt.Errorf("advisory unlock: %w", err)
+		}
+		return fmt.Errorf("advisory lock for key %s was not held", key)
+	}
+
+	s.keys--
+	if s.keys == 0 {
+		s.conn.Release()
+	}
+
+	return nil
+}
+
+const unlockQuery = `SELECT pg_advisory_unlock(hashtextextended($1, 0))`
+
+// UnlockAll releases keys taken with LockAll. Every key is unlocked even when one of them fails.
+func (l *Locker) UnlockAll(ctx context.Context, keys []string) error {
+	var errs []error
+
+	for _, key := range slices.Compact(slices.Sorted(slices.Values(keys))) {
+		if err := l.Unlock(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// forget drops the keys still held by a session that is about to be closed, s.mu must be held.
+func (l *Locker) forget(s *session) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, held := range l.conns {
+		if held == s {
+			delete(l.conns, key)
+		}
+	}
+
+	s.closed = true
+}
+
+// abandon returns the connection to the pool without the advisory locks it holds.
+func abandon(conn *pgxpool.Conn) {
+	if _, err := conn.Exec(context.Background(), unlockAllQuery); err != nil {
+		log.Error().Err(err).Msg("failed to release advisory locks")
+		closeConn(conn)
+		return
+	}
+
+	conn.Release()
+}
+
+const unlockAllQuery = `SELECT pg_advisory_unlock_all()`
+
+func closeConn(conn *pgxpool.Conn) {
+	raw := conn.Hijack()
+	if err := raw.Close(context.Background()); err != nil {
+		log.Error().Err(err).Msg("failed to close advisory lock connection")
+	}
+}
+
+func lockErr(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", entities.ErrLockTimeout, err)
+	}
+
+	return err
+}
@@ -0,0 +1,276 @@
+package locker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/repositories/locker"
+	"github.com/lever-dev/padel-backend/internal/repositories/reservation"
+	reservationService "github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+)
+
+// unpriced prices no court, the reservations are booked without a price.
+type unpriced struct{}
+
+func (unpriced) QuoteCourt(context.Context, string, time.Time, time.Time) (*entities.Quote, error) {
+	return nil, entities.ErrNotFound
+}
+
+// unrestricted has no cancellation policy or booking rules.
+type unrestricted struct{}
+
+func (unrestricted) CourtCancellationPolicy(context.Context, string) (*entities.CancellationPolicy, error) {
+	return nil, entities.ErrNotFound
+}
+
+func (unrestricted) CourtBookingRules(context.Context, string) (*entities.BookingRules, error) {
+	return nil, entities.ErrNotFound
+}
+
+// alwaysOpen has no opening hours, the courts are open at all times.
+type alwaysOpen struct{}
+
+func (alwaysOpen) CourtSchedule(context.Context, string) (*entities.Schedule, error) {
+	return &entities.Schedule{}, nil
+}
+
+func (alwaysOpen) OrganizationLocation(context.Context, string) (*time.Location, error) {
+	return time.UTC, nil
+}
+
+// unpaid has no payments to refund.
+type unpaid struct{}
+
+func (unpaid) RefundReservation(context.Context, string, int) error {
+	return nil
+}
+
+type lockerSuite struct {
+	suite.Suite
+
+	connString string
+	locker     *locker.Locker
+}
+
+func TestLockerSuite(t *testing.T) {
+	suite.Run(t, new(lockerSuite))
+}
+
+func (s *lockerSuite) SetupTest() {
+	s.connString = os.Getenv("POSTGRES_CONNECTION_URL")
+	s.locker = s.connect(time.Second)
+}
+
+func (s *lockerSuite) TearDownTest() {
+	if s.locker != nil {
+		s.locker.Close()
+	}
+}
+
+// connect returns a locker with its own pool, standing in for another replica.
+func (s *lockerSuite) connect(timeout time.Duration) *locker.Locker {
+	l := locker.NewLocker(s.connString, timeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(s.T(), l.Connect(ctx))
+
+	return l
+}
+
+func (s *lockerSuite) TestLockUnlock() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-1"))
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-2"))
+	s.Require().NoError(s.locker.Unlock(ctx, "locker-court-1"))
+	s.Require().NoError(s.locker.Unlock(ctx, "locker-court-2"))
+
+	s.Error(s.locker.Unlock(ctx, "locker-court-1"))
+}
+
+func (s *lockerSuite) TestLock_Timeout() {
+	ctx := context.Background()
+
+	replica := s.connect(100 * time.Millisecond)
+	defer replica.Close()
+
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-3"))
+
+	err := replica.Lock(ctx, "locker-court-3")
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrLockTimeout)
+
+	s.Require().NoError(s.locker.Unlock(ctx, "locker-court-3"))
+
+	s.Require().NoError(replica.Lock(ctx, "locker-court-3"))
+	s.Require().NoError(replica.Unlock(ctx, "locker-court-3"))
+}
+
+func (s *lockerSuite) TestLock_ContextCancelled() {
+	ctx := context.Background()
+
+	replica := s.connect(time.Minute)
+	defer replica.Close()
+
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-4"))
+	defer func() {
+		s.NoError(s.locker.Unlock(ctx, "locker-court-4"))
+	}()
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	err := replica.Lock(waitCtx, "locker-court-4")
+	s.Require().Error(err)
+	s.ErrorIs(err, context.Canceled)
+	s.NotErrorIs(err, entities.ErrLockTimeout)
+}
+
+func (s *lockerSuite) TestUnlock_CancelledContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-5"))
+	cancel()
+
+	s.Require().NoError(s.locker.Unlock(ctx, "locker-court-5"))
+	s.Require().NoError(s.locker.Lock(context.Background(), "locker-court-5"))
+	s.Require().NoError(s.locker.Unlock(context.Background(), "locker-court-5"))
+}
+
+// TestLockAll_MoreKeysThanPool closes more courts than the pool has connections and still books another court.
+func (s *lockerSuite) TestLockAll_MoreKeysThanPool() {
+	ctx := context.Background()
+
+	connString := s.connString + "?pool_max_conns=2"
+	if strings.Contains(s.connString, "?") {
+		connString = s.connString + "&pool_max_conns=2"
+	}
+
+	l := locker.NewLocker(connString, time.Second)
+	s.Require().NoError(l.Connect(ctx))
+	defer l.Close()
+
+	keys := make([]string, 0, 10)
+	for i := range 10 {
+		keys = append(keys, fmt.Sprintf("locker-blackout-court-%d", i))
+	}
+
+	s.Require().NoError(l.LockAll(ctx, keys))
+
+	s.Require().NoError(l.Lock(ctx, "locker-blackout-court-free"))
+	s.Require().NoError(l.Unlock(ctx, "locker-blackout-court-free"))
+
+	replica := s.connect(100 * time.Millisecond)
+	defer replica.Close()
+
+	s.ErrorIs(replica.Lock(ctx, keys[7]), entities.ErrLockTimeout)
+
+	s.Require().NoError(l.UnlockAll(ctx, keys))
+
+	s.Require().NoError(replica.LockAll(ctx, keys))
+	s.Require().NoError(replica.UnlockAll(ctx, keys))
+}
+
+func (s *lockerSuite) TestLockAll_NoneHeldOnFailure() {
+	ctx := context.Background()
+
+	replica := s.connect(100 * time.Millisecond)
+	defer replica.Close()
+
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-7"))
+
+	err := replica.LockAll(ctx, []string{"locker-court-6", "locker-court-7"})
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrLockTimeout)
+
+	// locker-court-6 was taken before locker-court-7 timed out and has been given back.
+	s.Require().NoError(s.locker.Lock(ctx, "locker-court-6"))
+	s.Require().NoError(s.locker.UnlockAll(ctx, []string{"locker-court-6", "locker-court-7"}))
+}
+
+// TestReserveCourt_ConcurrentReservations mirrors the service test of the same name, but runs the
+// bookings against real Postgres through two replicas that only share the database.
+func (s *lockerSuite) TestReserveCourt_ConcurrentReservations() {
+	ctx := context.Background()
+	courtID := "locker-court-concurrent"
+	reservedFrom := time.Now().UTC().Truncate(time.Hour).Add(48 * time.Hour)
+	reservedTo := reservedFrom.Add(time.Hour)
+
+	replicas := make([]*reservationService.Service, 0, 2)
+	for range 2 {
+		repo := reservation.NewRepository(s.connString)
+		s.Require().NoError(repo.Connect(ctx))
+		defer repo.Close()
+
+		l := s.connect(10 * time.Second)
+		defer l.Close()
+
+		replicas = append(replicas, reservationService.NewService(
+			reservationService.Dependencies{
+				Reservations: repo,
+				Pricer:       unpriced{},
+				Policies:     unrestricted{},
+				Scheduler:    alwaysOpen{},
+				Refunder:     unpaid{},
+				Locker:       l,
+				Clock:        clock.Real{},
+			},
+			reservationService.DefaultHoldTTL,
+		))
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results = make(chan error)
+
+		wantSuccess = 1
+		wantErrors  = 100
+		total       = wantSuccess + wantErrors
+	)
+
+	for i := range total {
+		service := replicas[i%len(replicas)]
+
+		wg.Go(func() {
+			rsv := entities.NewReservation(courtID, reservedFrom, reservedTo, "user-1")
+			results <- service.ReserveCourt(ctx, courtID, rsv)
+		})
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var successes, conflicts int
+
+	for err := range results {
+		switch {
+		case err == nil:
+			successes++
+		case errors.Is(err, entities.ErrCourtAlreadyReserved):
+			conflicts++
+		default:
+			s.Failf("unexpected error", "%v", err)
+		}
+	}
+
+	s.Equal(wantSuccess, successes)
+	s.Equal(wantErrors, conflicts)
+}
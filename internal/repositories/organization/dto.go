@@ -1,35 +1,197 @@
 package organization
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
 )
 
 type dto struct {
-	ID        string
-	Name      string
-	City      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           string
+	Name         string
+	City         string
+	Address      string
+	Latitude     *float64
+	Longitude    *float64
+	TimeZone     string
+	OpeningHours []byte
+	SpecialHours []byte
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
-func newDTO(o *entities.Organization) dto {
-	return dto{
-		ID:        o.ID,
-		Name:      o.Name,
-		City:      o.City,
-		CreatedAt: o.CreatedAt,
-		UpdatedAt: o.UpdatedAt,
+type openingHoursDTO struct {
+	Weekday  int    `json:"weekday"`
+	OpensAt  string `json:"opensAt"`
+	ClosesAt string `json:"closesAt"`
+}
+
+type specialHoursDTO struct {
+	Date    string            `json:"date"`
+	Windows []openingHoursDTO `json:"windows"`
+	Reason  string            `json:"reason"`
+}
+
+func newDTO(o *entities.Organization) (dto, error) {
+	rawHours, err := json.Marshal(newOpeningHoursDTOs(o.OpeningHours))
+	if err != nil {
+		return dto{}, fmt.Errorf("marshal opening hours: %w", err)
+	}
+
+	special := make([]specialHoursDTO, 0, len(o.SpecialHours))
+	for _, h := range o.SpecialHours {
+		special = append(special, specialHoursDTO{
+			Date:    h.Date.Format(time.DateOnly),
+			Windows: newOpeningHoursDTOs(h.Windows),
+			Reason:  h.Reason,
+		})
 	}
+
+	rawSpecial, err := json.Marshal(special)
+	if err != nil {
+		return dto{}, fmt.Errorf("marshal special hours: %w", err)
+	}
+
+	d := dto{
+		ID:           o.ID,
+		Name:         o.Name,
+		City:         o.City,
+		Address:      o.Address,
+		TimeZone:     o.TimeZone,
+		OpeningHours: rawHours,
+		SpecialHours: rawSpecial,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+
+	if o.Coordinates != nil {
+		d.Latitude = &o.Coordinates.Latitude
+		d.Longitude = &o.Coordinates.Longitude
+	}
+
+	return d, nil
 }
 
-func (d dto) toEntity() entities.Organization {
+func (d dto) toEntity() (entities.Organization, error) {
+	var hours []openingHoursDTO
+	if len(d.OpeningHours) > 0 {
+		if err := json.Unmarshal(d.OpeningHours, &hours); err != nil {
+			return entities.Organization{}, fmt.Errorf("unmarshal opening hours: %w", err)
+		}
+	}
+
+	openingHours, err := toOpeningHours(hours)
+	if err != nil {
+		return entities.Organization{}, err
+	}
+
+	var special []specialHoursDTO
+	if len(d.SpecialHours) > 0 {
+		if err := json.Unmarshal(d.SpecialHours, &special); err != nil {
+			return entities.Organization{}, fmt.Errorf("unmarshal special hours: %w", err)
+		}
+	}
+
+	var specialHours []entities.SpecialHours
+	for _, h := range special {
+		date, err := time.Parse(time.DateOnly, h.Date)
+		if err != nil {
+			return entities.Organization{}, fmt.Errorf("parse special hours date: %w", err)
+		}
+
+		windows, err := toOpeningHours(h.Windows)
+		if err != nil {
+			return entities.Organization{}, err
+		}
+
+		specialHours = append(specialHours, entities.SpecialHours{
+			Date:    date,
+			Windows: windows,
+			Reason:  h.Reason,
+		})
+	}
+
+	var coordinates *entities.GeoPoint
+	if d.Latitude != nil && d.Longitude != nil {
+		coordinates = &entities.GeoPoint{Latitude: *d.Latitude, Longitude: *d.Longitude}
+	}
+
 	return entities.Organization{
-		ID:        d.ID,
-		Name:      d.Name,
-		City:      d.City,
-		CreatedAt: d.CreatedAt,
-		UpdatedAt: d.UpdatedAt,
+		ID:           d.ID,
+		Name:         d.Name,
+		City:         d.City,
+		Address:      d.Address,
+		Coordinates:  coordinates,
+		TimeZone:     d.TimeZone,
+		OpeningHours: openingHours,
+		SpecialHours: specialHours,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}, nil
+}
+
+func newOpeningHoursDTOs(hours []entities.OpeningHours) []openingHoursDTO {
+	dtos := make([]openingHoursDTO, 0, len(hours))
+	for _, h := range hours {
+		dtos = append(dtos, openingHoursDTO{
+			Weekday:  int(h.Weekday),
+			OpensAt:  h.OpensAt.String(),
+			ClosesAt: h.ClosesAt.String(),
+		})
+	}
+
+	return dtos
+}
+
+func toOpeningHours(dtos []openingHoursDTO) ([]entities.OpeningHours, error) {
+	var hours []entities.OpeningHours
+	for _, h := range dtos {
+		opensAt, err := entities.ParseTimeOfDay(h.OpensAt)
+		if err != nil {
+			return nil, err
+		}
+
+		closesAt, err := entities.ParseTimeOfDay(h.ClosesAt)
+		if err != nil {
+			return nil, err
+		}
+
+		hours = append(hours, entities.OpeningHours{
+			Weekday:  time.Weekday(h.Weekday),
+			OpensAt:  opensAt,
+			ClosesAt: closesAt,
+		})
+	}
+
+	return hours, nil
+}
+
+type memberDTO struct {
+	OrganizationID string
+	UserID         string
+	Role           string
+	InvitedBy      string
+	CreatedAt      time.Time
+}
+
+func newMemberDTO(m *entities.Membership) memberDTO {
+	return memberDTO{
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		Role:           string(m.Role),
+		InvitedBy:      m.InvitedBy,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+func (d memberDTO) toEntity() entities.Membership {
+	return entities.Membership{
+		OrganizationID: d.OrganizationID,
+		UserID:         d.UserID,
+		Role:           entities.Role(d.Role),
+		InvitedBy:      d.InvitedBy,
+		CreatedAt:      d.CreatedAt,
 	}
 }
@@ -0,0 +1,295 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// CreateWithOwner stores the organization together with the membership of its owner.
+func (r *Repository) CreateWithOwner(
+	ctx context.Context,
+	organization *entities.Organization,
+	owner *entities.Membership,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if organization.CreatedAt.IsZero() {
+		organization.CreatedAt = time.Now().UTC()
+	}
+
+	if organization.TimeZone == "" {
+		organization.TimeZone = time.UTC.String()
+	}
+
+	if owner.CreatedAt.IsZero() {
+		owner.CreatedAt = organization.CreatedAt
+	}
+
+	d, err := newDTO(organization)
+	if err != nil {
+		return err
+	}
+
+	m := newMemberDTO(owner)
+
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			createOrganizationQuery,
+			d.ID,
+			d.Name,
+			d.City,
+			d.TimeZone,
+			d.OpeningHours,
+			d.SpecialHours,
+			d.CreatedAt,
+			d.Address,
+			d.Latitude,
+			d.Longitude,
+		)
+		if err != nil {
+			return fmt.Errorf("insert organization: %w", err)
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			createMemberQuery,
+			m.OrganizationID,
+			m.UserID,
+			m.Role,
+			nullableString(m.InvitedBy),
+			m.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert owner: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return entities.ErrOrganizationAlreadyExist
+			}
+		}
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateMember(ctx context.Context, member *entities.Membership) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if member.CreatedAt.IsZero() {
+		member.CreatedAt = time.Now().UTC()
+	}
+
+	m := newMemberDTO(member)
+
+	_, err := r.pool.Exec(
+		ctx,
+		createMemberQuery,
+		m.OrganizationID,
+		m.UserID,
+		m.Role,
+		nullableString(m.InvitedBy),
+		m.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return entities.ErrMemberAlreadyExist
+			case "23503":
+				return entities.ErrNotFound
+			}
+		}
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createMemberQuery = `
+	INSERT INTO organization_members(
+		organization_id,
+		user_id,
+		role,
+		invited_by,
+		created_at
+	) VALUES ($1, $2, $3, $4, $5)
+`
+
+func (r *Repository) GetMember(ctx context.Context, organizationID, userID string) (*entities.Membership, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	member, err := scanMember(r.pool.QueryRow(ctx, getMemberQuery, organizationID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan member: %w", err)
+	}
+
+	return &member, nil
+}
+
+const getMemberQuery = `
+	SELECT
+		organization_id,
+		user_id,
+		role,
+		invited_by,
+		created_at
+	FROM organization_members
+	WHERE organization_id = $1
+		AND user_id = $2
+	LIMIT 1
+`
+
+func (r *Repository) ListMembers(ctx context.Context, organizationID string) ([]entities.Membership, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listMembersQuery, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []entities.Membership
+
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan member: %w", err)
+		}
+
+		results = append(results, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return results, nil
+}
+
+const listMembersQuery = `
+	SELECT
+		organization_id,
+		user_id,
+		role,
+		invited_by,
+		created_at
+	FROM organization_members
+	WHERE organization_id = $1
+	ORDER BY created_at ASC, user_id ASC
+`
+
+// DeleteMember removes the membership. Removing the last owner fails with ErrLastOwner,
+// the check runs in the same statement so concurrent removals cannot leave the organization ownerless.
+func (r *Repository) DeleteMember(ctx context.Context, organizationID, userID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	var deleted, lastOwner bool
+
+	err := r.pool.QueryRow(ctx, deleteMemberQuery, organizationID, userID, entities.OwnerRole).
+		Scan(&deleted, &lastOwner)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if lastOwner {
+		return entities.ErrLastOwner
+	}
+
+	if !deleted {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteMemberQuery = `
+	WITH target AS (
+		SELECT role
+		FROM organization_members
+		WHERE organization_id = $1
+			AND user_id = $2
+		FOR UPDATE
+	), owners AS (
+		SELECT user_id
+		FROM organization_members
+		WHERE organization_id = $1
+			AND role = $3
+		FOR UPDATE
+	), last_owner AS (
+		SELECT EXISTS (SELECT 1 FROM target WHERE role = $3)
+			AND (SELECT count(*) FROM owners) <= 1 AS value
+	), deleted AS (
+		DELETE FROM organization_members
+		WHERE organization_id = $1
+			AND user_id = $2
+			AND NOT (SELECT value FROM last_owner)
+		RETURNING 1
+	)
+	SELECT
+		EXISTS (SELECT 1 FROM deleted),
+		(SELECT value FROM last_owner)
+`
+
+func scanMember(scanner rowScanner) (entities.Membership, error) {
+	var (
+		d         memberDTO
+		invitedBy sql.NullString
+	)
+
+	err := scanner.Scan(
+		&d.OrganizationID,
+		&d.UserID,
+		&d.Role,
+		&invitedBy,
+		&d.CreatedAt,
+	)
+	if err != nil {
+		return entities.Membership{}, err
+	}
+
+	if invitedBy.Valid {
+		d.InvitedBy = invitedBy.String
+	}
+
+	d.CreatedAt = d.CreatedAt.UTC()
+
+	return d.toEntity(), nil
+}
+
+func nullableString(s string) any {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
@@ -0,0 +1,90 @@
+package organization_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestCreateWithOwner() {
+	ctx := context.Background()
+
+	org := &entities.Organization{
+		ID:        "org-owner-1",
+		Name:      "Owned Club",
+		City:      "Almaty",
+		CreatedAt: time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+	owner := &entities.Membership{
+		OrganizationID: org.ID,
+		UserID:         "user-owner-1",
+		Role:           entities.OwnerRole,
+		CreatedAt:      org.CreatedAt,
+	}
+
+	err := s.repo.CreateWithOwner(ctx, org, owner)
+	s.Require().NoError(err)
+
+	orgDB, err := s.repo.GetByID(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Equal(org, orgDB)
+
+	memberDB, err := s.repo.GetMember(ctx, org.ID, owner.UserID)
+	s.Require().NoError(err)
+	s.Equal(owner, memberDB)
+
+	err = s.repo.CreateWithOwner(ctx, &entities.Organization{
+		ID:   "org-owner-2",
+		Name: "owned club",
+		City: "almaty",
+	}, &entities.Membership{OrganizationID: "org-owner-2", UserID: "user-owner-1", Role: entities.OwnerRole})
+	s.ErrorIs(err, entities.ErrOrganizationAlreadyExist)
+
+	_, err = s.repo.GetByID(ctx, "org-owner-2")
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestMembers() {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 7, 2, 8, 0, 0, 0, time.UTC)
+
+	org := &entities.Organization{ID: "org-members-1", Name: "Members Club", City: "Astana", CreatedAt: createdAt}
+	s.Require().NoError(s.repo.CreateWithOwner(ctx, org, &entities.Membership{
+		OrganizationID: org.ID,
+		UserID:         "user-members-owner",
+		Role:           entities.OwnerRole,
+		CreatedAt:      createdAt,
+	}))
+
+	staff := &entities.Membership{
+		OrganizationID: org.ID,
+		UserID:         "user-members-staff",
+		Role:           entities.StaffRole,
+		InvitedBy:      "user-members-owner",
+		CreatedAt:      createdAt.Add(time.Hour),
+	}
+
+	s.Require().NoError(s.repo.CreateMember(ctx, staff))
+	s.ErrorIs(s.repo.CreateMember(ctx, staff), entities.ErrMemberAlreadyExist)
+
+	s.ErrorIs(s.repo.CreateMember(ctx, &entities.Membership{
+		OrganizationID: "org-members-missing",
+		UserID:         "user-members-staff",
+		Role:           entities.StaffRole,
+	}), entities.ErrNotFound)
+
+	members, err := s.repo.ListMembers(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Require().Len(members, 2)
+	s.Equal("user-members-owner", members[0].UserID)
+	s.Equal(*staff, members[1])
+
+	s.ErrorIs(s.repo.DeleteMember(ctx, org.ID, "user-members-owner"), entities.ErrLastOwner)
+
+	s.Require().NoError(s.repo.DeleteMember(ctx, org.ID, staff.UserID))
+	s.ErrorIs(s.repo.DeleteMember(ctx, org.ID, staff.UserID), entities.ErrNotFound)
+
+	_, err = s.repo.GetMember(ctx, org.ID, staff.UserID)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
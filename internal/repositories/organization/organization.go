@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -50,15 +51,28 @@ func (r *Repository) Create(ctx context.Context, organization *entities.Organiza
 		organization.CreatedAt = time.Now().UTC()
 	}
 
-	d := newDTO(organization)
+	if organization.TimeZone == "" {
+		organization.TimeZone = time.UTC.String()
+	}
 
-	_, err := r.pool.Exec(
+	d, err := newDTO(organization)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(
 		ctx,
 		createOrganizationQuery,
 		d.ID,
 		d.Name,
 		d.City,
+		d.TimeZone,
+		d.OpeningHours,
+		d.SpecialHours,
 		d.CreatedAt,
+		d.Address,
+		d.Latitude,
+		d.Longitude,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -79,8 +93,14 @@ const createOrganizationQuery = `
 		id,
 		name,
 		city,
-		created_at
-	) VALUES ($1, $2, $3, $4)
+		time_zone,
+		opening_hours,
+		special_hours,
+		created_at,
+		address,
+		latitude,
+		longitude
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
 func (r *Repository) GetByID(ctx context.Context, organizationID string) (*entities.Organization, error) {
@@ -104,6 +124,12 @@ const getOrganizationByIDQuery = `
 		id,
 		name,
 		city,
+		address,
+		latitude,
+		longitude,
+		time_zone,
+		opening_hours,
+		special_hours,
 		created_at,
 		updated_at
 	FROM organizations
@@ -145,24 +171,120 @@ const getOrganizationsByCityQuery = `
 		id,
 		name,
 		city,
+		address,
+		latitude,
+		longitude,
+		time_zone,
+		opening_hours,
+		special_hours,
 		created_at,
 		updated_at
 	FROM organizations
 	WHERE city = $1
 `
 
-func (r *Repository) Update(ctx context.Context, org *entities.Organization) error {
+// NearbyOrganizations returns at most limit organizations within radius meters of the point, the nearest
+// first. Organizations without coordinates are left out. Distances are computed by the geo_distance function,
+// the haversine formula in plain SQL, which needs neither PostGIS nor any other extension.
+func (r *Repository) NearbyOrganizations(
+	ctx context.Context,
+	point entities.GeoPoint,
+	radius float64,
+	limit int,
+) ([]entities.NearbyOrganization, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	// Every organization within the radius lies within this many degrees of latitude of the point
+	band := radius / entities.EarthRadius * 180 / math.Pi
+
+	rows, err := r.pool.Query(
+		ctx,
+		nearbyOrganizationsQuery,
+		point.Latitude,
+		point.Longitude,
+		point.Latitude-band,
+		point.Latitude+band,
+		entities.EarthRadius,
+		radius,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query nearby organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var results []entities.NearbyOrganization
+
+	for rows.Next() {
+		var distance float64
+
+		org, err := scan(rows, &distance)
+		if err != nil {
+			return nil, fmt.Errorf("scan organization: %w", err)
+		}
+
+		results = append(results, entities.NearbyOrganization{Organization: org, Distance: distance})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return results, nil
+}
+
+const nearbyOrganizationsQuery = `
+	SELECT
+		id,
+		name,
+		city,
+		address,
+		latitude,
+		longitude,
+		time_zone,
+		opening_hours,
+		special_hours,
+		created_at,
+		updated_at,
+		distance
+	FROM (
+		SELECT
+			*,
+			geo_distance($1, $2, latitude, longitude, $5) AS distance
+		FROM organizations
+		WHERE latitude BETWEEN $3 AND $4
+	) o
+	WHERE distance <= $6
+	ORDER BY distance ASC, name ASC
+	LIMIT $7
+`
+
+func (r *Repository) Update(ctx context.Context, update entities.OrganizationUpdate) error {
 	if r.pool == nil {
 		return fmt.Errorf("not connected to pool")
 	}
 
+	var latitude, longitude *float64
+	if update.Coordinates != nil {
+		latitude = &update.Coordinates.Latitude
+		longitude = &update.Coordinates.Longitude
+	}
+
 	tag, err := r.pool.Exec(
 		ctx,
 		updateOrganizationQuery,
-		org.Name,
-		org.City,
-		org.UpdatedAt,
-		org.ID,
+		update.Name,
+		update.City,
+		update.TimeZone,
+		update.Address,
+		latitude,
+		longitude,
+		update.UpdatedAt,
+		update.ID,
+		update.SetAddress,
+		update.SetCoordinates,
 	)
 	if err != nil {
 		return fmt.Errorf("exec: %w", err)
@@ -180,6 +302,48 @@ const updateOrganizationQuery = `
 	SET 
     	name = $1,
     	city = $2,
+		time_zone = COALESCE(NULLIF($3, ''), time_zone),
+		address = CASE WHEN $9::boolean THEN $4 ELSE address END,
+		latitude = CASE WHEN $10::boolean THEN $5::double precision ELSE latitude END,
+		longitude = CASE WHEN $10::boolean THEN $6::double precision ELSE longitude END,
+		updated_at = $7
+	WHERE id = $8
+`
+
+func (r *Repository) UpdateHours(ctx context.Context, org *entities.Organization) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	d, err := newDTO(org)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		updateOrganizationHoursQuery,
+		d.OpeningHours,
+		d.SpecialHours,
+		d.UpdatedAt,
+		d.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const updateOrganizationHoursQuery = `
+	UPDATE organizations
+	SET
+		opening_hours = $1,
+		special_hours = $2,
 		updated_at = $3
 	WHERE id = $4
 `
@@ -210,18 +374,26 @@ type rowScanner interface {
 	Scan(dest ...any) error
 }
 
-func scan(scanner rowScanner) (entities.Organization, error) {
+// scan reads an organization row, the columns after the ones of the organization are read into extra.
+func scan(scanner rowScanner, extra ...any) (entities.Organization, error) {
 	var d dto
 	var sqlUpdateAt sql.NullTime
 
-	err := scanner.Scan(
+	dest := []any{
 		&d.ID,
 		&d.Name,
 		&d.City,
+		&d.Address,
+		&d.Latitude,
+		&d.Longitude,
+		&d.TimeZone,
+		&d.OpeningHours,
+		&d.SpecialHours,
 		&d.CreatedAt,
 		&sqlUpdateAt,
-	)
-	if err != nil {
+	}
+
+	if err := scanner.Scan(append(dest, extra...)...); err != nil {
 		return entities.Organization{}, err
 	}
 
@@ -230,5 +402,5 @@ func scan(scanner rowScanner) (entities.Organization, error) {
 	if sqlUpdateAt.Valid {
 		d.UpdatedAt = sqlUpdateAt.Time.UTC()
 	}
-	return d.toEntity(), nil
+	return d.toEntity()
 }
@@ -154,7 +154,7 @@ func (s *repositorySuite) TestUpdateOrganization() {
 	org.City = "Astana"
 	org.UpdatedAt = time.Now().UTC()
 
-	err := s.repo.Update(ctx, org)
+	err := s.repo.Update(ctx, entities.OrganizationUpdate{Organization: *org})
 	s.Require().NoError(err)
 
 	updated, err := s.repo.GetByID(ctx, org.ID)
@@ -173,11 +173,170 @@ func (s *repositorySuite) TestUpdateOrganization_NotFound() {
 		City: "Test",
 	}
 
-	err := s.repo.Update(ctx, org)
+	err := s.repo.Update(ctx, entities.OrganizationUpdate{Organization: *org})
 	s.Require().Error(err)
 	s.ErrorIs(err, entities.ErrNotFound)
 }
 
+func (s *repositorySuite) TestUpdateHours() {
+	ctx := context.Background()
+
+	org := &entities.Organization{
+		ID:        "org-hours-1",
+		Name:      "org-1",
+		City:      "Almaty",
+		TimeZone:  "Asia/Almaty",
+		CreatedAt: time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	s.seedOrganizations(ctx, []*entities.Organization{org})
+
+	org.OpeningHours = []entities.OpeningHours{
+		{Weekday: time.Monday, OpensAt: 8 * 60, ClosesAt: 22 * 60},
+	}
+	org.SpecialHours = []entities.SpecialHours{
+		{Date: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), Reason: "Christmas"},
+		{
+			Date:    time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			Windows: []entities.OpeningHours{{Weekday: time.Tuesday, OpensAt: 8 * 60, ClosesAt: 14 * 60}},
+			Reason:  "New Year's Eve",
+		},
+	}
+	org.UpdatedAt = time.Date(2024, 7, 2, 8, 0, 0, 0, time.UTC)
+
+	err := s.repo.UpdateHours(ctx, org)
+	s.Require().NoError(err)
+
+	updated, err := s.repo.GetByID(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Equal("Asia/Almaty", updated.TimeZone)
+	s.Equal(org.OpeningHours, updated.OpeningHours)
+	s.Equal(org.SpecialHours, updated.SpecialHours)
+}
+
+func (s *repositorySuite) TestUpdateHours_NotFound() {
+	err := s.repo.UpdateHours(context.Background(), &entities.Organization{ID: "org-hours-missing"})
+	s.Require().ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestUpdateOrganization_Location() {
+	ctx := context.Background()
+
+	org := &entities.Organization{
+		ID:          "org-location-1",
+		Name:        "org-location-1",
+		City:        "Almaty",
+		Address:     "Abay Ave 10",
+		Coordinates: &entities.GeoPoint{Latitude: 43.2389, Longitude: 76.8897},
+		CreatedAt:   time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	s.seedOrganizations(ctx, []*entities.Organization{org})
+
+	created, err := s.repo.GetByID(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Equal("Abay Ave 10", created.Address)
+	s.Equal(org.Coordinates, created.Coordinates)
+
+	err = s.repo.Update(ctx, entities.OrganizationUpdate{Organization: entities.Organization{
+		ID:        org.ID,
+		Name:      org.Name,
+		City:      org.City,
+		UpdatedAt: time.Date(2024, 7, 2, 8, 0, 0, 0, time.UTC),
+	}})
+	s.Require().NoError(err)
+
+	kept, err := s.repo.GetByID(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Equal("Abay Ave 10", kept.Address)
+	s.Equal(org.Coordinates, kept.Coordinates)
+
+	err = s.repo.Update(ctx, entities.OrganizationUpdate{
+		Organization: entities.Organization{
+			ID:          org.ID,
+			Name:        org.Name,
+			City:        org.City,
+			Address:     "Dostyk Ave 5",
+			Coordinates: &entities.GeoPoint{Latitude: 43.2330, Longitude: 76.9560},
+			UpdatedAt:   time.Date(2024, 7, 3, 8, 0, 0, 0, time.UTC),
+		},
+		SetAddress:     true,
+		SetCoordinates: true,
+	})
+	s.Require().NoError(err)
+
+	moved, err := s.repo.GetByID(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Equal("Dostyk Ave 5", moved.Address)
+	s.Equal(&entities.GeoPoint{Latitude: 43.2330, Longitude: 76.9560}, moved.Coordinates)
+
+	err = s.repo.Update(ctx, entities.OrganizationUpdate{
+		Organization: entities.Organization{
+			ID:        org.ID,
+			Name:      org.Name,
+			City:      org.City,
+			UpdatedAt: time.Date(2024, 7, 4, 8, 0, 0, 0, time.UTC),
+		},
+		SetAddress:     true,
+		SetCoordinates: true,
+	})
+	s.Require().NoError(err)
+
+	cleared, err := s.repo.GetByID(ctx, org.ID)
+	s.Require().NoError(err)
+	s.Empty(cleared.Address)
+	s.Nil(cleared.Coordinates)
+}
+
+func (s *repositorySuite) TestNearbyOrganizations() {
+	ctx := context.Background()
+
+	center := entities.GeoPoint{Latitude: 51.1282, Longitude: 71.4306}
+
+	orgs := []*entities.Organization{
+		{
+			ID:          "org-nearby-far",
+			Name:        "Far",
+			City:        "Astana",
+			Coordinates: &entities.GeoPoint{Latitude: 51.1700, Longitude: 71.4306},
+		},
+		{
+			ID:          "org-nearby-close",
+			Name:        "Close",
+			City:        "Astana",
+			Coordinates: &entities.GeoPoint{Latitude: 51.1300, Longitude: 71.4306},
+		},
+		{
+			ID:          "org-nearby-outside",
+			Name:        "Outside",
+			City:        "Karaganda",
+			Coordinates: &entities.GeoPoint{Latitude: 49.8047, Longitude: 73.1094},
+		},
+		{
+			ID:   "org-nearby-unplaced",
+			Name: "Unplaced",
+			City: "Astana",
+		},
+	}
+
+	s.seedOrganizations(ctx, orgs)
+
+	nearby, err := s.repo.NearbyOrganizations(ctx, center, 10000, 10)
+	s.Require().NoError(err)
+	s.Require().Len(nearby, 2)
+
+	// along a meridian the distance is the arc of the difference in latitude, 0.0018 and 0.0418 degrees
+	s.Equal("org-nearby-close", nearby[0].Organization.ID)
+	s.InDelta(200.15, nearby[0].Distance, 0.01)
+	s.Equal("org-nearby-far", nearby[1].Organization.ID)
+	s.InDelta(4647.95, nearby[1].Distance, 0.01)
+
+	nearest, err := s.repo.NearbyOrganizations(ctx, center, 10000, 1)
+	s.Require().NoError(err)
+	s.Require().Len(nearest, 1)
+	s.Equal("org-nearby-close", nearest[0].Organization.ID)
+}
+
 func (s *repositorySuite) TestDeleteOrganization() {
 	ctx := context.Background()
 
@@ -0,0 +1,44 @@
+package otp
+
+import (
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type dto struct {
+	ID          string
+	PhoneNumber string
+	Purpose     string
+	CodeHash    string
+	Attempts    int
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	ConsumedAt  *time.Time
+}
+
+func newDTO(c *entities.OTPChallenge) dto {
+	return dto{
+		ID:          c.ID,
+		PhoneNumber: c.PhoneNumber,
+		Purpose:     string(c.Purpose),
+		CodeHash:    c.CodeHash,
+		Attempts:    c.Attempts,
+		CreatedAt:   c.CreatedAt.UTC(),
+		ExpiresAt:   c.ExpiresAt.UTC(),
+		ConsumedAt:  c.ConsumedAt,
+	}
+}
+
+func (d dto) toEntity() entities.OTPChallenge {
+	return entities.OTPChallenge{
+		ID:          d.ID,
+		PhoneNumber: d.PhoneNumber,
+		Purpose:     entities.OTPPurpose(d.Purpose),
+		CodeHash:    d.CodeHash,
+		Attempts:    d.Attempts,
+		CreatedAt:   d.CreatedAt,
+		ExpiresAt:   d.ExpiresAt,
+		ConsumedAt:  d.ConsumedAt,
+	}
+}
@@ -0,0 +1,220 @@
+package otp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type Repository struct {
+	connectionURL string
+	pool          *pgxpool.Pool
+}
+
+func NewRepository(connectionURL string) *Repository {
+	return &Repository{connectionURL: connectionURL}
+}
+
+func (r *Repository) Connect(ctx context.Context) error {
+	p, err := pgxpool.New(ctx, r.connectionURL)
+	if err != nil {
+		return fmt.Errorf("pgxpool new: %w", err)
+	}
+
+	r.pool = p
+
+	return nil
+}
+
+func (r *Repository) Close() {
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, challenge *entities.OTPChallenge) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	d := newDTO(challenge)
+
+	_, err := r.pool.Exec(
+		ctx,
+		createChallengeQuery,
+		d.ID,
+		d.PhoneNumber,
+		d.Purpose,
+		d.CodeHash,
+		d.Attempts,
+		d.CreatedAt,
+		d.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec create otp challenge: %w", err)
+	}
+
+	return nil
+}
+
+const createChallengeQuery = `
+INSERT INTO otp_codes(
+	id,
+	phone_number,
+	purpose,
+	code_hash,
+	attempts,
+	created_at,
+	expires_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+// GetLatest returns the most recently issued challenge for the phone number and purpose. Issuing a new
+// code supersedes the previous ones, so only the latest challenge is ever checked.
+func (r *Repository) GetLatest(
+	ctx context.Context,
+	phoneNumber string,
+	purpose entities.OTPPurpose,
+) (*entities.OTPChallenge, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	challenge, err := scan(r.pool.QueryRow(ctx, getLatestChallengeQuery, phoneNumber, string(purpose)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan otp challenge: %w", err)
+	}
+
+	return &challenge, nil
+}
+
+const getLatestChallengeQuery = `
+SELECT
+	id,
+	phone_number,
+	purpose,
+	code_hash,
+	attempts,
+	created_at,
+	expires_at,
+	consumed_at
+FROM otp_codes
+WHERE phone_number = $1 AND purpose = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+// CountSince counts the codes sent to the phone number since the given time, whatever their purpose.
+func (r *Repository) CountSince(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+	if r.pool == nil {
+		return 0, fmt.Errorf("not connected to pool")
+	}
+
+	var count int
+
+	if err := r.pool.QueryRow(ctx, countChallengesSinceQuery, phoneNumber, since.UTC()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("scan count: %w", err)
+	}
+
+	return count, nil
+}
+
+const countChallengesSinceQuery = `
+SELECT count(*)
+FROM otp_codes
+WHERE phone_number = $1 AND created_at >= $2
+`
+
+// UseAttempt counts a guess against the challenge before the code is compared. It returns ErrNotFound when
+// the challenge is consumed or has no attempts left, so concurrent guesses never exceed maxAttempts.
+func (r *Repository) UseAttempt(ctx context.Context, challengeID string, maxAttempts int) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, useAttemptQuery, challengeID, maxAttempts)
+	if err != nil {
+		return fmt.Errorf("exec use attempt: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const useAttemptQuery = `
+UPDATE otp_codes
+SET attempts = attempts + 1
+WHERE id = $1 AND attempts < $2 AND consumed_at IS NULL
+`
+
+// Consume marks the challenge as used. It returns ErrNotFound when the challenge was already consumed,
+// so a code accepted by two concurrent requests is only honoured once.
+func (r *Repository) Consume(ctx context.Context, challengeID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, consumeChallengeQuery, challengeID, now.UTC())
+	if err != nil {
+		return fmt.Errorf("exec consume otp challenge: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const consumeChallengeQuery = `
+UPDATE otp_codes
+SET consumed_at = $2
+WHERE id = $1 AND consumed_at IS NULL
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scan(scanner rowScanner) (entities.OTPChallenge, error) {
+	var (
+		d          dto
+		consumedAt sql.NullTime
+	)
+
+	err := scanner.Scan(
+		&d.ID,
+		&d.PhoneNumber,
+		&d.Purpose,
+		&d.CodeHash,
+		&d.Attempts,
+		&d.CreatedAt,
+		&d.ExpiresAt,
+		&consumedAt,
+	)
+	if err != nil {
+		return entities.OTPChallenge{}, err
+	}
+
+	d.CreatedAt = d.CreatedAt.UTC()
+	d.ExpiresAt = d.ExpiresAt.UTC()
+
+	if consumedAt.Valid {
+		t := consumedAt.Time.UTC()
+		d.ConsumedAt = &t
+	}
+
+	return d.toEntity(), nil
+}
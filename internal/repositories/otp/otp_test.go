@@ -0,0 +1,101 @@
+package otp_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/repositories/otp"
+)
+
+type repositorySuite struct {
+	suite.Suite
+
+	repo *otp.Repository
+}
+
+func TestRepositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) SetupTest() {
+	connString := os.Getenv("POSTGRES_CONNECTION_URL")
+
+	repo := otp.NewRepository(connString)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := repo.Connect(ctx)
+	require.NoError(s.T(), err)
+
+	s.repo = repo
+}
+
+func (s *repositorySuite) TearDownTest() {
+	if s.repo != nil {
+		s.repo.Close()
+	}
+}
+
+func (s *repositorySuite) TestGetLatest() {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	phone := "+77030000001"
+
+	first := entities.NewOTPChallenge(phone, entities.LoginOTPPurpose, "hash-1", now, 5*time.Minute)
+	second := entities.NewOTPChallenge(phone, entities.LoginOTPPurpose, "hash-2", now.Add(time.Minute), 5*time.Minute)
+	other := entities.NewOTPChallenge(
+		phone,
+		entities.ResetPasswordOTPPurpose,
+		"hash-3",
+		now.Add(2*time.Minute),
+		time.Minute,
+	)
+
+	for _, c := range []*entities.OTPChallenge{first, second, other} {
+		s.Require().NoError(s.repo.Create(ctx, c))
+	}
+
+	latest, err := s.repo.GetLatest(ctx, phone, entities.LoginOTPPurpose)
+	s.Require().NoError(err)
+	s.Equal(*second, *latest)
+
+	count, err := s.repo.CountSince(ctx, phone, now.Add(30*time.Second))
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	_, err = s.repo.GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestAttemptsAndConsume() {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC)
+	phone := "+77030000002"
+
+	challenge := entities.NewOTPChallenge(phone, entities.VerifyPhoneOTPPurpose, "hash", now, 5*time.Minute)
+	s.Require().NoError(s.repo.Create(ctx, challenge))
+
+	s.Require().NoError(s.repo.UseAttempt(ctx, challenge.ID, 2))
+	s.Require().NoError(s.repo.UseAttempt(ctx, challenge.ID, 2))
+	s.ErrorIs(s.repo.UseAttempt(ctx, challenge.ID, 2), entities.ErrNotFound)
+
+	s.Require().NoError(s.repo.Consume(ctx, challenge.ID, now.Add(time.Minute)))
+	s.ErrorIs(s.repo.Consume(ctx, challenge.ID, now.Add(2*time.Minute)), entities.ErrNotFound)
+
+	stored, err := s.repo.GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose)
+	s.Require().NoError(err)
+	s.Equal(2, stored.Attempts)
+	s.Require().NotNil(stored.ConsumedAt)
+	s.Equal(now.Add(time.Minute), *stored.ConsumedAt)
+	s.False(stored.IsUsableAt(now.Add(time.Minute), 5))
+
+	s.ErrorIs(s.repo.UseAttempt(ctx, challenge.ID, 5), entities.ErrNotFound)
+	s.ErrorIs(s.repo.UseAttempt(ctx, "unknown", 5), entities.ErrNotFound)
+}
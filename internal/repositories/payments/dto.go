@@ -0,0 +1,63 @@
+package payments
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type dto struct {
+	ID             string
+	ReservationID  sql.NullString
+	ShareID        sql.NullString
+	SeriesID       sql.NullString
+	UserID         string
+	Amount         int64
+	Currency       string
+	Status         string
+	RefundedAmount int64
+	Provider       string
+	ProviderRef    string
+	ClientSecret   string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func newDTO(p *entities.Payment) dto {
+	return dto{
+		ID:             p.ID,
+		ReservationID:  sql.NullString{String: p.ReservationID, Valid: p.ReservationID != ""},
+		ShareID:        sql.NullString{String: p.ShareID, Valid: p.ShareID != ""},
+		SeriesID:       sql.NullString{String: p.SeriesID, Valid: p.SeriesID != ""},
+		UserID:         p.UserID,
+		Amount:         p.Amount,
+		Currency:       p.Currency,
+		Status:         string(p.Status),
+		RefundedAmount: p.RefundedAmount,
+		Provider:       p.Provider,
+		ProviderRef:    p.ProviderRef,
+		ClientSecret:   p.ClientSecret,
+		CreatedAt:      p.CreatedAt.UTC(),
+		UpdatedAt:      p.UpdatedAt.UTC(),
+	}
+}
+
+func (d dto) toEntity() entities.Payment {
+	return entities.Payment{
+		ID:             d.ID,
+		ReservationID:  d.ReservationID.String,
+		ShareID:        d.ShareID.String,
+		SeriesID:       d.SeriesID.String,
+		UserID:         d.UserID,
+		Amount:         d.Amount,
+		Currency:       d.Currency,
+		Status:         entities.PaymentStatus(d.Status),
+		RefundedAmount: d.RefundedAmount,
+		Provider:       d.Provider,
+		ProviderRef:    d.ProviderRef,
+		ClientSecret:   d.ClientSecret,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
@@ -0,0 +1,616 @@
+package payments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type Repository struct {
+	connectionURL string
+	pool          *pgxpool.Pool
+}
+
+func NewRepository(connectionURL string) *Repository {
+	return &Repository{connectionURL: connectionURL}
+}
+
+func (r *Repository) Connect(ctx context.Context) error {
+	p, err := pgxpool.New(ctx, r.connectionURL)
+	if err != nil {
+		return fmt.Errorf("pgxpool new: %w", err)
+	}
+
+	r.pool = p
+
+	return nil
+}
+
+func (r *Repository) Close() {
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
+
+// Create stores the payment together with its first status event. It fails with ErrPaymentAlreadyExist
+// when the reservation, the share for share payments or the series for series payments already has a
+// pending or succeeded payment.
+func (r *Repository) Create(ctx context.Context, payment *entities.Payment) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	d := newDTO(payment)
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			createPaymentQuery,
+			d.ID,
+			d.ReservationID,
+			d.ShareID,
+			d.SeriesID,
+			d.UserID,
+			d.Amount,
+			d.Currency,
+			d.Status,
+			d.Provider,
+			d.ProviderRef,
+			d.ClientSecret,
+			d.CreatedAt,
+			d.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert payment: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, createPaymentEventQuery, d.ID, d.Status, nil, d.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("insert payment event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return entities.ErrPaymentAlreadyExist
+		}
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createPaymentQuery = `
+INSERT INTO payments(
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+`
+
+const createPaymentEventQuery = `
+INSERT INTO payment_events(
+	payment_id,
+	status,
+	reason,
+	created_at
+) VALUES ($1, $2, $3, $4)
+`
+
+func (r *Repository) GetByID(ctx context.Context, paymentID string) (*entities.Payment, error) {
+	return r.get(ctx, getPaymentByIDQuery, paymentID)
+}
+
+const getPaymentByIDQuery = `
+SELECT
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	refunded_amount,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+FROM payments
+WHERE id = $1
+LIMIT 1
+`
+
+func (r *Repository) GetByProviderRef(ctx context.Context, provider, providerRef string) (*entities.Payment, error) {
+	return r.get(ctx, getPaymentByProviderRefQuery, provider, providerRef)
+}
+
+const getPaymentByProviderRefQuery = `
+SELECT
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	refunded_amount,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+FROM payments
+WHERE provider = $1
+	AND provider_ref = $2
+LIMIT 1
+`
+
+// GetActiveByReservationID returns the pending or succeeded payment the booker made for the reservation.
+// Payments of shares are left out.
+func (r *Repository) GetActiveByReservationID(ctx context.Context, reservationID string) (*entities.Payment, error) {
+	return r.get(
+		ctx,
+		getActivePaymentByReservationIDQuery,
+		reservationID,
+		entities.PendingPaymentStatus,
+		entities.SucceededPaymentStatus,
+	)
+}
+
+const getActivePaymentByReservationIDQuery = `
+SELECT
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	refunded_amount,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+FROM payments
+WHERE reservation_id = $1
+	AND share_id IS NULL
+	AND status IN ($2, $3)
+LIMIT 1
+`
+
+// GetActiveByShareID returns the pending or succeeded payment of the share.
+func (r *Repository) GetActiveByShareID(ctx context.Context, shareID string) (*entities.Payment, error) {
+	return r.get(
+		ctx,
+		getActivePaymentByShareIDQuery,
+		shareID,
+		entities.PendingPaymentStatus,
+		entities.SucceededPaymentStatus,
+	)
+}
+
+const getActivePaymentByShareIDQuery = `
+SELECT
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	refunded_amount,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+FROM payments
+WHERE share_id = $1
+	AND status IN ($2, $3)
+LIMIT 1
+`
+
+// ListByReservationID returns every payment of the reservation, the ones of its shares included,
+// oldest first.
+func (r *Repository) ListByReservationID(ctx context.Context, reservationID string) ([]entities.Payment, error) {
+	return r.list(ctx, listPaymentsByReservationIDQuery, reservationID)
+}
+
+const listPaymentsByReservationIDQuery = `
+SELECT
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	refunded_amount,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+FROM payments
+WHERE reservation_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+// ListBySeriesID returns every payment made for the holds of the series, oldest first.
+func (r *Repository) ListBySeriesID(ctx context.Context, seriesID string) ([]entities.Payment, error) {
+	return r.list(ctx, listPaymentsBySeriesIDQuery, seriesID)
+}
+
+const listPaymentsBySeriesIDQuery = `
+SELECT
+	id,
+	reservation_id,
+	share_id,
+	series_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	refunded_amount,
+	provider,
+	provider_ref,
+	client_secret,
+	created_at,
+	updated_at
+FROM payments
+WHERE series_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+func (r *Repository) list(ctx context.Context, query string, args ...any) ([]entities.Payment, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []entities.Payment
+
+	for rows.Next() {
+		payment, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan payment: %w", err)
+		}
+
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	for i := range payments {
+		history, err := r.listEvents(ctx, payments[i].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		payments[i].History = history
+	}
+
+	return payments, nil
+}
+
+func (r *Repository) get(ctx context.Context, query string, args ...any) (*entities.Payment, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	payment, err := scan(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan payment: %w", err)
+	}
+
+	history, err := r.listEvents(ctx, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.History = history
+
+	return &payment, nil
+}
+
+func (r *Repository) listEvents(ctx context.Context, paymentID string) ([]entities.PaymentEvent, error) {
+	rows, err := r.pool.Query(ctx, listPaymentEventsQuery, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("query payment events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []entities.PaymentEvent
+
+	for rows.Next() {
+		var (
+			event  entities.PaymentEvent
+			status string
+			reason sql.NullString
+		)
+
+		if err := rows.Scan(&status, &reason, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan payment event: %w", err)
+		}
+
+		event.Status = entities.PaymentStatus(status)
+		event.Reason = reason.String
+		event.CreatedAt = event.CreatedAt.UTC()
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return events, nil
+}
+
+const listPaymentEventsQuery = `
+SELECT
+	status,
+	reason,
+	created_at
+FROM payment_events
+WHERE payment_id = $1
+ORDER BY id ASC
+`
+
+// UpdateStatus moves the payment from one status to the next and records the change in its history.
+// It fails with ErrInvalidPaymentTransition when the payment is no longer in the from status.
+func (r *Repository) UpdateStatus(
+	ctx context.Context,
+	paymentID string,
+	from, to entities.PaymentStatus,
+	reason string,
+	now time.Time,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, updatePaymentStatusQuery, to, now, paymentID, from)
+		if err != nil {
+			return fmt.Errorf("update payment status: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: payment %s is not %s", entities.ErrInvalidPaymentTransition, paymentID, from)
+		}
+
+		_, err = tx.Exec(ctx, createPaymentEventQuery, paymentID, to, nullableString(reason), now)
+		if err != nil {
+			return fmt.Errorf("insert payment event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const updatePaymentStatusQuery = `
+UPDATE payments
+SET status = $1,
+	updated_at = $2
+WHERE id = $3
+	AND status = $4
+`
+
+// RecordRefund moves the succeeded payment to status, refunded or partially refunded, and records the
+// amount given back. It fails with ErrInvalidPaymentTransition when the payment is no longer succeeded.
+func (r *Repository) RecordRefund(
+	ctx context.Context,
+	paymentID string,
+	status entities.PaymentStatus,
+	amount int64,
+	reason string,
+	now time.Time,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			recordRefundQuery,
+			status,
+			amount,
+			now,
+			paymentID,
+			entities.SucceededPaymentStatus,
+		)
+		if err != nil {
+			return fmt.Errorf("update payment refund: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: payment %s is not %s",
+				entities.ErrInvalidPaymentTransition, paymentID, entities.SucceededPaymentStatus)
+		}
+
+		_, err = tx.Exec(ctx, createPaymentEventQuery, paymentID, status, nullableString(reason), now)
+		if err != nil {
+			return fmt.Errorf("insert payment event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const recordRefundQuery = `
+UPDATE payments
+SET status = $1,
+	refunded_amount = $2,
+	updated_at = $3
+WHERE id = $4
+	AND status = $5
+`
+
+// RecordOccurrenceRefund adds amount, given back for one cancelled occurrence of the series the payment
+// paid, to the refunded amount of the payment. The payment is refunded once nothing of it is left, partially
+// refunded until then. An occurrence that was recorded before is left alone, so a retried refund counts once.
+// It fails with ErrInvalidPaymentTransition when the payment is neither succeeded nor partially refunded.
+func (r *Repository) RecordOccurrenceRefund(
+	ctx context.Context,
+	paymentID, reservationID string,
+	amount int64,
+	reason string,
+	now time.Time,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, createPaymentRefundQuery, paymentID, reservationID, amount, now)
+		if err != nil {
+			return fmt.Errorf("insert payment refund: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		var status string
+
+		err = tx.QueryRow(
+			ctx,
+			addRefundQuery,
+			amount,
+			now,
+			paymentID,
+			entities.SucceededPaymentStatus,
+			entities.PartiallyRefundedPaymentStatus,
+			entities.RefundedPaymentStatus,
+		).Scan(&status)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%w: payment %s is not %s", entities.ErrInvalidPaymentTransition,
+					paymentID, entities.SucceededPaymentStatus)
+			}
+			return fmt.Errorf("update payment refund: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, createPaymentEventQuery, paymentID, status, nullableString(reason), now)
+		if err != nil {
+			return fmt.Errorf("insert payment event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createPaymentRefundQuery = `
+INSERT INTO payment_refunds(
+	payment_id,
+	reservation_id,
+	amount,
+	created_at
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (payment_id, reservation_id) DO NOTHING
+`
+
+const addRefundQuery = `
+UPDATE payments
+SET refunded_amount = refunded_amount + $1,
+	status = CASE WHEN refunded_amount + $1 >= amount THEN $6 ELSE $5 END,
+	updated_at = $2
+WHERE id = $3
+	AND status IN ($4, $5)
+RETURNING status
+`
+
+func nullableString(s string) any {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scan(scanner rowScanner) (entities.Payment, error) {
+	var d dto
+
+	err := scanner.Scan(
+		&d.ID,
+		&d.ReservationID,
+		&d.ShareID,
+		&d.SeriesID,
+		&d.UserID,
+		&d.Amount,
+		&d.Currency,
+		&d.Status,
+		&d.RefundedAmount,
+		&d.Provider,
+		&d.ProviderRef,
+		&d.ClientSecret,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		return entities.Payment{}, err
+	}
+
+	d.CreatedAt = d.CreatedAt.UTC()
+	d.UpdatedAt = d.UpdatedAt.UTC()
+
+	return d.toEntity(), nil
+}
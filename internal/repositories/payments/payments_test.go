@@ -0,0 +1,224 @@
+package payments_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/repositories/payments"
+	"github.com/lever-dev/padel-backend/internal/repositories/reservation"
+)
+
+type repositorySuite struct {
+	suite.Suite
+	connString string
+	repo       *payments.Repository
+}
+
+func TestRepositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) SetupTest() {
+	s.connString = os.Getenv("POSTGRES_CONNECTION_URL")
+	require.NotEmpty(s.T(), s.connString, "POSTGRES_CONNECTION_URL must be set")
+
+	repo := payments.NewRepository(s.connString)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := repo.Connect(ctx)
+	require.NoError(s.T(), err)
+
+	s.repo = repo
+}
+
+func (s *repositorySuite) TearDownTest() {
+	if s.repo != nil {
+		s.repo.Close()
+	}
+}
+
+func (s *repositorySuite) newPayment(id, reservationID string, now time.Time) *entities.Payment {
+	return &entities.Payment{
+		ID:            id,
+		ReservationID: reservationID,
+		UserID:        "user-1",
+		Amount:        3000,
+		Currency:      "EUR",
+		Status:        entities.PendingPaymentStatus,
+		Provider:      "fake",
+		ProviderRef:   "fake_pi_" + id,
+		ClientSecret:  "fake_pi_" + id + "_secret",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		History:       []entities.PaymentEvent{{Status: entities.PendingPaymentStatus, CreatedAt: now}},
+	}
+}
+
+func (s *repositorySuite) TestCreateAndGet() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+
+	payment := s.newPayment("pay-create-1", "res-pay-1", now)
+	s.Require().NoError(s.repo.Create(ctx, payment))
+
+	byID, err := s.repo.GetByID(ctx, payment.ID)
+	s.Require().NoError(err)
+	s.Equal(payment, byID)
+
+	byRef, err := s.repo.GetByProviderRef(ctx, "fake", payment.ProviderRef)
+	s.Require().NoError(err)
+	s.Equal(payment, byRef)
+
+	active, err := s.repo.GetActiveByReservationID(ctx, payment.ReservationID)
+	s.Require().NoError(err)
+	s.Equal(payment, active)
+
+	_, err = s.repo.GetByID(ctx, "pay-missing")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	err = s.repo.Create(ctx, s.newPayment("pay-create-2", payment.ReservationID, now))
+	s.ErrorIs(err, entities.ErrPaymentAlreadyExist)
+}
+
+func (s *repositorySuite) TestUpdateStatus() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 2, 8, 0, 0, 0, time.UTC)
+
+	payment := s.newPayment("pay-status-1", "res-pay-2", now)
+	s.Require().NoError(s.repo.Create(ctx, payment))
+
+	err := s.repo.UpdateStatus(
+		ctx, payment.ID, entities.PendingPaymentStatus, entities.FailedPaymentStatus, "card declined", now.Add(time.Minute),
+	)
+	s.Require().NoError(err)
+
+	err = s.repo.UpdateStatus(
+		ctx, payment.ID, entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", now.Add(time.Minute),
+	)
+	s.ErrorIs(err, entities.ErrInvalidPaymentTransition)
+
+	_, err = s.repo.GetActiveByReservationID(ctx, payment.ReservationID)
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	// a failed payment does not block paying the reservation again
+	retry := s.newPayment("pay-status-2", payment.ReservationID, now.Add(2*time.Minute))
+	s.Require().NoError(s.repo.Create(ctx, retry))
+
+	failed, err := s.repo.GetByID(ctx, payment.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.FailedPaymentStatus, failed.Status)
+	s.Equal(now.Add(time.Minute), failed.UpdatedAt)
+	s.Equal([]entities.PaymentEvent{
+		{Status: entities.PendingPaymentStatus, CreatedAt: now},
+		{Status: entities.FailedPaymentStatus, Reason: "card declined", CreatedAt: now.Add(time.Minute)},
+	}, failed.History)
+}
+
+func (s *repositorySuite) TestRecordRefund() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 3, 8, 0, 0, 0, time.UTC)
+
+	payment := s.newPayment("pay-refund-1", "res-pay-refund-1", now)
+	s.Require().NoError(s.repo.Create(ctx, payment))
+
+	// only succeeded payments are refunded
+	err := s.repo.RecordRefund(
+		ctx, payment.ID, entities.PartiallyRefundedPaymentStatus, payment.Amount/2, "cancelled", now,
+	)
+	s.ErrorIs(err, entities.ErrInvalidPaymentTransition)
+
+	err = s.repo.UpdateStatus(ctx, payment.ID, entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", now)
+	s.Require().NoError(err)
+
+	err = s.repo.RecordRefund(
+		ctx, payment.ID, entities.PartiallyRefundedPaymentStatus, payment.Amount/2, "cancelled", now.Add(time.Hour),
+	)
+	s.Require().NoError(err)
+
+	// the rest of a partial refund is not given back later
+	err = s.repo.RecordRefund(
+		ctx, payment.ID, entities.RefundedPaymentStatus, payment.Amount, "cancelled", now.Add(time.Hour),
+	)
+	s.ErrorIs(err, entities.ErrInvalidPaymentTransition)
+
+	refunded, err := s.repo.GetByID(ctx, payment.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.PartiallyRefundedPaymentStatus, refunded.Status)
+	s.Equal(payment.Amount/2, refunded.RefundedAmount)
+	s.Equal(
+		entities.PaymentEvent{
+			Status:    entities.PartiallyRefundedPaymentStatus,
+			Reason:    "cancelled",
+			CreatedAt: now.Add(time.Hour),
+		},
+		refunded.History[len(refunded.History)-1],
+	)
+}
+
+func (s *repositorySuite) TestSeriesPayment() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 4, 8, 0, 0, 0, time.UTC)
+
+	reservations := reservation.NewRepository(s.connString)
+	s.Require().NoError(reservations.Connect(ctx))
+	defer reservations.Close()
+
+	series := &entities.ReservationSeries{
+		ID:         "series-pay-1",
+		CourtID:    "court-pay-1",
+		Rule:       entities.RecurrenceRule{Frequency: entities.WeeklyRecurrence, Interval: 1},
+		StartDate:  time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		StartTime:  19 * 60,
+		Duration:   time.Hour,
+		ReservedBy: "user-1",
+		CreatedAt:  now,
+	}
+	s.Require().NoError(reservations.CreateSeries(ctx, series))
+
+	payment := s.newPayment("pay-series-1", "", now)
+	payment.SeriesID = series.ID
+	payment.Amount = 6000
+	s.Require().NoError(s.repo.Create(ctx, payment))
+
+	listed, err := s.repo.ListBySeriesID(ctx, series.ID)
+	s.Require().NoError(err)
+	s.Equal([]entities.Payment{*payment}, listed)
+
+	again := s.newPayment("pay-series-2", "", now)
+	again.SeriesID = series.ID
+	s.ErrorIs(s.repo.Create(ctx, again), entities.ErrPaymentAlreadyExist)
+
+	// only settled payments are refunded
+	err = s.repo.RecordOccurrenceRefund(ctx, payment.ID, "res-series-pay-1", 1500, "cancelled", now)
+	s.ErrorIs(err, entities.ErrInvalidPaymentTransition)
+
+	err = s.repo.UpdateStatus(ctx, payment.ID, entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", now)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.RecordOccurrenceRefund(ctx, payment.ID, "res-series-pay-1", 1500, "cancelled", now))
+
+	// a retried refund of the same occurrence counts once
+	s.Require().NoError(s.repo.RecordOccurrenceRefund(ctx, payment.ID, "res-series-pay-1", 1500, "cancelled", now))
+
+	partly, err := s.repo.GetByID(ctx, payment.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.PartiallyRefundedPaymentStatus, partly.Status)
+	s.Equal(int64(1500), partly.RefundedAmount)
+
+	s.Require().NoError(s.repo.RecordOccurrenceRefund(ctx, payment.ID, "res-series-pay-2", 4500, "cancelled", now))
+
+	refunded, err := s.repo.GetByID(ctx, payment.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.RefundedPaymentStatus, refunded.Status)
+	s.Equal(int64(6000), refunded.RefundedAmount)
+	s.Len(refunded.History, 4)
+}
@@ -0,0 +1,232 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// CreateShares stores the shares of a split reservation at once. It fails with ErrReservationAlreadySplit
+// when one of the players already has a share of the reservation.
+func (r *Repository) CreateShares(ctx context.Context, shares []entities.PaymentShare) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		for _, share := range shares {
+			_, err := tx.Exec(
+				ctx,
+				createShareQuery,
+				share.ID,
+				share.ReservationID,
+				share.UserID,
+				share.Amount,
+				share.Currency,
+				share.Status,
+				share.CreatedAt.UTC(),
+				share.UpdatedAt.UTC(),
+			)
+			if err != nil {
+				return fmt.Errorf("insert share %s: %w", share.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return entities.ErrReservationAlreadySplit
+		}
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createShareQuery = `
+INSERT INTO payment_shares(
+	id,
+	reservation_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	created_at,
+	updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+func (r *Repository) GetShare(ctx context.Context, shareID string) (*entities.PaymentShare, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	share, err := scanShare(r.pool.QueryRow(ctx, getShareQuery, shareID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan share: %w", err)
+	}
+
+	return &share, nil
+}
+
+const getShareQuery = `
+SELECT
+	id,
+	reservation_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	created_at,
+	updated_at
+FROM payment_shares
+WHERE id = $1
+LIMIT 1
+`
+
+// ListShares returns the shares of the reservation, nil when it is not split.
+func (r *Repository) ListShares(ctx context.Context, reservationID string) ([]entities.PaymentShare, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listSharesQuery, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("query shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []entities.PaymentShare
+
+	for rows.Next() {
+		share, err := scanShare(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan share: %w", err)
+		}
+
+		shares = append(shares, share)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return shares, nil
+}
+
+const listSharesQuery = `
+SELECT
+	id,
+	reservation_id,
+	user_id,
+	amount,
+	currency,
+	status,
+	created_at,
+	updated_at
+FROM payment_shares
+WHERE reservation_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+// MarkSharePaid settles an unpaid share. It fails with ErrInvalidPaymentTransition when the share is
+// no longer unpaid, e.g. because the booker covered it.
+func (r *Repository) MarkSharePaid(ctx context.Context, shareID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		updateShareStatusQuery,
+		entities.PaidShareStatus,
+		now,
+		shareID,
+		entities.UnpaidShareStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: share %s is not unpaid", entities.ErrInvalidPaymentTransition, shareID)
+	}
+
+	return nil
+}
+
+const updateShareStatusQuery = `
+UPDATE payment_shares
+SET status = $1,
+	updated_at = $2
+WHERE id = $3
+	AND status = $4
+`
+
+// CoverShares marks every unpaid share of the reservation as covered by the booker and returns how many
+// shares were covered.
+func (r *Repository) CoverShares(ctx context.Context, reservationID string, now time.Time) (int64, error) {
+	if r.pool == nil {
+		return 0, fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		coverSharesQuery,
+		entities.CoveredShareStatus,
+		now,
+		reservationID,
+		entities.UnpaidShareStatus,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("exec: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+const coverSharesQuery = `
+UPDATE payment_shares
+SET status = $1,
+	updated_at = $2
+WHERE reservation_id = $3
+	AND status = $4
+`
+
+func scanShare(scanner rowScanner) (entities.PaymentShare, error) {
+	var (
+		share  entities.PaymentShare
+		status string
+	)
+
+	err := scanner.Scan(
+		&share.ID,
+		&share.ReservationID,
+		&share.UserID,
+		&share.Amount,
+		&share.Currency,
+		&status,
+		&share.CreatedAt,
+		&share.UpdatedAt,
+	)
+	if err != nil {
+		return entities.PaymentShare{}, err
+	}
+
+	share.Status = entities.ShareStatus(status)
+	share.CreatedAt = share.CreatedAt.UTC()
+	share.UpdatedAt = share.UpdatedAt.UTC()
+
+	return share, nil
+}
@@ -0,0 +1,90 @@
+package payments_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestShares() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 3, 8, 0, 0, 0, time.UTC)
+
+	rsv := &entities.Reservation{ID: "res-split-1", Price: entities.Price{Amount: 3001, Currency: "EUR"}}
+	shares := entities.SplitPrice(rsv, []string{"user-1", "user-2"}, now)
+	s.Require().NoError(s.repo.CreateShares(ctx, shares))
+
+	err := s.repo.CreateShares(ctx, entities.SplitPrice(rsv, []string{"user-2"}, now))
+	s.ErrorIs(err, entities.ErrReservationAlreadySplit)
+
+	listed, err := s.repo.ListShares(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.ElementsMatch(shares, listed)
+
+	share, err := s.repo.GetShare(ctx, shares[0].ID)
+	s.Require().NoError(err)
+	s.Equal(shares[0], *share)
+
+	_, err = s.repo.GetShare(ctx, "share-missing")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	s.Require().NoError(s.repo.MarkSharePaid(ctx, shares[0].ID, now.Add(time.Minute)))
+	s.ErrorIs(s.repo.MarkSharePaid(ctx, shares[0].ID, now.Add(time.Minute)), entities.ErrInvalidPaymentTransition)
+
+	covered, err := s.repo.CoverShares(ctx, rsv.ID, now.Add(2*time.Minute))
+	s.Require().NoError(err)
+	s.Equal(int64(1), covered)
+
+	listed, err = s.repo.ListShares(ctx, rsv.ID)
+	s.Require().NoError(err)
+
+	statuses := map[string]entities.ShareStatus{}
+	for _, share := range listed {
+		statuses[share.UserID] = share.Status
+	}
+	s.Equal(map[string]entities.ShareStatus{
+		"user-1": entities.PaidShareStatus,
+		"user-2": entities.CoveredShareStatus,
+	}, statuses)
+}
+
+func (s *repositorySuite) TestSharePayments() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 4, 8, 0, 0, 0, time.UTC)
+
+	rsv := &entities.Reservation{ID: "res-split-2", Price: entities.Price{Amount: 4000, Currency: "EUR"}}
+	shares := entities.SplitPrice(rsv, []string{"user-1", "user-2"}, now)
+	s.Require().NoError(s.repo.CreateShares(ctx, shares))
+
+	first := s.newPayment("pay-share-1", rsv.ID, now)
+	first.ShareID = shares[0].ID
+	first.Amount = shares[0].Amount
+	s.Require().NoError(s.repo.Create(ctx, first))
+
+	second := s.newPayment("pay-share-2", rsv.ID, now.Add(time.Minute))
+	second.ShareID = shares[1].ID
+	second.UserID = "user-2"
+	second.Amount = shares[1].Amount
+	s.Require().NoError(s.repo.Create(ctx, second))
+
+	// the booker covering the rest does not clash with the payments of the shares
+	cover := s.newPayment("pay-share-cover", rsv.ID, now.Add(2*time.Minute))
+	s.Require().NoError(s.repo.Create(ctx, cover))
+
+	duplicate := s.newPayment("pay-share-3", rsv.ID, now.Add(3*time.Minute))
+	duplicate.ShareID = shares[0].ID
+	s.ErrorIs(s.repo.Create(ctx, duplicate), entities.ErrPaymentAlreadyExist)
+
+	byShare, err := s.repo.GetActiveByShareID(ctx, shares[1].ID)
+	s.Require().NoError(err)
+	s.Equal(second, byShare)
+
+	active, err := s.repo.GetActiveByReservationID(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Equal(cover, active)
+
+	listed, err := s.repo.ListByReservationID(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Equal([]entities.Payment{*first, *second, *cover}, listed)
+}
@@ -0,0 +1,143 @@
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// UpsertBookingRules stores the rules, replacing the previous rules of the same organization and court.
+func (r *Repository) UpsertBookingRules(ctx context.Context, rules *entities.BookingRules) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if rules.UpdatedAt.IsZero() {
+		rules.UpdatedAt = time.Now().UTC()
+	}
+
+	durations := make([]int32, 0, len(rules.Durations))
+	for _, d := range rules.Durations {
+		durations = append(durations, minutes(d))
+	}
+
+	_, err := r.pool.Exec(
+		ctx,
+		upsertBookingRulesQuery,
+		rules.OrganizationID,
+		rules.CourtID,
+		minutes(rules.MinDuration),
+		minutes(rules.MaxDuration),
+		durations,
+		minutes(rules.SlotInterval),
+		minutes(rules.MaxAdvance),
+		minutes(rules.MinNotice),
+		rules.MaxActiveBookings,
+		rules.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec upsert booking rules: %w", err)
+	}
+
+	return nil
+}
+
+const upsertBookingRulesQuery = `
+	INSERT INTO booking_rules(
+		organization_id,
+		court_id,
+		min_duration_minutes,
+		max_duration_minutes,
+		durations_minutes,
+		slot_interval_minutes,
+		max_advance_minutes,
+		min_notice_minutes,
+		max_active_bookings,
+		updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	ON CONFLICT (organization_id, court_id) DO UPDATE SET
+		min_duration_minutes = EXCLUDED.min_duration_minutes,
+		max_duration_minutes = EXCLUDED.max_duration_minutes,
+		durations_minutes = EXCLUDED.durations_minutes,
+		slot_interval_minutes = EXCLUDED.slot_interval_minutes,
+		max_advance_minutes = EXCLUDED.max_advance_minutes,
+		min_notice_minutes = EXCLUDED.min_notice_minutes,
+		max_active_bookings = EXCLUDED.max_active_bookings,
+		updated_at = EXCLUDED.updated_at
+`
+
+// GetBookingRules returns the rules of the court, or the organization default when courtID is empty.
+func (r *Repository) GetBookingRules(
+	ctx context.Context,
+	organizationID, courtID string,
+) (*entities.BookingRules, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var (
+		rules                    entities.BookingRules
+		minDuration, maxDuration int32
+		slotInterval             int32
+		maxAdvance, minNotice    int32
+		durations                []int32
+	)
+
+	err := r.pool.QueryRow(ctx, getBookingRulesQuery, organizationID, courtID).Scan(
+		&rules.OrganizationID,
+		&rules.CourtID,
+		&minDuration,
+		&maxDuration,
+		&durations,
+		&slotInterval,
+		&maxAdvance,
+		&minNotice,
+		&rules.MaxActiveBookings,
+		&rules.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan booking rules: %w", err)
+	}
+
+	rules.MinDuration = time.Duration(minDuration) * time.Minute
+	rules.MaxDuration = time.Duration(maxDuration) * time.Minute
+	rules.SlotInterval = time.Duration(slotInterval) * time.Minute
+	rules.MaxAdvance = time.Duration(maxAdvance) * time.Minute
+	rules.MinNotice = time.Duration(minNotice) * time.Minute
+
+	for _, d := range durations {
+		rules.Durations = append(rules.Durations, time.Duration(d)*time.Minute)
+	}
+
+	rules.UpdatedAt = rules.UpdatedAt.UTC()
+
+	return &rules, nil
+}
+
+const getBookingRulesQuery = `
+	SELECT
+		organization_id,
+		court_id,
+		min_duration_minutes,
+		max_duration_minutes,
+		durations_minutes,
+		slot_interval_minutes,
+		max_advance_minutes,
+		min_notice_minutes,
+		max_active_bookings,
+		updated_at
+	FROM booking_rules
+	WHERE organization_id = $1
+		AND court_id = $2
+`
+
+func minutes(d time.Duration) int32 {
+	return int32(d / time.Minute)
+}
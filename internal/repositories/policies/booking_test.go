@@ -0,0 +1,46 @@
+package policies_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestUpsertAndGetBookingRules() {
+	ctx := context.Background()
+
+	_, err := s.repo.GetBookingRules(ctx, "org-booking-1", "")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	rules := &entities.BookingRules{
+		OrganizationID:    "org-booking-1",
+		CourtID:           "court-booking-1",
+		Durations:         []time.Duration{60 * time.Minute, 90 * time.Minute, 120 * time.Minute},
+		SlotInterval:      30 * time.Minute,
+		MaxAdvance:        14 * 24 * time.Hour,
+		MinNotice:         time.Hour,
+		MaxActiveBookings: 3,
+		UpdatedAt:         time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	s.Require().NoError(s.repo.UpsertBookingRules(ctx, rules))
+
+	rulesDB, err := s.repo.GetBookingRules(ctx, rules.OrganizationID, rules.CourtID)
+	s.Require().NoError(err)
+	s.Equal(rules, rulesDB)
+
+	// the court rules do not stand in for the organization default
+	_, err = s.repo.GetBookingRules(ctx, rules.OrganizationID, "")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	rules.Durations = nil
+	rules.MinDuration = time.Hour
+	rules.MaxDuration = 2 * time.Hour
+	rules.UpdatedAt = rules.UpdatedAt.Add(time.Hour)
+	s.Require().NoError(s.repo.UpsertBookingRules(ctx, rules))
+
+	rulesDB, err = s.repo.GetBookingRules(ctx, rules.OrganizationID, rules.CourtID)
+	s.Require().NoError(err)
+	s.Equal(rules, rulesDB)
+}
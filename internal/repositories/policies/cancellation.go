@@ -0,0 +1,111 @@
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type tierDTO struct {
+	NoticeMinutes int64 `json:"noticeMinutes"`
+	RefundPercent int   `json:"refundPercent"`
+}
+
+// UpsertCancellationPolicy stores the policy, replacing the previous policy of the organization.
+func (r *Repository) UpsertCancellationPolicy(ctx context.Context, policy *entities.CancellationPolicy) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if policy.UpdatedAt.IsZero() {
+		policy.UpdatedAt = time.Now().UTC()
+	}
+
+	tiers := make([]tierDTO, 0, len(policy.Tiers))
+	for _, t := range policy.Tiers {
+		tiers = append(tiers, tierDTO{
+			NoticeMinutes: int64(t.Notice / time.Minute),
+			RefundPercent: t.RefundPercent,
+		})
+	}
+
+	rawTiers, err := json.Marshal(tiers)
+	if err != nil {
+		return fmt.Errorf("marshal tiers: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, upsertCancellationPolicyQuery, policy.OrganizationID, rawTiers, policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("exec upsert cancellation policy: %w", err)
+	}
+
+	return nil
+}
+
+const upsertCancellationPolicyQuery = `
+	INSERT INTO cancellation_policies(
+		organization_id,
+		tiers,
+		updated_at
+	) VALUES ($1, $2, $3)
+	ON CONFLICT (organization_id) DO UPDATE SET
+		tiers = EXCLUDED.tiers,
+		updated_at = EXCLUDED.updated_at
+`
+
+// GetCancellationPolicy returns the policy of the organization, ErrNotFound when it has none.
+func (r *Repository) GetCancellationPolicy(
+	ctx context.Context,
+	organizationID string,
+) (*entities.CancellationPolicy, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var (
+		policy   entities.CancellationPolicy
+		rawTiers []byte
+	)
+
+	err := r.pool.QueryRow(ctx, getCancellationPolicyQuery, organizationID).Scan(
+		&policy.OrganizationID,
+		&rawTiers,
+		&policy.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan cancellation policy: %w", err)
+	}
+
+	var tiers []tierDTO
+	if err := json.Unmarshal(rawTiers, &tiers); err != nil {
+		return nil, fmt.Errorf("unmarshal tiers: %w", err)
+	}
+
+	for _, t := range tiers {
+		policy.Tiers = append(policy.Tiers, entities.CancellationTier{
+			Notice:        time.Duration(t.NoticeMinutes) * time.Minute,
+			RefundPercent: t.RefundPercent,
+		})
+	}
+
+	policy.UpdatedAt = policy.UpdatedAt.UTC()
+
+	return &policy, nil
+}
+
+const getCancellationPolicyQuery = `
+	SELECT
+		organization_id,
+		tiers,
+		updated_at
+	FROM cancellation_policies
+	WHERE organization_id = $1
+`
@@ -0,0 +1,38 @@
+package policies_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestUpsertAndGetCancellationPolicy() {
+	ctx := context.Background()
+
+	_, err := s.repo.GetCancellationPolicy(ctx, "org-cancellation-1")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	policy := &entities.CancellationPolicy{
+		OrganizationID: "org-cancellation-1",
+		Tiers: []entities.CancellationTier{
+			{Notice: 24 * time.Hour, RefundPercent: 100},
+			{Notice: 6 * time.Hour, RefundPercent: 50},
+		},
+		UpdatedAt: time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	s.Require().NoError(s.repo.UpsertCancellationPolicy(ctx, policy))
+
+	policyDB, err := s.repo.GetCancellationPolicy(ctx, policy.OrganizationID)
+	s.Require().NoError(err)
+	s.Equal(policy, policyDB)
+
+	policy.Tiers = policy.Tiers[:1]
+	policy.UpdatedAt = policy.UpdatedAt.Add(time.Hour)
+	s.Require().NoError(s.repo.UpsertCancellationPolicy(ctx, policy))
+
+	policyDB, err = s.repo.GetCancellationPolicy(ctx, policy.OrganizationID)
+	s.Require().NoError(err)
+	s.Equal(policy, policyDB)
+}
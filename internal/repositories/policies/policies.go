@@ -0,0 +1,34 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	connectionURL string
+	pool          *pgxpool.Pool
+}
+
+func NewRepository(connectionURL string) *Repository {
+	return &Repository{connectionURL: connectionURL}
+}
+
+func (r *Repository) Connect(ctx context.Context) error {
+	p, err := pgxpool.New(ctx, r.connectionURL)
+	if err != nil {
+		return fmt.Errorf("pgxpool new: %w", err)
+	}
+
+	r.pool = p
+
+	return nil
+}
+
+func (r *Repository) Close() {
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
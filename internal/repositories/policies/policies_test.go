@@ -0,0 +1,43 @@
+package policies_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/lever-dev/padel-backend/internal/repositories/policies"
+)
+
+type repositorySuite struct {
+	suite.Suite
+	repo *policies.Repository
+}
+
+func TestRepositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) SetupTest() {
+	connString := os.Getenv("POSTGRES_CONNECTION_URL")
+	require.NotEmpty(s.T(), connString, "POSTGRES_CONNECTION_URL must be set")
+
+	repo := policies.NewRepository(connString)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := repo.Connect(ctx)
+	require.NoError(s.T(), err)
+
+	s.repo = repo
+}
+
+func (s *repositorySuite) TearDownTest() {
+	if s.repo != nil {
+		s.repo.Close()
+	}
+}
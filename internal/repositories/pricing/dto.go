@@ -0,0 +1,137 @@
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+const holidayLayout = "2006-01-02"
+
+type dto struct {
+	OrganizationID    string
+	CourtID           string
+	Currency          string
+	BaseHourlyRate    int64
+	Bands             []byte
+	Holidays          []byte
+	HolidayHourlyRate int64
+	MinimumCharge     int64
+	UpdatedAt         time.Time
+}
+
+type bandDTO struct {
+	Weekdays   []int  `json:"weekdays"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	HourlyRate int64  `json:"hourlyRate"`
+}
+
+func newDTO(r *entities.PricingRule) (dto, error) {
+	bands := make([]bandDTO, 0, len(r.Bands))
+	for _, b := range r.Bands {
+		weekdays := make([]int, 0, len(b.Weekdays))
+		for _, wd := range b.Weekdays {
+			weekdays = append(weekdays, int(wd))
+		}
+
+		bands = append(bands, bandDTO{
+			Weekdays:   weekdays,
+			From:       b.From.String(),
+			To:         b.To.String(),
+			HourlyRate: b.HourlyRate,
+		})
+	}
+
+	rawBands, err := json.Marshal(bands)
+	if err != nil {
+		return dto{}, fmt.Errorf("marshal bands: %w", err)
+	}
+
+	holidays := make([]string, 0, len(r.Holidays))
+	for _, h := range r.Holidays {
+		holidays = append(holidays, h.Format(holidayLayout))
+	}
+
+	rawHolidays, err := json.Marshal(holidays)
+	if err != nil {
+		return dto{}, fmt.Errorf("marshal holidays: %w", err)
+	}
+
+	return dto{
+		OrganizationID:    r.OrganizationID,
+		CourtID:           r.CourtID,
+		Currency:          r.Currency,
+		BaseHourlyRate:    r.BaseHourlyRate,
+		Bands:             rawBands,
+		Holidays:          rawHolidays,
+		HolidayHourlyRate: r.HolidayHourlyRate,
+		MinimumCharge:     r.MinimumCharge,
+		UpdatedAt:         r.UpdatedAt,
+	}, nil
+}
+
+func (d dto) toEntity() (entities.PricingRule, error) {
+	var bands []bandDTO
+	if len(d.Bands) > 0 {
+		if err := json.Unmarshal(d.Bands, &bands); err != nil {
+			return entities.PricingRule{}, fmt.Errorf("unmarshal bands: %w", err)
+		}
+	}
+
+	var priceBands []entities.PriceBand
+	for _, b := range bands {
+		from, err := entities.ParseTimeOfDay(b.From)
+		if err != nil {
+			return entities.PricingRule{}, err
+		}
+
+		to, err := entities.ParseTimeOfDay(b.To)
+		if err != nil {
+			return entities.PricingRule{}, err
+		}
+
+		var weekdays []time.Weekday
+		for _, wd := range b.Weekdays {
+			weekdays = append(weekdays, time.Weekday(wd))
+		}
+
+		priceBands = append(priceBands, entities.PriceBand{
+			Weekdays:   weekdays,
+			From:       from,
+			To:         to,
+			HourlyRate: b.HourlyRate,
+		})
+	}
+
+	var rawHolidays []string
+	if len(d.Holidays) > 0 {
+		if err := json.Unmarshal(d.Holidays, &rawHolidays); err != nil {
+			return entities.PricingRule{}, fmt.Errorf("unmarshal holidays: %w", err)
+		}
+	}
+
+	var holidays []time.Time
+	for _, h := range rawHolidays {
+		day, err := time.Parse(holidayLayout, h)
+		if err != nil {
+			return entities.PricingRule{}, fmt.Errorf("parse holiday %q: %w", h, err)
+		}
+
+		holidays = append(holidays, day)
+	}
+
+	return entities.PricingRule{
+		OrganizationID:    d.OrganizationID,
+		CourtID:           d.CourtID,
+		Currency:          d.Currency,
+		BaseHourlyRate:    d.BaseHourlyRate,
+		Bands:             priceBands,
+		Holidays:          holidays,
+		HolidayHourlyRate: d.HolidayHourlyRate,
+		MinimumCharge:     d.MinimumCharge,
+		UpdatedAt:         d.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type Repository struct {
+	connectionURL string
+	pool          *pgxpool.Pool
+}
+
+func NewRepository(connectionURL string) *Repository {
+	return &Repository{connectionURL: connectionURL}
+}
+
+func (r *Repository) Connect(ctx context.Context) error {
+	p, err := pgxpool.New(ctx, r.connectionURL)
+	if err != nil {
+		return fmt.Errorf("pgxpool new: %w", err)
+	}
+
+	r.pool = p
+
+	return nil
+}
+
+func (r *Repository) Close() {
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
+
+// Upsert stores the rule, replacing the previous rule of the same organization and court.
+func (r *Repository) Upsert(ctx context.Context, rule *entities.PricingRule) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if rule.UpdatedAt.IsZero() {
+		rule.UpdatedAt = time.Now().UTC()
+	}
+
+	d, err := newDTO(rule)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(
+		ctx,
+		upsertRuleQuery,
+		d.OrganizationID,
+		d.CourtID,
+		d.Currency,
+		d.BaseHourlyRate,
+		d.Bands,
+		d.Holidays,
+		d.HolidayHourlyRate,
+		d.MinimumCharge,
+		d.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec upsert pricing rule: %w", err)
+	}
+
+	return nil
+}
+
+const upsertRuleQuery = `
+	INSERT INTO pricing_rules(
+		organization_id,
+		court_id,
+		currency,
+		base_hourly_rate,
+		bands,
+		holidays,
+		holiday_hourly_rate,
+		minimum_charge,
+		updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (organization_id, court_id) DO UPDATE SET
+		currency = EXCLUDED.currency,
+		base_hourly_rate = EXCLUDED.base_hourly_rate,
+		bands = EXCLUDED.bands,
+		holidays = EXCLUDED.holidays,
+		holiday_hourly_rate = EXCLUDED.holiday_hourly_rate,
+		minimum_charge = EXCLUDED.minimum_charge,
+		updated_at = EXCLUDED.updated_at
+`
+
+// Get returns the rule of the court, or the organization default when courtID is empty.
+func (r *Repository) Get(ctx context.Context, organizationID, courtID string) (*entities.PricingRule, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var d dto
+
+	err := r.pool.QueryRow(ctx, getRuleQuery, organizationID, courtID).Scan(
+		&d.OrganizationID,
+		&d.CourtID,
+		&d.Currency,
+		&d.BaseHourlyRate,
+		&d.Bands,
+		&d.Holidays,
+		&d.HolidayHourlyRate,
+		&d.MinimumCharge,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan pricing rule: %w", err)
+	}
+
+	d.UpdatedAt = d.UpdatedAt.UTC()
+
+	rule, err := d.toEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+const getRuleQuery = `
+	SELECT
+		organization_id,
+		court_id,
+		currency,
+		base_hourly_rate,
+		bands,
+		holidays,
+		holiday_hourly_rate,
+		minimum_charge,
+		updated_at
+	FROM pricing_rules
+	WHERE organization_id = $1
+		AND court_id = $2
+`
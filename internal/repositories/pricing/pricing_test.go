@@ -0,0 +1,106 @@
+package pricing_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/repositories/pricing"
+)
+
+type repositorySuite struct {
+	suite.Suite
+	repo *pricing.Repository
+}
+
+func TestRepositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) SetupTest() {
+	connString := os.Getenv("POSTGRES_CONNECTION_URL")
+	require.NotEmpty(s.T(), connString, "POSTGRES_CONNECTION_URL must be set")
+
+	repo := pricing.NewRepository(connString)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := repo.Connect(ctx)
+	require.NoError(s.T(), err)
+
+	s.repo = repo
+}
+
+func (s *repositorySuite) TearDownTest() {
+	if s.repo != nil {
+		s.repo.Close()
+	}
+}
+
+func (s *repositorySuite) TestUpsertAndGet() {
+	ctx := context.Background()
+
+	rule := &entities.PricingRule{
+		OrganizationID: "org-pricing-1",
+		Currency:       "EUR",
+		BaseHourlyRate: 2000,
+		Bands: []entities.PriceBand{
+			{
+				Weekdays:   []time.Weekday{time.Monday, time.Tuesday},
+				From:       18 * 60,
+				To:         22 * 60,
+				HourlyRate: 3000,
+			},
+		},
+		Holidays:          []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+		HolidayHourlyRate: 3500,
+		MinimumCharge:     1500,
+		UpdatedAt:         time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	s.Require().NoError(s.repo.Upsert(ctx, rule))
+
+	ruleDB, err := s.repo.Get(ctx, rule.OrganizationID, "")
+	s.Require().NoError(err)
+	s.Equal(rule, ruleDB)
+
+	_, err = s.repo.Get(ctx, rule.OrganizationID, "court-pricing-1")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	rule.BaseHourlyRate = 2500
+	rule.Bands = nil
+	rule.Holidays = nil
+	rule.UpdatedAt = rule.UpdatedAt.Add(time.Hour)
+	s.Require().NoError(s.repo.Upsert(ctx, rule))
+
+	ruleDB, err = s.repo.Get(ctx, rule.OrganizationID, "")
+	s.Require().NoError(err)
+	s.Equal(rule, ruleDB)
+}
+
+func (s *repositorySuite) TestCourtRule() {
+	ctx := context.Background()
+
+	rule := &entities.PricingRule{
+		OrganizationID: "org-pricing-2",
+		CourtID:        "court-pricing-2",
+		Currency:       "KZT",
+		BaseHourlyRate: 1000000,
+		UpdatedAt:      time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	s.Require().NoError(s.repo.Upsert(ctx, rule))
+
+	ruleDB, err := s.repo.Get(ctx, rule.OrganizationID, rule.CourtID)
+	s.Require().NoError(err)
+	s.Equal(rule, ruleDB)
+
+	_, err = s.repo.Get(ctx, rule.OrganizationID, "")
+	s.ErrorIs(err, entities.ErrNotFound)
+}
@@ -0,0 +1,265 @@
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (r *Repository) CreateBlackout(ctx context.Context, blackout *entities.Blackout) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	_, err := r.pool.Exec(
+		ctx,
+		createBlackoutQuery,
+		blackout.ID,
+		blackout.OrganizationID,
+		nullableString(blackout.CourtID),
+		blackout.From.UTC(),
+		blackout.To.UTC(),
+		blackout.Reason,
+		blackout.CreatedBy,
+		blackout.CreatedAt.UTC(),
+		blackout.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createBlackoutQuery = `
+INSERT INTO court_blackouts (
+    id,
+    organization_id,
+    court_id,
+    blackout_from,
+    blackout_to,
+    reason,
+    created_by,
+    created_at,
+    updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+`
+
+func (r *Repository) GetBlackout(ctx context.Context, blackoutID string) (*entities.Blackout, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	blackout, err := scanBlackout(r.pool.QueryRow(ctx, getBlackoutQuery, blackoutID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan blackout: %w", err)
+	}
+
+	return &blackout, nil
+}
+
+const getBlackoutQuery = `
+SELECT
+    id,
+    organization_id,
+    court_id,
+    blackout_from,
+    blackout_to,
+    reason,
+    created_by,
+    created_at,
+    updated_at
+FROM court_blackouts
+WHERE id = $1
+`
+
+// UpdateBlackout stores the new scope, time range and reason of the blackout. It fails with ErrNotFound when
+// there is no blackout with the id.
+func (r *Repository) UpdateBlackout(ctx context.Context, blackout *entities.Blackout) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		updateBlackoutQuery,
+		nullableString(blackout.CourtID),
+		blackout.From.UTC(),
+		blackout.To.UTC(),
+		blackout.Reason,
+		blackout.UpdatedAt.UTC(),
+		blackout.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const updateBlackoutQuery = `
+UPDATE court_blackouts
+SET court_id = $1,
+    blackout_from = $2,
+    blackout_to = $3,
+    reason = $4,
+    updated_at = $5
+WHERE id = $6
+`
+
+// DeleteBlackout reopens the courts closed by the blackout. It fails with ErrNotFound when there is no
+// blackout with the id.
+func (r *Repository) DeleteBlackout(ctx context.Context, blackoutID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, deleteBlackoutQuery, blackoutID)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteBlackoutQuery = `
+DELETE FROM court_blackouts
+WHERE id = $1
+`
+
+// ListBlackouts returns the blackouts of the organization overlapping the time range, on any of its courts or
+// on all of them, the earliest first.
+func (r *Repository) ListBlackouts(
+	ctx context.Context,
+	organizationID string,
+	from, to time.Time,
+) ([]entities.Blackout, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	return r.queryBlackouts(ctx, listBlackoutsQuery, organizationID, from.UTC(), to.UTC())
+}
+
+const listBlackoutsQuery = `
+SELECT
+    id,
+    organization_id,
+    court_id,
+    blackout_from,
+    blackout_to,
+    reason,
+    created_by,
+    created_at,
+    updated_at
+FROM court_blackouts
+WHERE organization_id = $1
+    AND blackout_from < $3
+    AND blackout_to > $2
+ORDER BY blackout_from ASC, id ASC
+`
+
+// ListBlackoutsByCourt returns the blackouts closing the court within the time range, the ones of the court
+// and the ones of its whole organization, the earliest first.
+func (r *Repository) ListBlackoutsByCourt(
+	ctx context.Context,
+	courtID string,
+	from, to time.Time,
+) ([]entities.Blackout, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	return r.queryBlackouts(ctx, listBlackoutsByCourtQuery, courtID, from.UTC(), to.UTC())
+}
+
+const listBlackoutsByCourtQuery = `
+SELECT
+    b.id,
+    b.organization_id,
+    b.court_id,
+    b.blackout_from,
+    b.blackout_to,
+    b.reason,
+    b.created_by,
+    b.created_at,
+    b.updated_at
+FROM court_blackouts b
+JOIN courts c ON c.organization_id = b.organization_id
+WHERE c.id = $1
+    AND (b.court_id = c.id OR b.court_id IS NULL)
+    AND b.blackout_from < $3
+    AND b.blackout_to > $2
+ORDER BY b.blackout_from ASC, b.id ASC
+`
+
+func (r *Repository) queryBlackouts(ctx context.Context, query string, args ...any) ([]entities.Blackout, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var blackouts []entities.Blackout
+
+	for rows.Next() {
+		blackout, err := scanBlackout(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan blackout: %w", err)
+		}
+
+		blackouts = append(blackouts, blackout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return blackouts, nil
+}
+
+func scanBlackout(scanner rowScanner) (entities.Blackout, error) {
+	var (
+		blackout entities.Blackout
+		courtID  sql.NullString
+	)
+
+	err := scanner.Scan(
+		&blackout.ID,
+		&blackout.OrganizationID,
+		&courtID,
+		&blackout.From,
+		&blackout.To,
+		&blackout.Reason,
+		&blackout.CreatedBy,
+		&blackout.CreatedAt,
+		&blackout.UpdatedAt,
+	)
+	if err != nil {
+		return entities.Blackout{}, err
+	}
+
+	blackout.CourtID = courtID.String
+	blackout.From = blackout.From.UTC()
+	blackout.To = blackout.To.UTC()
+	blackout.CreatedAt = blackout.CreatedAt.UTC()
+	blackout.UpdatedAt = blackout.UpdatedAt.UTC()
+
+	return blackout, nil
+}
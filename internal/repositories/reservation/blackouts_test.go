@@ -0,0 +1,74 @@
+package reservation_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	courtRepo "github.com/lever-dev/padel-backend/internal/repositories/courts"
+)
+
+func (s *repositorySuite) TestBlackouts() {
+	ctx := context.Background()
+	base := time.Date(2024, 11, 4, 8, 0, 0, 0, time.UTC)
+
+	courtsRepo := courtRepo.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(courtsRepo.Connect(ctx))
+	defer courtsRepo.Close()
+
+	for _, id := range []string{"court-blackout-1", "court-blackout-2"} {
+		court := entities.NewCourt("org-blackout-1", "Court")
+		court.ID = id
+		s.Require().NoError(courtsRepo.Create(ctx, court))
+	}
+
+	resurfacing := entities.NewBlackout(
+		"org-blackout-1", "court-blackout-1", base, base.Add(4*time.Hour), "resurfacing", "manager-1", base,
+	)
+	tournament := entities.NewBlackout(
+		"org-blackout-1", "", base.AddDate(0, 0, 1), base.AddDate(0, 0, 2), "tournament", "manager-1", base,
+	)
+	other := entities.NewBlackout(
+		"org-blackout-2", "", base, base.AddDate(0, 0, 2), "private hire", "manager-2", base,
+	)
+
+	for _, b := range []*entities.Blackout{resurfacing, tournament, other} {
+		s.Require().NoError(s.repo.CreateBlackout(ctx, b))
+	}
+
+	got, err := s.repo.GetBlackout(ctx, resurfacing.ID)
+	s.Require().NoError(err)
+	s.Equal(*resurfacing, *got)
+
+	// the whole organization is closed by the tournament, only court-blackout-1 by the resurfacing
+	blackouts, err := s.repo.ListBlackoutsByCourt(ctx, "court-blackout-2", base, base.AddDate(0, 0, 3))
+	s.Require().NoError(err)
+	s.Require().Len(blackouts, 1)
+	s.Equal(tournament.ID, blackouts[0].ID)
+
+	blackouts, err = s.repo.ListBlackoutsByCourt(ctx, "court-blackout-1", base.Add(3*time.Hour), base.Add(5*time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(blackouts, 1)
+	s.Equal(resurfacing.ID, blackouts[0].ID)
+
+	blackouts, err = s.repo.ListBlackouts(ctx, "org-blackout-1", base, base.AddDate(0, 0, 3))
+	s.Require().NoError(err)
+	s.Require().Len(blackouts, 2)
+	s.Equal(resurfacing.ID, blackouts[0].ID)
+	s.Equal(tournament.ID, blackouts[1].ID)
+
+	resurfacing.To = base.Add(2 * time.Hour)
+	resurfacing.Reason = "net replacement"
+	s.Require().NoError(s.repo.UpdateBlackout(ctx, resurfacing))
+
+	blackouts, err = s.repo.ListBlackoutsByCourt(ctx, "court-blackout-1", base.Add(3*time.Hour), base.Add(5*time.Hour))
+	s.Require().NoError(err)
+	s.Empty(blackouts)
+
+	s.Require().NoError(s.repo.DeleteBlackout(ctx, tournament.ID))
+	s.ErrorIs(s.repo.DeleteBlackout(ctx, tournament.ID), entities.ErrNotFound)
+
+	_, err = s.repo.GetBlackout(ctx, tournament.ID)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
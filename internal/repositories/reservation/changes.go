@@ -0,0 +1,168 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MoveReservation moves the reservation to the slot of the change and records the change in its history.
+// It fails with ErrReservationNotActive when the reservation is no longer active or was moved in the
+// meantime, and with ErrCourtAlreadyReserved when the slot overlaps another active reservation.
+func (r *Repository) MoveReservation(ctx context.Context, change *entities.ReservationChange) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			moveReservationQuery,
+			change.CourtID,
+			change.ReservedFrom.UTC(),
+			change.ReservedTo.UTC(),
+			change.ReservationID,
+			change.PreviousCourtID,
+			change.PreviousFrom.UTC(),
+			change.PreviousTo.UTC(),
+			entities.PendingReservationStatus,
+			entities.ReservedReservationStatus,
+		)
+		if err != nil {
+			return fmt.Errorf("move reservation: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return entities.ErrReservationNotActive
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			createReservationChangeQuery,
+			change.ID,
+			change.ReservationID,
+			change.PreviousCourtID,
+			change.PreviousFrom.UTC(),
+			change.PreviousTo.UTC(),
+			change.CourtID,
+			change.ReservedFrom.UTC(),
+			change.ReservedTo.UTC(),
+			change.ChangedBy,
+			change.ChangedAt.UTC(),
+		)
+		if err != nil {
+			return fmt.Errorf("insert reservation change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			return entities.ErrCourtAlreadyReserved
+		}
+		if errors.Is(err, entities.ErrReservationNotActive) {
+			return err
+		}
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// moveReservationQuery only moves the reservation from the slot it was read with, so two concurrent moves
+// of the same reservation cannot both succeed.
+const moveReservationQuery = `
+UPDATE reservations
+SET court_id = $1,
+    reserved_from = $2,
+    reserved_to = $3
+WHERE id = $4
+    AND court_id = $5
+    AND reserved_from = $6
+    AND reserved_to = $7
+    AND status IN ($8, $9)
+`
+
+const createReservationChangeQuery = `
+INSERT INTO reservation_changes (
+    id,
+    reservation_id,
+    previous_court_id,
+    previous_from,
+    previous_to,
+    court_id,
+    reserved_from,
+    reserved_to,
+    changed_by,
+    changed_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+`
+
+// ListReservationChanges returns the history of the reservation, the earliest change first.
+func (r *Repository) ListReservationChanges(
+	ctx context.Context,
+	reservationID string,
+) ([]entities.ReservationChange, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listReservationChangesQuery, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []entities.ReservationChange
+
+	for rows.Next() {
+		var c entities.ReservationChange
+
+		err := rows.Scan(
+			&c.ID,
+			&c.ReservationID,
+			&c.PreviousCourtID,
+			&c.PreviousFrom,
+			&c.PreviousTo,
+			&c.CourtID,
+			&c.ReservedFrom,
+			&c.ReservedTo,
+			&c.ChangedBy,
+			&c.ChangedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan reservation change: %w", err)
+		}
+
+		changes = append(changes, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return changes, nil
+}
+
+const listReservationChangesQuery = `
+SELECT
+    id,
+    reservation_id,
+    previous_court_id,
+    previous_from,
+    previous_to,
+    court_id,
+    reserved_from,
+    reserved_to,
+    changed_by,
+    changed_at
+FROM reservation_changes
+WHERE reservation_id = $1
+ORDER BY changed_at ASC, id ASC
+`
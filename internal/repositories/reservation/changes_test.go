@@ -0,0 +1,62 @@
+package reservation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestMoveReservation() {
+	ctx := context.Background()
+	base := time.Date(2024, 10, 1, 18, 0, 0, 0, time.UTC)
+
+	moved := &entities.Reservation{
+		ID:           "res-move-1",
+		CourtID:      "court-move-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: base,
+		ReservedTo:   base.Add(time.Hour),
+		ReservedBy:   "user-1",
+		CreatedAt:    base.AddDate(0, 0, -1),
+	}
+
+	s.seedReservations(ctx, []*entities.Reservation{
+		moved,
+		{
+			ID:           "res-move-2",
+			CourtID:      "court-move-2",
+			Status:       entities.ReservedReservationStatus,
+			ReservedFrom: base.Add(2 * time.Hour),
+			ReservedTo:   base.Add(3 * time.Hour),
+			ReservedBy:   "user-2",
+			CreatedAt:    base.AddDate(0, 0, -1),
+		},
+	})
+
+	move := func(courtID string, from time.Time) *entities.ReservationChange {
+		return entities.NewReservationChange(*moved, courtID, from, from.Add(time.Hour), "user-1", base.AddDate(0, 0, -1))
+	}
+
+	// the slot taken by res-move-2
+	s.ErrorIs(s.repo.MoveReservation(ctx, move("court-move-2", base.Add(2*time.Hour))), entities.ErrCourtAlreadyReserved)
+
+	change := move("court-move-2", base)
+	s.Require().NoError(s.repo.MoveReservation(ctx, change))
+
+	// moving again from the slot it was read with fails, the reservation is no longer there
+	s.ErrorIs(s.repo.MoveReservation(ctx, move("court-move-1", base.Add(time.Hour))), entities.ErrReservationNotActive)
+
+	got, err := s.repo.GetByID(ctx, moved.ID)
+	s.Require().NoError(err)
+	s.Equal("court-move-2", got.CourtID)
+	s.True(base.Equal(got.ReservedFrom))
+
+	changes, err := s.repo.ListReservationChanges(ctx, moved.ID)
+	s.Require().NoError(err)
+	s.Require().Len(changes, 1)
+	s.Equal(change.ID, changes[0].ID)
+	s.Equal("court-move-1", changes[0].PreviousCourtID)
+	s.Equal("court-move-2", changes[0].CourtID)
+	s.Equal("user-1", changes[0].ChangedBy)
+}
@@ -15,6 +15,13 @@ type dto struct {
 	ReservedTo   time.Time
 	ReservedBy   string
 	CancelledBy  string
+	SeriesID     string
+	ExpiresAt    time.Time
+
+	PriceAmount   int64
+	PriceCurrency string
+
+	Cancellation *entities.Cancellation
 
 	CreatedAt time.Time
 }
@@ -28,7 +35,15 @@ func newDTO(r *entities.Reservation) dto {
 		ReservedTo:   r.ReservedTo,
 		ReservedBy:   r.ReservedBy,
 		CancelledBy:  r.CancelledBy,
-		CreatedAt:    r.CreatedAt,
+		SeriesID:     r.SeriesID,
+		ExpiresAt:    r.ExpiresAt,
+
+		PriceAmount:   r.Price.Amount,
+		PriceCurrency: r.Price.Currency,
+
+		Cancellation: r.Cancellation,
+
+		CreatedAt: r.CreatedAt,
 	}
 }
 
@@ -41,6 +56,71 @@ func (d dto) toEntity() entities.Reservation {
 		ReservedTo:   d.ReservedTo,
 		ReservedBy:   d.ReservedBy,
 		CancelledBy:  d.CancelledBy,
+		SeriesID:     d.SeriesID,
+		ExpiresAt:    d.ExpiresAt,
+		Price: entities.Price{
+			Amount:   d.PriceAmount,
+			Currency: d.PriceCurrency,
+		},
+		Cancellation: d.Cancellation,
 		CreatedAt:    d.CreatedAt,
 	}
 }
+
+type seriesDTO struct {
+	ID              string
+	CourtID         string
+	Frequency       string
+	RepeatInterval  int
+	Weekdays        []int32
+	StartDate       time.Time
+	EndDate         time.Time
+	StartMinute     int
+	DurationMinutes int
+	ReservedBy      string
+	CreatedAt       time.Time
+}
+
+func newSeriesDTO(s *entities.ReservationSeries) seriesDTO {
+	weekdays := make([]int32, 0, len(s.Rule.Weekdays))
+	for _, wd := range s.Rule.Weekdays {
+		weekdays = append(weekdays, int32(wd))
+	}
+
+	return seriesDTO{
+		ID:              s.ID,
+		CourtID:         s.CourtID,
+		Frequency:       string(s.Rule.Frequency),
+		RepeatInterval:  s.Rule.Interval,
+		Weekdays:        weekdays,
+		StartDate:       s.StartDate,
+		EndDate:         s.EndDate,
+		StartMinute:     int(s.StartTime),
+		DurationMinutes: int(s.Duration / time.Minute),
+		ReservedBy:      s.ReservedBy,
+		CreatedAt:       s.CreatedAt,
+	}
+}
+
+func (d seriesDTO) toEntity() entities.ReservationSeries {
+	var weekdays []time.Weekday
+	for _, wd := range d.Weekdays {
+		weekdays = append(weekdays, time.Weekday(wd))
+	}
+
+	return entities.ReservationSeries{
+		ID:      d.ID,
+		CourtID: d.CourtID,
+		Rule: entities.RecurrenceRule{
+			Frequency: entities.RecurrenceFrequency(d.Frequency),
+			Interval:  d.RepeatInterval,
+			Weekdays:  weekdays,
+		},
+		StartDate:  d.StartDate,
+		EndDate:    d.EndDate,
+		StartTime:  entities.TimeOfDay(d.StartMinute),
+		Duration:   time.Duration(d.DurationMinutes) * time.Minute,
+		ReservedBy: d.ReservedBy,
+		CreatedAt:  d.CreatedAt,
+	}
+}
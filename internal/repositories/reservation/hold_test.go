@@ -0,0 +1,121 @@
+package reservation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestConfirmReservation() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 25, 12, 0, 0, 0, time.UTC)
+
+	hold := func(id string, hour int, expiresAt time.Time) *entities.Reservation {
+		return &entities.Reservation{
+			ID:           id,
+			CourtID:      "court-hold-1",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: time.Date(2024, 7, 26, hour, 0, 0, 0, time.UTC),
+			ReservedTo:   time.Date(2024, 7, 26, hour+1, 0, 0, 0, time.UTC),
+			ReservedBy:   "user-1",
+			ExpiresAt:    expiresAt,
+			CreatedAt:    now.Add(-5 * time.Minute),
+		}
+	}
+
+	active := hold("res-confirm-1", 9, now.Add(10*time.Minute))
+	expired := hold("res-confirm-2", 10, now.Add(-time.Minute))
+
+	s.seedReservations(ctx, []*entities.Reservation{active, expired})
+
+	err := s.repo.ConfirmReservation(ctx, active.ID, now)
+	s.Require().NoError(err)
+
+	resDB, err := s.repo.GetByID(ctx, active.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.ReservedReservationStatus, resDB.Status)
+	s.True(resDB.ExpiresAt.IsZero())
+
+	err = s.repo.ConfirmReservation(ctx, active.ID, now)
+	s.ErrorIs(err, entities.ErrReservationNotPending)
+
+	err = s.repo.ConfirmReservation(ctx, expired.ID, now)
+	s.ErrorIs(err, entities.ErrReservationNotPending)
+}
+
+func (s *repositorySuite) TestReleaseHold() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 25, 12, 0, 0, 0, time.UTC)
+
+	hold := &entities.Reservation{
+		ID:           "res-release-1",
+		CourtID:      "court-hold-3",
+		Status:       entities.PendingReservationStatus,
+		ReservedFrom: time.Date(2024, 7, 26, 9, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 7, 26, 10, 0, 0, 0, time.UTC),
+		ReservedBy:   "user-1",
+		ExpiresAt:    now.Add(10 * time.Minute),
+		CreatedAt:    now.Add(-5 * time.Minute),
+	}
+
+	s.seedReservations(ctx, []*entities.Reservation{hold})
+
+	s.Require().NoError(s.repo.ReleaseHold(ctx, hold.ID))
+
+	resDB, err := s.repo.GetByID(ctx, hold.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.ExpiredReservationStatus, resDB.Status)
+
+	s.ErrorIs(s.repo.ReleaseHold(ctx, hold.ID), entities.ErrReservationNotPending)
+}
+
+func (s *repositorySuite) TestExpirePendingReservations() {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 27, 12, 0, 0, 0, time.UTC)
+
+	reservations := []*entities.Reservation{
+		{
+			ID:           "res-expire-1",
+			CourtID:      "court-hold-2",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: time.Date(2024, 7, 28, 9, 0, 0, 0, time.UTC),
+			ReservedTo:   time.Date(2024, 7, 28, 10, 0, 0, 0, time.UTC),
+			ReservedBy:   "user-1",
+			ExpiresAt:    now.Add(-time.Minute),
+			CreatedAt:    now.Add(-20 * time.Minute),
+		},
+		{
+			ID:           "res-expire-2",
+			CourtID:      "court-hold-2",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: time.Date(2024, 7, 28, 10, 0, 0, 0, time.UTC),
+			ReservedTo:   time.Date(2024, 7, 28, 11, 0, 0, 0, time.UTC),
+			ReservedBy:   "user-2",
+			ExpiresAt:    now.Add(time.Minute),
+			CreatedAt:    now.Add(-5 * time.Minute),
+		},
+	}
+
+	s.seedReservations(ctx, reservations)
+
+	expired, err := s.repo.ExpirePendingReservations(ctx, now)
+	s.Require().NoError(err)
+
+	var ids []string
+	for _, rsv := range expired {
+		ids = append(ids, rsv.ID)
+	}
+
+	s.Contains(ids, "res-expire-1")
+	s.NotContains(ids, "res-expire-2")
+
+	first, err := s.repo.GetByID(ctx, "res-expire-1")
+	s.Require().NoError(err)
+	s.Equal(entities.ExpiredReservationStatus, first.Status)
+
+	second, err := s.repo.GetByID(ctx, "res-expire-2")
+	s.Require().NoError(err)
+	s.Equal(entities.PendingReservationStatus, second.Status)
+	s.Equal(reservations[1].ExpiresAt, second.ExpiresAt)
+}
@@ -0,0 +1,311 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// SaveMatch opens the reservation as a match, or replaces the settings of a match that is already open.
+func (r *Repository) SaveMatch(ctx context.Context, match *entities.OpenMatch) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	_, err := r.pool.Exec(
+		ctx,
+		saveMatchQuery,
+		match.ReservationID,
+		match.MinLevel,
+		match.MaxLevel,
+		match.MissingPlayers,
+		match.AutoAccept,
+		match.CreatedAt.UTC(),
+		match.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const saveMatchQuery = `
+INSERT INTO open_matches (
+    reservation_id,
+    min_level,
+    max_level,
+    missing_players,
+    auto_accept,
+    created_at,
+    updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7)
+ON CONFLICT (reservation_id) DO UPDATE
+SET min_level = EXCLUDED.min_level,
+    max_level = EXCLUDED.max_level,
+    missing_players = EXCLUDED.missing_players,
+    auto_accept = EXCLUDED.auto_accept,
+    updated_at = EXCLUDED.updated_at
+`
+
+func (r *Repository) GetMatch(ctx context.Context, reservationID string) (*entities.OpenMatch, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var match entities.OpenMatch
+
+	err := r.pool.QueryRow(ctx, getMatchQuery, reservationID).Scan(
+		&match.ReservationID,
+		&match.MinLevel,
+		&match.MaxLevel,
+		&match.MissingPlayers,
+		&match.AutoAccept,
+		&match.CreatedAt,
+		&match.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan match: %w", err)
+	}
+
+	match.CreatedAt = match.CreatedAt.UTC()
+	match.UpdatedAt = match.UpdatedAt.UTC()
+
+	return &match, nil
+}
+
+const getMatchQuery = `
+SELECT
+    reservation_id,
+    min_level,
+    max_level,
+    missing_players,
+    auto_accept,
+    created_at,
+    updated_at
+FROM open_matches
+WHERE reservation_id = $1
+LIMIT 1
+`
+
+// DeleteMatch closes the match. The players who joined it stay on the roster.
+func (r *Repository) DeleteMatch(ctx context.Context, reservationID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, deleteMatchQuery, reservationID)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteMatchQuery = `
+DELETE FROM open_matches
+WHERE reservation_id = $1
+`
+
+// JoinMatch adds the player to the roster and takes one of the missing spots of the match at once.
+// It fails with ErrMatchFull when no spot is missing anymore, so concurrent joins never overfill the match.
+func (r *Repository) JoinMatch(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := addPlayer(ctx, tx, player, maxPlayers); err != nil {
+			return err
+		}
+
+		return takeMatchSpot(ctx, tx, player.ReservationID, player.UpdatedAt)
+	})
+}
+
+// ApproveJoin accepts the request of the player to join the match and takes one of its missing spots.
+// It fails with ErrInvalidPlayerTransition when the player has no pending request and with ErrMatchFull
+// when no spot is missing anymore.
+func (r *Repository) ApproveJoin(
+	ctx context.Context,
+	reservationID, userID string,
+	maxPlayers int,
+	now time.Time,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockReservation(ctx, tx, reservationID); err != nil {
+			return err
+		}
+
+		if err := checkRosterSpot(ctx, tx, reservationID, userID, maxPlayers); err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(
+			ctx,
+			updatePlayerStatusQuery,
+			entities.AcceptedPlayerStatus,
+			now,
+			reservationID,
+			userID,
+			entities.RequestedPlayerStatus,
+		)
+		if err != nil {
+			return fmt.Errorf("update player status: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: player %s did not request to join", entities.ErrInvalidPlayerTransition, userID)
+		}
+
+		return takeMatchSpot(ctx, tx, reservationID, now)
+	})
+}
+
+func takeMatchSpot(ctx context.Context, tx pgx.Tx, reservationID string, now time.Time) error {
+	tag, err := tx.Exec(ctx, takeMatchSpotQuery, now, reservationID)
+	if err != nil {
+		return fmt.Errorf("take match spot: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrMatchFull
+	}
+
+	return nil
+}
+
+const takeMatchSpotQuery = `
+UPDATE open_matches
+SET missing_players = missing_players - 1,
+    updated_at = $1
+WHERE reservation_id = $2
+    AND missing_players > 0
+`
+
+func releaseMatchSpot(ctx context.Context, tx pgx.Tx, reservationID string, now time.Time) error {
+	if _, err := tx.Exec(ctx, releaseMatchSpotQuery, now, reservationID); err != nil {
+		return fmt.Errorf("release match spot: %w", err)
+	}
+
+	return nil
+}
+
+const releaseMatchSpotQuery = `
+UPDATE open_matches
+SET missing_players = missing_players + 1,
+    updated_at = $1
+WHERE reservation_id = $2
+`
+
+// SearchMatches returns the open matches that still miss players and did not start at now, ordered by
+// their start.
+func (r *Repository) SearchMatches(
+	ctx context.Context,
+	filter entities.MatchFilter,
+	now time.Time,
+) ([]entities.MatchListing, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		searchMatchesQuery,
+		filter.OrganizationIDs,
+		filter.From,
+		filter.To,
+		now,
+		entities.ReservedReservationStatus,
+		entities.PendingReservationStatus,
+		filter.Level,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var listings []entities.MatchListing
+
+	for rows.Next() {
+		var listing entities.MatchListing
+
+		rsv, err := scan(trailingColumns{rows, []any{
+			&listing.Match.MinLevel,
+			&listing.Match.MaxLevel,
+			&listing.Match.MissingPlayers,
+			&listing.Match.AutoAccept,
+			&listing.Match.CreatedAt,
+			&listing.Match.UpdatedAt,
+			&listing.OrganizationID,
+		}})
+		if err != nil {
+			return nil, fmt.Errorf("scan match: %w", err)
+		}
+
+		listing.Reservation = rsv
+		listing.Match.ReservationID = rsv.ID
+		listing.Match.CreatedAt = listing.Match.CreatedAt.UTC()
+		listing.Match.UpdatedAt = listing.Match.UpdatedAt.UTC()
+
+		listings = append(listings, listing)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return listings, nil
+}
+
+const searchMatchesQuery = `
+SELECT
+    r.id,
+    r.court_id,
+    r.status,
+    r.reserved_from,
+    r.reserved_to,
+    r.reserved_by,
+    r.cancelled_by,
+    r.series_id,
+    r.expires_at,
+    r.price_amount,
+    r.price_currency,
+    r.cancellation_refund_percent,
+    r.cancellation_fee,
+    r.cancellation_waived,
+    r.created_at,
+    m.min_level,
+    m.max_level,
+    m.missing_players,
+    m.auto_accept,
+    m.created_at,
+    m.updated_at,
+    c.organization_id
+FROM open_matches m
+JOIN reservations r ON r.id = m.reservation_id
+JOIN courts c ON c.id = r.court_id
+WHERE c.organization_id = ANY($1)
+    AND r.reserved_from >= $2
+    AND r.reserved_from < $3
+    AND r.reserved_from > $4
+    AND (r.status = $5 OR (r.status = $6 AND (r.expires_at IS NULL OR r.expires_at > $4)))
+    AND m.missing_players > 0
+    AND ($7::numeric IS NULL OR $7::numeric BETWEEN m.min_level AND m.max_level)
+ORDER BY r.reserved_from ASC, r.id ASC
+`
@@ -0,0 +1,244 @@
+package reservation_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	courtRepo "github.com/lever-dev/padel-backend/internal/repositories/courts"
+)
+
+func (s *repositorySuite) TestJoinMatch() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+
+	rsv := &entities.Reservation{
+		ID:           "res-match-1",
+		CourtID:      "court-match-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: time.Date(2024, 8, 11, 9, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 8, 11, 10, 0, 0, 0, time.UTC),
+		ReservedBy:   "user-1",
+		CreatedAt:    now,
+	}
+	s.seedReservations(ctx, []*entities.Reservation{rsv})
+
+	match := &entities.OpenMatch{
+		ReservationID:  rsv.ID,
+		MinLevel:       2,
+		MaxLevel:       3.5,
+		MissingPlayers: 2,
+		AutoAccept:     true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	s.Require().NoError(s.repo.SaveMatch(ctx, match))
+
+	saved, err := s.repo.GetMatch(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Equal(match, saved)
+
+	// five players race for the two missing spots
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		joined int
+	)
+
+	for _, userID := range []string{"user-2", "user-3", "user-4", "user-5", "user-6"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			player := entities.NewReservationPlayer(rsv.ID, userID, userID, now)
+			player.Status = entities.AcceptedPlayerStatus
+
+			err := s.repo.JoinMatch(ctx, player, 4)
+			if err == nil {
+				mu.Lock()
+				joined++
+				mu.Unlock()
+				return
+			}
+
+			s.ErrorIs(err, entities.ErrMatchFull)
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(2, joined)
+
+	players, err := s.repo.ListPlayers(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Len(players, 2)
+
+	saved, err = s.repo.GetMatch(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Zero(saved.MissingPlayers)
+
+	s.Require().NoError(s.repo.DeleteMatch(ctx, rsv.ID))
+	s.ErrorIs(s.repo.DeleteMatch(ctx, rsv.ID), entities.ErrNotFound)
+
+	_, err = s.repo.GetMatch(ctx, rsv.ID)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestApproveJoin() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+
+	rsv := &entities.Reservation{
+		ID:           "res-match-2",
+		CourtID:      "court-match-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: time.Date(2024, 8, 11, 11, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 8, 11, 12, 0, 0, 0, time.UTC),
+		ReservedBy:   "user-1",
+		CreatedAt:    now,
+	}
+	s.seedReservations(ctx, []*entities.Reservation{rsv})
+
+	s.Require().NoError(s.repo.SaveMatch(ctx, &entities.OpenMatch{
+		ReservationID:  rsv.ID,
+		MaxLevel:       entities.MaxSkillLevel,
+		MissingPlayers: 1,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}))
+
+	for _, userID := range []string{"user-2", "user-3"} {
+		player := entities.NewReservationPlayer(rsv.ID, userID, userID, now)
+		player.Status = entities.RequestedPlayerStatus
+		s.Require().NoError(s.repo.AddPlayer(ctx, player, 4))
+	}
+
+	s.Require().NoError(s.repo.ApproveJoin(ctx, rsv.ID, "user-2", 4, now))
+	s.ErrorIs(s.repo.ApproveJoin(ctx, rsv.ID, "user-2", 4, now), entities.ErrInvalidPlayerTransition)
+	s.ErrorIs(s.repo.ApproveJoin(ctx, rsv.ID, "user-3", 4, now), entities.ErrMatchFull)
+
+	player, err := s.repo.GetPlayer(ctx, rsv.ID, "user-3")
+	s.Require().NoError(err)
+	s.Equal(entities.RequestedPlayerStatus, player.Status)
+}
+
+func (s *repositorySuite) TestLeaveMatch() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+
+	rsv := &entities.Reservation{
+		ID:           "res-match-3",
+		CourtID:      "court-match-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: time.Date(2024, 8, 11, 13, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 8, 11, 14, 0, 0, 0, time.UTC),
+		ReservedBy:   "user-1",
+		CreatedAt:    now,
+	}
+	s.seedReservations(ctx, []*entities.Reservation{rsv})
+
+	s.Require().NoError(s.repo.SaveMatch(ctx, &entities.OpenMatch{
+		ReservationID:  rsv.ID,
+		MaxLevel:       entities.MaxSkillLevel,
+		MissingPlayers: 1,
+		AutoAccept:     true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}))
+
+	invited := entities.NewReservationPlayer(rsv.ID, "user-2", "user-1", now)
+	invited.Status = entities.AcceptedPlayerStatus
+	s.Require().NoError(s.repo.AddPlayer(ctx, invited, 4))
+
+	joined := entities.NewReservationPlayer(rsv.ID, "user-3", "user-3", now)
+	joined.Status = entities.AcceptedPlayerStatus
+	s.Require().NoError(s.repo.JoinMatch(ctx, joined, 4))
+
+	// the player invited by the booker never took a spot of the match
+	err := s.repo.UpdatePlayerStatus(ctx, rsv.ID, "user-2", entities.AcceptedPlayerStatus, entities.LeftPlayerStatus, now)
+	s.Require().NoError(err)
+
+	match, err := s.repo.GetMatch(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Zero(match.MissingPlayers)
+
+	err = s.repo.UpdatePlayerStatus(ctx, rsv.ID, "user-3", entities.AcceptedPlayerStatus, entities.LeftPlayerStatus, now)
+	s.Require().NoError(err)
+
+	match, err = s.repo.GetMatch(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Equal(1, match.MissingPlayers)
+
+	err = s.repo.UpdatePlayerStatus(ctx, rsv.ID, "user-3", entities.AcceptedPlayerStatus, entities.LeftPlayerStatus, now)
+	s.ErrorIs(err, entities.ErrInvalidPlayerTransition)
+}
+
+func (s *repositorySuite) TestSearchMatches() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 12, 8, 0, 0, 0, time.UTC)
+
+	courtsRepo := courtRepo.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(courtsRepo.Connect(ctx))
+	defer courtsRepo.Close()
+
+	court := entities.NewCourt("org-match-1", "Center court")
+	court.ID = "court-match-search-1"
+	s.Require().NoError(courtsRepo.Create(ctx, court))
+
+	reservation := func(id string, hour int) *entities.Reservation {
+		return &entities.Reservation{
+			ID:           id,
+			CourtID:      court.ID,
+			Status:       entities.ReservedReservationStatus,
+			ReservedFrom: time.Date(2024, 8, 12, hour, 0, 0, 0, time.UTC),
+			ReservedTo:   time.Date(2024, 8, 12, hour+1, 0, 0, 0, time.UTC),
+			ReservedBy:   "user-1",
+			CreatedAt:    now,
+		}
+	}
+
+	started := reservation("res-search-started", 7)
+	beginners := reservation("res-search-beginners", 10)
+	advanced := reservation("res-search-advanced", 11)
+	full := reservation("res-search-full", 12)
+	s.seedReservations(ctx, []*entities.Reservation{started, beginners, advanced, full})
+
+	for _, m := range []entities.OpenMatch{
+		{ReservationID: started.ID, MaxLevel: 7, MissingPlayers: 1},
+		{ReservationID: beginners.ID, MinLevel: 1, MaxLevel: 2.5, MissingPlayers: 2},
+		{ReservationID: advanced.ID, MinLevel: 4, MaxLevel: 6, MissingPlayers: 1},
+		{ReservationID: full.ID, MaxLevel: 7},
+	} {
+		m.CreatedAt, m.UpdatedAt = now, now
+		s.Require().NoError(s.repo.SaveMatch(ctx, &m))
+	}
+
+	filter := entities.MatchFilter{
+		OrganizationIDs: []string{court.OrganizationID},
+		From:            time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC),
+		To:              time.Date(2024, 8, 13, 0, 0, 0, 0, time.UTC),
+	}
+
+	listings, err := s.repo.SearchMatches(ctx, filter, now)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 2)
+	s.Equal(beginners.ID, listings[0].Reservation.ID)
+	s.Equal(court.OrganizationID, listings[0].OrganizationID)
+	s.Equal(2, listings[0].Match.MissingPlayers)
+	s.Equal(advanced.ID, listings[1].Reservation.ID)
+
+	level := 5.0
+	filter.Level = &level
+
+	listings, err = s.repo.SearchMatches(ctx, filter, now)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 1)
+	s.Equal(advanced.ID, listings[0].Reservation.ID)
+
+	filter.OrganizationIDs = []string{"org-match-other"}
+
+	listings, err = s.repo.SearchMatches(ctx, filter, now)
+	s.Require().NoError(err)
+	s.Empty(listings)
+}
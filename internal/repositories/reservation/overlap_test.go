@@ -0,0 +1,137 @@
+package reservation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestCreate_OverlappingReservation() {
+	ctx := context.Background()
+	base := time.Date(2024, 8, 1, 18, 0, 0, 0, time.UTC)
+
+	s.seedReservations(ctx, []*entities.Reservation{
+		{
+			ID:           "res-overlap-1",
+			CourtID:      "court-overlap-1",
+			Status:       entities.ReservedReservationStatus,
+			ReservedFrom: base,
+			ReservedTo:   base.Add(time.Hour),
+			ReservedBy:   "user-1",
+			CreatedAt:    base.AddDate(0, 0, -1),
+		},
+	})
+
+	tests := []struct {
+		name    string
+		rsv     *entities.Reservation
+		wantErr error
+	}{
+		{
+			name: "overlapping active reservation",
+			rsv: &entities.Reservation{
+				ID:           "res-overlap-2",
+				CourtID:      "court-overlap-1",
+				Status:       entities.PendingReservationStatus,
+				ReservedFrom: base.Add(30 * time.Minute),
+				ReservedTo:   base.Add(90 * time.Minute),
+				ReservedBy:   "user-2",
+				ExpiresAt:    time.Now().Add(time.Hour),
+			},
+			wantErr: entities.ErrCourtAlreadyReserved,
+		},
+		{
+			name: "adjacent reservation",
+			rsv: &entities.Reservation{
+				ID:           "res-overlap-3",
+				CourtID:      "court-overlap-1",
+				Status:       entities.ReservedReservationStatus,
+				ReservedFrom: base.Add(time.Hour),
+				ReservedTo:   base.Add(2 * time.Hour),
+				ReservedBy:   "user-2",
+			},
+		},
+		{
+			name: "same slot on another court",
+			rsv: &entities.Reservation{
+				ID:           "res-overlap-4",
+				CourtID:      "court-overlap-2",
+				Status:       entities.ReservedReservationStatus,
+				ReservedFrom: base,
+				ReservedTo:   base.Add(time.Hour),
+				ReservedBy:   "user-2",
+			},
+		},
+		{
+			name: "cancelled reservation may overlap",
+			rsv: &entities.Reservation{
+				ID:           "res-overlap-5",
+				CourtID:      "court-overlap-1",
+				Status:       entities.CancelledReservationStatus,
+				ReservedFrom: base,
+				ReservedTo:   base.Add(time.Hour),
+				ReservedBy:   "user-2",
+				CancelledBy:  "user-2",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := s.repo.Create(ctx, tt.rsv)
+			if tt.wantErr != nil {
+				s.Require().Error(err)
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *repositorySuite) TestExpireOverlappingHolds() {
+	ctx := context.Background()
+	base := time.Date(2024, 8, 2, 18, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	hold := func(id string, from time.Time, expiresAt time.Time) *entities.Reservation {
+		return &entities.Reservation{
+			ID:           id,
+			CourtID:      "court-overlap-3",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: from,
+			ReservedTo:   from.Add(time.Hour),
+			ReservedBy:   "user-1",
+			ExpiresAt:    expiresAt,
+		}
+	}
+
+	stale := hold("res-stale-hold-1", base, now.Add(-time.Minute))
+	elsewhere := hold("res-stale-hold-3", base.Add(2*time.Hour), now.Add(-time.Minute))
+	s.seedReservations(ctx, []*entities.Reservation{stale, elsewhere})
+
+	// the run out hold still blocks the slot until it is expired
+	taking := &entities.Reservation{
+		ID:           "res-stale-hold-2",
+		CourtID:      "court-overlap-3",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: base,
+		ReservedTo:   base.Add(time.Hour),
+		ReservedBy:   "user-2",
+	}
+	s.ErrorIs(s.repo.Create(ctx, taking), entities.ErrCourtAlreadyReserved)
+
+	expired, err := s.repo.ExpireOverlappingHolds(ctx, "court-overlap-3", base, base.Add(time.Hour), now)
+	s.Require().NoError(err)
+	s.Require().Len(expired, 1)
+	s.Equal(stale.ID, expired[0].ID)
+	s.Equal(entities.ExpiredReservationStatus, expired[0].Status)
+
+	s.Require().NoError(s.repo.Create(ctx, taking))
+
+	elsewhereDB, err := s.repo.GetByID(ctx, elsewhere.ID)
+	s.Require().NoError(err)
+	s.Equal(entities.PendingReservationStatus, elsewhereDB.Status)
+}
@@ -0,0 +1,345 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// AddPlayer invites the player to the reservation, or records their request to join it. Players who
+// declined or left earlier are added again. It fails with ErrRosterFull when the booker and the players
+// holding a spot already fill maxPlayers, and with ErrPlayerAlreadyInvited when the player is on the
+// roster already.
+func (r *Repository) AddPlayer(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return addPlayer(ctx, tx, player, maxPlayers)
+	})
+}
+
+func addPlayer(ctx context.Context, tx pgx.Tx, player *entities.ReservationPlayer, maxPlayers int) error {
+	if err := lockReservation(ctx, tx, player.ReservationID); err != nil {
+		return err
+	}
+
+	if player.Status.TakesSpot() {
+		if err := checkRosterSpot(ctx, tx, player.ReservationID, player.UserID, maxPlayers); err != nil {
+			return err
+		}
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		upsertPlayerQuery,
+		player.ReservationID,
+		player.UserID,
+		player.InvitedBy,
+		player.Status,
+		player.CreatedAt.UTC(),
+		player.UpdatedAt.UTC(),
+		entities.DeclinedPlayerStatus,
+		entities.LeftPlayerStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert player: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrPlayerAlreadyInvited
+	}
+
+	return nil
+}
+
+// lockReservation serializes the roster changes of the reservation, so concurrent ones can not overflow it.
+func lockReservation(ctx context.Context, tx pgx.Tx, reservationID string) error {
+	var id string
+	if err := tx.QueryRow(ctx, lockReservationQuery, reservationID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("lock reservation: %w", err)
+	}
+
+	return nil
+}
+
+func checkRosterSpot(ctx context.Context, tx pgx.Tx, reservationID, userID string, maxPlayers int) error {
+	var taken int
+	err := tx.QueryRow(
+		ctx,
+		countRosterSpotsQuery,
+		reservationID,
+		userID,
+		entities.InvitedPlayerStatus,
+		entities.AcceptedPlayerStatus,
+	).Scan(&taken)
+	if err != nil {
+		return fmt.Errorf("count roster spots: %w", err)
+	}
+
+	// the booker holds a spot of their own
+	if taken+1 >= maxPlayers {
+		return entities.ErrRosterFull
+	}
+
+	return nil
+}
+
+const lockReservationQuery = `
+SELECT id
+FROM reservations
+WHERE id = $1
+FOR UPDATE
+`
+
+const countRosterSpotsQuery = `
+SELECT count(*)
+FROM reservation_players
+WHERE reservation_id = $1
+    AND user_id <> $2
+    AND status IN ($3, $4)
+`
+
+const upsertPlayerQuery = `
+INSERT INTO reservation_players (
+    reservation_id,
+    user_id,
+    invited_by,
+    status,
+    created_at,
+    updated_at
+) VALUES ($1,$2,$3,$4,$5,$6)
+ON CONFLICT (reservation_id, user_id) DO UPDATE
+SET invited_by = EXCLUDED.invited_by,
+    status = EXCLUDED.status,
+    updated_at = EXCLUDED.updated_at
+WHERE reservation_players.status IN ($7, $8)
+`
+
+func (r *Repository) GetPlayer(ctx context.Context, reservationID, userID string) (*entities.ReservationPlayer, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	player, err := scanPlayer(r.pool.QueryRow(ctx, getPlayerQuery, reservationID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan player: %w", err)
+	}
+
+	return &player, nil
+}
+
+const getPlayerQuery = `
+SELECT
+    reservation_id,
+    user_id,
+    invited_by,
+    status,
+    created_at,
+    updated_at
+FROM reservation_players
+WHERE reservation_id = $1
+    AND user_id = $2
+LIMIT 1
+`
+
+// ListPlayers returns every player invited to the reservation, including the ones who declined or left.
+func (r *Repository) ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listPlayersQuery, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var players []entities.ReservationPlayer
+
+	for rows.Next() {
+		player, err := scanPlayer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan player: %w", err)
+		}
+
+		players = append(players, player)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return players, nil
+}
+
+const listPlayersQuery = `
+SELECT
+    reservation_id,
+    user_id,
+    invited_by,
+    status,
+    created_at,
+    updated_at
+FROM reservation_players
+WHERE reservation_id = $1
+ORDER BY created_at ASC, user_id ASC
+`
+
+// UpdatePlayerStatus moves the player from one status to another. It fails with ErrInvalidPlayerTransition
+// when the player is no longer in the from status. A player who joined an open match and leaves it gives
+// the spot back to the match in the same transaction.
+func (r *Repository) UpdatePlayerStatus(
+	ctx context.Context,
+	reservationID, userID string,
+	from, to entities.PlayerStatus,
+	now time.Time,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var invitedBy string
+		err := tx.QueryRow(ctx, updatePlayerStatusQuery, to, now, reservationID, userID, from).Scan(&invitedBy)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%w: player %s is not %s", entities.ErrInvalidPlayerTransition, userID, from)
+			}
+			return fmt.Errorf("update player status: %w", err)
+		}
+
+		// players who joined an open match on their own took one of its spots, leaving gives it back
+		if from == entities.AcceptedPlayerStatus && to == entities.LeftPlayerStatus && invitedBy == userID {
+			return releaseMatchSpot(ctx, tx, reservationID, now)
+		}
+
+		return nil
+	})
+}
+
+const updatePlayerStatusQuery = `
+UPDATE reservation_players
+SET status = $1,
+    updated_at = $2
+WHERE reservation_id = $3
+    AND user_id = $4
+    AND status = $5
+RETURNING invited_by
+`
+
+// ListUpcomingByUser returns the reservations that did not end at now which the user booked, was invited
+// to or accepted. Cancelled reservations and holds that ran out are left out.
+func (r *Repository) ListUpcomingByUser(ctx context.Context, userID string, now time.Time) ([]entities.Game, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		listUpcomingByUserQuery,
+		userID,
+		entities.InvitedPlayerStatus,
+		entities.AcceptedPlayerStatus,
+		now,
+		entities.ReservedReservationStatus,
+		entities.PendingReservationStatus,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var games []entities.Game
+
+	for rows.Next() {
+		var status string
+
+		rsv, err := scan(trailingColumns{rows, []any{&status}})
+		if err != nil {
+			return nil, fmt.Errorf("scan game: %w", err)
+		}
+
+		games = append(games, entities.Game{Reservation: rsv, Status: entities.PlayerStatus(status)})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return games, nil
+}
+
+const listUpcomingByUserQuery = `
+SELECT
+    r.id,
+    r.court_id,
+    r.status,
+    r.reserved_from,
+    r.reserved_to,
+    r.reserved_by,
+    r.cancelled_by,
+    r.series_id,
+    r.expires_at,
+    r.price_amount,
+    r.price_currency,
+    r.cancellation_refund_percent,
+    r.cancellation_fee,
+    r.cancellation_waived,
+    r.created_at,
+    COALESCE(p.status, $3)
+FROM reservations r
+LEFT JOIN reservation_players p
+    ON p.reservation_id = r.id
+    AND p.user_id = $1
+WHERE (r.reserved_by = $1 OR p.status IN ($2, $3))
+    AND r.reserved_to > $4
+    AND (r.status = $5 OR (r.status = $6 AND (r.expires_at IS NULL OR r.expires_at > $4)))
+ORDER BY r.reserved_from ASC, r.id ASC
+`
+
+// trailingColumns scans the columns a query selects after the ones of the reservation.
+type trailingColumns struct {
+	rowScanner
+	dest []any
+}
+
+func (c trailingColumns) Scan(dest ...any) error {
+	return c.rowScanner.Scan(append(dest, c.dest...)...)
+}
+
+func scanPlayer(scanner rowScanner) (entities.ReservationPlayer, error) {
+	var (
+		player entities.ReservationPlayer
+		status string
+	)
+
+	err := scanner.Scan(
+		&player.ReservationID,
+		&player.UserID,
+		&player.InvitedBy,
+		&status,
+		&player.CreatedAt,
+		&player.UpdatedAt,
+	)
+	if err != nil {
+		return entities.ReservationPlayer{}, err
+	}
+
+	player.Status = entities.PlayerStatus(status)
+	player.CreatedAt = player.CreatedAt.UTC()
+	player.UpdatedAt = player.UpdatedAt.UTC()
+
+	return player, nil
+}
@@ -0,0 +1,107 @@
+package reservation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestPlayers() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	rsv := &entities.Reservation{
+		ID:           "res-players-1",
+		CourtID:      "court-players-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: time.Date(2024, 8, 2, 9, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 8, 2, 10, 0, 0, 0, time.UTC),
+		ReservedBy:   "user-1",
+		CreatedAt:    now,
+	}
+	s.seedReservations(ctx, []*entities.Reservation{rsv})
+
+	bob := entities.NewReservationPlayer(rsv.ID, "user-2", "user-1", now)
+	s.Require().NoError(s.repo.AddPlayer(ctx, bob, 3))
+	s.ErrorIs(s.repo.AddPlayer(ctx, bob, 3), entities.ErrPlayerAlreadyInvited)
+
+	carol := entities.NewReservationPlayer(rsv.ID, "user-3", "user-1", now.Add(time.Minute))
+	s.Require().NoError(s.repo.AddPlayer(ctx, carol, 3))
+
+	dave := entities.NewReservationPlayer(rsv.ID, "user-4", "user-1", now.Add(2*time.Minute))
+	s.ErrorIs(s.repo.AddPlayer(ctx, dave, 3), entities.ErrRosterFull)
+
+	missing := entities.NewReservationPlayer("res-players-missing", "user-4", "user-1", now)
+	s.ErrorIs(s.repo.AddPlayer(ctx, missing, 3), entities.ErrNotFound)
+
+	err := s.repo.UpdatePlayerStatus(
+		ctx, rsv.ID, carol.UserID, entities.InvitedPlayerStatus, entities.DeclinedPlayerStatus, now.Add(time.Hour),
+	)
+	s.Require().NoError(err)
+
+	err = s.repo.UpdatePlayerStatus(
+		ctx, rsv.ID, carol.UserID, entities.InvitedPlayerStatus, entities.AcceptedPlayerStatus, now.Add(time.Hour),
+	)
+	s.ErrorIs(err, entities.ErrInvalidPlayerTransition)
+
+	// the spot carol declined is free again
+	s.Require().NoError(s.repo.AddPlayer(ctx, dave, 3))
+
+	player, err := s.repo.GetPlayer(ctx, rsv.ID, carol.UserID)
+	s.Require().NoError(err)
+	s.Equal(entities.DeclinedPlayerStatus, player.Status)
+
+	_, err = s.repo.GetPlayer(ctx, rsv.ID, "user-missing")
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	players, err := s.repo.ListPlayers(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Require().Len(players, 3)
+	s.Equal(*bob, players[0])
+	s.Equal(dave.UserID, players[2].UserID)
+}
+
+func (s *repositorySuite) TestListUpcomingByUser() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC)
+
+	reservation := func(id string, hour int, status entities.ReservationStatus, reservedBy string) *entities.Reservation {
+		return &entities.Reservation{
+			ID:           id,
+			CourtID:      "court-upcoming-1",
+			Status:       status,
+			ReservedFrom: time.Date(2024, 8, 5, hour, 0, 0, 0, time.UTC),
+			ReservedTo:   time.Date(2024, 8, 5, hour+1, 0, 0, 0, time.UTC),
+			ReservedBy:   reservedBy,
+			CreatedAt:    now.Add(-time.Hour),
+		}
+	}
+
+	past := reservation("res-upcoming-past", 9, entities.ReservedReservationStatus, "user-10")
+	booked := reservation("res-upcoming-booked", 14, entities.ReservedReservationStatus, "user-10")
+	invited := reservation("res-upcoming-invited", 15, entities.ReservedReservationStatus, "user-11")
+	declined := reservation("res-upcoming-declined", 16, entities.ReservedReservationStatus, "user-11")
+	cancelled := reservation("res-upcoming-cancelled", 17, entities.CancelledReservationStatus, "user-10")
+	expired := reservation("res-upcoming-expired", 18, entities.PendingReservationStatus, "user-10")
+	expired.ExpiresAt = now.Add(-time.Minute)
+
+	s.seedReservations(ctx, []*entities.Reservation{past, booked, invited, declined, cancelled, expired})
+
+	s.Require().NoError(s.repo.AddPlayer(ctx, entities.NewReservationPlayer(invited.ID, "user-10", "user-11", now), 4))
+	s.Require().NoError(s.repo.AddPlayer(ctx, entities.NewReservationPlayer(declined.ID, "user-10", "user-11", now), 4))
+
+	err := s.repo.UpdatePlayerStatus(
+		ctx, declined.ID, "user-10", entities.InvitedPlayerStatus, entities.DeclinedPlayerStatus, now,
+	)
+	s.Require().NoError(err)
+
+	games, err := s.repo.ListUpcomingByUser(ctx, "user-10", now)
+	s.Require().NoError(err)
+	s.Require().Len(games, 2)
+
+	s.Equal(booked.ID, games[0].Reservation.ID)
+	s.Equal(entities.AcceptedPlayerStatus, games[0].Status)
+	s.Equal(invited.ID, games[1].Reservation.ID)
+	s.Equal(entities.InvitedPlayerStatus, games[1].Status)
+}
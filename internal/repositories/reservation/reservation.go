@@ -3,9 +3,12 @@ package reservation
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lever-dev/padel-backend/internal/entities"
 )
@@ -57,9 +60,20 @@ func (r *Repository) Create(ctx context.Context, reservation *entities.Reservati
 		d.ReservedTo,
 		d.ReservedBy,
 		nullableString(d.CancelledBy),
+		nullableString(d.SeriesID),
+		nullableTime(d.ExpiresAt),
+		nullablePriceAmount(d),
+		nullableString(d.PriceCurrency),
 		d.CreatedAt,
 	)
 	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23P01" {
+				return entities.ErrCourtAlreadyReserved
+			}
+		}
 		return fmt.Errorf("exec: %w", err)
 	}
 
@@ -75,8 +89,12 @@ INSERT INTO reservations (
     reserved_to,
     reserved_by,
     cancelled_by,
+    series_id,
+    expires_at,
+    price_amount,
+    price_currency,
     created_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
 `
 
 func (r *Repository) ListByCourtAndTimeRange(
@@ -121,6 +139,13 @@ SELECT
     reserved_to,
     reserved_by,
     cancelled_by,
+    series_id,
+    expires_at,
+    price_amount,
+    price_currency,
+    cancellation_refund_percent,
+    cancellation_fee,
+    cancellation_waived,
     created_at
 FROM reservations
 WHERE court_id = $1
@@ -129,6 +154,48 @@ WHERE court_id = $1
 ORDER BY reserved_from ASC
 `
 
+// CountActiveByUser counts the reservations the user holds on courts of the organization that start after
+// now, confirmed ones and holds that have not expired at now.
+func (r *Repository) CountActiveByUser(
+	ctx context.Context,
+	organizationID, userID string,
+	now time.Time,
+) (int, error) {
+	if r.pool == nil {
+		return 0, fmt.Errorf("not connected to pool")
+	}
+
+	var count int
+
+	err := r.pool.QueryRow(
+		ctx,
+		countActiveByUserQuery,
+		organizationID,
+		userID,
+		now,
+		entities.ReservedReservationStatus,
+		entities.PendingReservationStatus,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("scan count: %w", err)
+	}
+
+	return count, nil
+}
+
+const countActiveByUserQuery = `
+SELECT count(*)
+FROM reservations r
+JOIN courts c ON c.id = r.court_id
+WHERE c.organization_id = $1
+    AND r.reserved_by = $2
+    AND r.reserved_from > $3
+    AND (
+        r.status = $4
+        OR (r.status = $5 AND (r.expires_at IS NULL OR r.expires_at > $3))
+    )
+`
+
 func (r *Repository) GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error) {
 	if r.pool == nil {
 		return nil, fmt.Errorf("not connected to pool")
@@ -136,6 +203,9 @@ func (r *Repository) GetByID(ctx context.Context, reservationID string) (*entiti
 
 	rsv, err := scan(r.pool.QueryRow(ctx, getReservationByIDQuery, reservationID))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
 		return nil, fmt.Errorf("scan reservation: %w", err)
 	}
 
@@ -151,16 +221,27 @@ const getReservationByIDQuery = `
 	    reserved_to,
 	    reserved_by,
 	    cancelled_by,
+	    series_id,
+	    expires_at,
+	    price_amount,
+	    price_currency,
+	    cancellation_refund_percent,
+	    cancellation_fee,
+	    cancellation_waived,
 	    created_at
 	FROM reservations
 	WHERE id = $1
 	LIMIT 1
 `
 
+// CancelReservation cancels the reservation on the terms of the cancellation, which are recorded with it.
+// Only pending or reserved reservations can be cancelled, so concurrent cancellations are applied once:
+// it fails with ErrReservationNotActive when the reservation was cancelled, expired or moved in the meantime.
 func (r *Repository) CancelReservation(
 	ctx context.Context,
 	reservationID string,
 	cancelledByUser string,
+	cancellation entities.Cancellation,
 ) error {
 	if r.pool == nil {
 		return fmt.Errorf("not connected to pool")
@@ -171,24 +252,245 @@ func (r *Repository) CancelReservation(
 		cancelReservationQuery,
 		entities.CancelledReservationStatus,
 		cancelledByUser,
+		cancellation.RefundPercent,
+		cancellation.Fee,
+		cancellation.Waived,
 		reservationID,
+		entities.PendingReservationStatus,
+		entities.ReservedReservationStatus,
 	)
 	if err != nil {
 		return fmt.Errorf("exec: %w", err)
 	}
 
-	if tag.RowsAffected() == 0 {
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, reservationExistsQuery, reservationID).Scan(&exists); err != nil {
+		return fmt.Errorf("scan: %w", err)
+	}
+
+	if !exists {
 		return entities.ErrNotFound
 	}
 
-	return nil
+	return fmt.Errorf("%w: reservation %s", entities.ErrReservationNotActive, reservationID)
 }
 
 const cancelReservationQuery = `
 UPDATE reservations
 SET status = $1,
-    cancelled_by = $2
-WHERE id = $3
+    cancelled_by = $2,
+    cancellation_refund_percent = $3,
+    cancellation_fee = $4,
+    cancellation_waived = $5
+WHERE id = $6
+    AND status IN ($7, $8)
+`
+
+const reservationExistsQuery = `
+SELECT EXISTS (SELECT 1 FROM reservations WHERE id = $1)
+`
+
+// ConfirmReservation marks a pending hold as reserved. It fails with ErrReservationNotPending
+// when the hold is no longer pending or has already expired at now.
+func (r *Repository) ConfirmReservation(ctx context.Context, reservationID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		confirmReservationQuery,
+		entities.ReservedReservationStatus,
+		reservationID,
+		entities.PendingReservationStatus,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrReservationNotPending
+	}
+
+	return nil
+}
+
+const confirmReservationQuery = `
+UPDATE reservations
+SET status = $1,
+    expires_at = NULL
+WHERE id = $2
+    AND status = $3
+    AND (expires_at IS NULL OR expires_at > $4)
+`
+
+// ReleaseHold marks the pending hold as expired right away, e.g. when its payment failed. It fails with
+// ErrReservationNotPending when the reservation is no longer pending.
+func (r *Repository) ReleaseHold(ctx context.Context, reservationID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		releaseHoldQuery,
+		entities.ExpiredReservationStatus,
+		reservationID,
+		entities.PendingReservationStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrReservationNotPending
+	}
+
+	return nil
+}
+
+const releaseHoldQuery = `
+UPDATE reservations
+SET status = $1
+WHERE id = $2
+    AND status = $3
+`
+
+// ExpirePendingReservations marks every pending hold that expired at or before now as expired
+// and returns the released reservations.
+func (r *Repository) ExpirePendingReservations(ctx context.Context, now time.Time) ([]entities.Reservation, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		expirePendingReservationsQuery,
+		entities.ExpiredReservationStatus,
+		entities.PendingReservationStatus,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []entities.Reservation
+
+	for rows.Next() {
+		rsv, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan reservation: %w", err)
+		}
+
+		results = append(results, rsv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return results, nil
+}
+
+const expirePendingReservationsQuery = `
+UPDATE reservations
+SET status = $1
+WHERE status = $2
+    AND expires_at <= $3
+RETURNING
+    id,
+    court_id,
+    status,
+    reserved_from,
+    reserved_to,
+    reserved_by,
+    cancelled_by,
+    series_id,
+    expires_at,
+    price_amount,
+    price_currency,
+    cancellation_refund_percent,
+    cancellation_fee,
+    cancellation_waived,
+    created_at
+`
+
+// ExpireOverlappingHolds marks the pending holds on the court that overlap the slot and ran out at now as
+// expired, and returns them. The expirer releases run out holds only periodically, until then they still
+// count for the exclusion constraint.
+func (r *Repository) ExpireOverlappingHolds(
+	ctx context.Context,
+	courtID string,
+	from, to time.Time,
+	now time.Time,
+) ([]entities.Reservation, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		expireOverlappingHoldsQuery,
+		entities.ExpiredReservationStatus,
+		courtID,
+		from.UTC(),
+		to.UTC(),
+		entities.PendingReservationStatus,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []entities.Reservation
+
+	for rows.Next() {
+		rsv, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan reservation: %w", err)
+		}
+
+		results = append(results, rsv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return results, nil
+}
+
+const expireOverlappingHoldsQuery = `
+UPDATE reservations
+SET status = $1
+WHERE court_id = $2
+    AND reserved_from < $4
+    AND reserved_to > $3
+    AND status = $5
+    AND expires_at <= $6
+RETURNING
+    id,
+    court_id,
+    status,
+    reserved_from,
+    reserved_to,
+    reserved_by,
+    cancelled_by,
+    series_id,
+    expires_at,
+    price_amount,
+    price_currency,
+    cancellation_refund_percent,
+    cancellation_fee,
+    cancellation_waived,
+    created_at
 `
 
 func nullableString(s string) any {
@@ -199,6 +501,23 @@ func nullableString(s string) any {
 	return s
 }
 
+// nullablePriceAmount stores no amount for unpriced reservations, so they can be told apart from free ones.
+func nullablePriceAmount(d dto) any {
+	if d.PriceCurrency == "" {
+		return nil
+	}
+
+	return d.PriceAmount
+}
+
+func nullableTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+
+	return t
+}
+
 type rowScanner interface {
 	Scan(dest ...any) error
 }
@@ -207,6 +526,13 @@ func scan(scanner rowScanner) (entities.Reservation, error) {
 	var (
 		d           dto
 		cancelledBy sql.NullString
+		seriesID    sql.NullString
+		expiresAt   sql.NullTime
+		amount      sql.NullInt64
+		currency    sql.NullString
+		refund      sql.NullInt32
+		fee         sql.NullInt64
+		waived      bool
 	)
 
 	err := scanner.Scan(
@@ -217,6 +543,13 @@ func scan(scanner rowScanner) (entities.Reservation, error) {
 		&d.ReservedTo,
 		&d.ReservedBy,
 		&cancelledBy,
+		&seriesID,
+		&expiresAt,
+		&amount,
+		&currency,
+		&refund,
+		&fee,
+		&waived,
 		&d.CreatedAt,
 	)
 	if err != nil {
@@ -227,6 +560,27 @@ func scan(scanner rowScanner) (entities.Reservation, error) {
 		d.CancelledBy = cancelledBy.String
 	}
 
+	if seriesID.Valid {
+		d.SeriesID = seriesID.String
+	}
+
+	if expiresAt.Valid {
+		d.ExpiresAt = expiresAt.Time.UTC()
+	}
+
+	if amount.Valid && currency.Valid {
+		d.PriceAmount = amount.Int64
+		d.PriceCurrency = currency.String
+	}
+
+	if refund.Valid {
+		d.Cancellation = &entities.Cancellation{
+			RefundPercent: int(refund.Int32),
+			Fee:           fee.Int64,
+			Waived:        waived,
+		}
+	}
+
 	d.ReservedFrom = d.ReservedFrom.UTC()
 	d.ReservedTo = d.ReservedTo.UTC()
 	d.CreatedAt = d.CreatedAt.UTC()
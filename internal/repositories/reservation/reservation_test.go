@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
+	courtRepo "github.com/lever-dev/padel-backend/internal/repositories/courts"
 	"github.com/lever-dev/padel-backend/internal/repositories/reservation"
 )
 
@@ -64,6 +65,28 @@ func (s *repositorySuite) TestCreateReservation() {
 	s.Require().Equal(res, resDb)
 }
 
+func (s *repositorySuite) TestCreateReservation_WithPrice() {
+	ctx := context.Background()
+	res := &entities.Reservation{
+		ID:           "res-create-priced",
+		CourtID:      "court-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: time.Date(2024, 7, 20, 11, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 7, 20, 12, 30, 0, 0, time.UTC),
+		ReservedBy:   "user-1",
+		Price:        entities.Price{Amount: 3600, Currency: "EUR"},
+		CreatedAt:    time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	err := s.repo.Create(ctx, res)
+	s.Require().NoError(err)
+
+	resDb, err := s.repo.GetByID(ctx, res.ID)
+	s.Require().NoError(err)
+
+	s.Require().Equal(res, resDb)
+}
+
 func (s *repositorySuite) TestListReservations() {
 	ctx := context.Background()
 	base := time.Date(2024, 7, 21, 9, 0, 0, 0, time.UTC)
@@ -139,7 +162,8 @@ func (s *repositorySuite) TestCancelReservation() {
 	s.seedReservations(ctx, []*entities.Reservation{res})
 
 	cancelledBy := "admin-user"
-	err := s.repo.CancelReservation(ctx, res.ID, cancelledBy)
+	cancellation := entities.Cancellation{RefundPercent: 50, Fee: 1500}
+	err := s.repo.CancelReservation(ctx, res.ID, cancelledBy, cancellation)
 	s.Require().NoError(err)
 
 	resDb, err := s.repo.GetByID(ctx, res.ID)
@@ -147,15 +171,75 @@ func (s *repositorySuite) TestCancelReservation() {
 
 	s.Equal(entities.CancelledReservationStatus, resDb.Status)
 	s.Equal(cancelledBy, resDb.CancelledBy)
+	s.Equal(&cancellation, resDb.Cancellation)
+
+	// a second cancellation does not overwrite the terms of the first
+	err = s.repo.CancelReservation(ctx, res.ID, "user-1", entities.FreeCancellation(true))
+	s.ErrorIs(err, entities.ErrReservationNotActive)
+
+	resDb, err = s.repo.GetByID(ctx, res.ID)
+	s.Require().NoError(err)
+	s.Equal(cancelledBy, resDb.CancelledBy)
+	s.Equal(&cancellation, resDb.Cancellation)
 }
 
 func (s *repositorySuite) TestCancelReservation_NotFound() {
 	ctx := context.Background()
-	err := s.repo.CancelReservation(ctx, "does-not-exist-id", "someone")
+	err := s.repo.CancelReservation(ctx, "does-not-exist-id", "someone", entities.FreeCancellation(false))
 	s.Require().Error(err)
 	s.ErrorIs(err, entities.ErrNotFound)
 }
 
+func (s *repositorySuite) TestCountActiveByUser() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 20, 8, 0, 0, 0, time.UTC)
+
+	courtsRepo := courtRepo.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(courtsRepo.Connect(ctx))
+	defer courtsRepo.Close()
+
+	court := entities.NewCourt("org-count-1", "Center court")
+	court.ID = "court-count-1"
+	s.Require().NoError(courtsRepo.Create(ctx, court))
+
+	reservation := func(id string, hour int, status entities.ReservationStatus) *entities.Reservation {
+		return &entities.Reservation{
+			ID:           id,
+			CourtID:      court.ID,
+			Status:       status,
+			ReservedFrom: time.Date(2024, 8, 20, hour, 0, 0, 0, time.UTC),
+			ReservedTo:   time.Date(2024, 8, 20, hour+1, 0, 0, 0, time.UTC),
+			ReservedBy:   "user-count-1",
+			CreatedAt:    now,
+		}
+	}
+
+	expiredHold := reservation("res-count-expired-hold", 12, entities.PendingReservationStatus)
+	expiredHold.ExpiresAt = now.Add(-time.Minute)
+
+	hold := reservation("res-count-hold", 13, entities.PendingReservationStatus)
+	hold.ExpiresAt = now.Add(time.Minute)
+
+	cancelled := reservation("res-count-cancelled", 14, entities.CancelledReservationStatus)
+	cancelled.CancelledBy = "user-count-1"
+
+	s.seedReservations(ctx, []*entities.Reservation{
+		reservation("res-count-started", 7, entities.ReservedReservationStatus),
+		reservation("res-count-upcoming", 10, entities.ReservedReservationStatus),
+		expiredHold,
+		hold,
+		cancelled,
+	})
+
+	count, err := s.repo.CountActiveByUser(ctx, "org-count-1", "user-count-1", now)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	count, err = s.repo.CountActiveByUser(ctx, "org-count-2", "user-count-1", now)
+	s.Require().NoError(err)
+	s.Zero(count)
+}
+
 func (s *repositorySuite) seedReservations(ctx context.Context, reservations []*entities.Reservation) {
 	s.T().Helper()
 	for _, res := range reservations {
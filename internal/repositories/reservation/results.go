@@ -0,0 +1,316 @@
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// SaveResult records the result reported for the reservation. It fails with ErrResultAlreadyReported when
+// the reservation has a result already.
+func (r *Repository) SaveResult(ctx context.Context, result *entities.MatchResult) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	gamesA, gamesB := splitSets(result.Sets)
+
+	tag, err := r.pool.Exec(
+		ctx,
+		saveResultQuery,
+		result.ReservationID,
+		result.TeamA,
+		result.TeamB,
+		gamesA,
+		gamesB,
+		result.Status,
+		result.ReportedBy,
+		nullableString(result.AnsweredBy),
+		result.CreatedAt.UTC(),
+		result.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrResultAlreadyReported
+	}
+
+	return nil
+}
+
+const saveResultQuery = `
+INSERT INTO match_results (
+    reservation_id,
+    team_a,
+    team_b,
+    team_a_games,
+    team_b_games,
+    status,
+    reported_by,
+    answered_by,
+    created_at,
+    updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+ON CONFLICT (reservation_id) DO NOTHING
+`
+
+func (r *Repository) GetResult(ctx context.Context, reservationID string) (*entities.MatchResult, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var (
+		result     entities.MatchResult
+		gamesA     []int
+		gamesB     []int
+		status     string
+		answeredBy sql.NullString
+	)
+
+	err := r.pool.QueryRow(ctx, getResultQuery, reservationID).Scan(
+		&result.ReservationID,
+		&result.TeamA,
+		&result.TeamB,
+		&gamesA,
+		&gamesB,
+		&status,
+		&result.ReportedBy,
+		&answeredBy,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan result: %w", err)
+	}
+
+	result.Sets = make([]entities.SetScore, 0, len(gamesA))
+	for i := range gamesA {
+		result.Sets = append(result.Sets, entities.SetScore{TeamA: gamesA[i], TeamB: gamesB[i]})
+	}
+
+	result.Status = entities.ResultStatus(status)
+	result.AnsweredBy = answeredBy.String
+	result.CreatedAt = result.CreatedAt.UTC()
+	result.UpdatedAt = result.UpdatedAt.UTC()
+
+	return &result, nil
+}
+
+const getResultQuery = `
+SELECT
+    reservation_id,
+    team_a,
+    team_b,
+    team_a_games,
+    team_b_games,
+    status,
+    reported_by,
+    answered_by,
+    created_at,
+    updated_at
+FROM match_results
+WHERE reservation_id = $1
+LIMIT 1
+`
+
+// DisputeResult marks the pending result as contested by the user. It fails with
+// ErrInvalidResultTransition when the result was answered already.
+func (r *Repository) DisputeResult(ctx context.Context, reservationID, userID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		disputeResultQuery,
+		entities.DisputedResultStatus,
+		userID,
+		now.UTC(),
+		reservationID,
+		entities.PendingResultStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: result of reservation %s is not pending", entities.ErrInvalidResultTransition, reservationID)
+	}
+
+	return nil
+}
+
+const disputeResultQuery = `
+UPDATE match_results
+SET status = $1,
+    answered_by = $2,
+    updated_at = $3
+WHERE reservation_id = $4
+    AND status = $5
+`
+
+// ConfirmResult stores the teams and sets of the result as confirmed and rates its players in the same
+// transaction, returning their rating changes. The result must still be in status from, otherwise it fails
+// with ErrInvalidResultTransition, so a result is never rated twice.
+func (r *Repository) ConfirmResult(
+	ctx context.Context,
+	result *entities.MatchResult,
+	from entities.ResultStatus,
+) ([]entities.RatingChange, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var changes []entities.RatingChange
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		gamesA, gamesB := splitSets(result.Sets)
+
+		tag, err := tx.Exec(
+			ctx,
+			confirmResultQuery,
+			result.TeamA,
+			result.TeamB,
+			gamesA,
+			gamesB,
+			entities.ConfirmedResultStatus,
+			result.AnsweredBy,
+			result.UpdatedAt.UTC(),
+			result.ReservationID,
+			from,
+		)
+		if err != nil {
+			return fmt.Errorf("confirm result: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: result of reservation %s is not %s",
+				entities.ErrInvalidResultTransition, result.ReservationID, from)
+		}
+
+		ratings, err := lockRatings(ctx, tx, result.Players())
+		if err != nil {
+			return err
+		}
+
+		changes = entities.RateResult(*result, ratings, result.UpdatedAt.UTC())
+
+		for _, change := range changes {
+			if _, err := tx.Exec(ctx, updateRatingQuery, change.After, change.UserID); err != nil {
+				return fmt.Errorf("update rating: %w", err)
+			}
+
+			_, err := tx.Exec(
+				ctx,
+				insertRatingChangeQuery,
+				change.UserID,
+				change.ReservationID,
+				change.Before,
+				change.After,
+				change.CreatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("insert rating change: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Status = entities.ConfirmedResultStatus
+
+	return changes, nil
+}
+
+// lockRatings reads the ratings of the players and keeps their rows locked, so concurrent results of the
+// same players are rated one after the other.
+func lockRatings(ctx context.Context, tx pgx.Tx, userIDs []string) (map[string]entities.PlayerRating, error) {
+	rows, err := tx.Query(ctx, lockRatingsQuery, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("lock ratings: %w", err)
+	}
+	defer rows.Close()
+
+	ratings := make(map[string]entities.PlayerRating, len(userIDs))
+
+	for rows.Next() {
+		var rating entities.PlayerRating
+		if err := rows.Scan(&rating.UserID, &rating.Rating, &rating.RatedGames); err != nil {
+			return nil, fmt.Errorf("scan rating: %w", err)
+		}
+
+		ratings[rating.UserID] = rating
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	if len(ratings) != len(userIDs) {
+		return nil, fmt.Errorf("%w: some players of the result do not exist", entities.ErrNotFound)
+	}
+
+	return ratings, nil
+}
+
+func splitSets(sets []entities.SetScore) ([]int, []int) {
+	gamesA := make([]int, 0, len(sets))
+	gamesB := make([]int, 0, len(sets))
+	for _, set := range sets {
+		gamesA = append(gamesA, set.TeamA)
+		gamesB = append(gamesB, set.TeamB)
+	}
+
+	return gamesA, gamesB
+}
+
+const confirmResultQuery = `
+UPDATE match_results
+SET team_a = $1,
+    team_b = $2,
+    team_a_games = $3,
+    team_b_games = $4,
+    status = $5,
+    answered_by = $6,
+    updated_at = $7
+WHERE reservation_id = $8
+    AND status = $9
+`
+
+const lockRatingsQuery = `
+SELECT id, rating, rated_games
+FROM users
+WHERE id = ANY($1)
+ORDER BY id
+FOR UPDATE
+`
+
+const updateRatingQuery = `
+UPDATE users
+SET rating = $1,
+    rated_games = rated_games + 1
+WHERE id = $2
+`
+
+const insertRatingChangeQuery = `
+INSERT INTO rating_history (
+    user_id,
+    reservation_id,
+    rating_before,
+    rating_after,
+    created_at
+) VALUES ($1,$2,$3,$4,$5)
+`
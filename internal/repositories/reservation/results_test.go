@@ -0,0 +1,87 @@
+package reservation_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/repositories/users"
+)
+
+func (s *repositorySuite) TestConfirmResult() {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 20, 12, 0, 0, 0, time.UTC)
+
+	usersRepo := users.NewRepository(os.Getenv("POSTGRES_CONNECTION_URL"))
+	s.Require().NoError(usersRepo.Connect(ctx))
+	defer usersRepo.Close()
+
+	for i, id := range []string{"user-result-1", "user-result-2", "user-result-3", "user-result-4"} {
+		s.Require().NoError(usersRepo.Create(ctx, &entities.User{
+			ID:          id,
+			Nickname:    id,
+			PhoneNumber: "+7702000000" + string(rune('1'+i)),
+			CreatedAt:   now,
+		}))
+	}
+
+	rsv := &entities.Reservation{
+		ID:           "res-result-1",
+		CourtID:      "court-result-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: time.Date(2024, 8, 20, 9, 0, 0, 0, time.UTC),
+		ReservedTo:   time.Date(2024, 8, 20, 10, 30, 0, 0, time.UTC),
+		ReservedBy:   "user-result-1",
+		CreatedAt:    now,
+	}
+	s.seedReservations(ctx, []*entities.Reservation{rsv})
+
+	result := entities.NewMatchResult(
+		rsv.ID,
+		"user-result-1",
+		[]string{"user-result-1", "user-result-2"},
+		[]string{"user-result-3", "user-result-4"},
+		[]entities.SetScore{{TeamA: 6, TeamB: 4}, {TeamA: 3, TeamB: 6}, {TeamA: 7, TeamB: 5}},
+		now,
+	)
+	s.Require().NoError(s.repo.SaveResult(ctx, result))
+	s.ErrorIs(s.repo.SaveResult(ctx, result), entities.ErrResultAlreadyReported)
+
+	saved, err := s.repo.GetResult(ctx, rsv.ID)
+	s.Require().NoError(err)
+	s.Equal(result, saved)
+
+	s.Require().NoError(s.repo.DisputeResult(ctx, rsv.ID, "user-result-3", now))
+	s.ErrorIs(s.repo.DisputeResult(ctx, rsv.ID, "user-result-4", now), entities.ErrInvalidResultTransition)
+
+	_, err = s.repo.ConfirmResult(ctx, result, entities.PendingResultStatus)
+	s.ErrorIs(err, entities.ErrInvalidResultTransition)
+
+	result.AnsweredBy = "staff-1"
+	result.UpdatedAt = now.Add(time.Hour)
+
+	changes, err := s.repo.ConfirmResult(ctx, result, entities.DisputedResultStatus)
+	s.Require().NoError(err)
+	s.Require().Len(changes, 4)
+	s.Equal(entities.ConfirmedResultStatus, result.Status)
+
+	_, err = s.repo.ConfirmResult(ctx, result, entities.DisputedResultStatus)
+	s.ErrorIs(err, entities.ErrInvalidResultTransition)
+
+	winner, err := usersRepo.GetByID(ctx, "user-result-1")
+	s.Require().NoError(err)
+	s.Greater(winner.Rating, entities.InitialRating)
+	s.Equal(1, winner.RatedGames)
+
+	loser, err := usersRepo.GetByID(ctx, "user-result-3")
+	s.Require().NoError(err)
+	s.Less(loser.Rating, entities.InitialRating)
+
+	history, err := usersRepo.ListRatingHistory(ctx, "user-result-1", 10)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Equal(rsv.ID, history[0].ReservationID)
+	s.InDelta(entities.InitialRating, history[0].Before, 0.001)
+	s.InDelta(winner.Rating, history[0].After, 0.001)
+}
@@ -0,0 +1,263 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (r *Repository) CreateSeries(ctx context.Context, series *entities.ReservationSeries) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if series.CreatedAt.IsZero() {
+		series.CreatedAt = time.Now().UTC()
+	}
+
+	d := newSeriesDTO(series)
+
+	_, err := r.pool.Exec(
+		ctx,
+		createSeriesQuery,
+		d.ID,
+		d.CourtID,
+		d.Frequency,
+		d.RepeatInterval,
+		d.Weekdays,
+		d.StartDate,
+		d.EndDate,
+		d.StartMinute,
+		d.DurationMinutes,
+		d.ReservedBy,
+		d.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createSeriesQuery = `
+INSERT INTO reservation_series (
+    id,
+    court_id,
+    frequency,
+    repeat_interval,
+    weekdays,
+    start_date,
+    end_date,
+    start_minute,
+    duration_minutes,
+    reserved_by,
+    created_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+`
+
+func (r *Repository) GetSeriesByID(ctx context.Context, seriesID string) (*entities.ReservationSeries, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var d seriesDTO
+
+	err := r.pool.QueryRow(ctx, getSeriesByIDQuery, seriesID).Scan(
+		&d.ID,
+		&d.CourtID,
+		&d.Frequency,
+		&d.RepeatInterval,
+		&d.Weekdays,
+		&d.StartDate,
+		&d.EndDate,
+		&d.StartMinute,
+		&d.DurationMinutes,
+		&d.ReservedBy,
+		&d.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan series: %w", err)
+	}
+
+	d.CreatedAt = d.CreatedAt.UTC()
+
+	series := d.toEntity()
+
+	return &series, nil
+}
+
+const getSeriesByIDQuery = `
+SELECT
+    id,
+    court_id,
+    frequency,
+    repeat_interval,
+    weekdays,
+    start_date,
+    end_date,
+    start_minute,
+    duration_minutes,
+    reserved_by,
+    created_at
+FROM reservation_series
+WHERE id = $1
+LIMIT 1
+`
+
+func (r *Repository) ListBySeries(ctx context.Context, seriesID string) ([]entities.Reservation, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listReservationsBySeriesQuery, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []entities.Reservation
+
+	for rows.Next() {
+		rsv, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan reservation: %w", err)
+		}
+
+		results = append(results, rsv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return results, nil
+}
+
+const listReservationsBySeriesQuery = `
+SELECT
+    id,
+    court_id,
+    status,
+    reserved_from,
+    reserved_to,
+    reserved_by,
+    cancelled_by,
+    series_id,
+    expires_at,
+    price_amount,
+    price_currency,
+    cancellation_refund_percent,
+    cancellation_fee,
+    cancellation_waived,
+    created_at
+FROM reservations
+WHERE series_id = $1
+ORDER BY reserved_from ASC
+`
+
+// DeleteSeries removes the series together with its occurrences. It is meant to undo a series that failed
+// to be booked, before anything refers to its occurrences.
+func (r *Repository) DeleteSeries(ctx context.Context, seriesID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, deleteSeriesReservationsQuery, seriesID); err != nil {
+			return fmt.Errorf("delete series reservations: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, deleteSeriesQuery, seriesID); err != nil {
+			return fmt.Errorf("delete series: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const deleteSeriesReservationsQuery = `
+DELETE FROM reservations
+WHERE series_id = $1
+`
+
+const deleteSeriesQuery = `
+DELETE FROM reservation_series
+WHERE id = $1
+`
+
+// ConfirmSeriesHolds marks every pending hold of the series that has not expired at now as reserved and
+// returns the sum of their prices.
+func (r *Repository) ConfirmSeriesHolds(ctx context.Context, seriesID string, now time.Time) (int64, error) {
+	if r.pool == nil {
+		return 0, fmt.Errorf("not connected to pool")
+	}
+
+	var confirmed int64
+
+	err := r.pool.QueryRow(
+		ctx,
+		confirmSeriesHoldsQuery,
+		entities.ReservedReservationStatus,
+		seriesID,
+		entities.PendingReservationStatus,
+		now,
+	).Scan(&confirmed)
+	if err != nil {
+		return 0, fmt.Errorf("scan: %w", err)
+	}
+
+	return confirmed, nil
+}
+
+const confirmSeriesHoldsQuery = `
+WITH confirmed AS (
+    UPDATE reservations
+    SET status = $1,
+        expires_at = NULL
+    WHERE series_id = $2
+        AND status = $3
+        AND (expires_at IS NULL OR expires_at > $4)
+    RETURNING price_amount
+)
+SELECT COALESCE(SUM(price_amount), 0)::BIGINT FROM confirmed
+`
+
+// ReleaseSeriesHolds marks every pending hold of the series as expired right away, e.g. when the payment of
+// the series failed.
+func (r *Repository) ReleaseSeriesHolds(ctx context.Context, seriesID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	_, err := r.pool.Exec(
+		ctx,
+		releaseSeriesHoldsQuery,
+		entities.ExpiredReservationStatus,
+		seriesID,
+		entities.PendingReservationStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const releaseSeriesHoldsQuery = `
+UPDATE reservations
+SET status = $1
+WHERE series_id = $2
+    AND status = $3
+`
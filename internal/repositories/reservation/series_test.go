@@ -0,0 +1,180 @@
+package reservation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestCreateAndGetSeries() {
+	ctx := context.Background()
+
+	series := &entities.ReservationSeries{
+		ID:      "series-create-1",
+		CourtID: "court-series-1",
+		Rule: entities.RecurrenceRule{
+			Frequency: entities.WeeklyRecurrence,
+			Interval:  1,
+			Weekdays:  []time.Weekday{time.Tuesday, time.Thursday},
+		},
+		StartDate:  time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		StartTime:  19 * 60,
+		Duration:   90 * time.Minute,
+		ReservedBy: "user-1",
+		CreatedAt:  time.Date(2024, 8, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	err := s.repo.CreateSeries(ctx, series)
+	s.Require().NoError(err)
+
+	seriesDB, err := s.repo.GetSeriesByID(ctx, series.ID)
+	s.Require().NoError(err)
+	s.Equal(series, seriesDB)
+}
+
+func (s *repositorySuite) TestGetSeriesByID_NotFound() {
+	_, err := s.repo.GetSeriesByID(context.Background(), "series-missing")
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestListBySeries() {
+	ctx := context.Background()
+	base := time.Date(2024, 9, 3, 19, 0, 0, 0, time.UTC)
+
+	reservations := make([]*entities.Reservation, 0, 4)
+	for week := range 4 {
+		from := base.AddDate(0, 0, 7*week)
+		reservations = append(reservations, &entities.Reservation{
+			ID:           "res-series-list-" + from.Format(time.DateOnly),
+			CourtID:      "court-series-2",
+			Status:       entities.ReservedReservationStatus,
+			ReservedFrom: from,
+			ReservedTo:   from.Add(time.Hour),
+			ReservedBy:   "user-1",
+			SeriesID:     "series-list-1",
+			CreatedAt:    base.AddDate(0, -1, 0),
+		})
+	}
+
+	reservations[3].Status = entities.CancelledReservationStatus
+	reservations[3].CancelledBy = "user-1"
+
+	s.seedReservations(ctx, reservations)
+
+	list, err := s.repo.ListBySeries(ctx, "series-list-1")
+	s.Require().NoError(err)
+	s.Require().Len(list, 4)
+
+	for i, rsv := range list {
+		s.Equal(reservations[i].ID, rsv.ID)
+		s.Equal(reservations[i].Status, rsv.Status)
+		s.Equal("series-list-1", rsv.SeriesID)
+	}
+}
+
+func (s *repositorySuite) TestConfirmSeriesHolds() {
+	ctx := context.Background()
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	base := time.Date(2024, 9, 3, 19, 0, 0, 0, time.UTC)
+
+	reservations := make([]*entities.Reservation, 0, 3)
+	for week := range 3 {
+		from := base.AddDate(0, 0, 7*week)
+		reservations = append(reservations, &entities.Reservation{
+			ID:           "res-series-confirm-" + from.Format(time.DateOnly),
+			CourtID:      "court-series-3",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: from,
+			ReservedTo:   from.Add(time.Hour),
+			ReservedBy:   "user-1",
+			SeriesID:     "series-confirm-1",
+			ExpiresAt:    now.Add(15 * time.Minute),
+			Price:        entities.Price{Amount: 3000, Currency: "EUR"},
+			CreatedAt:    now,
+		})
+	}
+
+	// the last hold was cancelled before the series was paid
+	reservations[2].Status = entities.CancelledReservationStatus
+	reservations[2].CancelledBy = "user-1"
+
+	s.seedReservations(ctx, reservations)
+
+	confirmed, err := s.repo.ConfirmSeriesHolds(ctx, "series-confirm-1", now)
+	s.Require().NoError(err)
+	s.Equal(int64(6000), confirmed)
+
+	confirmed, err = s.repo.ConfirmSeriesHolds(ctx, "series-confirm-1", now)
+	s.Require().NoError(err)
+	s.Zero(confirmed)
+
+	list, err := s.repo.ListBySeries(ctx, "series-confirm-1")
+	s.Require().NoError(err)
+	s.Equal(entities.ReservedReservationStatus, list[0].Status)
+	s.True(list[0].ExpiresAt.IsZero())
+	s.Equal(entities.ReservedReservationStatus, list[1].Status)
+	s.Equal(entities.CancelledReservationStatus, list[2].Status)
+}
+
+func (s *repositorySuite) TestReleaseSeriesHolds() {
+	ctx := context.Background()
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2024, 9, 3, 19, 0, 0, 0, time.UTC)
+
+	s.seedReservations(ctx, []*entities.Reservation{{
+		ID:           "res-series-release-1",
+		CourtID:      "court-series-4",
+		Status:       entities.PendingReservationStatus,
+		ReservedFrom: from,
+		ReservedTo:   from.Add(time.Hour),
+		ReservedBy:   "user-1",
+		SeriesID:     "series-release-1",
+		ExpiresAt:    now.Add(15 * time.Minute),
+		Price:        entities.Price{Amount: 3000, Currency: "EUR"},
+		CreatedAt:    now,
+	}})
+
+	s.Require().NoError(s.repo.ReleaseSeriesHolds(ctx, "series-release-1"))
+
+	rsv, err := s.repo.GetByID(ctx, "res-series-release-1")
+	s.Require().NoError(err)
+	s.Equal(entities.ExpiredReservationStatus, rsv.Status)
+}
+
+func (s *repositorySuite) TestDeleteSeries() {
+	ctx := context.Background()
+	from := time.Date(2024, 9, 3, 19, 0, 0, 0, time.UTC)
+
+	series := &entities.ReservationSeries{
+		ID:         "series-delete-1",
+		CourtID:    "court-series-5",
+		Rule:       entities.RecurrenceRule{Frequency: entities.WeeklyRecurrence, Interval: 1},
+		StartDate:  time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		StartTime:  19 * 60,
+		Duration:   time.Hour,
+		ReservedBy: "user-1",
+	}
+	s.Require().NoError(s.repo.CreateSeries(ctx, series))
+
+	s.seedReservations(ctx, []*entities.Reservation{{
+		ID:           "res-series-delete-1",
+		CourtID:      "court-series-5",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: from,
+		ReservedTo:   from.Add(time.Hour),
+		ReservedBy:   "user-1",
+		SeriesID:     series.ID,
+	}})
+
+	s.Require().NoError(s.repo.DeleteSeries(ctx, series.ID))
+
+	_, err := s.repo.GetSeriesByID(ctx, series.ID)
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	_, err = s.repo.GetByID(ctx, "res-series-delete-1")
+	s.ErrorIs(err, entities.ErrNotFound)
+}
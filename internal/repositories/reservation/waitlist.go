@@ -0,0 +1,378 @@
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (r *Repository) CreateWaitlistEntry(ctx context.Context, entry *entities.WaitlistEntry) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	_, err := r.pool.Exec(
+		ctx,
+		createWaitlistEntryQuery,
+		entry.ID,
+		entry.OrganizationID,
+		nullableString(entry.CourtID),
+		entry.UserID,
+		entry.From.UTC(),
+		entry.To.UTC(),
+		entry.Status,
+		nullableString(entry.ReservationID),
+		entry.CreatedAt.UTC(),
+		entry.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+const createWaitlistEntryQuery = `
+INSERT INTO waitlist_entries (
+    id,
+    organization_id,
+    court_id,
+    user_id,
+    reserved_from,
+    reserved_to,
+    status,
+    reservation_id,
+    created_at,
+    updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+`
+
+// ListWaitlistByUser returns the waitlist entries of the user, the earliest window first.
+func (r *Repository) ListWaitlistByUser(ctx context.Context, userID string) ([]entities.WaitlistEntry, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listWaitlistByUserQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []entities.WaitlistEntry
+
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan waitlist entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return entries, nil
+}
+
+const listWaitlistByUserQuery = `
+SELECT
+    id,
+    organization_id,
+    court_id,
+    user_id,
+    reserved_from,
+    reserved_to,
+    status,
+    reservation_id,
+    created_at,
+    updated_at
+FROM waitlist_entries
+WHERE user_id = $1
+ORDER BY reserved_from ASC, created_at ASC
+`
+
+// DeleteWaitlistEntry takes the user off the waitlist. It fails with ErrNotFound when the user has no entry
+// with the id still waiting.
+func (r *Repository) DeleteWaitlistEntry(ctx context.Context, entryID, userID string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, deleteWaitlistEntryQuery, entryID, userID, entities.WaitingWaitlistStatus)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteWaitlistEntryQuery = `
+DELETE FROM waitlist_entries
+WHERE id = $1
+    AND user_id = $2
+    AND status = $3
+`
+
+// ClaimWaitlistEntry takes the first entry in the queue waiting for a window within from and to, either on
+// the court or on any court of the organization, and marks it offered with the hold reservationID. Entries
+// claimed by a concurrent transaction are skipped, so no entry is offered two slots. It fails with
+// ErrNotFound when nobody waits for the slot.
+func (r *Repository) ClaimWaitlistEntry(
+	ctx context.Context,
+	organizationID, courtID string,
+	from, to time.Time,
+	reservationID string,
+	now time.Time,
+) (*entities.WaitlistEntry, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	var entry entities.WaitlistEntry
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+
+		entry, err = scanWaitlistEntry(tx.QueryRow(
+			ctx,
+			nextWaitlistEntryQuery,
+			organizationID,
+			courtID,
+			from.UTC(),
+			to.UTC(),
+			entities.WaitingWaitlistStatus,
+		))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return entities.ErrNotFound
+			}
+			return fmt.Errorf("scan waitlist entry: %w", err)
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			offerWaitlistEntryQuery,
+			entities.OfferedWaitlistStatus,
+			reservationID,
+			now.UTC(),
+			entry.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("offer waitlist entry: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Status = entities.OfferedWaitlistStatus
+	entry.ReservationID = reservationID
+	entry.UpdatedAt = now.UTC()
+
+	return &entry, nil
+}
+
+const nextWaitlistEntryQuery = `
+SELECT
+    id,
+    organization_id,
+    court_id,
+    user_id,
+    reserved_from,
+    reserved_to,
+    status,
+    reservation_id,
+    created_at,
+    updated_at
+FROM waitlist_entries
+WHERE organization_id = $1
+    AND (court_id = $2 OR court_id IS NULL)
+    AND reserved_from >= $3
+    AND reserved_to <= $4
+    AND status = $5
+ORDER BY created_at ASC, id ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+const offerWaitlistEntryQuery = `
+UPDATE waitlist_entries
+SET status = $1,
+    reservation_id = $2,
+    updated_at = $3
+WHERE id = $4
+`
+
+// ReleaseWaitlistEntry puts an entry whose offer could not be made back in the queue, in its original place.
+func (r *Repository) ReleaseWaitlistEntry(ctx context.Context, entryID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		releaseWaitlistEntryQuery,
+		entities.WaitingWaitlistStatus,
+		now.UTC(),
+		entryID,
+		entities.OfferedWaitlistStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const releaseWaitlistEntryQuery = `
+UPDATE waitlist_entries
+SET status = $1,
+    reservation_id = NULL,
+    updated_at = $2
+WHERE id = $3
+    AND status = $4
+`
+
+// ResetWaitlistOffer puts the entry that was offered the hold reservationID back in the queue, in its original
+// place, once the hold ran out unconfirmed. It fails with ErrNotFound when the hold was not a waitlist offer.
+func (r *Repository) ResetWaitlistOffer(ctx context.Context, reservationID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		resetWaitlistOfferQuery,
+		entities.WaitingWaitlistStatus,
+		now.UTC(),
+		reservationID,
+		entities.OfferedWaitlistStatus,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const resetWaitlistOfferQuery = `
+UPDATE waitlist_entries
+SET status = $1,
+    reservation_id = NULL,
+    updated_at = $2
+WHERE reservation_id = $3
+    AND status = $4
+`
+
+// ExpireWaitlistEntries marks the entries still waiting for a window that already started as expired and
+// returns them.
+func (r *Repository) ExpireWaitlistEntries(ctx context.Context, now time.Time) ([]entities.WaitlistEntry, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		expireWaitlistEntriesQuery,
+		entities.ExpiredWaitlistStatus,
+		now.UTC(),
+		entities.WaitingWaitlistStatus,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []entities.WaitlistEntry
+
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan waitlist entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return entries, nil
+}
+
+const expireWaitlistEntriesQuery = `
+UPDATE waitlist_entries
+SET status = $1,
+    updated_at = $2
+WHERE status = $3
+    AND reserved_from <= $2
+RETURNING
+    id,
+    organization_id,
+    court_id,
+    user_id,
+    reserved_from,
+    reserved_to,
+    status,
+    reservation_id,
+    created_at,
+    updated_at
+`
+
+func scanWaitlistEntry(scanner rowScanner) (entities.WaitlistEntry, error) {
+	var (
+		entry         entities.WaitlistEntry
+		courtID       sql.NullString
+		status        string
+		reservationID sql.NullString
+	)
+
+	err := scanner.Scan(
+		&entry.ID,
+		&entry.OrganizationID,
+		&courtID,
+		&entry.UserID,
+		&entry.From,
+		&entry.To,
+		&status,
+		&reservationID,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return entities.WaitlistEntry{}, err
+	}
+
+	entry.CourtID = courtID.String
+	entry.Status = entities.WaitlistStatus(status)
+	entry.ReservationID = reservationID.String
+	entry.From = entry.From.UTC()
+	entry.To = entry.To.UTC()
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	entry.UpdatedAt = entry.UpdatedAt.UTC()
+
+	return entry, nil
+}
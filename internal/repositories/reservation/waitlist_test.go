@@ -0,0 +1,136 @@
+package reservation_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *repositorySuite) TestClaimWaitlistEntry() {
+	ctx := context.Background()
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2024, 9, 2, 18, 0, 0, 0, time.UTC)
+
+	entry := func(id, courtID string, createdAt time.Time) *entities.WaitlistEntry {
+		e := entities.NewWaitlistEntry("org-waitlist-1", courtID, "user-"+id, from, from.Add(time.Hour), createdAt)
+		e.ID = id
+		return e
+	}
+
+	entries := []*entities.WaitlistEntry{
+		entry("wl-any-court", "", now),
+		entry("wl-court-1", "court-waitlist-1", now.Add(time.Minute)),
+		entry("wl-court-2", "court-waitlist-2", now.Add(2*time.Minute)),
+		entry("wl-court-1-late", "court-waitlist-1", now.Add(3*time.Minute)),
+	}
+	for _, e := range entries {
+		s.Require().NoError(s.repo.CreateWaitlistEntry(ctx, e))
+	}
+
+	// a freed slot that is too short for the windows waited for
+	_, err := s.repo.ClaimWaitlistEntry(
+		ctx, "org-waitlist-1", "court-waitlist-1", from, from.Add(30*time.Minute), "res-wl-0", now,
+	)
+	s.ErrorIs(err, entities.ErrNotFound)
+
+	// concurrent cancellations of court 1 never offer the same entry twice
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		claimed []string
+	)
+
+	for _, rsvID := range []string{"res-wl-1", "res-wl-2", "res-wl-3"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			e, err := s.repo.ClaimWaitlistEntry(
+				ctx, "org-waitlist-1", "court-waitlist-1", from, from.Add(2*time.Hour), rsvID, now,
+			)
+			if err != nil {
+				s.ErrorIs(err, entities.ErrNotFound)
+				return
+			}
+
+			s.Equal(entities.OfferedWaitlistStatus, e.Status)
+			s.Equal(rsvID, e.ReservationID)
+
+			mu.Lock()
+			claimed = append(claimed, e.ID)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	s.ElementsMatch([]string{"wl-any-court", "wl-court-1", "wl-court-1-late"}, claimed)
+
+	s.Require().NoError(s.repo.ReleaseWaitlistEntry(ctx, "wl-court-1", now))
+	s.ErrorIs(s.repo.ReleaseWaitlistEntry(ctx, "wl-court-1", now), entities.ErrNotFound)
+
+	e, err := s.repo.ClaimWaitlistEntry(
+		ctx, "org-waitlist-1", "court-waitlist-1", from, from.Add(time.Hour), "res-wl-4", now,
+	)
+	s.Require().NoError(err)
+	s.Equal("wl-court-1", e.ID)
+
+	listed, err := s.repo.ListWaitlistByUser(ctx, "user-wl-court-2")
+	s.Require().NoError(err)
+	s.Require().Len(listed, 1)
+	s.Equal(*entries[2], listed[0])
+
+	s.ErrorIs(s.repo.DeleteWaitlistEntry(ctx, "wl-court-1", "user-wl-court-1"), entities.ErrNotFound)
+	s.Require().NoError(s.repo.DeleteWaitlistEntry(ctx, "wl-court-2", "user-wl-court-2"))
+	s.ErrorIs(s.repo.DeleteWaitlistEntry(ctx, "wl-court-2", "user-wl-court-2"), entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestExpireWaitlistEntries() {
+	ctx := context.Background()
+	now := time.Date(2024, 9, 3, 12, 0, 0, 0, time.UTC)
+
+	entry := func(id string, from time.Time) *entities.WaitlistEntry {
+		e := entities.NewWaitlistEntry("org-waitlist-2", "", "user-"+id, from, from.Add(time.Hour), now)
+		e.ID = id
+		return e
+	}
+
+	entries := []*entities.WaitlistEntry{
+		entry("wl-started", now.Add(-time.Minute)),
+		entry("wl-lapsed-offer", now.Add(-time.Minute)),
+		entry("wl-upcoming", now.Add(time.Hour)),
+	}
+	for _, e := range entries {
+		s.Require().NoError(s.repo.CreateWaitlistEntry(ctx, e))
+	}
+
+	_, err := s.repo.ClaimWaitlistEntry(
+		ctx, "org-waitlist-2", "court-waitlist-3", now.Add(-time.Minute), now.Add(59*time.Minute), "res-wl-5", now,
+	)
+	s.Require().NoError(err)
+
+	// the offer ran out, the entry is back in the queue
+	s.Require().NoError(s.repo.ResetWaitlistOffer(ctx, "res-wl-5", now))
+	s.ErrorIs(s.repo.ResetWaitlistOffer(ctx, "res-wl-5", now), entities.ErrNotFound)
+
+	expired, err := s.repo.ExpireWaitlistEntries(ctx, now)
+	s.Require().NoError(err)
+
+	ids := make([]string, 0, len(expired))
+	for _, e := range expired {
+		s.Equal(entities.ExpiredWaitlistStatus, e.Status)
+		s.Empty(e.ReservationID)
+		ids = append(ids, e.ID)
+	}
+	s.ElementsMatch([]string{"wl-started", "wl-lapsed-offer"}, ids)
+
+	listed, err := s.repo.ListWaitlistByUser(ctx, "user-wl-upcoming")
+	s.Require().NoError(err)
+	s.Require().Len(listed, 1)
+	s.Equal(entities.WaitingWaitlistStatus, listed[0].Status)
+
+	expired, err = s.repo.ExpireWaitlistEntries(ctx, now)
+	s.Require().NoError(err)
+	s.Empty(expired)
+}
@@ -0,0 +1,55 @@
+package sessions
+
+import (
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type dto struct {
+	ID                       string
+	UserID                   string
+	RefreshTokenHash         string
+	PreviousRefreshTokenHash string
+	DeviceName               string
+	UserAgent                string
+	IPAddress                string
+	CreatedAt                time.Time
+	LastUsedAt               time.Time
+	ExpiresAt                time.Time
+	RevokedAt                *time.Time
+}
+
+func newDTO(s *entities.Session) dto {
+	return dto{
+		ID:                       s.ID,
+		UserID:                   s.UserID,
+		RefreshTokenHash:         s.RefreshTokenHash,
+		PreviousRefreshTokenHash: s.PreviousRefreshTokenHash,
+		DeviceName:               s.Device.Name,
+		UserAgent:                s.Device.UserAgent,
+		IPAddress:                s.Device.IPAddress,
+		CreatedAt:                s.CreatedAt.UTC(),
+		LastUsedAt:               s.LastUsedAt.UTC(),
+		ExpiresAt:                s.ExpiresAt.UTC(),
+		RevokedAt:                s.RevokedAt,
+	}
+}
+
+func (d dto) toEntity() entities.Session {
+	return entities.Session{
+		ID:                       d.ID,
+		UserID:                   d.UserID,
+		RefreshTokenHash:         d.RefreshTokenHash,
+		PreviousRefreshTokenHash: d.PreviousRefreshTokenHash,
+		Device: entities.Device{
+			Name:      d.DeviceName,
+			UserAgent: d.UserAgent,
+			IPAddress: d.IPAddress,
+		},
+		CreatedAt:  d.CreatedAt,
+		LastUsedAt: d.LastUsedAt,
+		ExpiresAt:  d.ExpiresAt,
+		RevokedAt:  d.RevokedAt,
+	}
+}
@@ -0,0 +1,358 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type Repository struct {
+	connectionURL string
+	pool          *pgxpool.Pool
+}
+
+func NewRepository(connectionURL string) *Repository {
+	return &Repository{connectionURL: connectionURL}
+}
+
+func (r *Repository) Connect(ctx context.Context) error {
+	p, err := pgxpool.New(ctx, r.connectionURL)
+	if err != nil {
+		return fmt.Errorf("pgxpool new: %w", err)
+	}
+
+	r.pool = p
+
+	return nil
+}
+
+func (r *Repository) Close() {
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, session *entities.Session) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	d := newDTO(session)
+
+	_, err := r.pool.Exec(
+		ctx,
+		createSessionQuery,
+		d.ID,
+		d.UserID,
+		d.RefreshTokenHash,
+		nullableString(d.PreviousRefreshTokenHash),
+		d.DeviceName,
+		d.UserAgent,
+		d.IPAddress,
+		d.CreatedAt,
+		d.LastUsedAt,
+		d.ExpiresAt,
+		nullableTime(d.RevokedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("exec create session: %w", err)
+	}
+
+	return nil
+}
+
+const createSessionQuery = `
+INSERT INTO sessions(
+	id,
+	user_id,
+	refresh_token_hash,
+	previous_refresh_token_hash,
+	device_name,
+	user_agent,
+	ip_address,
+	created_at,
+	last_used_at,
+	expires_at,
+	revoked_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+func (r *Repository) GetByID(ctx context.Context, sessionID string) (*entities.Session, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	session, err := scan(r.pool.QueryRow(ctx, getSessionByIDQuery, sessionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan session: %w", err)
+	}
+
+	return &session, nil
+}
+
+const getSessionByIDQuery = `
+SELECT
+	id,
+	user_id,
+	refresh_token_hash,
+	previous_refresh_token_hash,
+	device_name,
+	user_agent,
+	ip_address,
+	created_at,
+	last_used_at,
+	expires_at,
+	revoked_at
+FROM sessions
+WHERE id = $1
+LIMIT 1
+`
+
+// GetByRefreshTokenHash finds the session whose current or previous refresh token has the given hash,
+// so that the caller can tell a reused, already rotated token from an unknown one.
+func (r *Repository) GetByRefreshTokenHash(ctx context.Context, hash string) (*entities.Session, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	session, err := scan(r.pool.QueryRow(ctx, getSessionByRefreshTokenHashQuery, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("scan session: %w", err)
+	}
+
+	return &session, nil
+}
+
+const getSessionByRefreshTokenHashQuery = `
+SELECT
+	id,
+	user_id,
+	refresh_token_hash,
+	previous_refresh_token_hash,
+	device_name,
+	user_agent,
+	ip_address,
+	created_at,
+	last_used_at,
+	expires_at,
+	revoked_at
+FROM sessions
+WHERE refresh_token_hash = $1 OR previous_refresh_token_hash = $1
+LIMIT 1
+`
+
+// RotateRefreshToken replaces the refresh token of an active session. It only succeeds while oldHash
+// is still the current token, so of two concurrent refreshes with the same token only one wins.
+func (r *Repository) RotateRefreshToken(
+	ctx context.Context,
+	sessionID, oldHash, newHash string,
+	device entities.Device,
+	now, expiresAt time.Time,
+) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(
+		ctx,
+		rotateRefreshTokenQuery,
+		sessionID,
+		oldHash,
+		newHash,
+		device.Name,
+		device.UserAgent,
+		device.IPAddress,
+		now.UTC(),
+		expiresAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("exec rotate refresh token: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const rotateRefreshTokenQuery = `
+UPDATE sessions
+SET
+	previous_refresh_token_hash = refresh_token_hash,
+	refresh_token_hash = $3,
+	device_name = CASE WHEN $4 = '' THEN device_name ELSE $4 END,
+	user_agent = $5,
+	ip_address = $6,
+	last_used_at = $7,
+	expires_at = $8
+WHERE id = $1
+  AND refresh_token_hash = $2
+  AND revoked_at IS NULL
+  AND expires_at > $7
+`
+
+func (r *Repository) ListActiveByUserID(ctx context.Context, userID string, now time.Time) ([]entities.Session, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listActiveSessionsQuery, userID, now.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []entities.Session
+
+	for rows.Next() {
+		session, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan session: %w", err)
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return sessions, nil
+}
+
+const listActiveSessionsQuery = `
+SELECT
+	id,
+	user_id,
+	refresh_token_hash,
+	previous_refresh_token_hash,
+	device_name,
+	user_agent,
+	ip_address,
+	created_at,
+	last_used_at,
+	expires_at,
+	revoked_at
+FROM sessions
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND expires_at > $2
+ORDER BY last_used_at DESC
+`
+
+// Revoke ends the session of the user. It returns ErrNotFound when the session does not belong
+// to the user or is already revoked.
+func (r *Repository) Revoke(ctx context.Context, userID, sessionID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, revokeSessionQuery, sessionID, userID, now.UTC())
+	if err != nil {
+		return fmt.Errorf("exec revoke session: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const revokeSessionQuery = `
+UPDATE sessions
+SET revoked_at = $3
+WHERE id = $1
+  AND user_id = $2
+  AND revoked_at IS NULL
+`
+
+// RevokeAllByUserID ends every active session of the user.
+func (r *Repository) RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	if _, err := r.pool.Exec(ctx, revokeAllSessionsQuery, userID, now.UTC()); err != nil {
+		return fmt.Errorf("exec revoke all sessions: %w", err)
+	}
+
+	return nil
+}
+
+const revokeAllSessionsQuery = `
+UPDATE sessions
+SET revoked_at = $2
+WHERE user_id = $1
+  AND revoked_at IS NULL
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scan(scanner rowScanner) (entities.Session, error) {
+	var (
+		d            dto
+		previousHash sql.NullString
+		revokedAt    sql.NullTime
+	)
+
+	err := scanner.Scan(
+		&d.ID,
+		&d.UserID,
+		&d.RefreshTokenHash,
+		&previousHash,
+		&d.DeviceName,
+		&d.UserAgent,
+		&d.IPAddress,
+		&d.CreatedAt,
+		&d.LastUsedAt,
+		&d.ExpiresAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	d.PreviousRefreshTokenHash = previousHash.String
+	d.CreatedAt = d.CreatedAt.UTC()
+	d.LastUsedAt = d.LastUsedAt.UTC()
+	d.ExpiresAt = d.ExpiresAt.UTC()
+
+	if revokedAt.Valid {
+		t := revokedAt.Time.UTC()
+		d.RevokedAt = &t
+	}
+
+	return d.toEntity(), nil
+}
+
+func nullableString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func nullableTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+
+	v := t.UTC()
+	return v
+}
@@ -0,0 +1,184 @@
+package sessions_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/repositories/sessions"
+	"github.com/lever-dev/padel-backend/internal/repositories/users"
+)
+
+type repositorySuite struct {
+	suite.Suite
+
+	repo      *sessions.Repository
+	usersRepo *users.Repository
+}
+
+func TestRepositorySuite(t *testing.T) {
+	suite.Run(t, new(repositorySuite))
+}
+
+func (s *repositorySuite) SetupTest() {
+	connString := os.Getenv("POSTGRES_CONNECTION_URL")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	repo := sessions.NewRepository(connString)
+	require.NoError(s.T(), repo.Connect(ctx))
+
+	usersRepo := users.NewRepository(connString)
+	require.NoError(s.T(), usersRepo.Connect(ctx))
+
+	s.repo = repo
+	s.usersRepo = usersRepo
+}
+
+func (s *repositorySuite) TearDownTest() {
+	if s.repo != nil {
+		s.repo.Close()
+	}
+	if s.usersRepo != nil {
+		s.usersRepo.Close()
+	}
+}
+
+func (s *repositorySuite) TestCreateAndGet() {
+	ctx := context.Background()
+	s.seedUser(ctx, "session-user-1", "+77020000001")
+
+	now := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	session := &entities.Session{
+		ID:               "session-create-1",
+		UserID:           "session-user-1",
+		RefreshTokenHash: "hash-create-1",
+		Device:           entities.Device{Name: "iPhone", UserAgent: "padel-ios/1.0", IPAddress: "10.0.0.1"},
+		CreatedAt:        now,
+		LastUsedAt:       now,
+		ExpiresAt:        now.Add(30 * 24 * time.Hour),
+	}
+
+	s.Require().NoError(s.repo.Create(ctx, session))
+
+	byID, err := s.repo.GetByID(ctx, session.ID)
+	s.Require().NoError(err)
+	s.Equal(*session, *byID)
+
+	byHash, err := s.repo.GetByRefreshTokenHash(ctx, "hash-create-1")
+	s.Require().NoError(err)
+	s.Equal(session.ID, byHash.ID)
+}
+
+func (s *repositorySuite) TestRotateRefreshToken() {
+	ctx := context.Background()
+	s.seedUser(ctx, "session-user-2", "+77020000002")
+
+	now := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	session := entities.NewSession("session-user-2", "hash-rotate-1", entities.Device{Name: "Pixel"}, now, time.Hour)
+	s.Require().NoError(s.repo.Create(ctx, session))
+
+	later := now.Add(30 * time.Minute)
+	device := entities.Device{UserAgent: "padel-android/2.0", IPAddress: "10.0.0.2"}
+
+	err := s.repo.RotateRefreshToken(
+		ctx,
+		session.ID,
+		"hash-rotate-1",
+		"hash-rotate-2",
+		device,
+		later,
+		later.Add(time.Hour),
+	)
+	s.Require().NoError(err)
+
+	rotated, err := s.repo.GetByID(ctx, session.ID)
+	s.Require().NoError(err)
+	s.Equal("hash-rotate-2", rotated.RefreshTokenHash)
+	s.Equal("hash-rotate-1", rotated.PreviousRefreshTokenHash)
+	s.Equal("Pixel", rotated.Device.Name)
+	s.Equal("padel-android/2.0", rotated.Device.UserAgent)
+	s.Equal(later, rotated.LastUsedAt)
+
+	s.Run("previous token finds the session", func() {
+		found, err := s.repo.GetByRefreshTokenHash(ctx, "hash-rotate-1")
+		s.Require().NoError(err)
+		s.Equal(session.ID, found.ID)
+	})
+
+	s.Run("rotating an old token fails", func() {
+		err := s.repo.RotateRefreshToken(ctx, session.ID, "hash-rotate-1", "hash-rotate-3", device, later, later)
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+}
+
+func (s *repositorySuite) TestRevoke() {
+	ctx := context.Background()
+	s.seedUser(ctx, "session-user-3", "+77020000003")
+
+	now := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	first := entities.NewSession("session-user-3", "hash-revoke-1", entities.Device{}, now, time.Hour)
+	second := entities.NewSession("session-user-3", "hash-revoke-2", entities.Device{}, now, time.Hour)
+	s.Require().NoError(s.repo.Create(ctx, first))
+	s.Require().NoError(s.repo.Create(ctx, second))
+
+	s.ErrorIs(s.repo.Revoke(ctx, "someone-else", first.ID, now), entities.ErrNotFound)
+
+	s.Require().NoError(s.repo.Revoke(ctx, "session-user-3", first.ID, now))
+	s.ErrorIs(s.repo.Revoke(ctx, "session-user-3", first.ID, now), entities.ErrNotFound)
+
+	active, err := s.repo.ListActiveByUserID(ctx, "session-user-3", now)
+	s.Require().NoError(err)
+	s.Require().Len(active, 1)
+	s.Equal(second.ID, active[0].ID)
+
+	revoked, err := s.repo.GetByID(ctx, first.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(revoked.RevokedAt)
+	s.False(revoked.IsActiveAt(now))
+
+	err = s.repo.RotateRefreshToken(ctx, first.ID, "hash-revoke-1", "hash-revoke-3", entities.Device{}, now, now)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestRevokeAllByUserID() {
+	ctx := context.Background()
+	s.seedUser(ctx, "session-user-4", "+77020000004")
+	s.seedUser(ctx, "session-user-5", "+77020000005")
+
+	now := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.repo.Create(ctx,
+		entities.NewSession("session-user-4", "hash-revoke-all-1", entities.Device{}, now, time.Hour)))
+	s.Require().NoError(s.repo.Create(ctx,
+		entities.NewSession("session-user-4", "hash-revoke-all-2", entities.Device{}, now, time.Hour)))
+	s.Require().NoError(s.repo.Create(ctx,
+		entities.NewSession("session-user-5", "hash-revoke-all-3", entities.Device{}, now, time.Hour)))
+
+	s.Require().NoError(s.repo.RevokeAllByUserID(ctx, "session-user-4", now))
+
+	active, err := s.repo.ListActiveByUserID(ctx, "session-user-4", now)
+	s.Require().NoError(err)
+	s.Empty(active)
+
+	others, err := s.repo.ListActiveByUserID(ctx, "session-user-5", now)
+	s.Require().NoError(err)
+	s.Len(others, 1)
+}
+
+func (s *repositorySuite) seedUser(ctx context.Context, id, phone string) {
+	s.T().Helper()
+	s.Require().NoError(s.usersRepo.Create(ctx, &entities.User{
+		ID:             id,
+		Nickname:       "nick-" + id,
+		HashedPassword: "hashed-password",
+		PhoneNumber:    phone,
+		FirstName:      "Session",
+		LastName:       "User",
+	}))
+}
@@ -7,38 +7,50 @@ import (
 )
 
 type dto struct {
-	ID             string
-	Nickname       string
-	HashedPassword string
-	PhoneNumber    string
-	FirstName      string
-	LastName       string
-	CreatedAt      time.Time
-	LastLoginAt    *time.Time
+	ID              string
+	Nickname        string
+	HashedPassword  string
+	PhoneNumber     string
+	FirstName       string
+	LastName        string
+	CreatedAt       time.Time
+	LastLoginAt     *time.Time
+	PhoneVerifiedAt *time.Time
+	Level           *float64
+	Rating          float64
+	RatedGames      int
 }
 
 func newDTO(u *entities.User) dto {
 	return dto{
-		ID:             u.ID,
-		Nickname:       u.Nickname,
-		HashedPassword: u.HashedPassword,
-		PhoneNumber:    u.PhoneNumber,
-		FirstName:      u.FirstName,
-		LastName:       u.LastName,
-		CreatedAt:      u.CreatedAt,
-		LastLoginAt:    u.LastLoginAt,
+		ID:              u.ID,
+		Nickname:        u.Nickname,
+		HashedPassword:  u.HashedPassword,
+		PhoneNumber:     u.PhoneNumber,
+		FirstName:       u.FirstName,
+		LastName:        u.LastName,
+		CreatedAt:       u.CreatedAt,
+		LastLoginAt:     u.LastLoginAt,
+		PhoneVerifiedAt: u.PhoneVerifiedAt,
+		Level:           u.Level,
+		Rating:          u.Rating,
+		RatedGames:      u.RatedGames,
 	}
 }
 
 func (d dto) toEntity() entities.User {
 	return entities.User{
-		ID:             d.ID,
-		Nickname:       d.Nickname,
-		HashedPassword: d.HashedPassword,
-		PhoneNumber:    d.PhoneNumber,
-		FirstName:      d.FirstName,
-		LastName:       d.LastName,
-		CreatedAt:      d.CreatedAt,
-		LastLoginAt:    d.LastLoginAt,
+		ID:              d.ID,
+		Nickname:        d.Nickname,
+		HashedPassword:  d.HashedPassword,
+		PhoneNumber:     d.PhoneNumber,
+		FirstName:       d.FirstName,
+		LastName:        d.LastName,
+		CreatedAt:       d.CreatedAt,
+		LastLoginAt:     d.LastLoginAt,
+		PhoneVerifiedAt: d.PhoneVerifiedAt,
+		Level:           d.Level,
+		Rating:          d.Rating,
+		RatedGames:      d.RatedGames,
 	}
 }
@@ -47,6 +47,10 @@ func (r *Repository) Create(ctx context.Context, user *entities.User) error {
 		user.CreatedAt = time.Now().UTC()
 	}
 
+	if user.Rating == 0 {
+		user.Rating = entities.InitialRating
+	}
+
 	d := newDTO(user)
 
 	if d.LastLoginAt != nil {
@@ -65,6 +69,10 @@ func (r *Repository) Create(ctx context.Context, user *entities.User) error {
 		d.LastName,
 		d.CreatedAt,
 		nullableTime(d.LastLoginAt),
+		nullableTime(d.PhoneVerifiedAt),
+		d.Level,
+		d.Rating,
+		d.RatedGames,
 	)
 	if err != nil {
 		return fmt.Errorf("exec create user: %w", err)
@@ -82,8 +90,12 @@ INSERT INTO users(
 	first_name,
 	last_name,
 	created_at,
-	last_login_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	last_login_at,
+	phone_verified_at,
+	level,
+	rating,
+	rated_games
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 `
 
 func (r *Repository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
@@ -111,7 +123,11 @@ SELECT
 	first_name,
 	last_name,
 	created_at,
-	last_login_at
+	last_login_at,
+	phone_verified_at,
+	level,
+	rating,
+	rated_games
 FROM users
 WHERE id = $1
 LIMIT 1
@@ -142,7 +158,11 @@ SELECT
 	first_name,
 	last_name,
 	created_at,
-	last_login_at
+	last_login_at,
+	phone_verified_at,
+	level,
+	rating,
+	rated_games
 FROM users
 WHERE phone_number = $1
 LIMIT 1
@@ -173,7 +193,11 @@ SELECT
 	first_name,
 	last_name,
 	created_at,
-	last_login_at
+	last_login_at,
+	phone_verified_at,
+	level,
+	rating,
+	rated_games
 FROM users
 WHERE nickname = $1
 LIMIT 1
@@ -204,14 +228,137 @@ SET last_login_at = $1
 WHERE id = $2
 `
 
+func (r *Repository) MarkPhoneVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, markPhoneVerifiedQuery, verifiedAt.UTC(), userID)
+	if err != nil {
+		return fmt.Errorf("exec mark phone verified: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const markPhoneVerifiedQuery = `
+UPDATE users
+SET phone_verified_at = COALESCE(phone_verified_at, $1)
+WHERE id = $2
+`
+
+func (r *Repository) UpdatePassword(ctx context.Context, userID, hashedPassword string) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, updatePasswordQuery, hashedPassword, userID)
+	if err != nil {
+		return fmt.Errorf("exec update password: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const updatePasswordQuery = `
+UPDATE users
+SET password = $1
+WHERE id = $2
+`
+
+// UpdateLevel sets the skill level the user declared.
+func (r *Repository) UpdateLevel(ctx context.Context, userID string, level float64) error {
+	if r.pool == nil {
+		return fmt.Errorf("not connected to pool")
+	}
+
+	tag, err := r.pool.Exec(ctx, updateLevelQuery, level, userID)
+	if err != nil {
+		return fmt.Errorf("exec update level: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ErrNotFound
+	}
+
+	return nil
+}
+
+const updateLevelQuery = `
+UPDATE users
+SET level = $1
+WHERE id = $2
+`
+
+// ListRatingHistory returns the rating changes of the user, the latest first.
+func (r *Repository) ListRatingHistory(ctx context.Context, userID string, limit int) ([]entities.RatingChange, error) {
+	if r.pool == nil {
+		return nil, fmt.Errorf("not connected to pool")
+	}
+
+	rows, err := r.pool.Query(ctx, listRatingHistoryQuery, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query rating history: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []entities.RatingChange
+
+	for rows.Next() {
+		var change entities.RatingChange
+
+		err := rows.Scan(
+			&change.UserID,
+			&change.ReservationID,
+			&change.Before,
+			&change.After,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan rating change: %w", err)
+		}
+
+		change.CreatedAt = change.CreatedAt.UTC()
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return changes, nil
+}
+
+const listRatingHistoryQuery = `
+SELECT
+	user_id,
+	reservation_id,
+	rating_before,
+	rating_after,
+	created_at
+FROM rating_history
+WHERE user_id = $1
+ORDER BY created_at DESC, reservation_id DESC
+LIMIT $2
+`
+
 type rowScanner interface {
 	Scan(dest ...any) error
 }
 
 func scan(scanner rowScanner) (entities.User, error) {
 	var (
-		d         dto
-		lastLogin sql.NullTime
+		d             dto
+		lastLogin     sql.NullTime
+		phoneVerified sql.NullTime
 	)
 
 	err := scanner.Scan(
@@ -223,6 +370,10 @@ func scan(scanner rowScanner) (entities.User, error) {
 		&d.LastName,
 		&d.CreatedAt,
 		&lastLogin,
+		&phoneVerified,
+		&d.Level,
+		&d.Rating,
+		&d.RatedGames,
 	)
 	if err != nil {
 		return entities.User{}, err
@@ -235,6 +386,11 @@ func scan(scanner rowScanner) (entities.User, error) {
 		d.LastLoginAt = &t
 	}
 
+	if phoneVerified.Valid {
+		t := phoneVerified.Time.UTC()
+		d.PhoneVerifiedAt = &t
+	}
+
 	return d.toEntity(), nil
 }
 
@@ -150,6 +150,76 @@ func (s *repositorySuite) TestUpdateLastLogin_NotFound() {
 	s.ErrorIs(err, entities.ErrNotFound)
 }
 
+func (s *repositorySuite) TestMarkPhoneVerified() {
+	ctx := context.Background()
+	user := &entities.User{
+		ID:          "user-verify-1",
+		PhoneNumber: "+77010000006",
+		FirstName:   "Erin",
+		LastName:    "Evans",
+	}
+
+	s.seedUsers(ctx, []*entities.User{user})
+
+	verifiedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.repo.MarkPhoneVerified(ctx, user.ID, verifiedAt))
+
+	// verifying again keeps the first timestamp
+	s.Require().NoError(s.repo.MarkPhoneVerified(ctx, user.ID, verifiedAt.Add(time.Hour)))
+
+	updated, err := s.repo.GetByID(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(updated.PhoneVerifiedAt)
+	s.Equal(verifiedAt, updated.PhoneVerifiedAt.UTC())
+
+	s.ErrorIs(s.repo.MarkPhoneVerified(ctx, "unknown", verifiedAt), entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestUpdatePassword() {
+	ctx := context.Background()
+	user := &entities.User{
+		ID:          "user-password-1",
+		PhoneNumber: "+77010000007",
+		FirstName:   "Frank",
+		LastName:    "Ford",
+	}
+
+	s.seedUsers(ctx, []*entities.User{user})
+
+	s.Require().NoError(s.repo.UpdatePassword(ctx, user.ID, "new-hash"))
+
+	updated, err := s.repo.GetByID(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Equal("new-hash", updated.HashedPassword)
+
+	s.ErrorIs(s.repo.UpdatePassword(ctx, "unknown", "new-hash"), entities.ErrNotFound)
+}
+
+func (s *repositorySuite) TestUpdateLevel() {
+	ctx := context.Background()
+	user := &entities.User{
+		ID:          "user-level-1",
+		PhoneNumber: "+77010000008",
+		FirstName:   "Gina",
+		LastName:    "Green",
+	}
+
+	s.seedUsers(ctx, []*entities.User{user})
+
+	created, err := s.repo.GetByID(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Nil(created.Level)
+
+	s.Require().NoError(s.repo.UpdateLevel(ctx, user.ID, 3.25))
+
+	updated, err := s.repo.GetByID(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(updated.Level)
+	s.InDelta(3.25, *updated.Level, 0.001)
+
+	s.ErrorIs(s.repo.UpdateLevel(ctx, "unknown", 3.25), entities.ErrNotFound)
+}
+
 func (s *repositorySuite) seedUsers(ctx context.Context, usersToSeed []*entities.User) {
 	s.T().Helper()
 	for _, u := range usersToSeed {
@@ -2,44 +2,206 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+	"github.com/rs/zerolog/log"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// DefaultAccessTokenTTL is how long an access token is valid when no TTL is configured.
+	DefaultAccessTokenTTL = 15 * time.Minute
+	// DefaultRefreshTokenTTL is how long a session survives without being refreshed when no TTL is configured.
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// Config tunes token lifetimes and the OTP policy. Zero fields fall back to the defaults.
+type Config struct {
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	OTP             OTPConfig
+}
+
 type Service struct {
-	usersRepo UsersRepository
-	jwtSecret []byte
+	usersRepo    UsersRepository
+	sessionsRepo SessionsRepository
+	otpRepo      OTPRepository
+	sms          SMSSender
+	clock        clock.Clock
+	keys         *KeySet
+	cfg          Config
 }
 
-func NewService(repo UsersRepository) *Service {
+func NewService(
+	repo UsersRepository,
+	sessionsRepo SessionsRepository,
+	otpRepo OTPRepository,
+	sms SMSSender,
+	keys *KeySet,
+	clock clock.Clock,
+	cfg Config,
+) *Service {
+	if cfg.AccessTokenTTL <= 0 {
+		cfg.AccessTokenTTL = DefaultAccessTokenTTL
+	}
+
+	if cfg.RefreshTokenTTL <= 0 {
+		cfg.RefreshTokenTTL = DefaultRefreshTokenTTL
+	}
+
+	cfg.OTP = cfg.OTP.withDefaults()
+
 	return &Service{
-		usersRepo: repo,
-		jwtSecret: []byte("some-jwt-key"), // TODO: use from env
+		usersRepo:    repo,
+		sessionsRepo: sessionsRepo,
+		otpRepo:      otpRepo,
+		sms:          sms,
+		clock:        clock,
+		keys:         keys,
+		cfg:          cfg,
 	}
 }
 
-func (s *Service) LoginViaPassword(ctx context.Context, nickname, password string) (string, error) {
+// LoginViaPassword opens a new session on the device and returns its first token pair.
+func (s *Service) LoginViaPassword(
+	ctx context.Context,
+	nickname, password string,
+	device entities.Device,
+) (*entities.TokenPair, error) {
 	user, err := s.usersRepo.GetByNickname(ctx, nickname)
 	if err != nil {
-		return "", fmt.Errorf("get by nickname: %w", err)
+		return nil, fmt.Errorf("get by nickname: %w", err)
 	}
 
 	if err := s.comparePasswords(user, password); err != nil {
-		return "", fmt.Errorf("%w: %w", entities.ErrInvalidCredentials, err)
+		return nil, fmt.Errorf("%w: %w", entities.ErrInvalidCredentials, err)
+	}
+
+	return s.openSession(ctx, user, device)
+}
+
+// openSession stores a new session of the user on the device and issues its first token pair.
+func (s *Service) openSession(
+	ctx context.Context,
+	user entities.User,
+	device entities.Device,
+) (*entities.TokenPair, error) {
+	refreshToken, refreshTokenHash, err := newRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("new refresh token: %w", err)
+	}
+
+	session := entities.NewSession(user.ID, refreshTokenHash, device, s.clock.Now(), s.cfg.RefreshTokenTTL)
+
+	if err := s.sessionsRepo.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+
+	pair, err := s.issueTokens(user, session, refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("issue tokens: %w", err)
+	}
+
+	return pair, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair. The presented token is rotated out and
+// cannot be used again: presenting it a second time is treated as theft and revokes the whole session.
+func (s *Service) Refresh(
+	ctx context.Context,
+	refreshToken string,
+	device entities.Device,
+) (*entities.TokenPair, error) {
+	hash := hashRefreshToken(refreshToken)
+
+	session, err := s.sessionsRepo.GetByRefreshTokenHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown refresh token", entities.ErrInvalidToken)
+		}
+		return nil, fmt.Errorf("get session by refresh token: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	if !session.IsActiveAt(now) {
+		return nil, fmt.Errorf("%w: session %s is no longer active", entities.ErrInvalidToken, session.ID)
+	}
+
+	if session.RefreshTokenHash != hash {
+		if err := s.sessionsRepo.Revoke(ctx, session.UserID, session.ID, now); err != nil &&
+			!errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("revoke session: %w", err)
+		}
+
+		log.Warn().
+			Str("session id", session.ID).
+			Str("user id", session.UserID).
+			Msg("rotated refresh token was reused, session revoked")
+
+		return nil, fmt.Errorf("%w: refresh token was already used", entities.ErrInvalidToken)
+	}
+
+	user, err := s.usersRepo.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id: %w", err)
+	}
+
+	newToken, newHash, err := newRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("new refresh token: %w", err)
+	}
+
+	expiresAt := now.Add(s.cfg.RefreshTokenTTL)
+
+	err = s.sessionsRepo.RotateRefreshToken(ctx, session.ID, hash, newHash, device, now, expiresAt)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("%w: refresh token was already used", entities.ErrInvalidToken)
+		}
+		return nil, fmt.Errorf("rotate refresh token: %w", err)
+	}
+
+	session.ExpiresAt = expiresAt
+
+	pair, err := s.issueTokens(*user, session, newToken)
+	if err != nil {
+		return nil, fmt.Errorf("issue tokens: %w", err)
 	}
 
-	tok, err := s.issueToken(user)
+	return pair, nil
+}
+
+// ListSessions returns the sessions of the user that are neither revoked nor expired.
+func (s *Service) ListSessions(ctx context.Context, userID string) ([]entities.Session, error) {
+	sessions, err := s.sessionsRepo.ListActiveByUserID(ctx, userID, s.clock.Now())
 	if err != nil {
-		return "", fmt.Errorf("issue token: %w", err)
+		return nil, fmt.Errorf("list active sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession ends one of the sessions of the user. Access tokens issued for it stop being accepted
+// right away and its refresh token can no longer be used.
+func (s *Service) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if err := s.sessionsRepo.Revoke(ctx, userID, sessionID, s.clock.Now()); err != nil {
+		return fmt.Errorf("revoke session: %w", err)
 	}
 
-	return tok, nil
+	return nil
 }
 
+// RegisterUser creates the user and sends a code to verify the phone number.
 func (s *Service) RegisterUser(ctx context.Context, user *entities.User, password string) error {
 	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -52,40 +214,145 @@ func (s *Service) RegisterUser(ctx context.Context, user *entities.User, passwor
 		return fmt.Errorf("create user: %w", err)
 	}
 
+	// the account is usable right away, a failed SMS can be retried with RequestOTP
+	if err := s.sendOTP(ctx, user.PhoneNumber, entities.VerifyPhoneOTPPurpose); err != nil {
+		log.Warn().Err(err).Str("user id", user.ID).Msg("failed to send phone verification code")
+	}
+
+	return nil
+}
+
+// SetLevel records the skill level the user declared, open matches admit players by it.
+func (s *Service) SetLevel(ctx context.Context, userID string, level float64) error {
+	if err := entities.ValidateSkillLevel(level); err != nil {
+		return err
+	}
+
+	if err := s.usersRepo.UpdateLevel(ctx, userID, level); err != nil {
+		return fmt.Errorf("update level: %w", err)
+	}
+
 	return nil
 }
 
-func (s *Service) VerifyToken(tokenStr string) error {
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, entities.ErrInvalidToken
+// tokenClaims is the JWT payload of an access token.
+type tokenClaims struct {
+	Nickname  string `json:"nick"`
+	SessionID string `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// VerifyToken validates the token signature and expiry, checks that its session has not been revoked
+// and returns the identity it was issued for.
+func (s *Service) VerifyToken(ctx context.Context, tokenStr string) (*entities.Claims, error) {
+	var claims tokenClaims
+
+	token, err := jwt.ParseWithClaims(
+		tokenStr,
+		&claims,
+		s.keys.keyFunc,
+		jwt.WithValidMethods(s.keys.algorithms()),
+		jwt.WithTimeFunc(s.clock.Now),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, fmt.Errorf("%w: %w", entities.ErrExpiredToken, err)
 		}
+		return nil, fmt.Errorf("%w: jwt parse: %w", entities.ErrInvalidToken, err)
+	}
+
+	if !token.Valid || claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", entities.ErrInvalidToken)
+	}
+
+	if claims.SessionID == "" {
+		return nil, fmt.Errorf("%w: missing session", entities.ErrInvalidToken)
+	}
 
-		return s.jwtSecret, nil
-	})
+	session, err := s.sessionsRepo.GetByID(ctx, claims.SessionID)
 	if err != nil {
-		return fmt.Errorf("jwt parse: %w", err)
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown session %s", entities.ErrInvalidToken, claims.SessionID)
+		}
+		return nil, fmt.Errorf("get session by id: %w", err)
 	}
 
-	if !token.Valid {
-		return fmt.Errorf("invalid token ")
+	if session.UserID != claims.Subject || !session.IsActiveAt(s.clock.Now()) {
+		return nil, fmt.Errorf("%w: session %s is no longer active", entities.ErrInvalidToken, session.ID)
 	}
 
-	return nil
+	result := &entities.Claims{
+		UserID:    claims.Subject,
+		Nickname:  claims.Nickname,
+		SessionID: claims.SessionID,
+	}
+
+	if claims.IssuedAt != nil {
+		result.IssuedAt = claims.IssuedAt.Time
+	}
+
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return result, nil
+}
+
+// PublicKeys returns the keys that verify access tokens, for services that check them on their own.
+func (s *Service) PublicKeys() []entities.PublicKey {
+	return s.keys.PublicKeys()
 }
 
 func (s *Service) comparePasswords(user entities.User, providedPass string) error {
 	return bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(providedPass))
 }
 
-func (s *Service) issueToken(user entities.User) (string, error) {
-	claims := jwt.MapClaims{
-		"sub":  user.ID,
-		"nick": user.Nickname,
-		"exp":  time.Now().Add(24 * time.Hour).Unix(),
-		"iat":  time.Now().Unix(),
+func (s *Service) issueTokens(
+	user entities.User,
+	session *entities.Session,
+	refreshToken string,
+) (*entities.TokenPair, error) {
+	now := s.clock.Now()
+	expiresAt := now.Add(s.cfg.AccessTokenTTL)
+
+	claims := tokenClaims{
+		Nickname:  user.Nickname,
+		SessionID: session.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	accessToken, err := s.keys.sign(claims)
+	if err != nil {
+		return nil, fmt.Errorf("sign access token: %w", err)
+	}
+
+	return &entities.TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: session.ExpiresAt,
+		SessionID:             session.ID,
+	}, nil
+}
+
+// newRefreshToken returns an opaque random token together with the hash stored in place of it.
+func newRefreshToken() (token, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("read random: %w", err)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.jwtSecret)
+	token = base64.RawURLEncoding.EncodeToString(buf)
+
+	return token, hashRefreshToken(token), nil
+}
+
+// hashRefreshToken hashes with plain SHA-256, refresh tokens carry enough entropy to not need a slow hash.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,312 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/auth"
+	"github.com/lever-dev/padel-backend/internal/services/auth/mocks"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testSecret = "test-secret-that-is-long-enough-for-hs256"
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	usersRepo    *mocks.MockUsersRepository
+	sessionsRepo *mocks.MockSessionsRepository
+	service      *auth.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+	s.sessionsRepo = mocks.NewMockSessionsRepository(s.ctrl)
+
+	keys, err := auth.NewKeySet("test", []auth.KeyConfig{
+		{ID: "test", Algorithm: auth.HS256, Secret: testSecret},
+	})
+	s.Require().NoError(err)
+
+	s.service = auth.NewService(
+		s.usersRepo,
+		s.sessionsRepo,
+		nil,
+		nil,
+		keys,
+		clock.Real{},
+		auth.Config{AccessTokenTTL: time.Hour, RefreshTokenTTL: 24 * time.Hour},
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// login logs alice in and returns the token pair together with the session that was stored for it.
+func (s *ServiceSuite) login() (*entities.TokenPair, *entities.Session) {
+	ctx := context.Background()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	s.Require().NoError(err)
+
+	s.usersRepo.EXPECT().
+		GetByNickname(ctx, "alice").
+		Return(entities.User{ID: "user-1", Nickname: "alice", HashedPassword: string(hashed)}, nil)
+
+	var stored *entities.Session
+
+	s.sessionsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, session *entities.Session) error {
+			stored = session
+			return nil
+		})
+
+	device := entities.Device{Name: "iPhone", UserAgent: "padel-ios/1.0", IPAddress: "10.0.0.1"}
+
+	pair, err := s.service.LoginViaPassword(ctx, "alice", "secret", device)
+	s.Require().NoError(err)
+	s.Require().NotNil(stored)
+
+	s.Equal("user-1", stored.UserID)
+	s.Equal(device, stored.Device)
+	s.Equal(stored.ID, pair.SessionID)
+	s.NotEmpty(pair.RefreshToken)
+	s.NotEqual(pair.RefreshToken, stored.RefreshTokenHash)
+
+	return pair, stored
+}
+
+func (s *ServiceSuite) TestVerifyToken() {
+	pair, session := s.login()
+
+	s.sessionsRepo.EXPECT().GetByID(gomock.Any(), session.ID).Return(session, nil)
+
+	claims, err := s.service.VerifyToken(context.Background(), pair.AccessToken)
+	s.Require().NoError(err)
+
+	s.Equal("user-1", claims.UserID)
+	s.Equal("alice", claims.Nickname)
+	s.Equal(session.ID, claims.SessionID)
+	s.WithinDuration(time.Now().Add(time.Hour), claims.ExpiresAt, time.Minute)
+}
+
+func (s *ServiceSuite) TestVerifyToken_RevokedSession() {
+	pair, session := s.login()
+
+	revokedAt := time.Now().UTC()
+	revoked := *session
+	revoked.RevokedAt = &revokedAt
+
+	s.sessionsRepo.EXPECT().GetByID(gomock.Any(), session.ID).Return(&revoked, nil)
+
+	claims, err := s.service.VerifyToken(context.Background(), pair.AccessToken)
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrInvalidToken)
+	s.Nil(claims)
+}
+
+func (s *ServiceSuite) TestVerifyToken_Invalid() {
+	foreign := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "user-1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	foreignToken, err := foreign.SignedString([]byte("another-key"))
+	s.Require().NoError(err)
+
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.RegisteredClaims{Subject: "user-1"})
+	unsignedToken, err := unsigned.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	s.Require().NoError(err)
+
+	withoutSession := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "user-1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	withoutSessionToken, err := withoutSession.SignedString([]byte(testSecret))
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "malformed", token: "not-a-jwt"},
+		{name: "signed with another key", token: foreignToken},
+		{name: "unsigned", token: unsignedToken},
+		{name: "issued without a session", token: withoutSessionToken},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			claims, err := s.service.VerifyToken(context.Background(), tt.token)
+			s.Require().Error(err)
+			s.ErrorIs(err, entities.ErrInvalidToken)
+			s.Nil(claims)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRefresh() {
+	ctx := context.Background()
+	pair, session := s.login()
+
+	device := entities.Device{UserAgent: "padel-ios/1.1", IPAddress: "10.0.0.2"}
+
+	s.sessionsRepo.EXPECT().
+		GetByRefreshTokenHash(ctx, session.RefreshTokenHash).
+		Return(session, nil)
+	s.usersRepo.EXPECT().
+		GetByID(ctx, "user-1").
+		Return(&entities.User{ID: "user-1", Nickname: "alice"}, nil)
+
+	var newHash string
+
+	s.sessionsRepo.EXPECT().
+		RotateRefreshToken(ctx, session.ID, session.RefreshTokenHash, gomock.Any(), device, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, hash string, _ entities.Device, now, expiresAt time.Time) error {
+			newHash = hash
+			s.Equal(24*time.Hour, expiresAt.Sub(now))
+			return nil
+		})
+
+	refreshed, err := s.service.Refresh(ctx, pair.RefreshToken, device)
+	s.Require().NoError(err)
+
+	s.Equal(session.ID, refreshed.SessionID)
+	s.NotEqual(pair.RefreshToken, refreshed.RefreshToken)
+	s.NotEmpty(newHash)
+	s.NotEqual(session.RefreshTokenHash, newHash)
+	s.NotEmpty(refreshed.AccessToken)
+}
+
+func (s *ServiceSuite) TestRefresh_ReusedToken() {
+	ctx := context.Background()
+	pair, session := s.login()
+
+	rotated := *session
+	rotated.PreviousRefreshTokenHash = session.RefreshTokenHash
+	rotated.RefreshTokenHash = "hash-of-the-next-token"
+
+	s.sessionsRepo.EXPECT().
+		GetByRefreshTokenHash(ctx, session.RefreshTokenHash).
+		Return(&rotated, nil)
+	s.sessionsRepo.EXPECT().
+		Revoke(ctx, "user-1", session.ID, gomock.Any()).
+		Return(nil)
+
+	refreshed, err := s.service.Refresh(ctx, pair.RefreshToken, entities.Device{})
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrInvalidToken)
+	s.Nil(refreshed)
+}
+
+func (s *ServiceSuite) TestRefresh_Rejected() {
+	ctx := context.Background()
+
+	revokedAt := time.Now().UTC()
+
+	tests := []struct {
+		name    string
+		prepare func(hash string)
+	}{
+		{
+			name: "unknown token",
+			prepare: func(hash string) {
+				s.sessionsRepo.EXPECT().GetByRefreshTokenHash(ctx, hash).Return(nil, entities.ErrNotFound)
+			},
+		},
+		{
+			name: "revoked session",
+			prepare: func(hash string) {
+				s.sessionsRepo.EXPECT().GetByRefreshTokenHash(ctx, hash).Return(&entities.Session{
+					ID:               "session-1",
+					UserID:           "user-1",
+					RefreshTokenHash: hash,
+					ExpiresAt:        time.Now().Add(time.Hour),
+					RevokedAt:        &revokedAt,
+				}, nil)
+			},
+		},
+		{
+			name: "expired session",
+			prepare: func(hash string) {
+				s.sessionsRepo.EXPECT().GetByRefreshTokenHash(ctx, hash).Return(&entities.Session{
+					ID:               "session-1",
+					UserID:           "user-1",
+					RefreshTokenHash: hash,
+					ExpiresAt:        time.Now().Add(-time.Minute),
+				}, nil)
+			},
+		},
+		{
+			name: "lost the race to a concurrent refresh",
+			prepare: func(hash string) {
+				s.sessionsRepo.EXPECT().GetByRefreshTokenHash(ctx, hash).Return(&entities.Session{
+					ID:               "session-1",
+					UserID:           "user-1",
+					RefreshTokenHash: hash,
+					ExpiresAt:        time.Now().Add(time.Hour),
+				}, nil)
+				s.usersRepo.EXPECT().GetByID(ctx, "user-1").Return(&entities.User{ID: "user-1"}, nil)
+				s.sessionsRepo.EXPECT().
+					RotateRefreshToken(ctx, "session-1", hash, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(entities.ErrNotFound)
+			},
+		},
+	}
+
+	sum := sha256.Sum256([]byte("refresh-token"))
+	hash := hex.EncodeToString(sum[:])
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.prepare(hash)
+
+			refreshed, err := s.service.Refresh(ctx, "refresh-token", entities.Device{})
+			s.Require().Error(err)
+			s.ErrorIs(err, entities.ErrInvalidToken)
+			s.Nil(refreshed)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestSessions() {
+	ctx := context.Background()
+
+	active := []entities.Session{{ID: "session-1", UserID: "user-1"}}
+
+	s.sessionsRepo.EXPECT().ListActiveByUserID(ctx, "user-1", gomock.Any()).Return(active, nil)
+
+	sessions, err := s.service.ListSessions(ctx, "user-1")
+	s.Require().NoError(err)
+	s.Equal(active, sessions)
+
+	s.sessionsRepo.EXPECT().Revoke(ctx, "user-1", "session-2", gomock.Any()).Return(entities.ErrNotFound)
+
+	err = s.service.RevokeSession(ctx, "user-1", "session-2")
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *ServiceSuite) TestSetLevel() {
+	ctx := context.Background()
+
+	s.usersRepo.EXPECT().UpdateLevel(ctx, "user-1", 3.5).Return(nil)
+	s.NoError(s.service.SetLevel(ctx, "user-1", 3.5))
+
+	s.ErrorIs(s.service.SetLevel(ctx, "user-1", 7.5), entities.ErrInvalidSkillLevel)
+	s.ErrorIs(s.service.SetLevel(ctx, "user-1", -1), entities.ErrInvalidSkillLevel)
+}
@@ -4,11 +4,45 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
 )
 
 type UsersRepository interface {
 	GetByNickname(ctx context.Context, nickname string) (entities.User, error)
+	GetByID(ctx context.Context, userID string) (*entities.User, error)
+	GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entities.User, error)
 	Create(ctx context.Context, user *entities.User) error
+	MarkPhoneVerified(ctx context.Context, userID string, verifiedAt time.Time) error
+	UpdatePassword(ctx context.Context, userID, hashedPassword string) error
+	UpdateLevel(ctx context.Context, userID string, level float64) error
+}
+
+type SessionsRepository interface {
+	Create(ctx context.Context, session *entities.Session) error
+	GetByID(ctx context.Context, sessionID string) (*entities.Session, error)
+	GetByRefreshTokenHash(ctx context.Context, hash string) (*entities.Session, error)
+	RotateRefreshToken(
+		ctx context.Context,
+		sessionID, oldHash, newHash string,
+		device entities.Device,
+		now, expiresAt time.Time,
+	) error
+	ListActiveByUserID(ctx context.Context, userID string, now time.Time) ([]entities.Session, error)
+	Revoke(ctx context.Context, userID, sessionID string, now time.Time) error
+	RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error
+}
+
+type OTPRepository interface {
+	Create(ctx context.Context, challenge *entities.OTPChallenge) error
+	GetLatest(ctx context.Context, phoneNumber string, purpose entities.OTPPurpose) (*entities.OTPChallenge, error)
+	CountSince(ctx context.Context, phoneNumber string, since time.Time) (int, error)
+	UseAttempt(ctx context.Context, challengeID string, maxAttempts int) error
+	Consume(ctx context.Context, challengeID string, now time.Time) error
+}
+
+// SMSSender delivers text messages to phone numbers.
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
 }
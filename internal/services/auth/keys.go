@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+// KeyConfig describes one signing key. Key material is given either inline or as a path to a file.
+// HS256 keys take a secret, RS256 and EdDSA keys take a PEM encoded private key, or only the public
+// key for retired keys that are kept to verify tokens issued before a rotation.
+type KeyConfig struct {
+	ID             string
+	Algorithm      string
+	Secret         string
+	SecretFile     string
+	PrivateKey     string
+	PrivateKeyFile string
+	PublicKey      string
+	PublicKeyFile  string
+}
+
+type signingKey struct {
+	id        string
+	method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+// KeySet signs tokens with the active key and verifies tokens signed by any key of the set, so that
+// tokens issued before a rotation stay valid until they expire.
+type KeySet struct {
+	active *signingKey
+	keys   map[string]*signingKey
+	// order keeps the configuration order for PublicKeys
+	order []string
+}
+
+func NewKeySet(activeID string, configs []KeyConfig) (*KeySet, error) {
+	set := &KeySet{
+		keys: make(map[string]*signingKey, len(configs)),
+	}
+
+	for _, cfg := range configs {
+		key, err := newSigningKey(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", cfg.ID, err)
+		}
+
+		if _, ok := set.keys[key.id]; ok {
+			return nil, fmt.Errorf("key %q: duplicate id", key.id)
+		}
+
+		set.keys[key.id] = key
+		set.order = append(set.order, key.id)
+	}
+
+	active, ok := set.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("active key %q is not configured", activeID)
+	}
+
+	if active.signKey == nil {
+		return nil, fmt.Errorf("active key %q has no private key", activeID)
+	}
+
+	set.active = active
+
+	return set, nil
+}
+
+func newSigningKey(cfg KeyConfig) (*signingKey, error) {
+	if cfg.ID == "" {
+		return nil, errors.New("id is required")
+	}
+
+	key := &signingKey{id: cfg.ID}
+
+	switch cfg.Algorithm {
+	case HS256:
+		secret, err := keyMaterial(cfg.Secret, cfg.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("secret: %w", err)
+		}
+
+		if len(secret) < 32 {
+			return nil, errors.New("secret must be at least 32 bytes long")
+		}
+
+		key.method = jwt.SigningMethodHS256
+		key.signKey = secret
+		key.verifyKey = secret
+	case RS256, EdDSA:
+		if cfg.Algorithm == RS256 {
+			key.method = jwt.SigningMethodRS256
+		} else {
+			key.method = jwt.SigningMethodEdDSA
+		}
+
+		if err := key.loadKeyPair(cfg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", cfg.Algorithm)
+	}
+
+	return key, nil
+}
+
+// loadKeyPair reads the private key, deriving the public key from it, or the public key alone.
+func (k *signingKey) loadKeyPair(cfg KeyConfig) error {
+	if cfg.PrivateKey != "" || cfg.PrivateKeyFile != "" {
+		pem, err := keyMaterial(cfg.PrivateKey, cfg.PrivateKeyFile)
+		if err != nil {
+			return fmt.Errorf("private key: %w", err)
+		}
+
+		var private crypto.Signer
+
+		if k.method == jwt.SigningMethodRS256 {
+			private, err = jwt.ParseRSAPrivateKeyFromPEM(pem)
+		} else {
+			var edKey crypto.PrivateKey
+			edKey, err = jwt.ParseEdPrivateKeyFromPEM(pem)
+			private, _ = edKey.(crypto.Signer)
+		}
+		if err != nil {
+			return fmt.Errorf("parse private key: %w", err)
+		}
+
+		k.signKey = private
+		k.verifyKey = private.Public()
+
+		return nil
+	}
+
+	pem, err := keyMaterial(cfg.PublicKey, cfg.PublicKeyFile)
+	if err != nil {
+		return fmt.Errorf("public key: %w", err)
+	}
+
+	if k.method == jwt.SigningMethodRS256 {
+		k.verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(pem)
+	} else {
+		k.verifyKey, err = jwt.ParseEdPublicKeyFromPEM(pem)
+	}
+	if err != nil {
+		return fmt.Errorf("parse public key: %w", err)
+	}
+
+	return nil
+}
+
+func keyMaterial(inline, file string) ([]byte, error) {
+	if inline != "" {
+		return []byte(inline), nil
+	}
+
+	if file == "" {
+		return nil, errors.New("neither value nor file is set")
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return data, nil
+}
+
+// sign signs the claims with the active key and puts its id into the kid header.
+func (s *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.active.method, claims)
+	token.Header["kid"] = s.active.id
+
+	return token.SignedString(s.active.signKey)
+}
+
+// keyFunc picks the verification key by the kid header. Tokens without kid are checked against the
+// active key. The algorithm of the token has to match the key, so a public key is never used as an
+// HMAC secret.
+func (s *KeySet) keyFunc(token *jwt.Token) (any, error) {
+	key := s.active
+
+	if kid, ok := token.Header["kid"]; ok {
+		id, _ := kid.(string)
+
+		key, ok = s.keys[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown key id %q", entities.ErrInvalidToken, id)
+		}
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("%w: algorithm %s does not match key %s", entities.ErrInvalidToken,
+			token.Method.Alg(), key.id)
+	}
+
+	return key.verifyKey, nil
+}
+
+// algorithms lists every algorithm accepted by the set.
+func (s *KeySet) algorithms() []string {
+	var algs []string
+
+	for _, id := range s.order {
+		if alg := s.keys[id].method.Alg(); !slices.Contains(algs, alg) {
+			algs = append(algs, alg)
+		}
+	}
+
+	return algs
+}
+
+// PublicKeys returns the keys other services may use to verify tokens. HS256 secrets are never published.
+func (s *KeySet) PublicKeys() []entities.PublicKey {
+	var keys []entities.PublicKey
+
+	for _, id := range s.order {
+		key := s.keys[id]
+
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey, ed25519.PublicKey:
+			keys = append(keys, entities.PublicKey{
+				ID:        key.id,
+				Algorithm: key.method.Alg(),
+				Key:       pub,
+			})
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,266 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/auth"
+	"github.com/lever-dev/padel-backend/internal/services/auth/mocks"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type KeysSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	usersRepo    *mocks.MockUsersRepository
+	sessionsRepo *mocks.MockSessionsRepository
+
+	rsaPrivatePEM string
+	rsaPublicPEM  string
+	edPrivatePEM  string
+	edPublicPEM   string
+}
+
+func TestKeysSuite(t *testing.T) {
+	suite.Run(t, new(KeysSuite))
+}
+
+func (s *KeysSuite) SetupSuite() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+
+	s.rsaPrivatePEM = s.encodePEM("PRIVATE KEY", x509.MarshalPKCS8PrivateKey, rsaKey)
+	s.rsaPublicPEM = s.encodePEM("PUBLIC KEY", x509.MarshalPKIXPublicKey, &rsaKey.PublicKey)
+	s.edPrivatePEM = s.encodePEM("PRIVATE KEY", x509.MarshalPKCS8PrivateKey, edPrivate)
+	s.edPublicPEM = s.encodePEM("PUBLIC KEY", x509.MarshalPKIXPublicKey, edPublic)
+}
+
+func (s *KeysSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+	s.sessionsRepo = mocks.NewMockSessionsRepository(s.ctrl)
+}
+
+func (s *KeysSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *KeysSuite) encodePEM(blockType string, marshal func(any) ([]byte, error), key any) string {
+	der, err := marshal(key)
+	s.Require().NoError(err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
+
+func (s *KeysSuite) newService(active string, configs ...auth.KeyConfig) *auth.Service {
+	keys, err := auth.NewKeySet(active, configs)
+	s.Require().NoError(err)
+
+	return auth.NewService(
+		s.usersRepo,
+		s.sessionsRepo,
+		nil,
+		nil,
+		keys,
+		clock.Real{},
+		auth.Config{AccessTokenTTL: time.Hour, RefreshTokenTTL: 24 * time.Hour},
+	)
+}
+
+// issue logs a user in through the service and returns the access token with its session.
+func (s *KeysSuite) issue(service *auth.Service) (string, *entities.Session) {
+	ctx := context.Background()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	s.Require().NoError(err)
+
+	s.usersRepo.EXPECT().
+		GetByNickname(ctx, "alice").
+		Return(entities.User{ID: "user-1", Nickname: "alice", HashedPassword: string(hashed)}, nil)
+
+	var session *entities.Session
+
+	s.sessionsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, created *entities.Session) error {
+			session = created
+			return nil
+		})
+
+	pair, err := service.LoginViaPassword(ctx, "alice", "secret", entities.Device{})
+	s.Require().NoError(err)
+
+	return pair.AccessToken, session
+}
+
+func (s *KeysSuite) TestAlgorithms() {
+	tests := []struct {
+		name string
+		key  auth.KeyConfig
+	}{
+		{
+			name: "HS256",
+			key:  auth.KeyConfig{ID: "hs", Algorithm: auth.HS256, Secret: testSecret},
+		},
+		{
+			name: "RS256",
+			key:  auth.KeyConfig{ID: "rs", Algorithm: auth.RS256, PrivateKey: s.rsaPrivatePEM},
+		},
+		{
+			name: "EdDSA",
+			key:  auth.KeyConfig{ID: "ed", Algorithm: auth.EdDSA, PrivateKey: s.edPrivatePEM},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			service := s.newService(tt.key.ID, tt.key)
+			token, session := s.issue(service)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+			s.Require().NoError(err)
+			s.Equal(tt.key.ID, parsed.Header["kid"])
+			s.Equal(tt.name, parsed.Method.Alg())
+
+			s.sessionsRepo.EXPECT().GetByID(gomock.Any(), session.ID).Return(session, nil)
+
+			claims, err := service.VerifyToken(context.Background(), token)
+			s.Require().NoError(err)
+			s.Equal("user-1", claims.UserID)
+		})
+	}
+}
+
+func (s *KeysSuite) TestRotation() {
+	oldKey := auth.KeyConfig{ID: "2026-09", Algorithm: auth.EdDSA, PrivateKey: s.edPrivatePEM}
+	newKey := auth.KeyConfig{ID: "2026-10", Algorithm: auth.RS256, PrivateKey: s.rsaPrivatePEM}
+
+	before := s.newService(oldKey.ID, oldKey)
+	token, session := s.issue(before)
+
+	s.Run("token of the previous key stays valid", func() {
+		retired := oldKey
+		retired.PrivateKey = ""
+		retired.PublicKey = s.edPublicPEM
+
+		after := s.newService(newKey.ID, newKey, retired)
+
+		s.sessionsRepo.EXPECT().GetByID(gomock.Any(), session.ID).Return(session, nil)
+
+		claims, err := after.VerifyToken(context.Background(), token)
+		s.Require().NoError(err)
+		s.Equal("user-1", claims.UserID)
+	})
+
+	s.Run("token of a removed key is rejected", func() {
+		after := s.newService(newKey.ID, newKey)
+
+		claims, err := after.VerifyToken(context.Background(), token)
+		s.Require().Error(err)
+		s.ErrorIs(err, entities.ErrInvalidToken)
+		s.Nil(claims)
+	})
+}
+
+func (s *KeysSuite) TestAlgorithmConfusion() {
+	service := s.newService(
+		"rs",
+		auth.KeyConfig{ID: "rs", Algorithm: auth.RS256, PrivateKey: s.rsaPrivatePEM},
+		auth.KeyConfig{ID: "hs", Algorithm: auth.HS256, Secret: testSecret},
+	)
+
+	// the RSA public key is public, a token "signed" with it as an HMAC secret must not pass
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "user-1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	forged.Header["kid"] = "rs"
+
+	token, err := forged.SignedString([]byte(s.rsaPublicPEM))
+	s.Require().NoError(err)
+
+	claims, err := service.VerifyToken(context.Background(), token)
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrInvalidToken)
+	s.Nil(claims)
+}
+
+func (s *KeysSuite) TestPublicKeys() {
+	service := s.newService(
+		"rs",
+		auth.KeyConfig{ID: "rs", Algorithm: auth.RS256, PrivateKey: s.rsaPrivatePEM},
+		auth.KeyConfig{ID: "ed", Algorithm: auth.EdDSA, PublicKey: s.edPublicPEM},
+		auth.KeyConfig{ID: "hs", Algorithm: auth.HS256, Secret: testSecret},
+	)
+
+	keys := service.PublicKeys()
+	s.Require().Len(keys, 2)
+
+	s.Equal("rs", keys[0].ID)
+	s.Equal("RS256", keys[0].Algorithm)
+	s.IsType(&rsa.PublicKey{}, keys[0].Key)
+
+	s.Equal("ed", keys[1].ID)
+	s.Equal("EdDSA", keys[1].Algorithm)
+	s.IsType(ed25519.PublicKey{}, keys[1].Key)
+}
+
+func (s *KeysSuite) TestNewKeySet_Invalid() {
+	tests := []struct {
+		name    string
+		active  string
+		configs []auth.KeyConfig
+	}{
+		{
+			name:    "active key missing",
+			active:  "missing",
+			configs: []auth.KeyConfig{{ID: "hs", Algorithm: auth.HS256, Secret: testSecret}},
+		},
+		{
+			name:    "short secret",
+			active:  "hs",
+			configs: []auth.KeyConfig{{ID: "hs", Algorithm: auth.HS256, Secret: "short"}},
+		},
+		{
+			name:    "unsupported algorithm",
+			active:  "es",
+			configs: []auth.KeyConfig{{ID: "es", Algorithm: "ES256", PrivateKey: "irrelevant"}},
+		},
+		{
+			name:    "active key without private key",
+			active:  "ed",
+			configs: []auth.KeyConfig{{ID: "ed", Algorithm: auth.EdDSA, PublicKey: s.edPublicPEM}},
+		},
+		{
+			name:   "duplicate id",
+			active: "hs",
+			configs: []auth.KeyConfig{
+				{ID: "hs", Algorithm: auth.HS256, Secret: testSecret},
+				{ID: "hs", Algorithm: auth.HS256, Secret: testSecret},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			keys, err := auth.NewKeySet(tt.active, tt.configs)
+			s.Require().Error(err)
+			s.Nil(keys)
+		})
+	}
+}
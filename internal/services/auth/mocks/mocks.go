@@ -0,0 +1,394 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/auth/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUsersRepository) Create(ctx context.Context, user *entities.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUsersRepositoryMockRecorder) Create(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUsersRepository)(nil).Create), ctx, user)
+}
+
+// GetByID mocks base method.
+func (m *MockUsersRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUsersRepositoryMockRecorder) GetByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsersRepository)(nil).GetByID), ctx, userID)
+}
+
+// GetByNickname mocks base method.
+func (m *MockUsersRepository) GetByNickname(ctx context.Context, nickname string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByNickname", ctx, nickname)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByNickname indicates an expected call of GetByNickname.
+func (mr *MockUsersRepositoryMockRecorder) GetByNickname(ctx, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNickname", reflect.TypeOf((*MockUsersRepository)(nil).GetByNickname), ctx, nickname)
+}
+
+// GetByPhoneNumber mocks base method.
+func (m *MockUsersRepository) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPhoneNumber indicates an expected call of GetByPhoneNumber.
+func (mr *MockUsersRepositoryMockRecorder) GetByPhoneNumber(ctx, phoneNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPhoneNumber", reflect.TypeOf((*MockUsersRepository)(nil).GetByPhoneNumber), ctx, phoneNumber)
+}
+
+// MarkPhoneVerified mocks base method.
+func (m *MockUsersRepository) MarkPhoneVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPhoneVerified", ctx, userID, verifiedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPhoneVerified indicates an expected call of MarkPhoneVerified.
+func (mr *MockUsersRepositoryMockRecorder) MarkPhoneVerified(ctx, userID, verifiedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPhoneVerified", reflect.TypeOf((*MockUsersRepository)(nil).MarkPhoneVerified), ctx, userID, verifiedAt)
+}
+
+// UpdateLevel mocks base method.
+func (m *MockUsersRepository) UpdateLevel(ctx context.Context, userID string, level float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLevel", ctx, userID, level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLevel indicates an expected call of UpdateLevel.
+func (mr *MockUsersRepositoryMockRecorder) UpdateLevel(ctx, userID, level interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLevel", reflect.TypeOf((*MockUsersRepository)(nil).UpdateLevel), ctx, userID, level)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUsersRepository) UpdatePassword(ctx context.Context, userID, hashedPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userID, hashedPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUsersRepositoryMockRecorder) UpdatePassword(ctx, userID, hashedPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUsersRepository)(nil).UpdatePassword), ctx, userID, hashedPassword)
+}
+
+// MockSessionsRepository is a mock of SessionsRepository interface.
+type MockSessionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionsRepositoryMockRecorder
+}
+
+// MockSessionsRepositoryMockRecorder is the mock recorder for MockSessionsRepository.
+type MockSessionsRepositoryMockRecorder struct {
+	mock *MockSessionsRepository
+}
+
+// NewMockSessionsRepository creates a new mock instance.
+func NewMockSessionsRepository(ctrl *gomock.Controller) *MockSessionsRepository {
+	mock := &MockSessionsRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionsRepository) EXPECT() *MockSessionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionsRepository) Create(ctx context.Context, session *entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionsRepositoryMockRecorder) Create(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionsRepository)(nil).Create), ctx, session)
+}
+
+// GetByID mocks base method.
+func (m *MockSessionsRepository) GetByID(ctx context.Context, sessionID string) (*entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSessionsRepositoryMockRecorder) GetByID(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSessionsRepository)(nil).GetByID), ctx, sessionID)
+}
+
+// GetByRefreshTokenHash mocks base method.
+func (m *MockSessionsRepository) GetByRefreshTokenHash(ctx context.Context, hash string) (*entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRefreshTokenHash", ctx, hash)
+	ret0, _ := ret[0].(*entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByRefreshTokenHash indicates an expected call of GetByRefreshTokenHash.
+func (mr *MockSessionsRepositoryMockRecorder) GetByRefreshTokenHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRefreshTokenHash", reflect.TypeOf((*MockSessionsRepository)(nil).GetByRefreshTokenHash), ctx, hash)
+}
+
+// ListActiveByUserID mocks base method.
+func (m *MockSessionsRepository) ListActiveByUserID(ctx context.Context, userID string, now time.Time) ([]entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByUserID", ctx, userID, now)
+	ret0, _ := ret[0].([]entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByUserID indicates an expected call of ListActiveByUserID.
+func (mr *MockSessionsRepositoryMockRecorder) ListActiveByUserID(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByUserID", reflect.TypeOf((*MockSessionsRepository)(nil).ListActiveByUserID), ctx, userID, now)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionsRepository) Revoke(ctx context.Context, userID, sessionID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, sessionID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionsRepositoryMockRecorder) Revoke(ctx, userID, sessionID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionsRepository)(nil).Revoke), ctx, userID, sessionID, now)
+}
+
+// RevokeAllByUserID mocks base method.
+func (m *MockSessionsRepository) RevokeAllByUserID(ctx context.Context, userID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUserID", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllByUserID indicates an expected call of RevokeAllByUserID.
+func (mr *MockSessionsRepositoryMockRecorder) RevokeAllByUserID(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUserID", reflect.TypeOf((*MockSessionsRepository)(nil).RevokeAllByUserID), ctx, userID, now)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockSessionsRepository) RotateRefreshToken(ctx context.Context, sessionID, oldHash, newHash string, device entities.Device, now, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, sessionID, oldHash, newHash, device, now, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockSessionsRepositoryMockRecorder) RotateRefreshToken(ctx, sessionID, oldHash, newHash, device, now, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockSessionsRepository)(nil).RotateRefreshToken), ctx, sessionID, oldHash, newHash, device, now, expiresAt)
+}
+
+// MockOTPRepository is a mock of OTPRepository interface.
+type MockOTPRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOTPRepositoryMockRecorder
+}
+
+// MockOTPRepositoryMockRecorder is the mock recorder for MockOTPRepository.
+type MockOTPRepositoryMockRecorder struct {
+	mock *MockOTPRepository
+}
+
+// NewMockOTPRepository creates a new mock instance.
+func NewMockOTPRepository(ctrl *gomock.Controller) *MockOTPRepository {
+	mock := &MockOTPRepository{ctrl: ctrl}
+	mock.recorder = &MockOTPRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOTPRepository) EXPECT() *MockOTPRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockOTPRepository) Consume(ctx context.Context, challengeID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, challengeID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockOTPRepositoryMockRecorder) Consume(ctx, challengeID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockOTPRepository)(nil).Consume), ctx, challengeID, now)
+}
+
+// CountSince mocks base method.
+func (m *MockOTPRepository) CountSince(ctx context.Context, phoneNumber string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSince", ctx, phoneNumber, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSince indicates an expected call of CountSince.
+func (mr *MockOTPRepositoryMockRecorder) CountSince(ctx, phoneNumber, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSince", reflect.TypeOf((*MockOTPRepository)(nil).CountSince), ctx, phoneNumber, since)
+}
+
+// Create mocks base method.
+func (m *MockOTPRepository) Create(ctx context.Context, challenge *entities.OTPChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOTPRepositoryMockRecorder) Create(ctx, challenge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOTPRepository)(nil).Create), ctx, challenge)
+}
+
+// GetLatest mocks base method.
+func (m *MockOTPRepository) GetLatest(ctx context.Context, phoneNumber string, purpose entities.OTPPurpose) (*entities.OTPChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatest", ctx, phoneNumber, purpose)
+	ret0, _ := ret[0].(*entities.OTPChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatest indicates an expected call of GetLatest.
+func (mr *MockOTPRepositoryMockRecorder) GetLatest(ctx, phoneNumber, purpose interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatest", reflect.TypeOf((*MockOTPRepository)(nil).GetLatest), ctx, phoneNumber, purpose)
+}
+
+// UseAttempt mocks base method.
+func (m *MockOTPRepository) UseAttempt(ctx context.Context, challengeID string, maxAttempts int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseAttempt", ctx, challengeID, maxAttempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseAttempt indicates an expected call of UseAttempt.
+func (mr *MockOTPRepositoryMockRecorder) UseAttempt(ctx, challengeID, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseAttempt", reflect.TypeOf((*MockOTPRepository)(nil).UseAttempt), ctx, challengeID, maxAttempts)
+}
+
+// MockSMSSender is a mock of SMSSender interface.
+type MockSMSSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSSenderMockRecorder
+}
+
+// MockSMSSenderMockRecorder is the mock recorder for MockSMSSender.
+type MockSMSSenderMockRecorder struct {
+	mock *MockSMSSender
+}
+
+// NewMockSMSSender creates a new mock instance.
+func NewMockSMSSender(ctrl *gomock.Controller) *MockSMSSender {
+	mock := &MockSMSSender{ctrl: ctrl}
+	mock.recorder = &MockSMSSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSSender) EXPECT() *MockSMSSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSMSSender) Send(ctx context.Context, phoneNumber, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, phoneNumber, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSMSSenderMockRecorder) Send(ctx, phoneNumber, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSMSSender)(nil).Send), ctx, phoneNumber, message)
+}
@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// DefaultOTPTTL is how long a code sent by SMS can be used.
+	DefaultOTPTTL = 5 * time.Minute
+	// DefaultOTPMaxAttempts is how many wrong guesses burn a code.
+	DefaultOTPMaxAttempts = 5
+	// DefaultOTPResendInterval is how long a new code for the same purpose has to wait.
+	DefaultOTPResendInterval = time.Minute
+	// DefaultOTPHourlyLimit caps the codes sent to one phone number per hour.
+	DefaultOTPHourlyLimit = 5
+)
+
+// OTPConfig limits how often codes are sent and how long and how many times they can be tried.
+type OTPConfig struct {
+	TTL            time.Duration
+	MaxAttempts    int
+	ResendInterval time.Duration
+	HourlyLimit    int
+}
+
+func (c OTPConfig) withDefaults() OTPConfig {
+	if c.TTL <= 0 {
+		c.TTL = DefaultOTPTTL
+	}
+
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = DefaultOTPMaxAttempts
+	}
+
+	if c.ResendInterval <= 0 {
+		c.ResendInterval = DefaultOTPResendInterval
+	}
+
+	if c.HourlyLimit <= 0 {
+		c.HourlyLimit = DefaultOTPHourlyLimit
+	}
+
+	return c
+}
+
+// RequestOTP sends a code for the purpose to the phone number. Numbers that are not registered, or are
+// already verified when asking for verification, get no SMS but no error either, so the endpoint does
+// not reveal which numbers have an account.
+func (s *Service) RequestOTP(ctx context.Context, phoneNumber string, purpose entities.OTPPurpose) error {
+	if !purpose.Valid() {
+		return fmt.Errorf("%w: %q", entities.ErrInvalidOTPPurpose, purpose)
+	}
+
+	user, err := s.usersRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get user by phone number: %w", err)
+	}
+
+	if purpose == entities.VerifyPhoneOTPPurpose && user.PhoneVerifiedAt != nil {
+		return nil
+	}
+
+	return s.sendOTP(ctx, phoneNumber, purpose)
+}
+
+// VerifyPhone marks the phone number of its user as verified.
+func (s *Service) VerifyPhone(ctx context.Context, phoneNumber, code string) error {
+	user, err := s.consumeOTP(ctx, phoneNumber, entities.VerifyPhoneOTPPurpose, code)
+	if err != nil {
+		return err
+	}
+
+	if err := s.usersRepo.MarkPhoneVerified(ctx, user.ID, s.clock.Now()); err != nil {
+		return fmt.Errorf("mark phone verified: %w", err)
+	}
+
+	return nil
+}
+
+// LoginViaOTP opens a session for the owner of the phone number without a password. Receiving the code
+// proves ownership of the number, so it is marked as verified as well.
+func (s *Service) LoginViaOTP(
+	ctx context.Context,
+	phoneNumber, code string,
+	device entities.Device,
+) (*entities.TokenPair, error) {
+	user, err := s.consumeOTP(ctx, phoneNumber, entities.LoginOTPPurpose, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.usersRepo.MarkPhoneVerified(ctx, user.ID, s.clock.Now()); err != nil {
+		return nil, fmt.Errorf("mark phone verified: %w", err)
+	}
+
+	return s.openSession(ctx, *user, device)
+}
+
+// ResetPassword sets a new password for the owner of the phone number and signs out all their sessions.
+func (s *Service) ResetPassword(ctx context.Context, phoneNumber, code, newPassword string) error {
+	user, err := s.consumeOTP(ctx, phoneNumber, entities.ResetPasswordOTPPurpose, code)
+	if err != nil {
+		return err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	if err := s.usersRepo.UpdatePassword(ctx, user.ID, string(hashed)); err != nil {
+		return fmt.Errorf("update password: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	if err := s.sessionsRepo.RevokeAllByUserID(ctx, user.ID, now); err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+
+	if err := s.usersRepo.MarkPhoneVerified(ctx, user.ID, now); err != nil {
+		return fmt.Errorf("mark phone verified: %w", err)
+	}
+
+	return nil
+}
+
+// sendOTP issues a new code, superseding the previous one for the same purpose, and sends it by SMS.
+func (s *Service) sendOTP(ctx context.Context, phoneNumber string, purpose entities.OTPPurpose) error {
+	now := s.clock.Now()
+
+	if err := s.checkOTPRate(ctx, phoneNumber, purpose, now); err != nil {
+		return err
+	}
+
+	code, err := newOTPCode()
+	if err != nil {
+		return fmt.Errorf("new code: %w", err)
+	}
+
+	// codes are short, a slow hash keeps a leaked table from giving them away
+	hashed, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hash code: %w", err)
+	}
+
+	challenge := entities.NewOTPChallenge(phoneNumber, purpose, string(hashed), now, s.cfg.OTP.TTL)
+
+	if err := s.otpRepo.Create(ctx, challenge); err != nil {
+		return fmt.Errorf("create otp challenge: %w", err)
+	}
+
+	message := fmt.Sprintf("Your Padel code is %s. It expires in %d minutes.", code, int(s.cfg.OTP.TTL.Minutes()))
+
+	if err := s.sms.Send(ctx, phoneNumber, message); err != nil {
+		return fmt.Errorf("send sms: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) checkOTPRate(
+	ctx context.Context,
+	phoneNumber string,
+	purpose entities.OTPPurpose,
+	now time.Time,
+) error {
+	latest, err := s.otpRepo.GetLatest(ctx, phoneNumber, purpose)
+	if err != nil && !errors.Is(err, entities.ErrNotFound) {
+		return fmt.Errorf("get latest otp challenge: %w", err)
+	}
+
+	if latest != nil && now.Sub(latest.CreatedAt) < s.cfg.OTP.ResendInterval {
+		return fmt.Errorf("%w: a code was sent less than %s ago", entities.ErrTooManyRequests, s.cfg.OTP.ResendInterval)
+	}
+
+	sent, err := s.otpRepo.CountSince(ctx, phoneNumber, now.Add(-time.Hour))
+	if err != nil {
+		return fmt.Errorf("count otp challenges: %w", err)
+	}
+
+	if sent >= s.cfg.OTP.HourlyLimit {
+		return fmt.Errorf("%w: %d codes sent in the last hour", entities.ErrTooManyRequests, sent)
+	}
+
+	return nil
+}
+
+// consumeOTP checks the code against the latest challenge and uses it up. Every guess counts against the
+// attempts of the challenge before the code is compared, so concurrent guesses can't exceed them.
+func (s *Service) consumeOTP(
+	ctx context.Context,
+	phoneNumber string,
+	purpose entities.OTPPurpose,
+	code string,
+) (*entities.User, error) {
+	challenge, err := s.otpRepo.GetLatest(ctx, phoneNumber, purpose)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("%w: no code was sent", entities.ErrInvalidOTP)
+		}
+		return nil, fmt.Errorf("get latest otp challenge: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	if !challenge.IsUsableAt(now, s.cfg.OTP.MaxAttempts) {
+		return nil, fmt.Errorf("%w: code %s is used, expired or locked", entities.ErrInvalidOTP, challenge.ID)
+	}
+
+	if err := s.otpRepo.UseAttempt(ctx, challenge.ID, s.cfg.OTP.MaxAttempts); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("%w: code %s is used or locked", entities.ErrInvalidOTP, challenge.ID)
+		}
+		return nil, fmt.Errorf("use otp attempt: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(challenge.CodeHash), []byte(code)); err != nil {
+		return nil, fmt.Errorf("%w: wrong code", entities.ErrInvalidOTP)
+	}
+
+	if err := s.otpRepo.Consume(ctx, challenge.ID, now); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("%w: code %s was already used", entities.ErrInvalidOTP, challenge.ID)
+		}
+		return nil, fmt.Errorf("consume otp challenge: %w", err)
+	}
+
+	user, err := s.usersRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return nil, fmt.Errorf("get user by phone number: %w", err)
+	}
+
+	return user, nil
+}
+
+// newOTPCode returns a random six digit code.
+func newOTPCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("read random: %w", err)
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
@@ -0,0 +1,349 @@
+package auth_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/auth"
+	"github.com/lever-dev/padel-backend/internal/services/auth/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type OTPSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	usersRepo    *mocks.MockUsersRepository
+	sessionsRepo *mocks.MockSessionsRepository
+	otpRepo      *mocks.MockOTPRepository
+	sms          *mocks.MockSMSSender
+	clock        *clockmocks.MockClock
+	service      *auth.Service
+
+	now time.Time
+}
+
+func TestOTPSuite(t *testing.T) {
+	suite.Run(t, new(OTPSuite))
+}
+
+const phone = "+77010000001"
+
+var codePattern = regexp.MustCompile(`\b(\d{6})\b`)
+
+func (s *OTPSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+	s.sessionsRepo = mocks.NewMockSessionsRepository(s.ctrl)
+	s.otpRepo = mocks.NewMockOTPRepository(s.ctrl)
+	s.sms = mocks.NewMockSMSSender(s.ctrl)
+	s.clock = clockmocks.NewMockClock(s.ctrl)
+
+	s.now = time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	s.clock.EXPECT().Now().Return(s.now).AnyTimes()
+
+	keys, err := auth.NewKeySet("test", []auth.KeyConfig{
+		{ID: "test", Algorithm: auth.HS256, Secret: testSecret},
+	})
+	s.Require().NoError(err)
+
+	s.service = auth.NewService(
+		s.usersRepo,
+		s.sessionsRepo,
+		s.otpRepo,
+		s.sms,
+		keys,
+		s.clock,
+		auth.Config{
+			OTP: auth.OTPConfig{
+				TTL:            5 * time.Minute,
+				MaxAttempts:    3,
+				ResendInterval: time.Minute,
+				HourlyLimit:    4,
+			},
+		},
+	)
+}
+
+func (s *OTPSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// challenge returns a challenge issued a minute ago for the code.
+func (s *OTPSuite) challenge(purpose entities.OTPPurpose, code string) *entities.OTPChallenge {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.MinCost)
+	s.Require().NoError(err)
+
+	return &entities.OTPChallenge{
+		ID:          "challenge-1",
+		PhoneNumber: phone,
+		Purpose:     purpose,
+		CodeHash:    string(hashed),
+		CreatedAt:   s.now.Add(-time.Minute),
+		ExpiresAt:   s.now.Add(4 * time.Minute),
+	}
+}
+
+func (s *OTPSuite) TestRequestOTP() {
+	ctx := context.Background()
+
+	var (
+		stored  *entities.OTPChallenge
+		message string
+	)
+
+	gomock.InOrder(
+		s.usersRepo.EXPECT().GetByPhoneNumber(ctx, phone).Return(&entities.User{ID: "user-1"}, nil),
+		s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.LoginOTPPurpose).Return(nil, entities.ErrNotFound),
+		s.otpRepo.EXPECT().CountSince(ctx, phone, s.now.Add(-time.Hour)).Return(3, nil),
+		s.otpRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, challenge *entities.OTPChallenge) error {
+				stored = challenge
+				return nil
+			}),
+		s.sms.EXPECT().Send(ctx, phone, gomock.Any()).DoAndReturn(func(_ context.Context, _, text string) error {
+			message = text
+			return nil
+		}),
+	)
+
+	s.Require().NoError(s.service.RequestOTP(ctx, phone, entities.LoginOTPPurpose))
+
+	match := codePattern.FindStringSubmatch(message)
+	s.Require().Len(match, 2, message)
+
+	code := match[1]
+
+	s.Equal(entities.LoginOTPPurpose, stored.Purpose)
+	s.Equal(s.now.Add(5*time.Minute), stored.ExpiresAt)
+	s.NotContains(stored.CodeHash, code)
+	s.NoError(bcrypt.CompareHashAndPassword([]byte(stored.CodeHash), []byte(code)))
+}
+
+func (s *OTPSuite) TestRequestOTP_NothingSent() {
+	ctx := context.Background()
+	verifiedAt := s.now.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		purpose entities.OTPPurpose
+		prepare func()
+	}{
+		{
+			name:    "unknown phone number",
+			purpose: entities.LoginOTPPurpose,
+			prepare: func() {
+				s.usersRepo.EXPECT().GetByPhoneNumber(ctx, phone).Return(nil, entities.ErrNotFound)
+			},
+		},
+		{
+			name:    "phone already verified",
+			purpose: entities.VerifyPhoneOTPPurpose,
+			prepare: func() {
+				s.usersRepo.EXPECT().
+					GetByPhoneNumber(ctx, phone).
+					Return(&entities.User{ID: "user-1", PhoneVerifiedAt: &verifiedAt}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.prepare()
+			s.NoError(s.service.RequestOTP(ctx, phone, tt.purpose))
+		})
+	}
+}
+
+func (s *OTPSuite) TestRequestOTP_RateLimited() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		prepare func()
+	}{
+		{
+			name: "resent too early",
+			prepare: func() {
+				latest := s.challenge(entities.ResetPasswordOTPPurpose, "123456")
+				latest.CreatedAt = s.now.Add(-30 * time.Second)
+
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.ResetPasswordOTPPurpose).Return(latest, nil)
+			},
+		},
+		{
+			name: "hourly limit reached",
+			prepare: func() {
+				s.otpRepo.EXPECT().
+					GetLatest(ctx, phone, entities.ResetPasswordOTPPurpose).
+					Return(s.challenge(entities.ResetPasswordOTPPurpose, "123456"), nil)
+				s.otpRepo.EXPECT().CountSince(ctx, phone, s.now.Add(-time.Hour)).Return(4, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.usersRepo.EXPECT().GetByPhoneNumber(ctx, phone).Return(&entities.User{ID: "user-1"}, nil)
+			tt.prepare()
+
+			err := s.service.RequestOTP(ctx, phone, entities.ResetPasswordOTPPurpose)
+			s.ErrorIs(err, entities.ErrTooManyRequests)
+		})
+	}
+}
+
+func (s *OTPSuite) TestRequestOTP_InvalidPurpose() {
+	err := s.service.RequestOTP(context.Background(), phone, "transfer_money")
+	s.ErrorIs(err, entities.ErrInvalidOTPPurpose)
+}
+
+func (s *OTPSuite) TestLoginViaOTP() {
+	ctx := context.Background()
+	device := entities.Device{Name: "iPhone"}
+
+	gomock.InOrder(
+		s.otpRepo.EXPECT().
+			GetLatest(ctx, phone, entities.LoginOTPPurpose).
+			Return(s.challenge(entities.LoginOTPPurpose, "123456"), nil),
+		s.otpRepo.EXPECT().UseAttempt(ctx, "challenge-1", 3).Return(nil),
+		s.otpRepo.EXPECT().Consume(ctx, "challenge-1", s.now).Return(nil),
+		s.usersRepo.EXPECT().
+			GetByPhoneNumber(ctx, phone).
+			Return(&entities.User{ID: "user-1", Nickname: "alice", PhoneNumber: phone}, nil),
+		s.usersRepo.EXPECT().MarkPhoneVerified(ctx, "user-1", s.now).Return(nil),
+		s.sessionsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil),
+	)
+
+	pair, err := s.service.LoginViaOTP(ctx, phone, "123456", device)
+	s.Require().NoError(err)
+	s.NotEmpty(pair.AccessToken)
+	s.NotEmpty(pair.RefreshToken)
+}
+
+func (s *OTPSuite) TestResetPassword() {
+	ctx := context.Background()
+
+	var newHash string
+
+	gomock.InOrder(
+		s.otpRepo.EXPECT().
+			GetLatest(ctx, phone, entities.ResetPasswordOTPPurpose).
+			Return(s.challenge(entities.ResetPasswordOTPPurpose, "654321"), nil),
+		s.otpRepo.EXPECT().UseAttempt(ctx, "challenge-1", 3).Return(nil),
+		s.otpRepo.EXPECT().Consume(ctx, "challenge-1", s.now).Return(nil),
+		s.usersRepo.EXPECT().GetByPhoneNumber(ctx, phone).Return(&entities.User{ID: "user-1"}, nil),
+		s.usersRepo.EXPECT().UpdatePassword(ctx, "user-1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, hashed string) error {
+				newHash = hashed
+				return nil
+			}),
+		s.sessionsRepo.EXPECT().RevokeAllByUserID(ctx, "user-1", s.now).Return(nil),
+		s.usersRepo.EXPECT().MarkPhoneVerified(ctx, "user-1", s.now).Return(nil),
+	)
+
+	s.Require().NoError(s.service.ResetPassword(ctx, phone, "654321", "new-password"))
+	s.NoError(bcrypt.CompareHashAndPassword([]byte(newHash), []byte("new-password")))
+}
+
+func (s *OTPSuite) TestVerifyPhone_Rejected() {
+	ctx := context.Background()
+	consumedAt := s.now.Add(-time.Second)
+
+	tests := []struct {
+		name    string
+		code    string
+		prepare func(challenge *entities.OTPChallenge)
+	}{
+		{
+			name: "no code sent",
+			code: "123456",
+			prepare: func(*entities.OTPChallenge) {
+				s.otpRepo.EXPECT().
+					GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).
+					Return(nil, entities.ErrNotFound)
+			},
+		},
+		{
+			name: "wrong code",
+			code: "000000",
+			prepare: func(challenge *entities.OTPChallenge) {
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(challenge, nil)
+				s.otpRepo.EXPECT().UseAttempt(ctx, challenge.ID, 3).Return(nil)
+			},
+		},
+		{
+			name: "expired",
+			code: "123456",
+			prepare: func(challenge *entities.OTPChallenge) {
+				challenge.ExpiresAt = s.now
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(challenge, nil)
+			},
+		},
+		{
+			name: "too many attempts",
+			code: "123456",
+			prepare: func(challenge *entities.OTPChallenge) {
+				challenge.Attempts = 3
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(challenge, nil)
+			},
+		},
+		{
+			name: "attempts used up by concurrent guesses",
+			code: "123456",
+			prepare: func(challenge *entities.OTPChallenge) {
+				challenge.Attempts = 2
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(challenge, nil)
+				s.otpRepo.EXPECT().UseAttempt(ctx, challenge.ID, 3).Return(entities.ErrNotFound)
+			},
+		},
+		{
+			name: "already used",
+			code: "123456",
+			prepare: func(challenge *entities.OTPChallenge) {
+				challenge.ConsumedAt = &consumedAt
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(challenge, nil)
+			},
+		},
+		{
+			name: "used by a concurrent request",
+			code: "123456",
+			prepare: func(challenge *entities.OTPChallenge) {
+				s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(challenge, nil)
+				s.otpRepo.EXPECT().UseAttempt(ctx, challenge.ID, 3).Return(nil)
+				s.otpRepo.EXPECT().Consume(ctx, challenge.ID, s.now).Return(entities.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.prepare(s.challenge(entities.VerifyPhoneOTPPurpose, "123456"))
+
+			err := s.service.VerifyPhone(ctx, phone, tt.code)
+			s.ErrorIs(err, entities.ErrInvalidOTP)
+		})
+	}
+}
+
+func (s *OTPSuite) TestRegisterUser_SendsVerificationCode() {
+	ctx := context.Background()
+	user := &entities.User{ID: "user-1", Nickname: "alice", PhoneNumber: phone}
+
+	gomock.InOrder(
+		s.usersRepo.EXPECT().Create(ctx, user).Return(nil),
+		s.otpRepo.EXPECT().GetLatest(ctx, phone, entities.VerifyPhoneOTPPurpose).Return(nil, entities.ErrNotFound),
+		s.otpRepo.EXPECT().CountSince(ctx, phone, gomock.Any()).Return(0, nil),
+		s.otpRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil),
+		s.sms.EXPECT().Send(ctx, phone, gomock.Any()).Return(nil),
+	)
+
+	s.Require().NoError(s.service.RegisterUser(ctx, user, "secret"))
+	s.NotEmpty(user.HashedPassword)
+}
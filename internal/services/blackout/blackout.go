@@ -0,0 +1,377 @@
+package blackout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	blackoutsRepo    BlackoutsRepository
+	reservationsRepo ReservationsRepository
+	courtsRepo       CourtsRepository
+	paymentsRepo     PaymentsRepository
+	usersRepo        UsersRepository
+	canceller        Canceller
+	scheduler        Scheduler
+	locker           Locker
+	smsSender        SMSSender
+	clock            clock.Clock
+}
+
+func NewService(
+	blackoutsRepo BlackoutsRepository,
+	reservationsRepo ReservationsRepository,
+	courtsRepo CourtsRepository,
+	paymentsRepo PaymentsRepository,
+	usersRepo UsersRepository,
+	canceller Canceller,
+	scheduler Scheduler,
+	locker Locker,
+	smsSender SMSSender,
+	clock clock.Clock,
+) *Service {
+	return &Service{
+		blackoutsRepo:    blackoutsRepo,
+		reservationsRepo: reservationsRepo,
+		courtsRepo:       courtsRepo,
+		paymentsRepo:     paymentsRepo,
+		usersRepo:        usersRepo,
+		canceller:        canceller,
+		scheduler:        scheduler,
+		locker:           locker,
+		smsSender:        smsSender,
+		clock:            clock,
+	}
+}
+
+// CreateBlackout closes the court, or every court of the organization, and returns the active reservations
+// the blackout overlaps. With cancelAffected those reservations are cancelled on behalf of the actor with the
+// cancellation policy waived, and their players are notified. The courts are locked while the blackout is
+// stored and the reservations are listed, so no booking slips in between.
+func (s *Service) CreateBlackout(
+	ctx context.Context,
+	blackout *entities.Blackout,
+	actor entities.Actor,
+	cancelAffected bool,
+) ([]entities.Reservation, error) {
+	if err := blackout.Validate(); err != nil {
+		return nil, err
+	}
+
+	courts, err := s.scopeCourts(ctx, *blackout)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.closeCourts(ctx, *blackout, courts, func() error {
+		if err := s.blackoutsRepo.CreateBlackout(ctx, blackout); err != nil {
+			return fmt.Errorf("create blackout: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.handleAffected(ctx, *blackout, affected, actor, cancelAffected)
+}
+
+// UpdateBlackout changes the scope, the time range and the reason of the blackout. The reservations the
+// updated blackout overlaps are returned and, with cancelAffected, cancelled as on creation. The courts of the
+// updated scope are locked as on creation.
+func (s *Service) UpdateBlackout(
+	ctx context.Context,
+	blackout *entities.Blackout,
+	actor entities.Actor,
+	cancelAffected bool,
+) ([]entities.Reservation, error) {
+	existing, err := s.GetBlackout(ctx, blackout.OrganizationID, blackout.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	blackout.CreatedBy = existing.CreatedBy
+	blackout.CreatedAt = existing.CreatedAt
+	blackout.UpdatedAt = s.clock.Now()
+
+	if err := blackout.Validate(); err != nil {
+		return nil, err
+	}
+
+	courts, err := s.scopeCourts(ctx, *blackout)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.closeCourts(ctx, *blackout, courts, func() error {
+		if err := s.blackoutsRepo.UpdateBlackout(ctx, blackout); err != nil {
+			return fmt.Errorf("update blackout: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.handleAffected(ctx, *blackout, affected, actor, cancelAffected)
+}
+
+// GetBlackout fails with ErrNotFound when the blackout belongs to another organization.
+func (s *Service) GetBlackout(ctx context.Context, organizationID, blackoutID string) (*entities.Blackout, error) {
+	blackout, err := s.blackoutsRepo.GetBlackout(ctx, blackoutID)
+	if err != nil {
+		return nil, fmt.Errorf("get blackout: %w", err)
+	}
+
+	if blackout.OrganizationID != organizationID {
+		return nil, fmt.Errorf("%w: blackout %s does not belong to organization %s",
+			entities.ErrNotFound, blackoutID, organizationID)
+	}
+
+	return blackout, nil
+}
+
+// ListBlackouts returns the blackouts of the organization overlapping the time range, the earliest first.
+func (s *Service) ListBlackouts(
+	ctx context.Context,
+	organizationID string,
+	from, to time.Time,
+) ([]entities.Blackout, error) {
+	blackouts, err := s.blackoutsRepo.ListBlackouts(ctx, organizationID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("list blackouts: %w", err)
+	}
+
+	return blackouts, nil
+}
+
+// DeleteBlackout reopens the courts. Reservations cancelled because of the blackout stay cancelled.
+func (s *Service) DeleteBlackout(ctx context.Context, organizationID, blackoutID string) error {
+	if _, err := s.GetBlackout(ctx, organizationID, blackoutID); err != nil {
+		return err
+	}
+
+	if err := s.blackoutsRepo.DeleteBlackout(ctx, blackoutID); err != nil {
+		return fmt.Errorf("delete blackout: %w", err)
+	}
+
+	return nil
+}
+
+// OrganizationLocation returns the time zone of the organization.
+func (s *Service) OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error) {
+	loc, err := s.scheduler.OrganizationLocation(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get organization time zone: %w", err)
+	}
+
+	return loc, nil
+}
+
+// scopeCourts returns the courts the blackout closes, the court has to belong to the organization.
+func (s *Service) scopeCourts(ctx context.Context, blackout entities.Blackout) ([]entities.Court, error) {
+	if blackout.IsOrganizationWide() {
+		courts, err := s.courtsRepo.ListByOrganizationID(ctx, blackout.OrganizationID)
+		if err != nil {
+			return nil, fmt.Errorf("list courts by organization id: %w", err)
+		}
+
+		return courts, nil
+	}
+
+	court, err := s.courtsRepo.GetByID(ctx, blackout.CourtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	if court.OrganizationID != blackout.OrganizationID {
+		return nil, fmt.Errorf("%w: court %s does not belong to organization %s",
+			entities.ErrNotFound, blackout.CourtID, blackout.OrganizationID)
+	}
+
+	return []entities.Court{*court}, nil
+}
+
+// affectedReservation is an active reservation on a court the blackout closes.
+type affectedReservation struct {
+	court       entities.Court
+	reservation entities.Reservation
+}
+
+// closeCourts stores the blackout with store and lists the active reservations it overlaps, holding the lock
+// of every court it closes. Cancelling is left to the caller once the locks are released, since freeing a slot
+// offers it to the waitlist, which books under the same lock.
+func (s *Service) closeCourts(
+	ctx context.Context,
+	blackout entities.Blackout,
+	courts []entities.Court,
+	store func() error,
+) ([]affectedReservation, error) {
+	courtIDs := make([]string, 0, len(courts))
+	for _, court := range courts {
+		courtIDs = append(courtIDs, court.ID)
+	}
+
+	if err := s.locker.LockAll(ctx, courtIDs); err != nil {
+		return nil, fmt.Errorf("failed to lock courts: %w", err)
+	}
+
+	defer func() {
+		if err := s.locker.UnlockAll(ctx, courtIDs); err != nil {
+			log.Error().Err(err).Str("blackout_id", blackout.ID).Msg("failed to unlock courts")
+		}
+	}()
+
+	if err := store(); err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+
+	var affected []affectedReservation
+
+	for _, court := range courts {
+		reservations, err := s.reservationsRepo.ListByCourtAndTimeRange(ctx, court.ID, blackout.From, blackout.To)
+		if err != nil {
+			return nil, fmt.Errorf("list reservations by court and time range: %w", err)
+		}
+
+		for _, rsv := range reservations {
+			if rsv.IsActiveAt(now) {
+				affected = append(affected, affectedReservation{court: court, reservation: rsv})
+			}
+		}
+	}
+
+	return affected, nil
+}
+
+// handleAffected cancels the reservations the blackout overlaps when asked to. A reservation that fails to
+// cancel is logged and returned as it was, so staff can deal with it.
+func (s *Service) handleAffected(
+	ctx context.Context,
+	blackout entities.Blackout,
+	affected []affectedReservation,
+	actor entities.Actor,
+	cancelAffected bool,
+) ([]entities.Reservation, error) {
+	if len(affected) == 0 {
+		return nil, nil
+	}
+
+	reservations := make([]entities.Reservation, 0, len(affected))
+
+	if !cancelAffected {
+		for _, a := range affected {
+			reservations = append(reservations, a.reservation)
+		}
+		return reservations, nil
+	}
+
+	// players are texted the time of their booking in the time zone of the club
+	loc, err := s.scheduler.OrganizationLocation(ctx, blackout.OrganizationID)
+	if err != nil {
+		log.Error().Err(err).Str("organization_id", blackout.OrganizationID).Msg("failed to get time zone")
+		loc = time.UTC
+	}
+
+	for _, a := range affected {
+		reservations = append(reservations, s.cancel(ctx, blackout, a.court, a.reservation, actor, loc))
+	}
+
+	return reservations, nil
+}
+
+func (s *Service) cancel(
+	ctx context.Context,
+	blackout entities.Blackout,
+	court entities.Court,
+	rsv entities.Reservation,
+	actor entities.Actor,
+	loc *time.Location,
+) entities.Reservation {
+	cancelled, err := s.canceller.CancelReservation(ctx, blackout.OrganizationID, court.ID, rsv.ID, actor, true)
+	if err != nil {
+		log.Error().Err(err).
+			Str("blackout_id", blackout.ID).
+			Str("reservation_id", rsv.ID).
+			Msg("failed to cancel reservation overlapping a blackout")
+		return rsv
+	}
+
+	s.notify(ctx, blackout, court, *cancelled, loc)
+
+	return *cancelled
+}
+
+// notify texts the booker and the players who accepted to play that the reservation was cancelled, and what
+// was refunded when anything had been paid. Players without a phone number are skipped and failures are only
+// logged, the cancellation stands either way.
+func (s *Service) notify(
+	ctx context.Context,
+	blackout entities.Blackout,
+	court entities.Court,
+	rsv entities.Reservation,
+	loc *time.Location,
+) {
+	players, err := s.reservationsRepo.ListPlayers(ctx, rsv.ID)
+	if err != nil {
+		log.Error().Err(err).Str("reservation_id", rsv.ID).Msg("failed to list players to notify")
+	}
+
+	recipients := []string{rsv.ReservedBy}
+	for _, player := range players {
+		if player.Status == entities.AcceptedPlayerStatus && player.UserID != rsv.ReservedBy {
+			recipients = append(recipients, player.UserID)
+		}
+	}
+
+	message := fmt.Sprintf(
+		"Your booking on %s on %s was cancelled: %s.",
+		court.Name,
+		rsv.ReservedFrom.In(loc).Format("Mon 2 Jan 15:04"),
+		blackout.Reason,
+	)
+
+	if refunded := s.refunded(ctx, rsv.ID); refunded > 0 {
+		message += fmt.Sprintf(" %d.%02d %s is refunded.", refunded/100, refunded%100, rsv.Price.Currency)
+	}
+
+	for _, userID := range recipients {
+		user, err := s.usersRepo.GetByID(ctx, userID)
+		if err != nil {
+			log.Error().Err(err).Str("user_id", userID).Msg("failed to get user to notify")
+			continue
+		}
+
+		if user.PhoneNumber == "" {
+			continue
+		}
+
+		if err := s.smsSender.Send(ctx, user.PhoneNumber, message); err != nil {
+			log.Error().Err(err).Str("user_id", userID).Msg("failed to notify about cancelled reservation")
+		}
+	}
+}
+
+// refunded sums what the cancellation gave back on the payments of the reservation. A failure to tell is only
+// logged, the players are then texted without the amount.
+func (s *Service) refunded(ctx context.Context, reservationID string) int64 {
+	payments, err := s.paymentsRepo.ListByReservationID(ctx, reservationID)
+	if err != nil {
+		log.Error().Err(err).Str("reservation_id", reservationID).Msg("failed to list refunded payments")
+		return 0
+	}
+
+	var refunded int64
+	for _, payment := range payments {
+		refunded += payment.RefundedAmount
+	}
+
+	return refunded
+}
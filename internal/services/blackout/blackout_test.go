@@ -0,0 +1,321 @@
+package blackout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/blackout"
+	"github.com/lever-dev/padel-backend/internal/services/blackout/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	blackoutsRepo    *mocks.MockBlackoutsRepository
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	paymentsRepo     *mocks.MockPaymentsRepository
+	usersRepo        *mocks.MockUsersRepository
+	canceller        *mocks.MockCanceller
+	scheduler        *mocks.MockScheduler
+	locker           *mocks.MockLocker
+	smsSender        *mocks.MockSMSSender
+	service          *blackout.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+var blackoutNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+var manager = entities.Actor{UserID: "manager-1", Role: entities.ManagerRole}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.blackoutsRepo = mocks.NewMockBlackoutsRepository(s.ctrl)
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.paymentsRepo = mocks.NewMockPaymentsRepository(s.ctrl)
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+	s.canceller = mocks.NewMockCanceller(s.ctrl)
+	s.scheduler = mocks.NewMockScheduler(s.ctrl)
+	s.locker = mocks.NewMockLocker(s.ctrl)
+	s.smsSender = mocks.NewMockSMSSender(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(blackoutNow).AnyTimes()
+
+	s.service = blackout.NewService(
+		s.blackoutsRepo,
+		s.reservationsRepo,
+		s.courtsRepo,
+		s.paymentsRepo,
+		s.usersRepo,
+		s.canceller,
+		s.scheduler,
+		s.locker,
+		s.smsSender,
+		clock,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// expectLocks expects the courts to be locked together and unlocked again.
+func (s *ServiceSuite) expectLocks(courtIDs ...string) {
+	s.locker.EXPECT().LockAll(gomock.Any(), courtIDs).Return(nil)
+	s.locker.EXPECT().UnlockAll(gomock.Any(), courtIDs).Return(nil)
+}
+
+func court(id string) entities.Court {
+	return entities.Court{ID: id, OrganizationID: "org-1", Name: "Court " + id}
+}
+
+func newBlackout(courtID string) *entities.Blackout {
+	return entities.NewBlackout(
+		"org-1",
+		courtID,
+		blackoutNow.Add(24*time.Hour),
+		blackoutNow.Add(28*time.Hour),
+		"resurfacing",
+		"manager-1",
+		blackoutNow,
+	)
+}
+
+func booked(id, courtID string) entities.Reservation {
+	return entities.Reservation{
+		ID:           id,
+		CourtID:      courtID,
+		ReservedBy:   "user-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: blackoutNow.Add(25 * time.Hour),
+		ReservedTo:   blackoutNow.Add(26 * time.Hour),
+	}
+}
+
+func (s *ServiceSuite) TestCreateBlackout() {
+	ctx := context.Background()
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	s.Require().NoError(err)
+
+	s.Run("returns the reservations it overlaps", func() {
+		b := newBlackout("court-1")
+		c := court("court-1")
+
+		cancelled := booked("res-2", "court-1")
+		cancelled.Status = entities.CancelledReservationStatus
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&c, nil)
+		s.expectLocks("court-1")
+		s.blackoutsRepo.EXPECT().CreateBlackout(ctx, b).Return(nil)
+		s.reservationsRepo.EXPECT().
+			ListByCourtAndTimeRange(ctx, "court-1", b.From, b.To).
+			Return([]entities.Reservation{booked("res-1", "court-1"), cancelled}, nil)
+
+		affected, err := s.service.CreateBlackout(ctx, b, manager, false)
+		s.Require().NoError(err)
+		s.Equal([]entities.Reservation{booked("res-1", "court-1")}, affected)
+	})
+
+	s.Run("cancels the reservations on every court and notifies the players", func() {
+		b := newBlackout("")
+
+		cancelled := booked("res-1", "court-2")
+		cancelled.Status = entities.CancelledReservationStatus
+		cancelled.Price = entities.Price{Amount: 3000, Currency: "EUR"}
+
+		s.courtsRepo.EXPECT().
+			ListByOrganizationID(ctx, "org-1").
+			Return([]entities.Court{court("court-2"), court("court-1")}, nil)
+		gomock.InOrder(
+			s.locker.EXPECT().LockAll(ctx, []string{"court-2", "court-1"}).Return(nil),
+			s.blackoutsRepo.EXPECT().CreateBlackout(ctx, b).Return(nil),
+			s.reservationsRepo.EXPECT().
+				ListByCourtAndTimeRange(ctx, "court-2", b.From, b.To).
+				Return([]entities.Reservation{booked("res-1", "court-2")}, nil),
+			s.reservationsRepo.EXPECT().ListByCourtAndTimeRange(ctx, "court-1", b.From, b.To).Return(nil, nil),
+			s.locker.EXPECT().UnlockAll(ctx, []string{"court-2", "court-1"}).Return(nil),
+			s.canceller.EXPECT().
+				CancelReservation(ctx, "org-1", "court-2", "res-1", manager, true).
+				Return(&cancelled, nil),
+		)
+		s.scheduler.EXPECT().OrganizationLocation(ctx, "org-1").Return(madrid, nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return([]entities.Payment{
+			{ID: "pay-1", Status: entities.RefundedPaymentStatus, Amount: 1500, RefundedAmount: 1500},
+			{ID: "pay-2", Status: entities.RefundedPaymentStatus, Amount: 1500, RefundedAmount: 1500},
+			{ID: "pay-3", Status: entities.FailedPaymentStatus, Amount: 1500},
+		}, nil)
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return([]entities.ReservationPlayer{
+			{ReservationID: "res-1", UserID: "user-2", Status: entities.AcceptedPlayerStatus},
+			{ReservationID: "res-1", UserID: "user-3", Status: entities.InvitedPlayerStatus},
+			{ReservationID: "res-1", UserID: "user-4", Status: entities.AcceptedPlayerStatus},
+		}, nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-1").Return(&entities.User{ID: "user-1", PhoneNumber: "+34600000001"}, nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-2").Return(&entities.User{ID: "user-2", PhoneNumber: "+34600000002"}, nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-4").Return(&entities.User{ID: "user-4"}, nil)
+
+		// the booking starts at 13:00 UTC, 14:00 in Madrid
+		message := "Your booking on Court court-2 on Tue 4 Nov 14:00 was cancelled: resurfacing. 30.00 EUR is refunded."
+		s.smsSender.EXPECT().Send(ctx, "+34600000001", message).Return(nil)
+		s.smsSender.EXPECT().Send(ctx, "+34600000002", message).Return(errors.New("gateway down"))
+
+		affected, err := s.service.CreateBlackout(ctx, b, manager, true)
+		s.Require().NoError(err)
+		s.Equal([]entities.Reservation{cancelled}, affected)
+	})
+
+	s.Run("does not mention a refund when nothing was paid", func() {
+		b := newBlackout("court-1")
+		c := court("court-1")
+
+		cancelled := booked("res-1", "court-1")
+		cancelled.Status = entities.CancelledReservationStatus
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&c, nil)
+		s.expectLocks("court-1")
+		s.blackoutsRepo.EXPECT().CreateBlackout(ctx, b).Return(nil)
+		s.reservationsRepo.EXPECT().
+			ListByCourtAndTimeRange(ctx, "court-1", b.From, b.To).
+			Return([]entities.Reservation{booked("res-1", "court-1")}, nil)
+		s.scheduler.EXPECT().OrganizationLocation(ctx, "org-1").Return(time.UTC, nil)
+		s.canceller.EXPECT().
+			CancelReservation(ctx, "org-1", "court-1", "res-1", manager, true).
+			Return(&cancelled, nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return([]entities.Payment{
+			{ID: "pay-1", Status: entities.PendingPaymentStatus, Amount: 3000},
+		}, nil)
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return(nil, nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-1").Return(&entities.User{ID: "user-1", PhoneNumber: "+34600000001"}, nil)
+		s.smsSender.EXPECT().
+			Send(ctx, "+34600000001", "Your booking on Court court-1 on Tue 4 Nov 13:00 was cancelled: resurfacing.").
+			Return(nil)
+
+		affected, err := s.service.CreateBlackout(ctx, b, manager, true)
+		s.Require().NoError(err)
+		s.Equal([]entities.Reservation{cancelled}, affected)
+	})
+
+	s.Run("courts that can't be locked are not blacked out", func() {
+		b := newBlackout("")
+
+		s.courtsRepo.EXPECT().
+			ListByOrganizationID(ctx, "org-1").
+			Return([]entities.Court{court("court-1"), court("court-2")}, nil)
+		s.locker.EXPECT().LockAll(ctx, []string{"court-1", "court-2"}).Return(context.DeadlineExceeded)
+
+		_, err := s.service.CreateBlackout(ctx, b, manager, false)
+		s.ErrorIs(err, context.DeadlineExceeded)
+	})
+
+	s.Run("a reservation that fails to cancel is returned as it was", func() {
+		b := newBlackout("court-1")
+		c := court("court-1")
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&c, nil)
+		s.expectLocks("court-1")
+		s.blackoutsRepo.EXPECT().CreateBlackout(ctx, b).Return(nil)
+		s.scheduler.EXPECT().OrganizationLocation(ctx, "org-1").Return(nil, errors.New("db down"))
+		s.reservationsRepo.EXPECT().
+			ListByCourtAndTimeRange(ctx, "court-1", b.From, b.To).
+			Return([]entities.Reservation{booked("res-1", "court-1")}, nil)
+		s.canceller.EXPECT().
+			CancelReservation(ctx, "org-1", "court-1", "res-1", manager, true).
+			Return(nil, errors.New("refund failed"))
+
+		affected, err := s.service.CreateBlackout(ctx, b, manager, true)
+		s.Require().NoError(err)
+		s.Equal([]entities.Reservation{booked("res-1", "court-1")}, affected)
+	})
+
+	s.Run("court of another organization", func() {
+		c := court("court-9")
+		c.OrganizationID = "org-9"
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-9").Return(&c, nil)
+
+		_, err := s.service.CreateBlackout(ctx, newBlackout("court-9"), manager, false)
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("blackout without a reason", func() {
+		b := newBlackout("court-1")
+		b.Reason = ""
+
+		_, err := s.service.CreateBlackout(ctx, b, manager, false)
+		s.ErrorIs(err, entities.ErrInvalidBlackout)
+	})
+}
+
+func (s *ServiceSuite) TestUpdateBlackout() {
+	ctx := context.Background()
+
+	s.Run("keeps who created it and when", func() {
+		existing := newBlackout("court-1")
+		existing.CreatedAt = blackoutNow.Add(-time.Hour)
+
+		b := &entities.Blackout{
+			ID:             existing.ID,
+			OrganizationID: "org-1",
+			CourtID:        "court-2",
+			From:           existing.From,
+			To:             existing.To.Add(time.Hour),
+			Reason:         "tournament",
+		}
+		c := court("court-2")
+
+		s.blackoutsRepo.EXPECT().GetBlackout(ctx, existing.ID).Return(existing, nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-2").Return(&c, nil)
+		s.expectLocks("court-2")
+		s.blackoutsRepo.EXPECT().UpdateBlackout(ctx, b).Return(nil)
+		s.reservationsRepo.EXPECT().ListByCourtAndTimeRange(ctx, "court-2", b.From, b.To).Return(nil, nil)
+
+		affected, err := s.service.UpdateBlackout(ctx, b, manager, true)
+		s.Require().NoError(err)
+		s.Empty(affected)
+		s.Equal("manager-1", b.CreatedBy)
+		s.Equal(blackoutNow.Add(-time.Hour), b.CreatedAt)
+		s.Equal(blackoutNow, b.UpdatedAt)
+	})
+
+	s.Run("blackout of another organization", func() {
+		existing := newBlackout("court-1")
+		existing.OrganizationID = "org-9"
+
+		s.blackoutsRepo.EXPECT().GetBlackout(ctx, existing.ID).Return(existing, nil)
+
+		_, err := s.service.UpdateBlackout(ctx, &entities.Blackout{ID: existing.ID, OrganizationID: "org-1"}, manager, false)
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+}
+
+func (s *ServiceSuite) TestDeleteBlackout() {
+	ctx := context.Background()
+
+	s.Run("deletes the blackout", func() {
+		b := newBlackout("court-1")
+
+		s.blackoutsRepo.EXPECT().GetBlackout(ctx, b.ID).Return(b, nil)
+		s.blackoutsRepo.EXPECT().DeleteBlackout(ctx, b.ID).Return(nil)
+
+		s.NoError(s.service.DeleteBlackout(ctx, "org-1", b.ID))
+	})
+
+	s.Run("blackout of another organization", func() {
+		b := newBlackout("court-1")
+
+		s.blackoutsRepo.EXPECT().GetBlackout(ctx, b.ID).Return(b, nil)
+
+		s.ErrorIs(s.service.DeleteBlackout(ctx, "org-9", b.ID), entities.ErrNotFound)
+	})
+}
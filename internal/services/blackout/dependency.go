@@ -0,0 +1,65 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package blackout
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type BlackoutsRepository interface {
+	CreateBlackout(ctx context.Context, blackout *entities.Blackout) error
+	GetBlackout(ctx context.Context, blackoutID string) (*entities.Blackout, error)
+	UpdateBlackout(ctx context.Context, blackout *entities.Blackout) error
+	DeleteBlackout(ctx context.Context, blackoutID string) error
+	ListBlackouts(ctx context.Context, organizationID string, from, to time.Time) ([]entities.Blackout, error)
+}
+
+type ReservationsRepository interface {
+	ListByCourtAndTimeRange(ctx context.Context, courtID string, from, to time.Time) ([]entities.Reservation, error)
+	ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+	ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error)
+}
+
+// PaymentsRepository tells players what a cancellation refunded them.
+type PaymentsRepository interface {
+	ListByReservationID(ctx context.Context, reservationID string) ([]entities.Payment, error)
+}
+
+type UsersRepository interface {
+	GetByID(ctx context.Context, userID string) (*entities.User, error)
+}
+
+// Canceller cancels a reservation on behalf of the actor, who waives the cancellation policy when asked to.
+type Canceller interface {
+	CancelReservation(
+		ctx context.Context,
+		organizationID, courtID, reservationID string,
+		actor entities.Actor,
+		waivePolicy bool,
+	) (*entities.Reservation, error)
+}
+
+// Scheduler resolves the time zone blackout times are given and notified in.
+type Scheduler interface {
+	OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error)
+}
+
+// Locker is the per-court lock reservations are booked under. A blackout holds it while it closes the courts,
+// so a booking can't pass the blackout check before the blackout is stored and land after it was looked at.
+// The courts are taken together, so a club-wide blackout doesn't hold a pooled connection per court.
+type Locker interface {
+	LockAll(ctx context.Context, keys []string) error
+	UnlockAll(ctx context.Context, keys []string) error
+}
+
+// SMSSender notifies the players whose bookings a blackout cancelled.
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
@@ -0,0 +1,455 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/blackout/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockBlackoutsRepository is a mock of BlackoutsRepository interface.
+type MockBlackoutsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlackoutsRepositoryMockRecorder
+}
+
+// MockBlackoutsRepositoryMockRecorder is the mock recorder for MockBlackoutsRepository.
+type MockBlackoutsRepositoryMockRecorder struct {
+	mock *MockBlackoutsRepository
+}
+
+// NewMockBlackoutsRepository creates a new mock instance.
+func NewMockBlackoutsRepository(ctrl *gomock.Controller) *MockBlackoutsRepository {
+	mock := &MockBlackoutsRepository{ctrl: ctrl}
+	mock.recorder = &MockBlackoutsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlackoutsRepository) EXPECT() *MockBlackoutsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBlackout mocks base method.
+func (m *MockBlackoutsRepository) CreateBlackout(ctx context.Context, blackout *entities.Blackout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlackout", ctx, blackout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBlackout indicates an expected call of CreateBlackout.
+func (mr *MockBlackoutsRepositoryMockRecorder) CreateBlackout(ctx, blackout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlackout", reflect.TypeOf((*MockBlackoutsRepository)(nil).CreateBlackout), ctx, blackout)
+}
+
+// DeleteBlackout mocks base method.
+func (m *MockBlackoutsRepository) DeleteBlackout(ctx context.Context, blackoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlackout", ctx, blackoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlackout indicates an expected call of DeleteBlackout.
+func (mr *MockBlackoutsRepositoryMockRecorder) DeleteBlackout(ctx, blackoutID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlackout", reflect.TypeOf((*MockBlackoutsRepository)(nil).DeleteBlackout), ctx, blackoutID)
+}
+
+// GetBlackout mocks base method.
+func (m *MockBlackoutsRepository) GetBlackout(ctx context.Context, blackoutID string) (*entities.Blackout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlackout", ctx, blackoutID)
+	ret0, _ := ret[0].(*entities.Blackout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlackout indicates an expected call of GetBlackout.
+func (mr *MockBlackoutsRepositoryMockRecorder) GetBlackout(ctx, blackoutID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlackout", reflect.TypeOf((*MockBlackoutsRepository)(nil).GetBlackout), ctx, blackoutID)
+}
+
+// ListBlackouts mocks base method.
+func (m *MockBlackoutsRepository) ListBlackouts(ctx context.Context, organizationID string, from, to time.Time) ([]entities.Blackout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlackouts", ctx, organizationID, from, to)
+	ret0, _ := ret[0].([]entities.Blackout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlackouts indicates an expected call of ListBlackouts.
+func (mr *MockBlackoutsRepositoryMockRecorder) ListBlackouts(ctx, organizationID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlackouts", reflect.TypeOf((*MockBlackoutsRepository)(nil).ListBlackouts), ctx, organizationID, from, to)
+}
+
+// UpdateBlackout mocks base method.
+func (m *MockBlackoutsRepository) UpdateBlackout(ctx context.Context, blackout *entities.Blackout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBlackout", ctx, blackout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBlackout indicates an expected call of UpdateBlackout.
+func (mr *MockBlackoutsRepositoryMockRecorder) UpdateBlackout(ctx, blackout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlackout", reflect.TypeOf((*MockBlackoutsRepository)(nil).UpdateBlackout), ctx, blackout)
+}
+
+// MockReservationsRepository is a mock of ReservationsRepository interface.
+type MockReservationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsRepositoryMockRecorder
+}
+
+// MockReservationsRepositoryMockRecorder is the mock recorder for MockReservationsRepository.
+type MockReservationsRepositoryMockRecorder struct {
+	mock *MockReservationsRepository
+}
+
+// NewMockReservationsRepository creates a new mock instance.
+func NewMockReservationsRepository(ctrl *gomock.Controller) *MockReservationsRepository {
+	mock := &MockReservationsRepository{ctrl: ctrl}
+	mock.recorder = &MockReservationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsRepository) EXPECT() *MockReservationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByCourtAndTimeRange mocks base method.
+func (m *MockReservationsRepository) ListByCourtAndTimeRange(ctx context.Context, courtID string, from, to time.Time) ([]entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCourtAndTimeRange", ctx, courtID, from, to)
+	ret0, _ := ret[0].([]entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCourtAndTimeRange indicates an expected call of ListByCourtAndTimeRange.
+func (mr *MockReservationsRepositoryMockRecorder) ListByCourtAndTimeRange(ctx, courtID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCourtAndTimeRange", reflect.TypeOf((*MockReservationsRepository)(nil).ListByCourtAndTimeRange), ctx, courtID, from, to)
+}
+
+// ListPlayers mocks base method.
+func (m *MockReservationsRepository) ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlayers", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.ReservationPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlayers indicates an expected call of ListPlayers.
+func (mr *MockReservationsRepositoryMockRecorder) ListPlayers(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayers", reflect.TypeOf((*MockReservationsRepository)(nil).ListPlayers), ctx, reservationID)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// ListByOrganizationID mocks base method.
+func (m *MockCourtsRepository) ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrganizationID indicates an expected call of ListByOrganizationID.
+func (mr *MockCourtsRepositoryMockRecorder) ListByOrganizationID(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrganizationID", reflect.TypeOf((*MockCourtsRepository)(nil).ListByOrganizationID), ctx, organizationID)
+}
+
+// MockPaymentsRepository is a mock of PaymentsRepository interface.
+type MockPaymentsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentsRepositoryMockRecorder
+}
+
+// MockPaymentsRepositoryMockRecorder is the mock recorder for MockPaymentsRepository.
+type MockPaymentsRepositoryMockRecorder struct {
+	mock *MockPaymentsRepository
+}
+
+// NewMockPaymentsRepository creates a new mock instance.
+func NewMockPaymentsRepository(ctrl *gomock.Controller) *MockPaymentsRepository {
+	mock := &MockPaymentsRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentsRepository) EXPECT() *MockPaymentsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByReservationID mocks base method.
+func (m *MockPaymentsRepository) ListByReservationID(ctx context.Context, reservationID string) ([]entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByReservationID indicates an expected call of ListByReservationID.
+func (mr *MockPaymentsRepositoryMockRecorder) ListByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReservationID", reflect.TypeOf((*MockPaymentsRepository)(nil).ListByReservationID), ctx, reservationID)
+}
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockUsersRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUsersRepositoryMockRecorder) GetByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsersRepository)(nil).GetByID), ctx, userID)
+}
+
+// MockCanceller is a mock of Canceller interface.
+type MockCanceller struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancellerMockRecorder
+}
+
+// MockCancellerMockRecorder is the mock recorder for MockCanceller.
+type MockCancellerMockRecorder struct {
+	mock *MockCanceller
+}
+
+// NewMockCanceller creates a new mock instance.
+func NewMockCanceller(ctrl *gomock.Controller) *MockCanceller {
+	mock := &MockCanceller{ctrl: ctrl}
+	mock.recorder = &MockCancellerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCanceller) EXPECT() *MockCancellerMockRecorder {
+	return m.recorder
+}
+
+// CancelReservation mocks base method.
+func (m *MockCanceller) CancelReservation(ctx context.Context, organizationID, courtID, reservationID string, actor entities.Actor, waivePolicy bool) (*entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReservation", ctx, organizationID, courtID, reservationID, actor, waivePolicy)
+	ret0, _ := ret[0].(*entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReservation indicates an expected call of CancelReservation.
+func (mr *MockCancellerMockRecorder) CancelReservation(ctx, organizationID, courtID, reservationID, actor, waivePolicy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockCanceller)(nil).CancelReservation), ctx, organizationID, courtID, reservationID, actor, waivePolicy)
+}
+
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// OrganizationLocation mocks base method.
+func (m *MockScheduler) OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrganizationLocation", ctx, organizationID)
+	ret0, _ := ret[0].(*time.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrganizationLocation indicates an expected call of OrganizationLocation.
+func (mr *MockSchedulerMockRecorder) OrganizationLocation(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrganizationLocation", reflect.TypeOf((*MockScheduler)(nil).OrganizationLocation), ctx, organizationID)
+}
+
+// MockLocker is a mock of Locker interface.
+type MockLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockerMockRecorder
+}
+
+// MockLockerMockRecorder is the mock recorder for MockLocker.
+type MockLockerMockRecorder struct {
+	mock *MockLocker
+}
+
+// NewMockLocker creates a new mock instance.
+func NewMockLocker(ctrl *gomock.Controller) *MockLocker {
+	mock := &MockLocker{ctrl: ctrl}
+	mock.recorder = &MockLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocker) EXPECT() *MockLockerMockRecorder {
+	return m.recorder
+}
+
+// LockAll mocks base method.
+func (m *MockLocker) LockAll(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAll", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAll indicates an expected call of LockAll.
+func (mr *MockLockerMockRecorder) LockAll(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAll", reflect.TypeOf((*MockLocker)(nil).LockAll), ctx, keys)
+}
+
+// UnlockAll mocks base method.
+func (m *MockLocker) UnlockAll(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAll", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAll indicates an expected call of UnlockAll.
+func (mr *MockLockerMockRecorder) UnlockAll(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAll", reflect.TypeOf((*MockLocker)(nil).UnlockAll), ctx, keys)
+}
+
+// MockSMSSender is a mock of SMSSender interface.
+type MockSMSSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSSenderMockRecorder
+}
+
+// MockSMSSenderMockRecorder is the mock recorder for MockSMSSender.
+type MockSMSSenderMockRecorder struct {
+	mock *MockSMSSender
+}
+
+// NewMockSMSSender creates a new mock instance.
+func NewMockSMSSender(ctrl *gomock.Controller) *MockSMSSender {
+	mock := &MockSMSSender{ctrl: ctrl}
+	mock.recorder = &MockSMSSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSSender) EXPECT() *MockSMSSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSMSSender) Send(ctx context.Context, phoneNumber, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, phoneNumber, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSMSSenderMockRecorder) Send(ctx, phoneNumber, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSMSSender)(nil).Send), ctx, phoneNumber, message)
+}
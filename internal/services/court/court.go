@@ -4,21 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/clock"
 )
 
 type Service struct {
-	courtsRepo CourtsRepository
+	courtsRepo        CourtsRepository
+	organizationsRepo OrganizationsRepository
+	clock             clock.Clock
 }
 
-func NewService(repo CourtsRepository) *Service {
+func NewService(repo CourtsRepository, organizationsRepo OrganizationsRepository, clock clock.Clock) *Service {
 	return &Service{
-		courtsRepo: repo,
+		courtsRepo:        repo,
+		organizationsRepo: organizationsRepo,
+		clock:             clock,
 	}
 }
 
 func (s *Service) Create(ctx context.Context, court *entities.Court) error {
+	if err := entities.ValidateOpeningHours(court.OpeningHours); err != nil {
+		return err
+	}
+
+	if err := court.CourtAttributes.Validate(); err != nil {
+		return err
+	}
+
 	if err := s.courtsRepo.Create(ctx, court); err != nil {
 		return fmt.Errorf("create court: %w", err)
 	}
@@ -42,14 +56,123 @@ func (s *Service) GetByID(ctx context.Context, organizationID, courtID string) (
 	return court, nil
 }
 
-func (s *Service) ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error) {
-	courts, err := s.courtsRepo.ListByOrganizationID(ctx, organizationID)
+// ListByOrganizationID lists the courts of the organization that match the filter.
+func (s *Service) ListByOrganizationID(
+	ctx context.Context,
+	organizationID string,
+	filter entities.CourtFilter,
+) ([]entities.Court, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	courts, err := s.courtsRepo.Search(ctx, []string{organizationID}, filter)
 	if err != nil {
 		return nil, fmt.Errorf("list courts by organization id: %w", err)
 	}
 	return courts, nil
 }
 
+// SearchCourts finds the courts that match the filter at the organizations of the city, grouped by
+// organization. Organizations without a matching court are left out.
+func (s *Service) SearchCourts(
+	ctx context.Context,
+	city string,
+	filter entities.CourtFilter,
+) ([]entities.OrganizationCourts, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	orgs, err := s.organizationsRepo.GetOrganizationsByCity(ctx, city)
+	if err != nil {
+		return nil, fmt.Errorf("get organizations by city: %w", err)
+	}
+
+	if len(orgs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(orgs))
+	for _, org := range orgs {
+		ids = append(ids, org.ID)
+	}
+
+	courts, err := s.courtsRepo.Search(ctx, ids, filter)
+	if err != nil {
+		return nil, fmt.Errorf("search courts: %w", err)
+	}
+
+	byOrganization := make(map[string][]entities.Court, len(orgs))
+	for _, court := range courts {
+		byOrganization[court.OrganizationID] = append(byOrganization[court.OrganizationID], court)
+	}
+
+	var result []entities.OrganizationCourts
+	for _, org := range orgs {
+		if matched := byOrganization[org.ID]; len(matched) > 0 {
+			result = append(result, entities.OrganizationCourts{Organization: org, Courts: matched})
+		}
+	}
+
+	return result, nil
+}
+
+// SearchAvailability finds the courts at the organizations of the city that are free to book for the duration
+// of the search from a start on their slot grid within its window, the earliest start first. Starts outside
+// the opening hours of a court or refused by its booking rules are left out. When the search is made near a
+// point, courts with the same start are ranked by their distance from it and the courts of organizations
+// without coordinates come last. The page holds at most the limit of the search and points to the next one
+// when more courts are free.
+func (s *Service) SearchAvailability(
+	ctx context.Context,
+	search entities.AvailabilitySearch,
+) (entities.FreeCourtPage, error) {
+	if err := search.Validate(); err != nil {
+		return entities.FreeCourtPage{}, err
+	}
+
+	orgs, err := s.organizationsRepo.GetOrganizationsByCity(ctx, search.City)
+	if err != nil {
+		return entities.FreeCourtPage{}, fmt.Errorf("get organizations by city: %w", err)
+	}
+
+	if len(orgs) == 0 {
+		return entities.FreeCourtPage{}, nil
+	}
+
+	ids := make([]string, 0, len(orgs))
+	byID := make(map[string]entities.Organization, len(orgs))
+	for _, org := range orgs {
+		ids = append(ids, org.ID)
+		byID[org.ID] = org
+	}
+
+	// one court more than the page tells whether there is a next page
+	probe := search
+	probe.Limit++
+
+	free, err := s.courtsRepo.SearchFree(ctx, ids, probe, s.clock.Now())
+	if err != nil {
+		return entities.FreeCourtPage{}, fmt.Errorf("search free courts: %w", err)
+	}
+
+	var page entities.FreeCourtPage
+	if len(free) > search.Limit {
+		free = free[:search.Limit]
+		next := free[len(free)-1].Cursor()
+		page.Next = &next
+	}
+
+	page.Courts = make([]entities.FreeCourt, 0, len(free))
+	for _, f := range free {
+		f.Organization = byID[f.Court.OrganizationID]
+		page.Courts = append(page.Courts, f)
+	}
+
+	return page, nil
+}
+
 func (s *Service) Update(ctx context.Context, court *entities.Court) error {
 	if err := s.courtsRepo.Update(ctx, court); err != nil {
 		if errors.Is(err, entities.ErrNotFound) {
@@ -60,25 +183,109 @@ func (s *Service) Update(ctx context.Context, court *entities.Court) error {
 	return nil
 }
 
-func (s *Service) UpdateName(
+// UpdateDetails renames the court, changes the size of its rosters and its attributes. Zero values in details
+// keep the current ones.
+func (s *Service) UpdateDetails(
+	ctx context.Context,
+	organizationID string,
+	courtID string,
+	details entities.CourtDetails,
+) (*entities.Court, error) {
+	court, err := s.GetByID(ctx, organizationID, courtID)
+	if err != nil {
+		return nil, err
+	}
+
+	details.Apply(court)
+
+	if err := court.CourtAttributes.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.courtsRepo.UpdateDetails(ctx, court); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("update court details: %w", err)
+	}
+
+	return court, nil
+}
+
+func (s *Service) UpdateOpeningHours(
 	ctx context.Context,
 	organizationID string,
 	courtID string,
-	name string,
+	hours []entities.OpeningHours,
+	slotDuration time.Duration,
 ) (*entities.Court, error) {
+	if err := entities.ValidateOpeningHours(hours); err != nil {
+		return nil, err
+	}
+
 	court, err := s.GetByID(ctx, organizationID, courtID)
 	if err != nil {
 		return nil, err
 	}
 
-	court.Name = name
+	court.OpeningHours = hours
+	if slotDuration > 0 {
+		court.SlotDuration = slotDuration
+	}
 
-	if err := s.courtsRepo.UpdateName(ctx, court); err != nil {
+	if err := s.courtsRepo.UpdateOpeningHours(ctx, court); err != nil {
 		if errors.Is(err, entities.ErrNotFound) {
 			return nil, entities.ErrNotFound
 		}
-		return nil, fmt.Errorf("update court name: %w", err)
+		return nil, fmt.Errorf("update court opening hours: %w", err)
 	}
 
 	return court, nil
 }
+
+// CourtSchedule returns when the court is open, in the time zone of its organization.
+func (s *Service) CourtSchedule(ctx context.Context, courtID string) (*entities.Schedule, error) {
+	court, err := s.courtsRepo.GetByID(ctx, courtID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	org, err := s.organizationsRepo.GetByID(ctx, court.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get organization: %w", err)
+	}
+
+	schedule := entities.NewSchedule(*org, *court)
+
+	return &schedule, nil
+}
+
+// OrganizationLocation returns the time zone of the organization.
+func (s *Service) OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error) {
+	org, err := s.organizationsRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("get organization: %w", err)
+	}
+
+	return org.Location(), nil
+}
+
+// CityLocation returns the time zone of the organizations of the city, UTC when the city has none.
+func (s *Service) CityLocation(ctx context.Context, city string) (*time.Location, error) {
+	orgs, err := s.organizationsRepo.GetOrganizationsByCity(ctx, city)
+	if err != nil {
+		return nil, fmt.Errorf("get organizations by city: %w", err)
+	}
+
+	if len(orgs) == 0 {
+		return time.UTC, nil
+	}
+
+	return orgs[0].Location(), nil
+}
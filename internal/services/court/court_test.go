@@ -11,6 +11,8 @@ import (
 	"github.com/lever-dev/padel-backend/internal/entities"
 	"github.com/lever-dev/padel-backend/internal/services/court"
 	"github.com/lever-dev/padel-backend/internal/services/court/mocks"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -75,7 +77,7 @@ func (s *ServiceSuite) TestCreate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockCourtsRepository(s.ctrl)
-			service := court.NewService(mockRepo)
+			service := court.NewService(mockRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
 
 			tt.setupMocks(mockRepo)
 
@@ -171,7 +173,7 @@ func (s *ServiceSuite) TestGetByID() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockCourtsRepository(s.ctrl)
-			service := court.NewService(mockRepo)
+			service := court.NewService(mockRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
 
 			tt.setupMocks(mockRepo)
 
@@ -209,7 +211,7 @@ func (s *ServiceSuite) TestListByOrganizationID() {
 			orgID: organizationID,
 			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {
 				mockRepo.EXPECT().
-					ListByOrganizationID(ctx, organizationID).
+					Search(ctx, []string{organizationID}, entities.CourtFilter{}).
 					Return([]entities.Court{
 						{
 							ID:             "court-1",
@@ -242,7 +244,7 @@ func (s *ServiceSuite) TestListByOrganizationID() {
 			orgID: organizationID,
 			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {
 				mockRepo.EXPECT().
-					ListByOrganizationID(ctx, organizationID).
+					Search(ctx, []string{organizationID}, entities.CourtFilter{}).
 					Return([]entities.Court{}, nil)
 			},
 			wantCourts: []entities.Court{},
@@ -253,7 +255,7 @@ func (s *ServiceSuite) TestListByOrganizationID() {
 			orgID: organizationID,
 			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {
 				mockRepo.EXPECT().
-					ListByOrganizationID(ctx, organizationID).
+					Search(ctx, []string{organizationID}, entities.CourtFilter{}).
 					Return(nil, fmt.Errorf("db error"))
 			},
 			wantCourts: nil,
@@ -264,11 +266,11 @@ func (s *ServiceSuite) TestListByOrganizationID() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockCourtsRepository(s.ctrl)
-			service := court.NewService(mockRepo)
+			service := court.NewService(mockRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
 
 			tt.setupMocks(mockRepo)
 
-			result, err := service.ListByOrganizationID(ctx, tt.orgID)
+			result, err := service.ListByOrganizationID(ctx, tt.orgID, entities.CourtFilter{})
 
 			if tt.wantErr {
 				s.Error(err)
@@ -344,7 +346,7 @@ func (s *ServiceSuite) TestUpdate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockCourtsRepository(s.ctrl)
-			service := court.NewService(mockRepo)
+			service := court.NewService(mockRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
 
 			tt.setupMocks(mockRepo)
 
@@ -363,7 +365,7 @@ func (s *ServiceSuite) TestUpdate() {
 		})
 	}
 }
-func (s *ServiceSuite) TestUpdateName() {
+func (s *ServiceSuite) TestUpdateDetails() {
 	ctx := context.Background()
 	orgID := "org-1"
 	courtID := "court-1"
@@ -397,7 +399,7 @@ func (s *ServiceSuite) TestUpdateName() {
 						Return(existing, nil),
 
 					mockRepo.EXPECT().
-						UpdateName(ctx, gomock.AssignableToTypeOf(&entities.Court{})).
+						UpdateDetails(ctx, gomock.AssignableToTypeOf(&entities.Court{})).
 						Return(nil),
 				)
 			},
@@ -422,7 +424,7 @@ func (s *ServiceSuite) TestUpdateName() {
 			wantErr:   entities.ErrNotFound,
 		},
 		{
-			name:    "repository error on UpdateName",
+			name:    "repository error on UpdateDetails",
 			orgID:   orgID,
 			courtID: courtID,
 			newName: newName,
@@ -440,7 +442,7 @@ func (s *ServiceSuite) TestUpdateName() {
 						Return(existing, nil),
 
 					mockRepo.EXPECT().
-						UpdateName(ctx, gomock.AssignableToTypeOf(&entities.Court{})).
+						UpdateDetails(ctx, gomock.AssignableToTypeOf(&entities.Court{})).
 						Return(fmt.Errorf("db error")),
 				)
 			},
@@ -452,11 +454,11 @@ func (s *ServiceSuite) TestUpdateName() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockCourtsRepository(s.ctrl)
-			service := court.NewService(mockRepo)
+			service := court.NewService(mockRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
 
 			tt.setupMocks(mockRepo)
 
-			result, err := service.UpdateName(ctx, tt.orgID, tt.courtID, tt.newName)
+			result, err := service.UpdateDetails(ctx, tt.orgID, tt.courtID, entities.CourtDetails{Name: tt.newName})
 
 			if tt.wantErr != nil {
 				s.Error(err)
@@ -464,8 +466,8 @@ func (s *ServiceSuite) TestUpdateName() {
 				if errors.Is(tt.wantErr, entities.ErrNotFound) {
 					s.ErrorIs(err, entities.ErrNotFound)
 				} else {
-					if tt.name == "repository error on UpdateName" {
-						s.Contains(err.Error(), "update court name")
+					if tt.name == "repository error on UpdateDetails" {
+						s.Contains(err.Error(), "update court details")
 					} else {
 						s.Contains(err.Error(), "get court by id")
 					}
@@ -482,3 +484,405 @@ func (s *ServiceSuite) TestUpdateName() {
 		})
 	}
 }
+
+func (s *ServiceSuite) TestUpdateOpeningHours() {
+	ctx := context.Background()
+	orgID := "org-1"
+	courtID := "court-1"
+
+	validHours := []entities.OpeningHours{
+		{Weekday: time.Monday, OpensAt: 8 * 60, ClosesAt: 22 * 60},
+	}
+
+	tests := []struct {
+		name         string
+		hours        []entities.OpeningHours
+		slotDuration time.Duration
+		setupMocks   func(mockRepo *mocks.MockCourtsRepository)
+		wantSlot     time.Duration
+		wantErr      error
+	}{
+		{
+			name:         "success",
+			hours:        validHours,
+			slotDuration: time.Hour,
+			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {
+				gomock.InOrder(
+					mockRepo.EXPECT().
+						GetByID(ctx, courtID).
+						Return(&entities.Court{
+							ID:             courtID,
+							OrganizationID: orgID,
+							SlotDuration:   entities.DefaultSlotDuration,
+						}, nil),
+					mockRepo.EXPECT().
+						UpdateOpeningHours(ctx, gomock.AssignableToTypeOf(&entities.Court{})).
+						Return(nil),
+				)
+			},
+			wantSlot: time.Hour,
+		},
+		{
+			name:  "keeps slot duration when not provided",
+			hours: validHours,
+			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {
+				mockRepo.EXPECT().
+					GetByID(ctx, courtID).
+					Return(&entities.Court{
+						ID:             courtID,
+						OrganizationID: orgID,
+						SlotDuration:   90 * time.Minute,
+					}, nil)
+				mockRepo.EXPECT().
+					UpdateOpeningHours(ctx, gomock.Any()).
+					Return(nil)
+			},
+			wantSlot: 90 * time.Minute,
+		},
+		{
+			name: "invalid hours",
+			hours: []entities.OpeningHours{
+				{Weekday: time.Monday, OpensAt: 22 * 60, ClosesAt: 8 * 60},
+			},
+			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {},
+			wantErr:    entities.ErrInvalidOpeningHours,
+		},
+		{
+			name:  "court not found",
+			hours: validHours,
+			setupMocks: func(mockRepo *mocks.MockCourtsRepository) {
+				mockRepo.EXPECT().
+					GetByID(ctx, courtID).
+					Return(nil, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			mockRepo := mocks.NewMockCourtsRepository(s.ctrl)
+			service := court.NewService(mockRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
+
+			tt.setupMocks(mockRepo)
+
+			result, err := service.UpdateOpeningHours(ctx, orgID, courtID, tt.hours, tt.slotDuration)
+
+			if tt.wantErr != nil {
+				s.Require().Error(err)
+				s.ErrorIs(err, tt.wantErr)
+				s.Nil(result)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(tt.hours, result.OpeningHours)
+			s.Equal(tt.wantSlot, result.SlotDuration)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestCourtSchedule() {
+	ctx := context.Background()
+
+	courtsRepo := mocks.NewMockCourtsRepository(s.ctrl)
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := court.NewService(courtsRepo, orgsRepo, clock.Real{})
+
+	orgHours := []entities.OpeningHours{{Weekday: time.Monday, OpensAt: 8 * 60, ClosesAt: 22 * 60}}
+	courtHours := []entities.OpeningHours{{Weekday: time.Monday, OpensAt: 10 * 60, ClosesAt: 20 * 60}}
+	holidays := []entities.SpecialHours{{Date: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), Reason: "Christmas"}}
+
+	org := &entities.Organization{
+		ID:           "org-1",
+		TimeZone:     "Europe/Madrid",
+		OpeningHours: orgHours,
+		SpecialHours: holidays,
+	}
+
+	courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	courtsRepo.EXPECT().
+		GetByID(ctx, "court-2").
+		Return(&entities.Court{ID: "court-2", OrganizationID: "org-1", OpeningHours: courtHours}, nil)
+	orgsRepo.EXPECT().GetByID(ctx, "org-1").Return(org, nil).Times(2)
+
+	schedule, err := service.CourtSchedule(ctx, "court-1")
+	s.Require().NoError(err)
+	s.Equal("Europe/Madrid", schedule.Location.String())
+	s.Equal(orgHours, schedule.OpeningHours)
+	s.Equal(holidays, schedule.SpecialHours)
+
+	schedule, err = service.CourtSchedule(ctx, "court-2")
+	s.Require().NoError(err)
+	s.Equal(courtHours, schedule.OpeningHours)
+	s.Equal(holidays, schedule.SpecialHours)
+}
+
+func (s *ServiceSuite) TestOrganizationLocation() {
+	ctx := context.Background()
+
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := court.NewService(mocks.NewMockCourtsRepository(s.ctrl), orgsRepo, clock.Real{})
+
+	orgsRepo.EXPECT().GetByID(ctx, "org-1").Return(&entities.Organization{ID: "org-1", TimeZone: "Europe/Madrid"}, nil)
+	orgsRepo.EXPECT().GetByID(ctx, "org-2").Return(nil, entities.ErrNotFound)
+
+	loc, err := service.OrganizationLocation(ctx, "org-1")
+	s.Require().NoError(err)
+	s.Equal("Europe/Madrid", loc.String())
+
+	_, err = service.OrganizationLocation(ctx, "org-2")
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *ServiceSuite) TestCityLocation() {
+	ctx := context.Background()
+
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := court.NewService(mocks.NewMockCourtsRepository(s.ctrl), orgsRepo, clock.Real{})
+
+	orgsRepo.EXPECT().
+		GetOrganizationsByCity(ctx, "Madrid").
+		Return([]entities.Organization{{ID: "org-1", TimeZone: "Europe/Madrid"}}, nil)
+	orgsRepo.EXPECT().GetOrganizationsByCity(ctx, "Nowhere").Return(nil, nil)
+
+	loc, err := service.CityLocation(ctx, "Madrid")
+	s.Require().NoError(err)
+	s.Equal("Europe/Madrid", loc.String())
+
+	loc, err = service.CityLocation(ctx, "Nowhere")
+	s.Require().NoError(err)
+	s.Equal(time.UTC, loc)
+}
+
+func (s *ServiceSuite) TestUpdateDetails_Attributes() {
+	ctx := context.Background()
+
+	courtsRepo := mocks.NewMockCourtsRepository(s.ctrl)
+	service := court.NewService(courtsRepo, mocks.NewMockOrganizationsRepository(s.ctrl), clock.Real{})
+
+	existing := &entities.Court{
+		ID:             "court-1",
+		OrganizationID: "org-1",
+		Name:           "Court A",
+		CourtAttributes: entities.CourtAttributes{
+			Environment: entities.OutdoorCourtEnvironment,
+			Format:      entities.DoublesCourtFormat,
+			Lighting:    true,
+		},
+	}
+	panoramic := true
+
+	courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(existing, nil)
+	courtsRepo.EXPECT().UpdateDetails(ctx, existing).Return(nil)
+
+	result, err := service.UpdateDetails(ctx, "org-1", "court-1", entities.CourtDetails{
+		Environment: entities.IndoorCourtEnvironment,
+		Panoramic:   &panoramic,
+	})
+	s.Require().NoError(err)
+	s.Equal("Court A", result.Name)
+	s.Equal(entities.CourtAttributes{
+		Environment: entities.IndoorCourtEnvironment,
+		Format:      entities.DoublesCourtFormat,
+		Panoramic:   true,
+		Lighting:    true,
+	}, result.CourtAttributes)
+
+	courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(existing, nil)
+
+	_, err = service.UpdateDetails(ctx, "org-1", "court-1", entities.CourtDetails{Surface: "clay"})
+	s.Require().ErrorIs(err, entities.ErrInvalidCourtAttributes)
+}
+
+func (s *ServiceSuite) TestSearchCourts() {
+	ctx := context.Background()
+
+	courtsRepo := mocks.NewMockCourtsRepository(s.ctrl)
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := court.NewService(courtsRepo, orgsRepo, clock.Real{})
+
+	filter := entities.CourtFilter{Environment: entities.IndoorCourtEnvironment}
+	orgs := []entities.Organization{
+		{ID: "org-1", Name: "Club One", City: "Almaty"},
+		{ID: "org-2", Name: "Club Two", City: "Almaty"},
+		{ID: "org-3", Name: "Club Three", City: "Almaty"},
+	}
+
+	orgsRepo.EXPECT().GetOrganizationsByCity(ctx, "Almaty").Return(orgs, nil)
+	courtsRepo.EXPECT().
+		Search(ctx, []string{"org-1", "org-2", "org-3"}, filter).
+		Return([]entities.Court{
+			{ID: "court-1", OrganizationID: "org-1"},
+			{ID: "court-2", OrganizationID: "org-1"},
+			{ID: "court-3", OrganizationID: "org-3"},
+		}, nil)
+
+	result, err := service.SearchCourts(ctx, "Almaty", filter)
+	s.Require().NoError(err)
+	s.Require().Len(result, 2)
+
+	s.Equal("Club One", result[0].Organization.Name)
+	s.Len(result[0].Courts, 2)
+	s.Equal("Club Three", result[1].Organization.Name)
+	s.Len(result[1].Courts, 1)
+}
+
+func (s *ServiceSuite) TestSearchCourts_NoOrganizations() {
+	ctx := context.Background()
+
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := court.NewService(mocks.NewMockCourtsRepository(s.ctrl), orgsRepo, clock.Real{})
+
+	orgsRepo.EXPECT().GetOrganizationsByCity(ctx, "Nowhere").Return(nil, nil)
+
+	result, err := service.SearchCourts(ctx, "Nowhere", entities.CourtFilter{})
+	s.Require().NoError(err)
+	s.Empty(result)
+}
+
+func (s *ServiceSuite) TestSearchCourts_InvalidFilter() {
+	service := court.NewService(
+		mocks.NewMockCourtsRepository(s.ctrl),
+		mocks.NewMockOrganizationsRepository(s.ctrl),
+		clock.Real{},
+	)
+
+	_, err := service.SearchCourts(context.Background(), "Almaty", entities.CourtFilter{Format: "triples"})
+	s.Require().ErrorIs(err, entities.ErrInvalidCourtAttributes)
+}
+
+func (s *ServiceSuite) TestSearchAvailability() {
+	ctx := context.Background()
+
+	courtsRepo := mocks.NewMockCourtsRepository(s.ctrl)
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	clk := clockmocks.NewMockClock(s.ctrl)
+	service := court.NewService(courtsRepo, orgsRepo, clk)
+
+	from := time.Date(2031, 3, 5, 19, 0, 0, 0, time.UTC)
+	now := from.Add(-24 * time.Hour)
+	search := entities.AvailabilitySearch{City: "Almaty", From: from, Duration: time.Hour, Window: time.Hour, Limit: 2}
+
+	orgs := []entities.Organization{
+		{ID: "org-1", Name: "Club One", City: "Almaty"},
+		{ID: "org-2", Name: "Club Two", City: "Almaty"},
+	}
+	one := entities.Court{ID: "court-1", OrganizationID: "org-1", Name: "One"}
+	two := entities.Court{ID: "court-2", OrganizationID: "org-2", Name: "Two"}
+
+	// the service asks for one court more than the page to tell whether there is a next page
+	probe := search
+	probe.Limit = 3
+
+	clk.EXPECT().Now().Return(now)
+	orgsRepo.EXPECT().GetOrganizationsByCity(ctx, "Almaty").Return(orgs, nil)
+	courtsRepo.EXPECT().
+		SearchFree(ctx, []string{"org-1", "org-2"}, probe, now).
+		Return([]entities.FreeCourt{
+			{Court: one, From: from, To: from.Add(time.Hour)},
+			{Court: two, From: from, To: from.Add(time.Hour)},
+			{Court: one, From: from.Add(time.Hour), To: from.Add(2 * time.Hour)},
+		}, nil)
+
+	page, err := service.SearchAvailability(ctx, search)
+	s.Require().NoError(err)
+	s.Equal([]entities.FreeCourt{
+		{Organization: orgs[0], Court: one, From: from, To: from.Add(time.Hour)},
+		{Organization: orgs[1], Court: two, From: from, To: from.Add(time.Hour)},
+	}, page.Courts)
+	s.Equal(&entities.SearchCursor{
+		From:           from,
+		OrganizationID: "org-2",
+		CourtName:      "Two",
+		CourtID:        "court-2",
+	}, page.Next)
+}
+
+func (s *ServiceSuite) TestSearchAvailability_Invalid() {
+	service := court.NewService(
+		mocks.NewMockCourtsRepository(s.ctrl),
+		mocks.NewMockOrganizationsRepository(s.ctrl),
+		clock.Real{},
+	)
+
+	from := time.Date(2031, 3, 5, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		search entities.AvailabilitySearch
+	}{
+		{name: "no city", search: entities.AvailabilitySearch{From: from, Duration: time.Hour, Limit: 10}},
+		{name: "no duration", search: entities.AvailabilitySearch{City: "Almaty", From: from, Limit: 10}},
+		{
+			name: "window too wide",
+			search: entities.AvailabilitySearch{
+				City: "Almaty", From: from, Duration: time.Hour, Window: 24 * time.Hour, Limit: 10,
+			},
+		},
+		{name: "no limit", search: entities.AvailabilitySearch{City: "Almaty", From: from, Duration: time.Hour}},
+		{
+			name: "limit too high",
+			search: entities.AvailabilitySearch{
+				City: "Almaty", From: from, Duration: time.Hour, Limit: entities.MaxSearchLimit + 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := service.SearchAvailability(context.Background(), tt.search)
+			s.Require().ErrorIs(err, entities.ErrInvalidAvailabilitySearch)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestSearchAvailability_Near() {
+	ctx := context.Background()
+
+	courtsRepo := mocks.NewMockCourtsRepository(s.ctrl)
+	orgsRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := court.NewService(courtsRepo, orgsRepo, clock.Real{})
+
+	me := entities.GeoPoint{Latitude: 43.2389, Longitude: 76.8897}
+	from := time.Date(2031, 3, 5, 19, 0, 0, 0, time.UTC)
+	search := entities.AvailabilitySearch{
+		City: "Almaty", From: from, Duration: time.Hour, Window: time.Hour, Near: &me, Limit: 10,
+	}
+
+	orgs := []entities.Organization{
+		{ID: "org-far", City: "Almaty", Coordinates: &entities.GeoPoint{Latitude: 43.3000, Longitude: 76.8897}},
+		{ID: "org-unplaced", City: "Almaty"},
+		{ID: "org-close", City: "Almaty", Coordinates: &entities.GeoPoint{Latitude: 43.2400, Longitude: 76.8897}},
+	}
+	far := entities.Court{ID: "court-far", OrganizationID: "org-far"}
+	unplaced := entities.Court{ID: "court-unplaced", OrganizationID: "org-unplaced"}
+	nearby := entities.Court{ID: "court-close", OrganizationID: "org-close"}
+
+	closeDistance, farDistance := 122.3, 6795.4
+
+	// the repository ranks the courts, the service keeps its order
+	orgsRepo.EXPECT().GetOrganizationsByCity(ctx, "Almaty").Return(orgs, nil)
+	courtsRepo.EXPECT().
+		SearchFree(ctx, []string{"org-far", "org-unplaced", "org-close"}, gomock.Any(), gomock.Any()).
+		Return([]entities.FreeCourt{
+			{Court: nearby, From: from, To: from.Add(time.Hour), Distance: &closeDistance},
+			{Court: far, From: from, To: from.Add(time.Hour), Distance: &farDistance},
+			{Court: unplaced, From: from, To: from.Add(time.Hour)},
+			{Court: far, From: from.Add(time.Hour), To: from.Add(2 * time.Hour), Distance: &farDistance},
+		}, nil)
+
+	page, err := service.SearchAvailability(ctx, search)
+	s.Require().NoError(err)
+	s.Nil(page.Next)
+
+	var ids []string
+	for _, f := range page.Courts {
+		ids = append(ids, f.Court.ID)
+	}
+	s.Equal([]string{"court-close", "court-far", "court-unplaced", "court-far"}, ids)
+
+	s.Equal(orgs[2], page.Courts[0].Organization)
+	s.Equal(&closeDistance, page.Courts[0].Distance)
+	s.Nil(page.Courts[2].Distance)
+}
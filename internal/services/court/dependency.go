@@ -4,6 +4,7 @@ package court
 
 import (
 	"context"
+	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
 )
@@ -11,7 +12,20 @@ import (
 type CourtsRepository interface {
 	Create(ctx context.Context, court *entities.Court) error
 	ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error)
+	Search(ctx context.Context, organizationIDs []string, filter entities.CourtFilter) ([]entities.Court, error)
+	SearchFree(
+		ctx context.Context,
+		organizationIDs []string,
+		search entities.AvailabilitySearch,
+		now time.Time,
+	) ([]entities.FreeCourt, error)
 	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
 	Update(ctx context.Context, court *entities.Court) error
-	UpdateName(ctx context.Context, court *entities.Court) error
+	UpdateDetails(ctx context.Context, court *entities.Court) error
+	UpdateOpeningHours(ctx context.Context, court *entities.Court) error
+}
+
+type OrganizationsRepository interface {
+	GetByID(ctx context.Context, organizationID string) (*entities.Organization, error)
+	GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error)
 }
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/lever-dev/padel-backend/internal/entities"
@@ -79,6 +80,36 @@ func (mr *MockCourtsRepositoryMockRecorder) ListByOrganizationID(ctx, organizati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrganizationID", reflect.TypeOf((*MockCourtsRepository)(nil).ListByOrganizationID), ctx, organizationID)
 }
 
+// Search mocks base method.
+func (m *MockCourtsRepository) Search(ctx context.Context, organizationIDs []string, filter entities.CourtFilter) ([]entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, organizationIDs, filter)
+	ret0, _ := ret[0].([]entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockCourtsRepositoryMockRecorder) Search(ctx, organizationIDs, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCourtsRepository)(nil).Search), ctx, organizationIDs, filter)
+}
+
+// SearchFree mocks base method.
+func (m *MockCourtsRepository) SearchFree(ctx context.Context, organizationIDs []string, search entities.AvailabilitySearch, now time.Time) ([]entities.FreeCourt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFree", ctx, organizationIDs, search, now)
+	ret0, _ := ret[0].([]entities.FreeCourt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFree indicates an expected call of SearchFree.
+func (mr *MockCourtsRepositoryMockRecorder) SearchFree(ctx, organizationIDs, search, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFree", reflect.TypeOf((*MockCourtsRepository)(nil).SearchFree), ctx, organizationIDs, search, now)
+}
+
 // Update mocks base method.
 func (m *MockCourtsRepository) Update(ctx context.Context, court *entities.Court) error {
 	m.ctrl.T.Helper()
@@ -93,16 +124,83 @@ func (mr *MockCourtsRepositoryMockRecorder) Update(ctx, court interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCourtsRepository)(nil).Update), ctx, court)
 }
 
-// UpdateName mocks base method.
-func (m *MockCourtsRepository) UpdateName(ctx context.Context, court *entities.Court) error {
+// UpdateDetails mocks base method.
+func (m *MockCourtsRepository) UpdateDetails(ctx context.Context, court *entities.Court) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDetails", ctx, court)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDetails indicates an expected call of UpdateDetails.
+func (mr *MockCourtsRepositoryMockRecorder) UpdateDetails(ctx, court interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDetails", reflect.TypeOf((*MockCourtsRepository)(nil).UpdateDetails), ctx, court)
+}
+
+// UpdateOpeningHours mocks base method.
+func (m *MockCourtsRepository) UpdateOpeningHours(ctx context.Context, court *entities.Court) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateName", ctx, court)
+	ret := m.ctrl.Call(m, "UpdateOpeningHours", ctx, court)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateName indicates an expected call of UpdateName.
-func (mr *MockCourtsRepositoryMockRecorder) UpdateName(ctx, court interface{}) *gomock.Call {
+// UpdateOpeningHours indicates an expected call of UpdateOpeningHours.
+func (mr *MockCourtsRepositoryMockRecorder) UpdateOpeningHours(ctx, court interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOpeningHours", reflect.TypeOf((*MockCourtsRepository)(nil).UpdateOpeningHours), ctx, court)
+}
+
+// MockOrganizationsRepository is a mock of OrganizationsRepository interface.
+type MockOrganizationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationsRepositoryMockRecorder
+}
+
+// MockOrganizationsRepositoryMockRecorder is the mock recorder for MockOrganizationsRepository.
+type MockOrganizationsRepositoryMockRecorder struct {
+	mock *MockOrganizationsRepository
+}
+
+// NewMockOrganizationsRepository creates a new mock instance.
+func NewMockOrganizationsRepository(ctrl *gomock.Controller) *MockOrganizationsRepository {
+	mock := &MockOrganizationsRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationsRepository) EXPECT() *MockOrganizationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockOrganizationsRepository) GetByID(ctx context.Context, organizationID string) (*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, organizationID)
+	ret0, _ := ret[0].(*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOrganizationsRepositoryMockRecorder) GetByID(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrganizationsRepository)(nil).GetByID), ctx, organizationID)
+}
+
+// GetOrganizationsByCity mocks base method.
+func (m *MockOrganizationsRepository) GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsByCity", ctx, city)
+	ret0, _ := ret[0].([]entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationsByCity indicates an expected call of GetOrganizationsByCity.
+func (mr *MockOrganizationsRepositoryMockRecorder) GetOrganizationsByCity(ctx, city interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateName", reflect.TypeOf((*MockCourtsRepository)(nil).UpdateName), ctx, court)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsByCity", reflect.TypeOf((*MockOrganizationsRepository)(nil).GetOrganizationsByCity), ctx, city)
 }
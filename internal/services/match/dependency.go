@@ -0,0 +1,42 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package match
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type ReservationsRepository interface {
+	GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error)
+	ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error)
+	GetPlayer(ctx context.Context, reservationID, userID string) (*entities.ReservationPlayer, error)
+	AddPlayer(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error
+	UpdatePlayerStatus(
+		ctx context.Context,
+		reservationID, userID string,
+		from, to entities.PlayerStatus,
+		now time.Time,
+	) error
+
+	SaveMatch(ctx context.Context, match *entities.OpenMatch) error
+	GetMatch(ctx context.Context, reservationID string) (*entities.OpenMatch, error)
+	DeleteMatch(ctx context.Context, reservationID string) error
+	JoinMatch(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error
+	ApproveJoin(ctx context.Context, reservationID, userID string, maxPlayers int, now time.Time) error
+	SearchMatches(ctx context.Context, filter entities.MatchFilter, now time.Time) ([]entities.MatchListing, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
+
+type OrganizationsRepository interface {
+	GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error)
+}
+
+type UsersRepository interface {
+	GetByID(ctx context.Context, userID string) (*entities.User, error)
+}
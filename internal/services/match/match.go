@@ -0,0 +1,289 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+)
+
+type Service struct {
+	reservationsRepo  ReservationsRepository
+	courtsRepo        CourtsRepository
+	organizationsRepo OrganizationsRepository
+	usersRepo         UsersRepository
+	clock             clock.Clock
+}
+
+func NewService(
+	reservationsRepo ReservationsRepository,
+	courtsRepo CourtsRepository,
+	organizationsRepo OrganizationsRepository,
+	usersRepo UsersRepository,
+	clock clock.Clock,
+) *Service {
+	return &Service{
+		reservationsRepo:  reservationsRepo,
+		courtsRepo:        courtsRepo,
+		organizationsRepo: organizationsRepo,
+		usersRepo:         usersRepo,
+		clock:             clock,
+	}
+}
+
+// OpenMatch publishes the reservation as a match other players can join, or updates a match that is
+// already open. Only the booker opens a match, and only for as many players as the roster has room for.
+func (s *Service) OpenMatch(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+	match *entities.OpenMatch,
+) error {
+	if err := match.Validate(); err != nil {
+		return err
+	}
+
+	court, rsv, err := s.getBookerReservation(ctx, organizationID, courtID, reservationID, userID)
+	if err != nil {
+		return err
+	}
+
+	players, err := s.reservationsRepo.ListPlayers(ctx, reservationID)
+	if err != nil {
+		return fmt.Errorf("list players: %w", err)
+	}
+
+	// the booker holds a spot of their own
+	taken := 1
+	for _, player := range players {
+		if player.Status.TakesSpot() {
+			taken++
+		}
+	}
+
+	if taken+match.MissingPlayers > court.MaxPlayers {
+		return fmt.Errorf("%w: %d players are on the roster of court with %d spots, %d can not be missing",
+			entities.ErrInvalidMatch, taken, court.MaxPlayers, match.MissingPlayers)
+	}
+
+	now := s.clock.Now()
+
+	match.ReservationID = rsv.ID
+	match.CreatedAt = now
+	match.UpdatedAt = now
+
+	if err := s.reservationsRepo.SaveMatch(ctx, match); err != nil {
+		return fmt.Errorf("save match: %w", err)
+	}
+
+	return nil
+}
+
+// CloseMatch stops the match from taking players. The players who joined it stay on the roster.
+func (s *Service) CloseMatch(ctx context.Context, organizationID, courtID, reservationID, userID string) error {
+	if _, _, err := s.getBookerReservation(ctx, organizationID, courtID, reservationID, userID); err != nil {
+		return err
+	}
+
+	if err := s.reservationsRepo.DeleteMatch(ctx, reservationID); err != nil {
+		return fmt.Errorf("delete match: %w", err)
+	}
+
+	return nil
+}
+
+// JoinMatch adds the user to an open match their level fits. The user takes a spot right away when the
+// match auto-accepts players, otherwise the request waits for the booker to approve it.
+func (s *Service) JoinMatch(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+) (*entities.ReservationPlayer, error) {
+	court, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsv, err := s.getUpcomingReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsv.ReservedBy == userID {
+		return nil, fmt.Errorf("%w: the booker is already on the roster", entities.ErrPlayerAlreadyInvited)
+	}
+
+	match, err := s.reservationsRepo.GetMatch(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	if match.MissingPlayers == 0 {
+		return nil, fmt.Errorf("%w: reservation %s", entities.ErrMatchFull, reservationID)
+	}
+
+	user, err := s.usersRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id: %w", err)
+	}
+
+	if !match.Admits(user.Level) {
+		return nil, fmt.Errorf("%w: match is open to levels %.2f to %.2f",
+			entities.ErrLevelOutOfRange, match.MinLevel, match.MaxLevel)
+	}
+
+	player := entities.NewReservationPlayer(reservationID, userID, userID, s.clock.Now())
+
+	if match.AutoAccept {
+		player.Status = entities.AcceptedPlayerStatus
+
+		if err := s.reservationsRepo.JoinMatch(ctx, player, court.MaxPlayers); err != nil {
+			return nil, fmt.Errorf("join match: %w", err)
+		}
+
+		return player, nil
+	}
+
+	player.Status = entities.RequestedPlayerStatus
+
+	if err := s.reservationsRepo.AddPlayer(ctx, player, court.MaxPlayers); err != nil {
+		return nil, fmt.Errorf("add player: %w", err)
+	}
+
+	return player, nil
+}
+
+// ApproveRequest lets the player who asked to join the match take one of its missing spots.
+func (s *Service) ApproveRequest(
+	ctx context.Context,
+	organizationID, courtID, reservationID, playerID string,
+	userID string,
+) error {
+	court, _, err := s.getBookerReservation(ctx, organizationID, courtID, reservationID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.reservationsRepo.ApproveJoin(ctx, reservationID, playerID, court.MaxPlayers, s.clock.Now())
+	if err != nil {
+		return fmt.Errorf("approve join: %w", err)
+	}
+
+	return nil
+}
+
+// RejectRequest turns down the request of the player to join the match.
+func (s *Service) RejectRequest(
+	ctx context.Context,
+	organizationID, courtID, reservationID, playerID string,
+	userID string,
+) error {
+	if _, _, err := s.getBookerReservation(ctx, organizationID, courtID, reservationID, userID); err != nil {
+		return err
+	}
+
+	err := s.reservationsRepo.UpdatePlayerStatus(
+		ctx,
+		reservationID,
+		playerID,
+		entities.RequestedPlayerStatus,
+		entities.DeclinedPlayerStatus,
+		s.clock.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("update player status: %w", err)
+	}
+
+	return nil
+}
+
+// SearchMatches returns the open matches on the day of date at the clubs of the city which still miss
+// players. When level is set only the matches admitting it are returned.
+func (s *Service) SearchMatches(
+	ctx context.Context,
+	city string,
+	date time.Time,
+	level *float64,
+) ([]entities.MatchListing, error) {
+	if level != nil {
+		if err := entities.ValidateSkillLevel(*level); err != nil {
+			return nil, err
+		}
+	}
+
+	orgs, err := s.organizationsRepo.GetOrganizationsByCity(ctx, city)
+	if err != nil {
+		return nil, fmt.Errorf("get organizations by city: %w", err)
+	}
+
+	if len(orgs) == 0 {
+		return nil, nil
+	}
+
+	orgIDs := make([]string, 0, len(orgs))
+	for _, org := range orgs {
+		orgIDs = append(orgIDs, org.ID)
+	}
+
+	y, m, d := date.Date()
+	dayStart := time.Date(y, m, d, 0, 0, 0, 0, date.Location())
+
+	listings, err := s.reservationsRepo.SearchMatches(ctx, entities.MatchFilter{
+		OrganizationIDs: orgIDs,
+		From:            dayStart,
+		To:              dayStart.AddDate(0, 0, 1),
+		Level:           level,
+	}, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("search matches: %w", err)
+	}
+
+	return listings, nil
+}
+
+// getUpcomingReservation returns the reservation on the court as long as it is booked and did not start.
+func (s *Service) getUpcomingReservation(
+	ctx context.Context,
+	courtID, reservationID string,
+) (*entities.Reservation, error) {
+	rsv, err := s.reservationsRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	if rsv.CourtID != courtID {
+		return nil, fmt.Errorf("%w: reservation %s is not on court %s", entities.ErrNotFound, reservationID, courtID)
+	}
+
+	now := s.clock.Now()
+	if !rsv.IsActiveAt(now) || !rsv.ReservedFrom.After(now) {
+		return nil, fmt.Errorf("%w: reservation %s", entities.ErrReservationNotActive, reservationID)
+	}
+
+	return rsv, nil
+}
+
+func (s *Service) getBookerReservation(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+) (*entities.Court, *entities.Reservation, error) {
+	court, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rsv, err := s.getUpcomingReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if rsv.ReservedBy != userID {
+		return nil, nil, fmt.Errorf("%w: reservation %s was booked by another user", entities.ErrForbidden, reservationID)
+	}
+
+	return court, rsv, nil
+}
@@ -0,0 +1,270 @@
+package match_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/match"
+	"github.com/lever-dev/padel-backend/internal/services/match/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo  *mocks.MockReservationsRepository
+	courtsRepo        *mocks.MockCourtsRepository
+	organizationsRepo *mocks.MockOrganizationsRepository
+	usersRepo         *mocks.MockUsersRepository
+	service           *match.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+var matchNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.organizationsRepo = mocks.NewMockOrganizationsRepository(s.ctrl)
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(matchNow).AnyTimes()
+
+	s.service = match.NewService(s.reservationsRepo, s.courtsRepo, s.organizationsRepo, s.usersRepo, clock)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func court() *entities.Court {
+	return &entities.Court{ID: "court-1", OrganizationID: "org-1", MaxPlayers: 4}
+}
+
+func reservation() *entities.Reservation {
+	return &entities.Reservation{
+		ID:           "res-1",
+		CourtID:      "court-1",
+		ReservedBy:   "user-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: matchNow.Add(24 * time.Hour),
+		ReservedTo:   matchNow.Add(25 * time.Hour),
+	}
+}
+
+func openMatch(autoAccept bool) *entities.OpenMatch {
+	return &entities.OpenMatch{
+		ReservationID:  "res-1",
+		MinLevel:       2,
+		MaxLevel:       4,
+		MissingPlayers: 2,
+		AutoAccept:     autoAccept,
+	}
+}
+
+func level(l float64) *float64 {
+	return &l
+}
+
+func (s *ServiceSuite) expectReservation() {
+	s.courtsRepo.EXPECT().GetByID(gomock.Any(), "court-1").Return(court(), nil)
+	s.reservationsRepo.EXPECT().GetByID(gomock.Any(), "res-1").Return(reservation(), nil)
+}
+
+func (s *ServiceSuite) TestOpenMatch() {
+	ctx := context.Background()
+
+	s.Run("opens the match", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return([]entities.ReservationPlayer{
+			{UserID: "user-2", Status: entities.AcceptedPlayerStatus},
+			{UserID: "user-3", Status: entities.DeclinedPlayerStatus},
+		}, nil)
+		s.reservationsRepo.EXPECT().SaveMatch(ctx, gomock.Any()).Return(nil)
+
+		m := openMatch(true)
+		s.Require().NoError(s.service.OpenMatch(ctx, "org-1", "court-1", "res-1", "user-1", m))
+		s.Equal(matchNow, m.CreatedAt)
+	})
+
+	s.Run("more players missing than the roster has room for", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return([]entities.ReservationPlayer{
+			{UserID: "user-2", Status: entities.InvitedPlayerStatus},
+		}, nil)
+
+		m := openMatch(true)
+		m.MissingPlayers = 3
+
+		err := s.service.OpenMatch(ctx, "org-1", "court-1", "res-1", "user-1", m)
+		s.ErrorIs(err, entities.ErrInvalidMatch)
+	})
+
+	s.Run("invalid level range", func() {
+		m := openMatch(true)
+		m.MinLevel, m.MaxLevel = 5, 3
+
+		err := s.service.OpenMatch(ctx, "org-1", "court-1", "res-1", "user-1", m)
+		s.ErrorIs(err, entities.ErrInvalidMatch)
+	})
+
+	s.Run("only the booker opens a match", func() {
+		s.expectReservation()
+
+		err := s.service.OpenMatch(ctx, "org-1", "court-1", "res-1", "user-2", openMatch(true))
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+
+	s.Run("reservation already started", func() {
+		rsv := reservation()
+		rsv.ReservedFrom = matchNow.Add(-time.Minute)
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+
+		err := s.service.OpenMatch(ctx, "org-1", "court-1", "res-1", "user-1", openMatch(true))
+		s.ErrorIs(err, entities.ErrReservationNotActive)
+	})
+}
+
+func (s *ServiceSuite) TestJoinMatch() {
+	ctx := context.Background()
+
+	s.Run("auto accepted", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().GetMatch(ctx, "res-1").Return(openMatch(true), nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-2").Return(&entities.User{ID: "user-2", Level: level(3)}, nil)
+		s.reservationsRepo.EXPECT().JoinMatch(ctx, gomock.Any(), 4).Return(nil)
+
+		player, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.Require().NoError(err)
+		s.Equal(entities.AcceptedPlayerStatus, player.Status)
+	})
+
+	s.Run("waits for the booker", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().GetMatch(ctx, "res-1").Return(openMatch(false), nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-2").Return(&entities.User{ID: "user-2", Level: level(2)}, nil)
+		s.reservationsRepo.EXPECT().AddPlayer(ctx, gomock.Any(), 4).Return(nil)
+
+		player, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.Require().NoError(err)
+		s.Equal(entities.RequestedPlayerStatus, player.Status)
+	})
+
+	s.Run("level out of range", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().GetMatch(ctx, "res-1").Return(openMatch(true), nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-2").Return(&entities.User{ID: "user-2", Level: level(5)}, nil)
+
+		_, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.ErrorIs(err, entities.ErrLevelOutOfRange)
+	})
+
+	s.Run("player without a level", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().GetMatch(ctx, "res-1").Return(openMatch(true), nil)
+		s.usersRepo.EXPECT().GetByID(ctx, "user-2").Return(&entities.User{ID: "user-2"}, nil)
+
+		_, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.ErrorIs(err, entities.ErrLevelOutOfRange)
+	})
+
+	s.Run("match is full", func() {
+		m := openMatch(true)
+		m.MissingPlayers = 0
+
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().GetMatch(ctx, "res-1").Return(m, nil)
+
+		_, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.ErrorIs(err, entities.ErrMatchFull)
+	})
+
+	s.Run("match is not open", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().GetMatch(ctx, "res-1").Return(nil, entities.ErrNotFound)
+
+		_, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("booker can not join", func() {
+		s.expectReservation()
+
+		_, err := s.service.JoinMatch(ctx, "org-1", "court-1", "res-1", "user-1")
+		s.ErrorIs(err, entities.ErrPlayerAlreadyInvited)
+	})
+}
+
+func (s *ServiceSuite) TestAnswerRequest() {
+	ctx := context.Background()
+
+	s.Run("approve", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().ApproveJoin(ctx, "res-1", "user-2", 4, matchNow).Return(nil)
+
+		s.NoError(s.service.ApproveRequest(ctx, "org-1", "court-1", "res-1", "user-2", "user-1"))
+	})
+
+	s.Run("reject", func() {
+		s.expectReservation()
+		s.reservationsRepo.EXPECT().UpdatePlayerStatus(
+			ctx, "res-1", "user-2", entities.RequestedPlayerStatus, entities.DeclinedPlayerStatus, matchNow,
+		).Return(nil)
+
+		s.NoError(s.service.RejectRequest(ctx, "org-1", "court-1", "res-1", "user-2", "user-1"))
+	})
+
+	s.Run("only the booker answers", func() {
+		s.expectReservation()
+
+		err := s.service.ApproveRequest(ctx, "org-1", "court-1", "res-1", "user-2", "user-2")
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+}
+
+func (s *ServiceSuite) TestSearchMatches() {
+	ctx := context.Background()
+
+	s.Run("searches the clubs of the city on the day", func() {
+		s.organizationsRepo.EXPECT().GetOrganizationsByCity(ctx, "Madrid").Return([]entities.Organization{
+			{ID: "org-1"}, {ID: "org-2"},
+		}, nil)
+
+		listings := []entities.MatchListing{{Match: *openMatch(true), Reservation: *reservation()}}
+		s.reservationsRepo.EXPECT().SearchMatches(ctx, entities.MatchFilter{
+			OrganizationIDs: []string{"org-1", "org-2"},
+			From:            time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC),
+			To:              time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC),
+			Level:           level(3),
+		}, matchNow).Return(listings, nil)
+
+		found, err := s.service.SearchMatches(ctx, "Madrid", time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC), level(3))
+		s.Require().NoError(err)
+		s.Equal(listings, found)
+	})
+
+	s.Run("no clubs in the city", func() {
+		s.organizationsRepo.EXPECT().GetOrganizationsByCity(ctx, "Nowhere").Return(nil, nil)
+
+		found, err := s.service.SearchMatches(ctx, "Nowhere", matchNow, nil)
+		s.Require().NoError(err)
+		s.Empty(found)
+	})
+
+	s.Run("invalid level", func() {
+		_, err := s.service.SearchMatches(ctx, "Madrid", matchNow, level(8))
+		s.ErrorIs(err, entities.ErrInvalidSkillLevel)
+	})
+}
@@ -0,0 +1,310 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/match/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockReservationsRepository is a mock of ReservationsRepository interface.
+type MockReservationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsRepositoryMockRecorder
+}
+
+// MockReservationsRepositoryMockRecorder is the mock recorder for MockReservationsRepository.
+type MockReservationsRepositoryMockRecorder struct {
+	mock *MockReservationsRepository
+}
+
+// NewMockReservationsRepository creates a new mock instance.
+func NewMockReservationsRepository(ctrl *gomock.Controller) *MockReservationsRepository {
+	mock := &MockReservationsRepository{ctrl: ctrl}
+	mock.recorder = &MockReservationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsRepository) EXPECT() *MockReservationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPlayer mocks base method.
+func (m *MockReservationsRepository) AddPlayer(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlayer", ctx, player, maxPlayers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlayer indicates an expected call of AddPlayer.
+func (mr *MockReservationsRepositoryMockRecorder) AddPlayer(ctx, player, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlayer", reflect.TypeOf((*MockReservationsRepository)(nil).AddPlayer), ctx, player, maxPlayers)
+}
+
+// ApproveJoin mocks base method.
+func (m *MockReservationsRepository) ApproveJoin(ctx context.Context, reservationID, userID string, maxPlayers int, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJoin", ctx, reservationID, userID, maxPlayers, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveJoin indicates an expected call of ApproveJoin.
+func (mr *MockReservationsRepositoryMockRecorder) ApproveJoin(ctx, reservationID, userID, maxPlayers, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoin", reflect.TypeOf((*MockReservationsRepository)(nil).ApproveJoin), ctx, reservationID, userID, maxPlayers, now)
+}
+
+// DeleteMatch mocks base method.
+func (m *MockReservationsRepository) DeleteMatch(ctx context.Context, reservationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMatch", ctx, reservationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMatch indicates an expected call of DeleteMatch.
+func (mr *MockReservationsRepositoryMockRecorder) DeleteMatch(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMatch", reflect.TypeOf((*MockReservationsRepository)(nil).DeleteMatch), ctx, reservationID)
+}
+
+// GetByID mocks base method.
+func (m *MockReservationsRepository) GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReservationsRepositoryMockRecorder) GetByID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetByID), ctx, reservationID)
+}
+
+// GetMatch mocks base method.
+func (m *MockReservationsRepository) GetMatch(ctx context.Context, reservationID string) (*entities.OpenMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatch", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.OpenMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatch indicates an expected call of GetMatch.
+func (mr *MockReservationsRepositoryMockRecorder) GetMatch(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatch", reflect.TypeOf((*MockReservationsRepository)(nil).GetMatch), ctx, reservationID)
+}
+
+// GetPlayer mocks base method.
+func (m *MockReservationsRepository) GetPlayer(ctx context.Context, reservationID, userID string) (*entities.ReservationPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayer", ctx, reservationID, userID)
+	ret0, _ := ret[0].(*entities.ReservationPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayer indicates an expected call of GetPlayer.
+func (mr *MockReservationsRepositoryMockRecorder) GetPlayer(ctx, reservationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayer", reflect.TypeOf((*MockReservationsRepository)(nil).GetPlayer), ctx, reservationID, userID)
+}
+
+// JoinMatch mocks base method.
+func (m *MockReservationsRepository) JoinMatch(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinMatch", ctx, player, maxPlayers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinMatch indicates an expected call of JoinMatch.
+func (mr *MockReservationsRepositoryMockRecorder) JoinMatch(ctx, player, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinMatch", reflect.TypeOf((*MockReservationsRepository)(nil).JoinMatch), ctx, player, maxPlayers)
+}
+
+// ListPlayers mocks base method.
+func (m *MockReservationsRepository) ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlayers", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.ReservationPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlayers indicates an expected call of ListPlayers.
+func (mr *MockReservationsRepositoryMockRecorder) ListPlayers(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayers", reflect.TypeOf((*MockReservationsRepository)(nil).ListPlayers), ctx, reservationID)
+}
+
+// SaveMatch mocks base method.
+func (m *MockReservationsRepository) SaveMatch(ctx context.Context, match *entities.OpenMatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMatch", ctx, match)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMatch indicates an expected call of SaveMatch.
+func (mr *MockReservationsRepositoryMockRecorder) SaveMatch(ctx, match interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMatch", reflect.TypeOf((*MockReservationsRepository)(nil).SaveMatch), ctx, match)
+}
+
+// SearchMatches mocks base method.
+func (m *MockReservationsRepository) SearchMatches(ctx context.Context, filter entities.MatchFilter, now time.Time) ([]entities.MatchListing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMatches", ctx, filter, now)
+	ret0, _ := ret[0].([]entities.MatchListing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMatches indicates an expected call of SearchMatches.
+func (mr *MockReservationsRepositoryMockRecorder) SearchMatches(ctx, filter, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMatches", reflect.TypeOf((*MockReservationsRepository)(nil).SearchMatches), ctx, filter, now)
+}
+
+// UpdatePlayerStatus mocks base method.
+func (m *MockReservationsRepository) UpdatePlayerStatus(ctx context.Context, reservationID, userID string, from, to entities.PlayerStatus, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlayerStatus", ctx, reservationID, userID, from, to, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlayerStatus indicates an expected call of UpdatePlayerStatus.
+func (mr *MockReservationsRepositoryMockRecorder) UpdatePlayerStatus(ctx, reservationID, userID, from, to, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlayerStatus", reflect.TypeOf((*MockReservationsRepository)(nil).UpdatePlayerStatus), ctx, reservationID, userID, from, to, now)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// MockOrganizationsRepository is a mock of OrganizationsRepository interface.
+type MockOrganizationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationsRepositoryMockRecorder
+}
+
+// MockOrganizationsRepositoryMockRecorder is the mock recorder for MockOrganizationsRepository.
+type MockOrganizationsRepositoryMockRecorder struct {
+	mock *MockOrganizationsRepository
+}
+
+// NewMockOrganizationsRepository creates a new mock instance.
+func NewMockOrganizationsRepository(ctrl *gomock.Controller) *MockOrganizationsRepository {
+	mock := &MockOrganizationsRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationsRepository) EXPECT() *MockOrganizationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetOrganizationsByCity mocks base method.
+func (m *MockOrganizationsRepository) GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsByCity", ctx, city)
+	ret0, _ := ret[0].([]entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationsByCity indicates an expected call of GetOrganizationsByCity.
+func (mr *MockOrganizationsRepositoryMockRecorder) GetOrganizationsByCity(ctx, city interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsByCity", reflect.TypeOf((*MockOrganizationsRepository)(nil).GetOrganizationsByCity), ctx, city)
+}
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockUsersRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUsersRepositoryMockRecorder) GetByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsersRepository)(nil).GetByID), ctx, userID)
+}
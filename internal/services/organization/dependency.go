@@ -9,8 +9,24 @@ import (
 )
 
 type OrganizationsRepository interface {
-	Create(ctx context.Context, organization *entities.Organization) error
 	GetByID(ctx context.Context, organizationID string) (*entities.Organization, error)
 	GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error)
-	Update(ctx context.Context, org *entities.Organization) error
+	NearbyOrganizations(
+		ctx context.Context,
+		point entities.GeoPoint,
+		radius float64,
+		limit int,
+	) ([]entities.NearbyOrganization, error)
+	Update(ctx context.Context, update entities.OrganizationUpdate) error
+	UpdateHours(ctx context.Context, org *entities.Organization) error
+
+	CreateWithOwner(ctx context.Context, organization *entities.Organization, owner *entities.Membership) error
+	CreateMember(ctx context.Context, member *entities.Membership) error
+	GetMember(ctx context.Context, organizationID, userID string) (*entities.Membership, error)
+	ListMembers(ctx context.Context, organizationID string) ([]entities.Membership, error)
+	DeleteMember(ctx context.Context, organizationID, userID string) error
+}
+
+type UsersRepository interface {
+	GetByNickname(ctx context.Context, nickname string) (entities.User, error)
 }
@@ -0,0 +1,105 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// GetMemberRole returns the role of the user in the organization, or ErrNotFound when the user is not a member.
+func (s *Service) GetMemberRole(ctx context.Context, organizationID, userID string) (entities.Role, error) {
+	member, err := s.organizationsRepo.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return "", fmt.Errorf("get member: %w", err)
+	}
+
+	return member.Role, nil
+}
+
+func (s *Service) ListMembers(ctx context.Context, organizationID string) ([]entities.Membership, error) {
+	members, err := s.organizationsRepo.ListMembers(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list members: %w", err)
+	}
+
+	return members, nil
+}
+
+// InviteMember adds the user with the given nickname to the organization. Managers may invite
+// staff and players, only owners may hand out the manager and owner roles.
+func (s *Service) InviteMember(
+	ctx context.Context,
+	organizationID, invitedBy string,
+	nickname string,
+	role entities.Role,
+) (*entities.Membership, error) {
+	if !role.Valid() {
+		return nil, fmt.Errorf("%w: %q", entities.ErrInvalidRole, role)
+	}
+
+	inviterRole, err := s.actorRole(ctx, organizationID, invitedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inviterRole.AtLeast(entities.ManagerRole) ||
+		(role.AtLeast(entities.ManagerRole) && inviterRole != entities.OwnerRole) {
+		return nil, fmt.Errorf("%w: %s cannot grant the %s role", entities.ErrForbidden, inviterRole, role)
+	}
+
+	user, err := s.usersRepo.GetByNickname(ctx, nickname)
+	if err != nil {
+		return nil, fmt.Errorf("get user by nickname: %w", err)
+	}
+
+	member := entities.NewMembership(organizationID, user.ID, role, invitedBy)
+
+	if err := s.organizationsRepo.CreateMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("create member: %w", err)
+	}
+
+	return member, nil
+}
+
+// RemoveMember removes the user from the organization. Members may always leave on their own,
+// managers may remove staff and players and owners may remove anyone. The last owner cannot be removed.
+func (s *Service) RemoveMember(ctx context.Context, organizationID, removedBy, userID string) error {
+	if removedBy != userID {
+		removerRole, err := s.actorRole(ctx, organizationID, removedBy)
+		if err != nil {
+			return err
+		}
+
+		member, err := s.organizationsRepo.GetMember(ctx, organizationID, userID)
+		if err != nil {
+			return fmt.Errorf("get member: %w", err)
+		}
+
+		canRemove := removerRole == entities.OwnerRole ||
+			(removerRole == entities.ManagerRole && !member.Role.AtLeast(entities.ManagerRole))
+		if !canRemove {
+			return fmt.Errorf("%w: %s cannot remove a %s", entities.ErrForbidden, removerRole, member.Role)
+		}
+	}
+
+	if err := s.organizationsRepo.DeleteMember(ctx, organizationID, userID); err != nil {
+		return fmt.Errorf("delete member: %w", err)
+	}
+
+	return nil
+}
+
+// actorRole returns the role of the user acting on the organization, non-members are forbidden.
+func (s *Service) actorRole(ctx context.Context, organizationID, userID string) (entities.Role, error) {
+	role, err := s.GetMemberRole(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return "", fmt.Errorf("%w: %s is not a member of %s", entities.ErrForbidden, userID, organizationID)
+		}
+		return "", err
+	}
+
+	return role, nil
+}
@@ -0,0 +1,242 @@
+package organization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/organization"
+	"github.com/lever-dev/padel-backend/internal/services/organization/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type MembersSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	orgsRepo  *mocks.MockOrganizationsRepository
+	usersRepo *mocks.MockUsersRepository
+	service   *organization.Service
+}
+
+func TestMembersSuite(t *testing.T) {
+	suite.Run(t, new(MembersSuite))
+}
+
+func (s *MembersSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.orgsRepo = mocks.NewMockOrganizationsRepository(s.ctrl)
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+	s.service = organization.NewService(s.orgsRepo, s.usersRepo)
+}
+
+func (s *MembersSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *MembersSuite) expectRole(userID string, role entities.Role) {
+	s.orgsRepo.EXPECT().
+		GetMember(gomock.Any(), "org-1", userID).
+		Return(&entities.Membership{OrganizationID: "org-1", UserID: userID, Role: role}, nil)
+}
+
+func (s *MembersSuite) TestInviteMember() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		role        entities.Role
+		setupMocks  func()
+		wantErr     error
+		wantCreated bool
+	}{
+		{
+			name: "manager invites staff",
+			role: entities.StaffRole,
+			setupMocks: func() {
+				s.expectRole("inviter", entities.ManagerRole)
+				s.usersRepo.EXPECT().GetByNickname(ctx, "bob").Return(entities.User{ID: "user-bob"}, nil)
+				s.orgsRepo.EXPECT().CreateMember(ctx, gomock.Any()).Return(nil)
+			},
+			wantCreated: true,
+		},
+		{
+			name: "owner invites manager",
+			role: entities.ManagerRole,
+			setupMocks: func() {
+				s.expectRole("inviter", entities.OwnerRole)
+				s.usersRepo.EXPECT().GetByNickname(ctx, "bob").Return(entities.User{ID: "user-bob"}, nil)
+				s.orgsRepo.EXPECT().CreateMember(ctx, gomock.Any()).Return(nil)
+			},
+			wantCreated: true,
+		},
+		{
+			name: "manager cannot invite manager",
+			role: entities.ManagerRole,
+			setupMocks: func() {
+				s.expectRole("inviter", entities.ManagerRole)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name: "staff cannot invite",
+			role: entities.PlayerRole,
+			setupMocks: func() {
+				s.expectRole("inviter", entities.StaffRole)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name: "non member cannot invite",
+			role: entities.PlayerRole,
+			setupMocks: func() {
+				s.orgsRepo.EXPECT().GetMember(ctx, "org-1", "inviter").Return(nil, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:       "unknown role",
+			role:       entities.Role("coach"),
+			setupMocks: func() {},
+			wantErr:    entities.ErrInvalidRole,
+		},
+		{
+			name: "unknown user",
+			role: entities.PlayerRole,
+			setupMocks: func() {
+				s.expectRole("inviter", entities.OwnerRole)
+				s.usersRepo.EXPECT().GetByNickname(ctx, "bob").Return(entities.User{}, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "already a member",
+			role: entities.PlayerRole,
+			setupMocks: func() {
+				s.expectRole("inviter", entities.OwnerRole)
+				s.usersRepo.EXPECT().GetByNickname(ctx, "bob").Return(entities.User{ID: "user-bob"}, nil)
+				s.orgsRepo.EXPECT().CreateMember(ctx, gomock.Any()).Return(entities.ErrMemberAlreadyExist)
+			},
+			wantErr: entities.ErrMemberAlreadyExist,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			member, err := s.service.InviteMember(ctx, "org-1", "inviter", "bob", tt.role)
+			if tt.wantErr != nil {
+				s.Require().Error(err)
+				s.ErrorIs(err, tt.wantErr)
+				s.Nil(member)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal("user-bob", member.UserID)
+			s.Equal(tt.role, member.Role)
+			s.Equal("inviter", member.InvitedBy)
+		})
+	}
+}
+
+func (s *MembersSuite) TestRemoveMember() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		removedBy  string
+		setupMocks func()
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{
+			name:      "member leaves",
+			removedBy: "target",
+			setupMocks: func() {
+				s.orgsRepo.EXPECT().DeleteMember(ctx, "org-1", "target").Return(nil)
+			},
+		},
+		{
+			name:      "last owner cannot leave",
+			removedBy: "target",
+			setupMocks: func() {
+				s.orgsRepo.EXPECT().DeleteMember(ctx, "org-1", "target").Return(entities.ErrLastOwner)
+			},
+			wantErr: entities.ErrLastOwner,
+		},
+		{
+			name:      "manager removes player",
+			removedBy: "remover",
+			setupMocks: func() {
+				s.expectRole("remover", entities.ManagerRole)
+				s.expectRole("target", entities.PlayerRole)
+				s.orgsRepo.EXPECT().DeleteMember(ctx, "org-1", "target").Return(nil)
+			},
+		},
+		{
+			name:      "manager cannot remove manager",
+			removedBy: "remover",
+			setupMocks: func() {
+				s.expectRole("remover", entities.ManagerRole)
+				s.expectRole("target", entities.ManagerRole)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:      "owner removes manager",
+			removedBy: "remover",
+			setupMocks: func() {
+				s.expectRole("remover", entities.OwnerRole)
+				s.expectRole("target", entities.ManagerRole)
+				s.orgsRepo.EXPECT().DeleteMember(ctx, "org-1", "target").Return(nil)
+			},
+		},
+		{
+			name:      "player cannot remove others",
+			removedBy: "remover",
+			setupMocks: func() {
+				s.expectRole("remover", entities.PlayerRole)
+				s.expectRole("target", entities.PlayerRole)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:      "target is not a member",
+			removedBy: "remover",
+			setupMocks: func() {
+				s.expectRole("remover", entities.OwnerRole)
+				s.orgsRepo.EXPECT().GetMember(ctx, "org-1", "target").Return(nil, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name:      "repository error",
+			removedBy: "target",
+			setupMocks: func() {
+				s.orgsRepo.EXPECT().DeleteMember(ctx, "org-1", "target").Return(fmt.Errorf("db error"))
+			},
+			wantAnyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			err := s.service.RemoveMember(ctx, "org-1", tt.removedBy, "target")
+			switch {
+			case tt.wantErr != nil:
+				s.Require().Error(err)
+				s.ErrorIs(err, tt.wantErr)
+			case tt.wantAnyErr:
+				s.Error(err)
+			default:
+				s.NoError(err)
+			}
+		})
+	}
+}
@@ -35,32 +35,46 @@ func (m *MockOrganizationsRepository) EXPECT() *MockOrganizationsRepositoryMockR
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockOrganizationsRepository) Create(ctx context.Context, organization *entities.Organization) error {
+// CreateMember mocks base method.
+func (m *MockOrganizationsRepository) CreateMember(ctx context.Context, member *entities.Membership) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, organization)
+	ret := m.ctrl.Call(m, "CreateMember", ctx, member)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockOrganizationsRepositoryMockRecorder) Create(ctx, organization interface{}) *gomock.Call {
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockOrganizationsRepositoryMockRecorder) CreateMember(ctx, member interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationsRepository)(nil).Create), ctx, organization)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockOrganizationsRepository)(nil).CreateMember), ctx, member)
 }
 
-// Delete mocks base method.
-func (m *MockOrganizationsRepository) Delete(ctx context.Context, id string) error {
+// CreateWithOwner mocks base method.
+func (m *MockOrganizationsRepository) CreateWithOwner(ctx context.Context, organization *entities.Organization, owner *entities.Membership) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "CreateWithOwner", ctx, organization, owner)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockOrganizationsRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+// CreateWithOwner indicates an expected call of CreateWithOwner.
+func (mr *MockOrganizationsRepositoryMockRecorder) CreateWithOwner(ctx, organization, owner interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationsRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithOwner", reflect.TypeOf((*MockOrganizationsRepository)(nil).CreateWithOwner), ctx, organization, owner)
+}
+
+// DeleteMember mocks base method.
+func (m *MockOrganizationsRepository) DeleteMember(ctx context.Context, organizationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockOrganizationsRepositoryMockRecorder) DeleteMember(ctx, organizationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockOrganizationsRepository)(nil).DeleteMember), ctx, organizationID, userID)
 }
 
 // GetByID mocks base method.
@@ -78,6 +92,21 @@ func (mr *MockOrganizationsRepositoryMockRecorder) GetByID(ctx, organizationID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrganizationsRepository)(nil).GetByID), ctx, organizationID)
 }
 
+// GetMember mocks base method.
+func (m *MockOrganizationsRepository) GetMember(ctx context.Context, organizationID, userID string) (*entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(*entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockOrganizationsRepositoryMockRecorder) GetMember(ctx, organizationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockOrganizationsRepository)(nil).GetMember), ctx, organizationID, userID)
+}
+
 // GetOrganizationsByCity mocks base method.
 func (m *MockOrganizationsRepository) GetOrganizationsByCity(ctx context.Context, city string) ([]entities.Organization, error) {
 	m.ctrl.T.Helper()
@@ -93,16 +122,98 @@ func (mr *MockOrganizationsRepositoryMockRecorder) GetOrganizationsByCity(ctx, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsByCity", reflect.TypeOf((*MockOrganizationsRepository)(nil).GetOrganizationsByCity), ctx, city)
 }
 
+// ListMembers mocks base method.
+func (m *MockOrganizationsRepository) ListMembers(ctx context.Context, organizationID string) ([]entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockOrganizationsRepositoryMockRecorder) ListMembers(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockOrganizationsRepository)(nil).ListMembers), ctx, organizationID)
+}
+
+// NearbyOrganizations mocks base method.
+func (m *MockOrganizationsRepository) NearbyOrganizations(ctx context.Context, point entities.GeoPoint, radius float64, limit int) ([]entities.NearbyOrganization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NearbyOrganizations", ctx, point, radius, limit)
+	ret0, _ := ret[0].([]entities.NearbyOrganization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NearbyOrganizations indicates an expected call of NearbyOrganizations.
+func (mr *MockOrganizationsRepositoryMockRecorder) NearbyOrganizations(ctx, point, radius, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NearbyOrganizations", reflect.TypeOf((*MockOrganizationsRepository)(nil).NearbyOrganizations), ctx, point, radius, limit)
+}
+
 // Update mocks base method.
-func (m *MockOrganizationsRepository) Update(ctx context.Context, org *entities.Organization) error {
+func (m *MockOrganizationsRepository) Update(ctx context.Context, update entities.OrganizationUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, org)
+	ret := m.ctrl.Call(m, "Update", ctx, update)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockOrganizationsRepositoryMockRecorder) Update(ctx, org interface{}) *gomock.Call {
+func (mr *MockOrganizationsRepositoryMockRecorder) Update(ctx, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationsRepository)(nil).Update), ctx, update)
+}
+
+// UpdateHours mocks base method.
+func (m *MockOrganizationsRepository) UpdateHours(ctx context.Context, org *entities.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHours", ctx, org)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHours indicates an expected call of UpdateHours.
+func (mr *MockOrganizationsRepositoryMockRecorder) UpdateHours(ctx, org interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHours", reflect.TypeOf((*MockOrganizationsRepository)(nil).UpdateHours), ctx, org)
+}
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByNickname mocks base method.
+func (m *MockUsersRepository) GetByNickname(ctx context.Context, nickname string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByNickname", ctx, nickname)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByNickname indicates an expected call of GetByNickname.
+func (mr *MockUsersRepositoryMockRecorder) GetByNickname(ctx, nickname interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationsRepository)(nil).Update), ctx, org)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNickname", reflect.TypeOf((*MockUsersRepository)(nil).GetByNickname), ctx, nickname)
 }
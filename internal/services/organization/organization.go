@@ -3,22 +3,32 @@ package organization
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
 )
 
 type Service struct {
 	organizationsRepo OrganizationsRepository
+	usersRepo         UsersRepository
 }
 
-func NewService(repo OrganizationsRepository) *Service {
+func NewService(repo OrganizationsRepository, usersRepo UsersRepository) *Service {
 	return &Service{
 		organizationsRepo: repo,
+		usersRepo:         usersRepo,
 	}
 }
 
-func (s *Service) CreateOrganization(ctx context.Context, organization *entities.Organization) error {
-	if err := s.organizationsRepo.Create(ctx, organization); err != nil {
+// CreateOrganization creates the organization and makes ownerID its owner.
+func (s *Service) CreateOrganization(ctx context.Context, organization *entities.Organization, ownerID string) error {
+	if err := validateOrganization(organization); err != nil {
+		return err
+	}
+
+	owner := entities.NewMembership(organization.ID, ownerID, entities.OwnerRole, "")
+
+	if err := s.organizationsRepo.CreateWithOwner(ctx, organization, owner); err != nil {
 		return fmt.Errorf("create organization: %w", err)
 	}
 	return nil
@@ -40,9 +50,105 @@ func (s *Service) GetOrganizationsByCity(ctx context.Context, city string) ([]en
 	return orgs, nil
 }
 
-func (s *Service) UpdateOrganization(ctx context.Context, org *entities.Organization) error {
-	if err := s.organizationsRepo.Update(ctx, org); err != nil {
+// NearbyOrganizations returns at most limit organizations within radius meters of the point, the nearest first.
+func (s *Service) NearbyOrganizations(
+	ctx context.Context,
+	point entities.GeoPoint,
+	radius float64,
+	limit int,
+) ([]entities.NearbyOrganization, error) {
+	if err := point.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := entities.ValidateNearbyRadius(radius); err != nil {
+		return nil, err
+	}
+
+	if err := entities.ValidateNearbyLimit(limit); err != nil {
+		return nil, err
+	}
+
+	orgs, err := s.organizationsRepo.NearbyOrganizations(ctx, point, radius, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get nearby organizations: %w", err)
+	}
+	return orgs, nil
+}
+
+// UpdateOrganization updates the name, the city, the time zone, the address and the coordinates of the
+// organization. The current time zone is kept when the update has none, the address and the coordinates are
+// kept unless the update sets them.
+func (s *Service) UpdateOrganization(ctx context.Context, update entities.OrganizationUpdate) error {
+	if err := validateOrganization(&update.Organization); err != nil {
+		return err
+	}
+
+	if err := s.organizationsRepo.Update(ctx, update); err != nil {
 		return fmt.Errorf("update organization: %w", err)
 	}
 	return nil
 }
+
+// SetOpeningHours replaces the weekly opening hours of the organization. They apply to the courts that have no
+// opening hours of their own.
+func (s *Service) SetOpeningHours(
+	ctx context.Context,
+	orgID string,
+	hours []entities.OpeningHours,
+) (*entities.Organization, error) {
+	if err := entities.ValidateOpeningHours(hours); err != nil {
+		return nil, err
+	}
+
+	return s.updateHours(ctx, orgID, func(org *entities.Organization) {
+		org.OpeningHours = hours
+	})
+}
+
+// SetSpecialHours replaces the holidays and the special opening hours of the organization.
+func (s *Service) SetSpecialHours(
+	ctx context.Context,
+	orgID string,
+	hours []entities.SpecialHours,
+) (*entities.Organization, error) {
+	if err := entities.ValidateSpecialHours(hours); err != nil {
+		return nil, err
+	}
+
+	return s.updateHours(ctx, orgID, func(org *entities.Organization) {
+		org.SpecialHours = hours
+	})
+}
+
+func (s *Service) updateHours(
+	ctx context.Context,
+	orgID string,
+	update func(org *entities.Organization),
+) (*entities.Organization, error) {
+	org, err := s.organizationsRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("get organization: %w", err)
+	}
+
+	update(org)
+	org.UpdatedAt = time.Now().UTC()
+
+	if err := s.organizationsRepo.UpdateHours(ctx, org); err != nil {
+		return nil, fmt.Errorf("update organization hours: %w", err)
+	}
+
+	return org, nil
+}
+
+func validateOrganization(org *entities.Organization) error {
+	if err := entities.ValidateTimeZone(org.TimeZone); err != nil {
+		return err
+	}
+
+	if org.Coordinates != nil {
+		return org.Coordinates.Validate()
+	}
+
+	return nil
+}
@@ -47,7 +47,14 @@ func (s *ServiceSuite) TestCreateOrganization() {
 				UpdatedAt: time.Now().UTC(),
 			},
 			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {
-				mockRepo.EXPECT().Create(gomock.Any(), org).Return(nil)
+				mockRepo.EXPECT().
+					CreateWithOwner(gomock.Any(), org, gomock.AssignableToTypeOf(&entities.Membership{})).
+					DoAndReturn(func(_ context.Context, _ *entities.Organization, owner *entities.Membership) error {
+						s.Equal(org.ID, owner.OrganizationID)
+						s.Equal("user-1", owner.UserID)
+						s.Equal(entities.OwnerRole, owner.Role)
+						return nil
+					})
 			},
 			wantErr: false,
 		},
@@ -61,10 +68,21 @@ func (s *ServiceSuite) TestCreateOrganization() {
 				UpdatedAt: time.Now().UTC(),
 			},
 			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {
-				mockRepo.EXPECT().Create(gomock.Any(), org).Return(fmt.Errorf("db error"))
+				mockRepo.EXPECT().CreateWithOwner(gomock.Any(), org, gomock.Any()).Return(fmt.Errorf("db error"))
 			},
 			wantErr: true,
 		},
+		{
+			name: "coordinates out of range",
+			organization: &entities.Organization{
+				ID:          "org-1",
+				Name:        "Padel Astana",
+				City:        "Astana",
+				Coordinates: &entities.GeoPoint{Latitude: 91, Longitude: 71.43},
+			},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {},
+			wantErr:    true,
+		},
 		{
 			name: "data conflict",
 			organization: &entities.Organization{
@@ -75,7 +93,9 @@ func (s *ServiceSuite) TestCreateOrganization() {
 				UpdatedAt: time.Now().UTC(),
 			},
 			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {
-				mockRepo.EXPECT().Create(gomock.Any(), org).Return(fmt.Errorf("create organization: duplicate key"))
+				mockRepo.EXPECT().
+					CreateWithOwner(gomock.Any(), org, gomock.Any()).
+					Return(fmt.Errorf("create organization: duplicate key"))
 			},
 			wantErr: true,
 		},
@@ -86,11 +106,11 @@ func (s *ServiceSuite) TestCreateOrganization() {
 			ctx := context.Background()
 
 			mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
-			service := organization.NewService(mockRepo)
+			service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
 
 			tt.setupMocks(mockRepo, tt.organization)
 
-			err := service.CreateOrganization(ctx, tt.organization)
+			err := service.CreateOrganization(ctx, tt.organization, "user-1")
 			if tt.wantErr {
 				s.Error(err)
 			} else {
@@ -160,7 +180,7 @@ func (s *ServiceSuite) TestGetOrganization() {
 			ctx := context.Background()
 
 			mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
-			service := organization.NewService(mockRepo)
+			service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
 
 			tt.setupMocks(mockRepo, tt.orgID)
 
@@ -240,7 +260,7 @@ func (s *ServiceSuite) TestGetOrganizationsByCity() {
 			ctx := context.Background()
 
 			mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
-			service := organization.NewService(mockRepo)
+			service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
 
 			tt.setupMocks(mockRepo, tt.city)
 
@@ -260,54 +280,79 @@ func (s *ServiceSuite) TestGetOrganizationsByCity() {
 func (s *ServiceSuite) TestUpdateOrganization() {
 	tests := []struct {
 		name       string
-		org        *entities.Organization
-		setupMocks func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization)
+		update     entities.OrganizationUpdate
+		setupMocks func(mockRepo *mocks.MockOrganizationsRepository, update entities.OrganizationUpdate)
 		wantErr    bool
 	}{
 		{
 			name: "success",
-			org: &entities.Organization{
+			update: entities.OrganizationUpdate{Organization: entities.Organization{
 				ID:        "org-1",
 				Name:      "Updated Name",
 				City:      "Updated City",
 				CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			}},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, update entities.OrganizationUpdate) {
+				mockRepo.EXPECT().
+					Update(gomock.Any(), update).
+					Return(nil)
 			},
-			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {
+			wantErr: false,
+		},
+		{
+			name: "clears the location",
+			update: entities.OrganizationUpdate{
+				Organization:   entities.Organization{ID: "org-1", Name: "Test", City: "Test"},
+				SetAddress:     true,
+				SetCoordinates: true,
+			},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, update entities.OrganizationUpdate) {
 				mockRepo.EXPECT().
-					Update(gomock.Any(), org).
+					Update(gomock.Any(), update).
 					Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "not found",
-			org: &entities.Organization{
+			update: entities.OrganizationUpdate{Organization: entities.Organization{
 				ID:   "non-existent",
 				Name: "Test",
 				City: "Test",
-			},
-			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {
+			}},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, update entities.OrganizationUpdate) {
 				mockRepo.EXPECT().
-					Update(gomock.Any(), org).
+					Update(gomock.Any(), update).
 					Return(entities.ErrNotFound)
 			},
 			wantErr: true,
 		},
 		{
 			name: "db error",
-			org: &entities.Organization{
+			update: entities.OrganizationUpdate{Organization: entities.Organization{
 				ID:   "org-error",
 				Name: "Test",
 				City: "Test",
-			},
-			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, org *entities.Organization) {
+			}},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, update entities.OrganizationUpdate) {
 				mockRepo.EXPECT().
-					Update(gomock.Any(), org).
+					Update(gomock.Any(), update).
 					Return(fmt.Errorf("db error"))
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid time zone",
+			update: entities.OrganizationUpdate{Organization: entities.Organization{
+				ID:       "org-1",
+				Name:     "Test",
+				City:     "Test",
+				TimeZone: "Mars/Olympus",
+			}},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository, update entities.OrganizationUpdate) {},
+			wantErr:    true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -315,11 +360,11 @@ func (s *ServiceSuite) TestUpdateOrganization() {
 			ctx := context.Background()
 
 			mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
-			service := organization.NewService(mockRepo)
+			service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
 
-			tt.setupMocks(mockRepo, tt.org)
+			tt.setupMocks(mockRepo, tt.update)
 
-			err := service.UpdateOrganization(ctx, tt.org)
+			err := service.UpdateOrganization(ctx, tt.update)
 
 			if tt.wantErr {
 				s.Error(err)
@@ -329,3 +374,182 @@ func (s *ServiceSuite) TestUpdateOrganization() {
 		})
 	}
 }
+
+func (s *ServiceSuite) TestSetOpeningHours() {
+	ctx := context.Background()
+
+	mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+	service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
+
+	hours := []entities.OpeningHours{{Weekday: time.Monday, OpensAt: 8 * 60, ClosesAt: 22 * 60}}
+
+	mockRepo.EXPECT().GetByID(ctx, "org-1").Return(&entities.Organization{ID: "org-1"}, nil)
+	mockRepo.EXPECT().
+		UpdateHours(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, org *entities.Organization) error {
+			s.Equal(hours, org.OpeningHours)
+			s.False(org.UpdatedAt.IsZero())
+			return nil
+		})
+
+	org, err := service.SetOpeningHours(ctx, "org-1", hours)
+	s.Require().NoError(err)
+	s.Equal(hours, org.OpeningHours)
+}
+
+func (s *ServiceSuite) TestSetSpecialHours() {
+	ctx := context.Background()
+
+	// 2025-12-31 is a Wednesday.
+	newYearsEve := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		hours      []entities.SpecialHours
+		setupMocks func(mockRepo *mocks.MockOrganizationsRepository)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			hours: []entities.SpecialHours{
+				{Date: newYearsEve.AddDate(0, 0, 1), Reason: "New Year"},
+				{
+					Date:    newYearsEve,
+					Windows: []entities.OpeningHours{{Weekday: time.Wednesday, OpensAt: 8 * 60, ClosesAt: 14 * 60}},
+				},
+			},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {
+				mockRepo.EXPECT().GetByID(ctx, "org-1").Return(&entities.Organization{ID: "org-1"}, nil)
+				mockRepo.EXPECT().UpdateHours(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "window on another weekday",
+			hours: []entities.SpecialHours{
+				{
+					Date:    newYearsEve,
+					Windows: []entities.OpeningHours{{Weekday: time.Monday, OpensAt: 8 * 60, ClosesAt: 14 * 60}},
+				},
+			},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {},
+			wantErr:    entities.ErrInvalidOpeningHours,
+		},
+		{
+			name: "date given twice",
+			hours: []entities.SpecialHours{
+				{Date: newYearsEve, Reason: "Closed"},
+				{Date: newYearsEve, Reason: "Closed again"},
+			},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {},
+			wantErr:    entities.ErrInvalidOpeningHours,
+		},
+		{
+			name:  "organization not found",
+			hours: []entities.SpecialHours{{Date: newYearsEve}},
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {
+				mockRepo.EXPECT().GetByID(ctx, "org-1").Return(nil, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+			service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
+
+			tt.setupMocks(mockRepo)
+
+			org, err := service.SetSpecialHours(ctx, "org-1", tt.hours)
+			if tt.wantErr != nil {
+				s.Require().ErrorIs(err, tt.wantErr)
+				s.Nil(org)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(tt.hours, org.SpecialHours)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestNearbyOrganizations() {
+	ctx := context.Background()
+
+	point := entities.GeoPoint{Latitude: 51.1282, Longitude: 71.4306}
+
+	tests := []struct {
+		name       string
+		point      entities.GeoPoint
+		radius     float64
+		limit      int
+		setupMocks func(mockRepo *mocks.MockOrganizationsRepository)
+		wantLen    int
+		wantErr    error
+	}{
+		{
+			name:   "success",
+			point:  point,
+			radius: 5000,
+			limit:  10,
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {
+				mockRepo.EXPECT().
+					NearbyOrganizations(ctx, point, 5000.0, 10).
+					Return([]entities.NearbyOrganization{
+						{Organization: entities.Organization{ID: "org-1"}, Distance: 150},
+						{Organization: entities.Organization{ID: "org-2"}, Distance: 4200},
+					}, nil)
+			},
+			wantLen: 2,
+		},
+		{
+			name:       "longitude out of range",
+			point:      entities.GeoPoint{Latitude: 51.1282, Longitude: 181},
+			radius:     5000,
+			limit:      10,
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {},
+			wantErr:    entities.ErrInvalidCoordinates,
+		},
+		{
+			name:       "radius too large",
+			point:      point,
+			radius:     entities.MaxNearbyRadius + 1,
+			limit:      10,
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {},
+			wantErr:    entities.ErrInvalidCoordinates,
+		},
+		{
+			name:       "no radius",
+			point:      point,
+			limit:      10,
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {},
+			wantErr:    entities.ErrInvalidCoordinates,
+		},
+		{
+			name:       "limit too high",
+			point:      point,
+			radius:     5000,
+			limit:      entities.MaxNearbyLimit + 1,
+			setupMocks: func(mockRepo *mocks.MockOrganizationsRepository) {},
+			wantErr:    entities.ErrInvalidCoordinates,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			mockRepo := mocks.NewMockOrganizationsRepository(s.ctrl)
+			service := organization.NewService(mockRepo, mocks.NewMockUsersRepository(s.ctrl))
+
+			tt.setupMocks(mockRepo)
+
+			orgs, err := service.NearbyOrganizations(ctx, tt.point, tt.radius, tt.limit)
+			if tt.wantErr != nil {
+				s.Require().ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Len(orgs, tt.wantLen)
+		})
+	}
+}
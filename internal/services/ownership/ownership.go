@@ -0,0 +1,35 @@
+// Package ownership checks that the resources named in a request belong to the organization of its path, so
+// that the services look them up the same way.
+package ownership
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// CourtsRepository is the part of the courts repository the checks need.
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
+
+// Court returns the court when it belongs to the organization. A court of another organization fails with
+// ErrNotFound, like a missing one, so that clubs cannot probe the courts of each other.
+func Court(
+	ctx context.Context,
+	courts CourtsRepository,
+	organizationID, courtID string,
+) (*entities.Court, error) {
+	court, err := courts.GetByID(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	if court.OrganizationID != organizationID {
+		return nil, fmt.Errorf("%w: court %s does not belong to organization %s",
+			entities.ErrNotFound, courtID, organizationID)
+	}
+
+	return court, nil
+}
@@ -0,0 +1,50 @@
+package ownership_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+)
+
+type fakeCourts map[string]*entities.Court
+
+func (f fakeCourts) GetByID(_ context.Context, courtID string) (*entities.Court, error) {
+	court, ok := f[courtID]
+	if !ok {
+		return nil, entities.ErrNotFound
+	}
+
+	return court, nil
+}
+
+func TestCourt(t *testing.T) {
+	courts := fakeCourts{"court-1": {ID: "court-1", OrganizationID: "club-a"}}
+
+	tests := []struct {
+		name           string
+		organizationID string
+		courtID        string
+		wantErr        error
+	}{
+		{name: "own court", organizationID: "club-a", courtID: "court-1"},
+		{name: "court of another club", organizationID: "club-b", courtID: "court-1", wantErr: entities.ErrNotFound},
+		{name: "missing court", organizationID: "club-a", courtID: "court-2", wantErr: entities.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			court, err := ownership.Court(context.Background(), courts, tt.organizationID, tt.courtID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.courtID, court.ID)
+		})
+	}
+}
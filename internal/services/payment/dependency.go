@@ -0,0 +1,78 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package payment
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type PaymentsRepository interface {
+	Create(ctx context.Context, payment *entities.Payment) error
+	GetByID(ctx context.Context, paymentID string) (*entities.Payment, error)
+	GetByProviderRef(ctx context.Context, provider, providerRef string) (*entities.Payment, error)
+	GetActiveByReservationID(ctx context.Context, reservationID string) (*entities.Payment, error)
+	GetActiveByShareID(ctx context.Context, shareID string) (*entities.Payment, error)
+	ListByReservationID(ctx context.Context, reservationID string) ([]entities.Payment, error)
+	ListBySeriesID(ctx context.Context, seriesID string) ([]entities.Payment, error)
+	UpdateStatus(
+		ctx context.Context,
+		paymentID string,
+		from, to entities.PaymentStatus,
+		reason string,
+		now time.Time,
+	) error
+	RecordRefund(
+		ctx context.Context,
+		paymentID string,
+		status entities.PaymentStatus,
+		amount int64,
+		reason string,
+		now time.Time,
+	) error
+	RecordOccurrenceRefund(
+		ctx context.Context,
+		paymentID, reservationID string,
+		amount int64,
+		reason string,
+		now time.Time,
+	) error
+
+	CreateShares(ctx context.Context, shares []entities.PaymentShare) error
+	GetShare(ctx context.Context, shareID string) (*entities.PaymentShare, error)
+	ListShares(ctx context.Context, reservationID string) ([]entities.PaymentShare, error)
+	MarkSharePaid(ctx context.Context, shareID string, now time.Time) error
+	CoverShares(ctx context.Context, reservationID string, now time.Time) (int64, error)
+}
+
+type ReservationsRepository interface {
+	GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error)
+	ConfirmReservation(ctx context.Context, reservationID string, now time.Time) error
+	ReleaseHold(ctx context.Context, reservationID string) error
+
+	GetSeriesByID(ctx context.Context, seriesID string) (*entities.ReservationSeries, error)
+	ListBySeries(ctx context.Context, seriesID string) ([]entities.Reservation, error)
+	ConfirmSeriesHolds(ctx context.Context, seriesID string, now time.Time) (int64, error)
+	ReleaseSeriesHolds(ctx context.Context, seriesID string) error
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
+
+// Roster looks up the players invited to split a reservation the way they are invited to its roster.
+type Roster interface {
+	FindUser(ctx context.Context, invitee entities.CoPlayer) (*entities.User, error)
+}
+
+// PaymentProvider collects money on behalf of the clubs. Payments are settled asynchronously:
+// the provider notifies the outcome of a payment intent through a signed webhook. Refunds carry an
+// idempotency key, the provider refunds requests with the same key only once.
+type PaymentProvider interface {
+	Name() string
+	CreateIntent(ctx context.Context, payment *entities.Payment) (*entities.PaymentIntent, error)
+	ParseWebhook(payload []byte, signature string) (*entities.PaymentNotification, error)
+	Refund(ctx context.Context, ref string, amount int64, idempotencyKey string) error
+}
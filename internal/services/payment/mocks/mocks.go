@@ -0,0 +1,538 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/payment/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockPaymentsRepository is a mock of PaymentsRepository interface.
+type MockPaymentsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentsRepositoryMockRecorder
+}
+
+// MockPaymentsRepositoryMockRecorder is the mock recorder for MockPaymentsRepository.
+type MockPaymentsRepositoryMockRecorder struct {
+	mock *MockPaymentsRepository
+}
+
+// NewMockPaymentsRepository creates a new mock instance.
+func NewMockPaymentsRepository(ctrl *gomock.Controller) *MockPaymentsRepository {
+	mock := &MockPaymentsRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentsRepository) EXPECT() *MockPaymentsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CoverShares mocks base method.
+func (m *MockPaymentsRepository) CoverShares(ctx context.Context, reservationID string, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CoverShares", ctx, reservationID, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CoverShares indicates an expected call of CoverShares.
+func (mr *MockPaymentsRepositoryMockRecorder) CoverShares(ctx, reservationID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CoverShares", reflect.TypeOf((*MockPaymentsRepository)(nil).CoverShares), ctx, reservationID, now)
+}
+
+// Create mocks base method.
+func (m *MockPaymentsRepository) Create(ctx context.Context, payment *entities.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentsRepositoryMockRecorder) Create(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentsRepository)(nil).Create), ctx, payment)
+}
+
+// CreateShares mocks base method.
+func (m *MockPaymentsRepository) CreateShares(ctx context.Context, shares []entities.PaymentShare) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShares", ctx, shares)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShares indicates an expected call of CreateShares.
+func (mr *MockPaymentsRepositoryMockRecorder) CreateShares(ctx, shares interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShares", reflect.TypeOf((*MockPaymentsRepository)(nil).CreateShares), ctx, shares)
+}
+
+// GetActiveByReservationID mocks base method.
+func (m *MockPaymentsRepository) GetActiveByReservationID(ctx context.Context, reservationID string) (*entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByReservationID indicates an expected call of GetActiveByReservationID.
+func (mr *MockPaymentsRepositoryMockRecorder) GetActiveByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByReservationID", reflect.TypeOf((*MockPaymentsRepository)(nil).GetActiveByReservationID), ctx, reservationID)
+}
+
+// GetActiveByShareID mocks base method.
+func (m *MockPaymentsRepository) GetActiveByShareID(ctx context.Context, shareID string) (*entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByShareID", ctx, shareID)
+	ret0, _ := ret[0].(*entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByShareID indicates an expected call of GetActiveByShareID.
+func (mr *MockPaymentsRepositoryMockRecorder) GetActiveByShareID(ctx, shareID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByShareID", reflect.TypeOf((*MockPaymentsRepository)(nil).GetActiveByShareID), ctx, shareID)
+}
+
+// GetByID mocks base method.
+func (m *MockPaymentsRepository) GetByID(ctx context.Context, paymentID string) (*entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, paymentID)
+	ret0, _ := ret[0].(*entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPaymentsRepositoryMockRecorder) GetByID(ctx, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPaymentsRepository)(nil).GetByID), ctx, paymentID)
+}
+
+// GetByProviderRef mocks base method.
+func (m *MockPaymentsRepository) GetByProviderRef(ctx context.Context, provider, providerRef string) (*entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProviderRef", ctx, provider, providerRef)
+	ret0, _ := ret[0].(*entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProviderRef indicates an expected call of GetByProviderRef.
+func (mr *MockPaymentsRepositoryMockRecorder) GetByProviderRef(ctx, provider, providerRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProviderRef", reflect.TypeOf((*MockPaymentsRepository)(nil).GetByProviderRef), ctx, provider, providerRef)
+}
+
+// GetShare mocks base method.
+func (m *MockPaymentsRepository) GetShare(ctx context.Context, shareID string) (*entities.PaymentShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShare", ctx, shareID)
+	ret0, _ := ret[0].(*entities.PaymentShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShare indicates an expected call of GetShare.
+func (mr *MockPaymentsRepositoryMockRecorder) GetShare(ctx, shareID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShare", reflect.TypeOf((*MockPaymentsRepository)(nil).GetShare), ctx, shareID)
+}
+
+// ListByReservationID mocks base method.
+func (m *MockPaymentsRepository) ListByReservationID(ctx context.Context, reservationID string) ([]entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByReservationID indicates an expected call of ListByReservationID.
+func (mr *MockPaymentsRepositoryMockRecorder) ListByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReservationID", reflect.TypeOf((*MockPaymentsRepository)(nil).ListByReservationID), ctx, reservationID)
+}
+
+// ListBySeriesID mocks base method.
+func (m *MockPaymentsRepository) ListBySeriesID(ctx context.Context, seriesID string) ([]entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySeriesID", ctx, seriesID)
+	ret0, _ := ret[0].([]entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySeriesID indicates an expected call of ListBySeriesID.
+func (mr *MockPaymentsRepositoryMockRecorder) ListBySeriesID(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySeriesID", reflect.TypeOf((*MockPaymentsRepository)(nil).ListBySeriesID), ctx, seriesID)
+}
+
+// ListShares mocks base method.
+func (m *MockPaymentsRepository) ListShares(ctx context.Context, reservationID string) ([]entities.PaymentShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShares", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.PaymentShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShares indicates an expected call of ListShares.
+func (mr *MockPaymentsRepositoryMockRecorder) ListShares(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShares", reflect.TypeOf((*MockPaymentsRepository)(nil).ListShares), ctx, reservationID)
+}
+
+// MarkSharePaid mocks base method.
+func (m *MockPaymentsRepository) MarkSharePaid(ctx context.Context, shareID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSharePaid", ctx, shareID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSharePaid indicates an expected call of MarkSharePaid.
+func (mr *MockPaymentsRepositoryMockRecorder) MarkSharePaid(ctx, shareID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSharePaid", reflect.TypeOf((*MockPaymentsRepository)(nil).MarkSharePaid), ctx, shareID, now)
+}
+
+// RecordOccurrenceRefund mocks base method.
+func (m *MockPaymentsRepository) RecordOccurrenceRefund(ctx context.Context, paymentID, reservationID string, amount int64, reason string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOccurrenceRefund", ctx, paymentID, reservationID, amount, reason, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOccurrenceRefund indicates an expected call of RecordOccurrenceRefund.
+func (mr *MockPaymentsRepositoryMockRecorder) RecordOccurrenceRefund(ctx, paymentID, reservationID, amount, reason, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOccurrenceRefund", reflect.TypeOf((*MockPaymentsRepository)(nil).RecordOccurrenceRefund), ctx, paymentID, reservationID, amount, reason, now)
+}
+
+// RecordRefund mocks base method.
+func (m *MockPaymentsRepository) RecordRefund(ctx context.Context, paymentID string, status entities.PaymentStatus, amount int64, reason string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRefund", ctx, paymentID, status, amount, reason, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordRefund indicates an expected call of RecordRefund.
+func (mr *MockPaymentsRepositoryMockRecorder) RecordRefund(ctx, paymentID, status, amount, reason, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRefund", reflect.TypeOf((*MockPaymentsRepository)(nil).RecordRefund), ctx, paymentID, status, amount, reason, now)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPaymentsRepository) UpdateStatus(ctx context.Context, paymentID string, from, to entities.PaymentStatus, reason string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, paymentID, from, to, reason, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPaymentsRepositoryMockRecorder) UpdateStatus(ctx, paymentID, from, to, reason, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPaymentsRepository)(nil).UpdateStatus), ctx, paymentID, from, to, reason, now)
+}
+
+// MockReservationsRepository is a mock of ReservationsRepository interface.
+type MockReservationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsRepositoryMockRecorder
+}
+
+// MockReservationsRepositoryMockRecorder is the mock recorder for MockReservationsRepository.
+type MockReservationsRepositoryMockRecorder struct {
+	mock *MockReservationsRepository
+}
+
+// NewMockReservationsRepository creates a new mock instance.
+func NewMockReservationsRepository(ctrl *gomock.Controller) *MockReservationsRepository {
+	mock := &MockReservationsRepository{ctrl: ctrl}
+	mock.recorder = &MockReservationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsRepository) EXPECT() *MockReservationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmReservation mocks base method.
+func (m *MockReservationsRepository) ConfirmReservation(ctx context.Context, reservationID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmReservation", ctx, reservationID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmReservation indicates an expected call of ConfirmReservation.
+func (mr *MockReservationsRepositoryMockRecorder) ConfirmReservation(ctx, reservationID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReservation", reflect.TypeOf((*MockReservationsRepository)(nil).ConfirmReservation), ctx, reservationID, now)
+}
+
+// ConfirmSeriesHolds mocks base method.
+func (m *MockReservationsRepository) ConfirmSeriesHolds(ctx context.Context, seriesID string, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmSeriesHolds", ctx, seriesID, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmSeriesHolds indicates an expected call of ConfirmSeriesHolds.
+func (mr *MockReservationsRepositoryMockRecorder) ConfirmSeriesHolds(ctx, seriesID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmSeriesHolds", reflect.TypeOf((*MockReservationsRepository)(nil).ConfirmSeriesHolds), ctx, seriesID, now)
+}
+
+// GetByID mocks base method.
+func (m *MockReservationsRepository) GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReservationsRepositoryMockRecorder) GetByID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetByID), ctx, reservationID)
+}
+
+// GetSeriesByID mocks base method.
+func (m *MockReservationsRepository) GetSeriesByID(ctx context.Context, seriesID string) (*entities.ReservationSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesByID", ctx, seriesID)
+	ret0, _ := ret[0].(*entities.ReservationSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesByID indicates an expected call of GetSeriesByID.
+func (mr *MockReservationsRepositoryMockRecorder) GetSeriesByID(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetSeriesByID), ctx, seriesID)
+}
+
+// ListBySeries mocks base method.
+func (m *MockReservationsRepository) ListBySeries(ctx context.Context, seriesID string) ([]entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySeries", ctx, seriesID)
+	ret0, _ := ret[0].([]entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySeries indicates an expected call of ListBySeries.
+func (mr *MockReservationsRepositoryMockRecorder) ListBySeries(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySeries", reflect.TypeOf((*MockReservationsRepository)(nil).ListBySeries), ctx, seriesID)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockReservationsRepository) ReleaseHold(ctx context.Context, reservationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, reservationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockReservationsRepositoryMockRecorder) ReleaseHold(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockReservationsRepository)(nil).ReleaseHold), ctx, reservationID)
+}
+
+// ReleaseSeriesHolds mocks base method.
+func (m *MockReservationsRepository) ReleaseSeriesHolds(ctx context.Context, seriesID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSeriesHolds", ctx, seriesID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSeriesHolds indicates an expected call of ReleaseSeriesHolds.
+func (mr *MockReservationsRepositoryMockRecorder) ReleaseSeriesHolds(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSeriesHolds", reflect.TypeOf((*MockReservationsRepository)(nil).ReleaseSeriesHolds), ctx, seriesID)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// MockRoster is a mock of Roster interface.
+type MockRoster struct {
+	ctrl     *gomock.Controller
+	recorder *MockRosterMockRecorder
+}
+
+// MockRosterMockRecorder is the mock recorder for MockRoster.
+type MockRosterMockRecorder struct {
+	mock *MockRoster
+}
+
+// NewMockRoster creates a new mock instance.
+func NewMockRoster(ctrl *gomock.Controller) *MockRoster {
+	mock := &MockRoster{ctrl: ctrl}
+	mock.recorder = &MockRosterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoster) EXPECT() *MockRosterMockRecorder {
+	return m.recorder
+}
+
+// FindUser mocks base method.
+func (m *MockRoster) FindUser(ctx context.Context, invitee entities.CoPlayer) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUser", ctx, invitee)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUser indicates an expected call of FindUser.
+func (mr *MockRosterMockRecorder) FindUser(ctx, invitee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUser", reflect.TypeOf((*MockRoster)(nil).FindUser), ctx, invitee)
+}
+
+// MockPaymentProvider is a mock of PaymentProvider interface.
+type MockPaymentProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentProviderMockRecorder
+}
+
+// MockPaymentProviderMockRecorder is the mock recorder for MockPaymentProvider.
+type MockPaymentProviderMockRecorder struct {
+	mock *MockPaymentProvider
+}
+
+// NewMockPaymentProvider creates a new mock instance.
+func NewMockPaymentProvider(ctrl *gomock.Controller) *MockPaymentProvider {
+	mock := &MockPaymentProvider{ctrl: ctrl}
+	mock.recorder = &MockPaymentProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentProvider) EXPECT() *MockPaymentProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateIntent mocks base method.
+func (m *MockPaymentProvider) CreateIntent(ctx context.Context, payment *entities.Payment) (*entities.PaymentIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIntent", ctx, payment)
+	ret0, _ := ret[0].(*entities.PaymentIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIntent indicates an expected call of CreateIntent.
+func (mr *MockPaymentProviderMockRecorder) CreateIntent(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIntent", reflect.TypeOf((*MockPaymentProvider)(nil).CreateIntent), ctx, payment)
+}
+
+// Name mocks base method.
+func (m *MockPaymentProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockPaymentProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentProvider)(nil).Name))
+}
+
+// ParseWebhook mocks base method.
+func (m *MockPaymentProvider) ParseWebhook(payload []byte, signature string) (*entities.PaymentNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWebhook", payload, signature)
+	ret0, _ := ret[0].(*entities.PaymentNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWebhook indicates an expected call of ParseWebhook.
+func (mr *MockPaymentProviderMockRecorder) ParseWebhook(payload, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWebhook", reflect.TypeOf((*MockPaymentProvider)(nil).ParseWebhook), payload, signature)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentProvider) Refund(ctx context.Context, ref string, amount int64, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, ref, amount, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentProviderMockRecorder) Refund(ctx, ref, amount, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentProvider)(nil).Refund), ctx, ref, amount, idempotencyKey)
+}
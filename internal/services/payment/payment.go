@@ -0,0 +1,467 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	paymentsRepo     PaymentsRepository
+	reservationsRepo ReservationsRepository
+	courtsRepo       CourtsRepository
+	roster           Roster
+	provider         PaymentProvider
+	clock            clock.Clock
+}
+
+func NewService(
+	paymentsRepo PaymentsRepository,
+	reservationsRepo ReservationsRepository,
+	courtsRepo CourtsRepository,
+	roster Roster,
+	provider PaymentProvider,
+	clock clock.Clock,
+) *Service {
+	return &Service{
+		paymentsRepo:     paymentsRepo,
+		reservationsRepo: reservationsRepo,
+		courtsRepo:       courtsRepo,
+		roster:           roster,
+		provider:         provider,
+		clock:            clock,
+	}
+}
+
+// PayReservation opens a payment for the price of the pending reservation. When the reservation is split,
+// the booker covers whatever the other players have not paid yet. A payment that is still pending is
+// returned as is, so the client can retry without paying twice.
+func (s *Service) PayReservation(
+	ctx context.Context,
+	courtID, reservationID string,
+	userID string,
+) (*entities.Payment, error) {
+	rsv, err := s.getHold(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsv.ReservedBy != userID {
+		return nil, fmt.Errorf("%w: reservation %s was booked by another user", entities.ErrForbidden, reservationID)
+	}
+
+	existing, err := s.paymentsRepo.GetActiveByReservationID(ctx, reservationID)
+	if err == nil {
+		return existing, nil
+	}
+
+	if !errors.Is(err, entities.ErrNotFound) {
+		return nil, fmt.Errorf("get active payment: %w", err)
+	}
+
+	shares, err := s.paymentsRepo.ListShares(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("list shares: %w", err)
+	}
+
+	payment := entities.NewPayment(rsv, s.provider.Name(), s.clock.Now())
+
+	if len(shares) > 0 {
+		payment.Amount = entities.OutstandingAmount(shares)
+		if payment.Amount == 0 {
+			return nil, fmt.Errorf("%w: every share of reservation %s is paid", entities.ErrNothingToPay, reservationID)
+		}
+	}
+
+	return s.open(ctx, payment)
+}
+
+// getHold returns the reservation on the court as long as it is a priced hold that can still be paid.
+func (s *Service) getHold(ctx context.Context, courtID, reservationID string) (*entities.Reservation, error) {
+	rsv, err := s.reservationsRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	if rsv.CourtID != courtID {
+		return nil, fmt.Errorf("%w: reservation %s is not on court %s", entities.ErrNotFound, reservationID, courtID)
+	}
+
+	if rsv.Status != entities.PendingReservationStatus {
+		return nil, fmt.Errorf("%w: reservation %s is %s", entities.ErrReservationNotPending, reservationID, rsv.Status)
+	}
+
+	if rsv.IsHoldExpired(s.clock.Now()) {
+		return nil, fmt.Errorf("%w: reservation %s expired at %s",
+			entities.ErrReservationHoldExpired, reservationID, rsv.ExpiresAt)
+	}
+
+	if rsv.Price.IsZero() || rsv.Price.Amount == 0 {
+		return nil, fmt.Errorf("%w: reservation %s", entities.ErrNothingToPay, reservationID)
+	}
+
+	if rsv.SeriesID != "" {
+		return nil, fmt.Errorf("%w: reservation %s belongs to series %s",
+			entities.ErrPaidWithSeries, reservationID, rsv.SeriesID)
+	}
+
+	return rsv, nil
+}
+
+// PaySeries opens one payment for the price of every hold of the series, so a season is paid at once and its
+// holds are confirmed together. A payment that is still pending is returned as is, so the client can retry
+// without paying twice.
+func (s *Service) PaySeries(ctx context.Context, courtID, seriesID, userID string) (*entities.Payment, error) {
+	series, err := s.reservationsRepo.GetSeriesByID(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("get series by id: %w", err)
+	}
+
+	if series.CourtID != courtID {
+		return nil, fmt.Errorf("%w: series %s is not on court %s", entities.ErrNotFound, seriesID, courtID)
+	}
+
+	if series.ReservedBy != userID {
+		return nil, fmt.Errorf("%w: series %s was booked by another user", entities.ErrForbidden, seriesID)
+	}
+
+	payments, err := s.paymentsRepo.ListBySeriesID(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("list series payments: %w", err)
+	}
+
+	for i := range payments {
+		if payments[i].Status == entities.PendingPaymentStatus {
+			return &payments[i], nil
+		}
+	}
+
+	occurrences, err := s.reservationsRepo.ListBySeries(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("list reservations by series: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	var holds []entities.Reservation
+
+	for _, rsv := range occurrences {
+		if rsv.Status == entities.PendingReservationStatus && !rsv.IsHoldExpired(now) && rsv.Price.Amount > 0 {
+			holds = append(holds, rsv)
+		}
+	}
+
+	if len(holds) == 0 {
+		return nil, fmt.Errorf("%w: series %s has no holds left to pay", entities.ErrNothingToPay, seriesID)
+	}
+
+	return s.open(ctx, entities.NewSeriesPayment(series, holds, s.provider.Name(), now))
+}
+
+// open creates the payment intent with the provider and stores the payment.
+func (s *Service) open(ctx context.Context, payment *entities.Payment) (*entities.Payment, error) {
+	intent, err := s.provider.CreateIntent(ctx, payment)
+	if err != nil {
+		return nil, fmt.Errorf("create payment intent: %w", err)
+	}
+
+	payment.ProviderRef = intent.Ref
+	payment.ClientSecret = intent.ClientSecret
+
+	if err := s.paymentsRepo.Create(ctx, payment); err != nil {
+		return nil, fmt.Errorf("create payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// GetPayment returns the payment with its status history. Only the user who pays may see it.
+func (s *Service) GetPayment(ctx context.Context, paymentID, userID string) (*entities.Payment, error) {
+	payment, err := s.paymentsRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("get payment by id: %w", err)
+	}
+
+	if payment.UserID != userID {
+		return nil, fmt.Errorf("%w: payment %s belongs to another user", entities.ErrNotFound, paymentID)
+	}
+
+	return payment, nil
+}
+
+// HandleWebhook settles the payment the provider notified about. Once the reservation is paid in full
+// it is confirmed, a failed payment of the booker releases the slot. Repeated notifications are ignored.
+func (s *Service) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	notification, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		return fmt.Errorf("parse webhook: %w", err)
+	}
+
+	payment, err := s.paymentsRepo.GetByProviderRef(ctx, s.provider.Name(), notification.Ref)
+	if err != nil {
+		return fmt.Errorf("get payment by provider ref: %w", err)
+	}
+
+	if payment.Status == notification.Status {
+		return nil
+	}
+
+	now := s.clock.Now()
+
+	err = s.paymentsRepo.UpdateStatus(
+		ctx,
+		payment.ID,
+		entities.PendingPaymentStatus,
+		notification.Status,
+		notification.Reason,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("update payment status: %w", err)
+	}
+
+	payment.Status = notification.Status
+
+	switch notification.Status {
+	case entities.SucceededPaymentStatus:
+		return s.settle(ctx, payment, now)
+	case entities.FailedPaymentStatus:
+		return s.release(ctx, payment)
+	}
+
+	return nil
+}
+
+// settle books a succeeded payment. A payment of the booker covers every share that is still unpaid,
+// a share payment settles its own share. The reservation is confirmed once nothing is owed anymore.
+func (s *Service) settle(ctx context.Context, payment *entities.Payment, now time.Time) error {
+	if payment.SeriesID != "" {
+		return s.settleSeries(ctx, payment, now)
+	}
+
+	if payment.ShareID != "" {
+		paidInFull, err := s.settleShare(ctx, payment, now)
+		if err != nil || !paidInFull {
+			return err
+		}
+	} else if _, err := s.paymentsRepo.CoverShares(ctx, payment.ReservationID, now); err != nil {
+		return fmt.Errorf("cover shares: %w", err)
+	}
+
+	err := s.reservationsRepo.ConfirmReservation(ctx, payment.ReservationID, now)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, entities.ErrReservationNotPending) {
+		return fmt.Errorf("confirm reservation: %w", err)
+	}
+
+	// The hold ran out or was cancelled before the money arrived, the slot may be taken by now.
+	log.Warn().
+		Str("payment_id", payment.ID).
+		Str("reservation_id", payment.ReservationID).
+		Msg("payment succeeded after the reservation was released, refunding")
+
+	return s.refund(ctx, payment, payment.Amount, "reservation was released before the payment succeeded")
+}
+
+// settleSeries confirms the holds of the series the payment covers. The price of the holds that were
+// released or cancelled before the money arrived is refunded.
+func (s *Service) settleSeries(ctx context.Context, payment *entities.Payment, now time.Time) error {
+	confirmed, err := s.reservationsRepo.ConfirmSeriesHolds(ctx, payment.SeriesID, now)
+	if err != nil {
+		return fmt.Errorf("confirm series holds: %w", err)
+	}
+
+	if confirmed >= payment.Amount {
+		return nil
+	}
+
+	log.Warn().
+		Str("payment_id", payment.ID).
+		Str("series_id", payment.SeriesID).
+		Int64("confirmed", confirmed).
+		Msg("series payment succeeded after some of its holds were released, refunding them")
+
+	return s.refund(ctx, payment, payment.Amount-confirmed, "holds were released before the payment succeeded")
+}
+
+// settleShare marks the share of the payment as paid and reports whether the reservation is paid in full.
+// A share that arrives after the hold was released, or while the booker covers the rest, is refunded.
+func (s *Service) settleShare(ctx context.Context, payment *entities.Payment, now time.Time) (bool, error) {
+	rsv, err := s.reservationsRepo.GetByID(ctx, payment.ReservationID)
+	if err != nil {
+		return false, fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	if rsv.Status != entities.PendingReservationStatus {
+		return false, s.refund(ctx, payment, payment.Amount, "reservation was released before the share was paid")
+	}
+
+	_, err = s.paymentsRepo.GetActiveByReservationID(ctx, payment.ReservationID)
+	if err == nil {
+		return false, s.refund(ctx, payment, payment.Amount, "share is covered by the booker")
+	}
+
+	if !errors.Is(err, entities.ErrNotFound) {
+		return false, fmt.Errorf("get active payment: %w", err)
+	}
+
+	err = s.paymentsRepo.MarkSharePaid(ctx, payment.ShareID, now)
+	if errors.Is(err, entities.ErrInvalidPaymentTransition) {
+		return false, s.refund(ctx, payment, payment.Amount, "share is covered by the booker")
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("mark share paid: %w", err)
+	}
+
+	shares, err := s.paymentsRepo.ListShares(ctx, payment.ReservationID)
+	if err != nil {
+		return false, fmt.Errorf("list shares: %w", err)
+	}
+
+	return entities.OutstandingAmount(shares) == 0, nil
+}
+
+// release gives the slot back when the payment of the booker fails, or every slot of the series for a
+// series payment. On a split reservation the other players may still pay their shares, so the hold is kept
+// until it expires.
+func (s *Service) release(ctx context.Context, payment *entities.Payment) error {
+	if payment.ShareID != "" {
+		return nil
+	}
+
+	if payment.SeriesID != "" {
+		if err := s.reservationsRepo.ReleaseSeriesHolds(ctx, payment.SeriesID); err != nil {
+			return fmt.Errorf("release series holds: %w", err)
+		}
+		return nil
+	}
+
+	shares, err := s.paymentsRepo.ListShares(ctx, payment.ReservationID)
+	if err != nil {
+		return fmt.Errorf("list shares: %w", err)
+	}
+
+	if len(shares) > 0 {
+		return nil
+	}
+
+	err = s.reservationsRepo.ReleaseHold(ctx, payment.ReservationID)
+	if err != nil && !errors.Is(err, entities.ErrReservationNotPending) {
+		return fmt.Errorf("release hold: %w", err)
+	}
+
+	return nil
+}
+
+// RefundReservation refunds refundPercent of every settled payment of a cancelled or released reservation,
+// the shares of a split reservation included. An occurrence of a series gets refundPercent of its price back
+// from the payment of the series. Reservations without a settled payment have nothing to refund, and payments
+// the club keeps entirely are left settled.
+func (s *Service) RefundReservation(ctx context.Context, reservationID string, refundPercent int) error {
+	if refundPercent <= 0 {
+		return nil
+	}
+
+	rsv, err := s.reservationsRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		return fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	payments, err := s.paymentsRepo.ListByReservationID(ctx, reservationID)
+	if err != nil {
+		return fmt.Errorf("list payments: %w", err)
+	}
+
+	reason := "reservation cancelled"
+	if refundPercent < 100 {
+		reason = fmt.Sprintf("reservation cancelled, %d%% refunded", refundPercent)
+	}
+
+	if rsv.SeriesID != "" {
+		if err := s.refundOccurrence(ctx, rsv, refundPercent, reason); err != nil {
+			return err
+		}
+	}
+
+	for i := range payments {
+		if payments[i].Status != entities.SucceededPaymentStatus {
+			continue
+		}
+
+		amount := payments[i].Amount * int64(refundPercent) / 100
+		if err := s.refund(ctx, &payments[i], amount, reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundOccurrence gives refundPercent of the price of the occurrence back from the settled payment of its
+// series. The provider is asked with the refund key of the occurrence and the refund is recorded once per
+// occurrence, so a retried refund is paid out once.
+func (s *Service) refundOccurrence(
+	ctx context.Context,
+	rsv *entities.Reservation,
+	refundPercent int,
+	reason string,
+) error {
+	amount := rsv.Price.Amount * int64(refundPercent) / 100
+	if amount == 0 {
+		return nil
+	}
+
+	payments, err := s.paymentsRepo.ListBySeriesID(ctx, rsv.SeriesID)
+	if err != nil {
+		return fmt.Errorf("list series payments: %w", err)
+	}
+
+	for _, payment := range payments {
+		if payment.Status != entities.SucceededPaymentStatus &&
+			payment.Status != entities.PartiallyRefundedPaymentStatus {
+			continue
+		}
+
+		if err := s.provider.Refund(ctx, payment.ProviderRef, amount, payment.OccurrenceRefundKey(rsv.ID)); err != nil {
+			return fmt.Errorf("refund payment: %w", err)
+		}
+
+		err := s.paymentsRepo.RecordOccurrenceRefund(ctx, payment.ID, rsv.ID, amount, reason, s.clock.Now())
+		if err != nil {
+			return fmt.Errorf("record occurrence refund: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// refund gives amount of the succeeded payment back. The provider is asked with the refund key of the
+// payment, so a refund retried after the bookkeeping failed, or racing another one, is paid out once.
+func (s *Service) refund(ctx context.Context, payment *entities.Payment, amount int64, reason string) error {
+	if err := s.provider.Refund(ctx, payment.ProviderRef, amount, payment.RefundKey()); err != nil {
+		return fmt.Errorf("refund payment: %w", err)
+	}
+
+	status := entities.RefundedPaymentStatus
+	if amount < payment.Amount {
+		status = entities.PartiallyRefundedPaymentStatus
+	}
+
+	if err := s.paymentsRepo.RecordRefund(ctx, payment.ID, status, amount, reason, s.clock.Now()); err != nil {
+		return fmt.Errorf("record refund: %w", err)
+	}
+
+	payment.Status = status
+	payment.RefundedAmount = amount
+
+	return nil
+}
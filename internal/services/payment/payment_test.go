@@ -0,0 +1,467 @@
+package payment_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/payment"
+	"github.com/lever-dev/padel-backend/internal/services/payment/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	paymentsRepo     *mocks.MockPaymentsRepository
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	roster           *mocks.MockRoster
+	provider         *mocks.MockPaymentProvider
+	service          *payment.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+var paymentNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.paymentsRepo = mocks.NewMockPaymentsRepository(s.ctrl)
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.roster = mocks.NewMockRoster(s.ctrl)
+	s.provider = mocks.NewMockPaymentProvider(s.ctrl)
+	s.provider.EXPECT().Name().Return("fake").AnyTimes()
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(paymentNow).AnyTimes()
+
+	s.service = payment.NewService(s.paymentsRepo, s.reservationsRepo, s.courtsRepo, s.roster, s.provider, clock)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func hold() *entities.Reservation {
+	return &entities.Reservation{
+		ID:         "res-1",
+		CourtID:    "court-1",
+		ReservedBy: "user-1",
+		Status:     entities.PendingReservationStatus,
+		ExpiresAt:  paymentNow.Add(5 * time.Minute),
+		Price:      entities.Price{Amount: 3000, Currency: "EUR"},
+	}
+}
+
+func pendingPayment() *entities.Payment {
+	return &entities.Payment{
+		ID:            "pay-1",
+		ReservationID: "res-1",
+		UserID:        "user-1",
+		Amount:        3000,
+		Currency:      "EUR",
+		Status:        entities.PendingPaymentStatus,
+		Provider:      "fake",
+		ProviderRef:   "fake_pi_pay-1",
+	}
+}
+
+// seriesPayment is a pending payment of the two 30 EUR holds of series-1.
+func seriesPayment() *entities.Payment {
+	return &entities.Payment{
+		ID:          "pay-1",
+		SeriesID:    "series-1",
+		UserID:      "user-1",
+		Amount:      6000,
+		Currency:    "EUR",
+		Status:      entities.PendingPaymentStatus,
+		Provider:    "fake",
+		ProviderRef: "fake_pi_pay-1",
+	}
+}
+
+func (s *ServiceSuite) TestPayReservation() {
+	ctx := context.Background()
+
+	s.Run("creates a payment intent", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.provider.EXPECT().
+			CreateIntent(ctx, gomock.Any()).
+			Return(&entities.PaymentIntent{Ref: "fake_pi_1", ClientSecret: "secret"}, nil)
+		s.paymentsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		p, err := s.service.PayReservation(ctx, "court-1", "res-1", "user-1")
+		s.Require().NoError(err)
+		s.Equal("res-1", p.ReservationID)
+		s.Equal(int64(3000), p.Amount)
+		s.Equal("EUR", p.Currency)
+		s.Equal(entities.PendingPaymentStatus, p.Status)
+		s.Equal("fake", p.Provider)
+		s.Equal("fake_pi_1", p.ProviderRef)
+		s.Equal("secret", p.ClientSecret)
+		s.Equal([]entities.PaymentEvent{{Status: entities.PendingPaymentStatus, CreatedAt: paymentNow}}, p.History)
+	})
+
+	s.Run("returns the pending payment", func() {
+		existing := pendingPayment()
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(existing, nil)
+
+		p, err := s.service.PayReservation(ctx, "court-1", "res-1", "user-1")
+		s.Require().NoError(err)
+		s.Equal(existing, p)
+	})
+
+	tests := []struct {
+		name    string
+		mutate  func(rsv *entities.Reservation)
+		wantErr error
+	}{
+		{
+			name:    "another court",
+			mutate:  func(rsv *entities.Reservation) { rsv.CourtID = "court-2" },
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name:    "another user",
+			mutate:  func(rsv *entities.Reservation) { rsv.ReservedBy = "user-2" },
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:    "already reserved",
+			mutate:  func(rsv *entities.Reservation) { rsv.Status = entities.ReservedReservationStatus },
+			wantErr: entities.ErrReservationNotPending,
+		},
+		{
+			name:    "hold expired",
+			mutate:  func(rsv *entities.Reservation) { rsv.ExpiresAt = paymentNow },
+			wantErr: entities.ErrReservationHoldExpired,
+		},
+		{
+			name:    "unpriced",
+			mutate:  func(rsv *entities.Reservation) { rsv.Price = entities.Price{} },
+			wantErr: entities.ErrNothingToPay,
+		},
+		{
+			name:    "occurrence of a series",
+			mutate:  func(rsv *entities.Reservation) { rsv.SeriesID = "series-1" },
+			wantErr: entities.ErrPaidWithSeries,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rsv := hold()
+			tt.mutate(rsv)
+
+			s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+
+			_, err := s.service.PayReservation(ctx, "court-1", "res-1", "user-1")
+			s.ErrorIs(err, tt.wantErr)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestHandleWebhook() {
+	ctx := context.Background()
+	payload := []byte(`{}`)
+
+	notify := func(status entities.PaymentStatus) {
+		s.provider.EXPECT().
+			ParseWebhook(payload, "sig").
+			Return(&entities.PaymentNotification{Ref: "fake_pi_pay-1", Status: status}, nil)
+	}
+
+	s.Run("success confirms the reservation", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(pendingPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", paymentNow).
+			Return(nil)
+		s.paymentsRepo.EXPECT().CoverShares(ctx, "res-1", paymentNow).Return(int64(0), nil)
+		s.reservationsRepo.EXPECT().ConfirmReservation(ctx, "res-1", paymentNow).Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("success after the hold was released is refunded", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(pendingPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", paymentNow).
+			Return(nil)
+		s.paymentsRepo.EXPECT().CoverShares(ctx, "res-1", paymentNow).Return(int64(0), nil)
+		s.reservationsRepo.EXPECT().
+			ConfirmReservation(ctx, "res-1", paymentNow).
+			Return(entities.ErrReservationNotPending)
+		s.provider.EXPECT().Refund(ctx, "fake_pi_pay-1", int64(3000), "refund_pay-1").Return(nil)
+		s.paymentsRepo.EXPECT().
+			RecordRefund(ctx, "pay-1", entities.RefundedPaymentStatus, int64(3000), gomock.Any(), paymentNow).
+			Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("failure releases the slot", func() {
+		notify(entities.FailedPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(pendingPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, entities.FailedPaymentStatus, "", paymentNow).
+			Return(nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.reservationsRepo.EXPECT().ReleaseHold(ctx, "res-1").Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("success confirms the holds of the series", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(seriesPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", paymentNow).
+			Return(nil)
+		s.reservationsRepo.EXPECT().ConfirmSeriesHolds(ctx, "series-1", paymentNow).Return(int64(6000), nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("holds of the series released before the success are refunded", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(seriesPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, entities.SucceededPaymentStatus, "", paymentNow).
+			Return(nil)
+		s.reservationsRepo.EXPECT().ConfirmSeriesHolds(ctx, "series-1", paymentNow).Return(int64(3000), nil)
+		s.provider.EXPECT().Refund(ctx, "fake_pi_pay-1", int64(3000), "refund_pay-1").Return(nil)
+		s.paymentsRepo.EXPECT().
+			RecordRefund(ctx, "pay-1", entities.PartiallyRefundedPaymentStatus, int64(3000), gomock.Any(), paymentNow).
+			Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("failure releases the holds of the series", func() {
+		notify(entities.FailedPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(seriesPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, entities.FailedPaymentStatus, "", paymentNow).
+			Return(nil)
+		s.reservationsRepo.EXPECT().ReleaseSeriesHolds(ctx, "series-1").Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("repeated notification is ignored", func() {
+		settled := pendingPayment()
+		settled.Status = entities.SucceededPaymentStatus
+
+		notify(entities.SucceededPaymentStatus)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(settled, nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("invalid signature", func() {
+		s.provider.EXPECT().
+			ParseWebhook(payload, "forged").
+			Return(nil, fmt.Errorf("%w: signature mismatch", entities.ErrInvalidWebhook))
+
+		s.ErrorIs(s.service.HandleWebhook(ctx, payload, "forged"), entities.ErrInvalidWebhook)
+	})
+}
+
+func (s *ServiceSuite) TestRefundReservation() {
+	ctx := context.Background()
+
+	settled := func(id string) entities.Payment {
+		p := pendingPayment()
+		p.ID = id
+		p.ProviderRef = "fake_pi_" + id
+		p.Status = entities.SucceededPaymentStatus
+		return *p
+	}
+
+	s.Run("settled payments are refunded", func() {
+		failed := *pendingPayment()
+		failed.Status = entities.FailedPaymentStatus
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().
+			ListByReservationID(ctx, "res-1").
+			Return([]entities.Payment{failed, settled("pay-2"), settled("pay-3")}, nil)
+
+		for _, id := range []string{"pay-2", "pay-3"} {
+			s.provider.EXPECT().Refund(ctx, "fake_pi_"+id, int64(3000), "refund_"+id).Return(nil)
+			s.paymentsRepo.EXPECT().
+				RecordRefund(ctx, id, entities.RefundedPaymentStatus, int64(3000), "reservation cancelled", paymentNow).
+				Return(nil)
+		}
+
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 100))
+	})
+
+	s.Run("part of the settled payments is refunded", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return([]entities.Payment{settled("pay-1")}, nil)
+		s.provider.EXPECT().Refund(ctx, "fake_pi_pay-1", int64(1500), "refund_pay-1").Return(nil)
+		s.paymentsRepo.EXPECT().
+			RecordRefund(
+				ctx, "pay-1", entities.PartiallyRefundedPaymentStatus, int64(1500),
+				"reservation cancelled, 50% refunded", paymentNow,
+			).
+			Return(nil)
+
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 50))
+	})
+
+	s.Run("nothing is refunded without notice", func() {
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 0))
+	})
+
+	s.Run("pending payment is left to the provider", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return([]entities.Payment{*pendingPayment()}, nil)
+
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 100))
+	})
+
+	s.Run("no payment", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return(nil, nil)
+
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 100))
+	})
+
+	s.Run("an occurrence gets its share of the series payment back", func() {
+		occurrence := hold()
+		occurrence.SeriesID = "series-1"
+
+		partly := *seriesPayment()
+		partly.Status = entities.PartiallyRefundedPaymentStatus
+		partly.RefundedAmount = 3000
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(occurrence, nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().ListBySeriesID(ctx, "series-1").Return([]entities.Payment{partly}, nil)
+		s.provider.EXPECT().Refund(ctx, "fake_pi_pay-1", int64(1500), "refund_pay-1_res-1").Return(nil)
+		s.paymentsRepo.EXPECT().
+			RecordOccurrenceRefund(ctx, "pay-1", "res-1", int64(1500), "reservation cancelled, 50% refunded", paymentNow).
+			Return(nil)
+
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 50))
+	})
+
+	s.Run("an occurrence of an unpaid series has nothing to refund", func() {
+		occurrence := hold()
+		occurrence.SeriesID = "series-1"
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(occurrence, nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().ListBySeriesID(ctx, "series-1").Return([]entities.Payment{*seriesPayment()}, nil)
+
+		s.NoError(s.service.RefundReservation(ctx, "res-1", 100))
+	})
+
+	s.Run("provider error keeps the payment settled", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().ListByReservationID(ctx, "res-1").Return([]entities.Payment{settled("pay-1")}, nil)
+		s.provider.EXPECT().
+			Refund(ctx, "fake_pi_pay-1", int64(3000), "refund_pay-1").
+			Return(fmt.Errorf("provider down"))
+
+		s.Error(s.service.RefundReservation(ctx, "res-1", 100))
+	})
+}
+
+func (s *ServiceSuite) TestPaySeries() {
+	ctx := context.Background()
+
+	series := func() *entities.ReservationSeries {
+		return &entities.ReservationSeries{ID: "series-1", CourtID: "court-1", ReservedBy: "user-1"}
+	}
+
+	occurrences := func() []entities.Reservation {
+		first, second, free, paid := *hold(), *hold(), *hold(), *hold()
+		second.ID = "res-2"
+		free.ID = "res-3"
+		free.Status = entities.ReservedReservationStatus
+		free.Price = entities.Price{}
+		paid.ID = "res-4"
+		paid.Status = entities.CancelledReservationStatus
+		return []entities.Reservation{first, second, free, paid}
+	}
+
+	s.Run("one payment covers every hold", func() {
+		s.reservationsRepo.EXPECT().GetSeriesByID(ctx, "series-1").Return(series(), nil)
+		s.paymentsRepo.EXPECT().ListBySeriesID(ctx, "series-1").Return(nil, nil)
+		s.reservationsRepo.EXPECT().ListBySeries(ctx, "series-1").Return(occurrences(), nil)
+		s.provider.EXPECT().
+			CreateIntent(ctx, gomock.Any()).
+			Return(&entities.PaymentIntent{Ref: "fake_pi_1", ClientSecret: "secret"}, nil)
+		s.paymentsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		p, err := s.service.PaySeries(ctx, "court-1", "series-1", "user-1")
+		s.Require().NoError(err)
+		s.Equal("series-1", p.SeriesID)
+		s.Empty(p.ReservationID)
+		s.Equal(int64(6000), p.Amount)
+		s.Equal("EUR", p.Currency)
+		s.Equal(entities.PendingPaymentStatus, p.Status)
+		s.Equal("secret", p.ClientSecret)
+	})
+
+	s.Run("returns the pending payment", func() {
+		failed := *seriesPayment()
+		failed.ID = "pay-0"
+		failed.Status = entities.FailedPaymentStatus
+
+		s.reservationsRepo.EXPECT().GetSeriesByID(ctx, "series-1").Return(series(), nil)
+		s.paymentsRepo.EXPECT().
+			ListBySeriesID(ctx, "series-1").
+			Return([]entities.Payment{failed, *seriesPayment()}, nil)
+
+		p, err := s.service.PaySeries(ctx, "court-1", "series-1", "user-1")
+		s.Require().NoError(err)
+		s.Equal(seriesPayment(), p)
+	})
+
+	s.Run("holds that ran out are not paid", func() {
+		expired := occurrences()
+		for i := range expired {
+			expired[i].ExpiresAt = paymentNow
+		}
+
+		s.reservationsRepo.EXPECT().GetSeriesByID(ctx, "series-1").Return(series(), nil)
+		s.paymentsRepo.EXPECT().ListBySeriesID(ctx, "series-1").Return(nil, nil)
+		s.reservationsRepo.EXPECT().ListBySeries(ctx, "series-1").Return(expired, nil)
+
+		_, err := s.service.PaySeries(ctx, "court-1", "series-1", "user-1")
+		s.ErrorIs(err, entities.ErrNothingToPay)
+	})
+
+	s.Run("another court", func() {
+		s.reservationsRepo.EXPECT().GetSeriesByID(ctx, "series-1").Return(series(), nil)
+
+		_, err := s.service.PaySeries(ctx, "court-2", "series-1", "user-1")
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("another user", func() {
+		s.reservationsRepo.EXPECT().GetSeriesByID(ctx, "series-1").Return(series(), nil)
+
+		_, err := s.service.PaySeries(ctx, "court-1", "series-1", "user-2")
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+}
@@ -0,0 +1,167 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// SplitReservation splits the price of a pending hold between the booker and the invited players, each of
+// them then pays their own share. The hold is confirmed once every share is paid, or once the booker covers
+// the shares that are left before it expires.
+func (s *Service) SplitReservation(
+	ctx context.Context,
+	courtID, reservationID string,
+	userID string,
+	players []entities.CoPlayer,
+) ([]entities.PaymentShare, error) {
+	if len(players) == 0 {
+		return nil, fmt.Errorf("%w: at least 1 player has to be invited", entities.ErrInvalidSplit)
+	}
+
+	rsv, err := s.getHold(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsv.ReservedBy != userID {
+		return nil, fmt.Errorf("%w: reservation %s was booked by another user", entities.ErrForbidden, reservationID)
+	}
+
+	court, err := s.courtsRepo.GetByID(ctx, rsv.CourtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	// the booker takes one of the spots of the court
+	if len(players) >= court.MaxPlayers {
+		return nil, fmt.Errorf("%w: between 1 and %d players can be invited",
+			entities.ErrInvalidSplit, court.MaxPlayers-1)
+	}
+
+	shares, err := s.paymentsRepo.ListShares(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("list shares: %w", err)
+	}
+
+	if len(shares) > 0 {
+		return nil, fmt.Errorf("%w: reservation %s", entities.ErrReservationAlreadySplit, reservationID)
+	}
+
+	// once the booker pays the whole price there is nothing left to split
+	_, err = s.paymentsRepo.GetActiveByReservationID(ctx, reservationID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: reservation %s is paid by the booker", entities.ErrPaymentAlreadyExist, reservationID)
+	}
+
+	if !errors.Is(err, entities.ErrNotFound) {
+		return nil, fmt.Errorf("get active payment: %w", err)
+	}
+
+	userIDs := []string{userID}
+	for _, player := range players {
+		user, err := s.roster.FindUser(ctx, player)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(userIDs, user.ID) {
+			return nil, fmt.Errorf("%w: user %s is invited twice", entities.ErrInvalidSplit, user.ID)
+		}
+
+		userIDs = append(userIDs, user.ID)
+	}
+
+	shares = entities.SplitPrice(rsv, userIDs, s.clock.Now())
+
+	if err := s.paymentsRepo.CreateShares(ctx, shares); err != nil {
+		return nil, fmt.Errorf("create shares: %w", err)
+	}
+
+	return shares, nil
+}
+
+// ListShares returns the shares of the reservation. Only the booker and the players who share it may see them.
+func (s *Service) ListShares(
+	ctx context.Context,
+	courtID, reservationID string,
+	userID string,
+) ([]entities.PaymentShare, error) {
+	rsv, err := s.reservationsRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	if rsv.CourtID != courtID {
+		return nil, fmt.Errorf("%w: reservation %s is not on court %s", entities.ErrNotFound, reservationID, courtID)
+	}
+
+	shares, err := s.paymentsRepo.ListShares(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("list shares: %w", err)
+	}
+
+	if rsv.ReservedBy == userID {
+		return shares, nil
+	}
+
+	for _, share := range shares {
+		if share.UserID == userID {
+			return shares, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: user %s does not play in reservation %s", entities.ErrForbidden, userID, reservationID)
+}
+
+// PayShare opens a payment for the share the player owes on a split reservation. A payment of the share
+// that is still pending is returned as is.
+func (s *Service) PayShare(
+	ctx context.Context,
+	courtID, reservationID, shareID string,
+	userID string,
+) (*entities.Payment, error) {
+	if _, err := s.getHold(ctx, courtID, reservationID); err != nil {
+		return nil, err
+	}
+
+	share, err := s.paymentsRepo.GetShare(ctx, shareID)
+	if err != nil {
+		return nil, fmt.Errorf("get share: %w", err)
+	}
+
+	if share.ReservationID != reservationID {
+		return nil, fmt.Errorf("%w: share %s is not part of reservation %s", entities.ErrNotFound, shareID, reservationID)
+	}
+
+	if share.UserID != userID {
+		return nil, fmt.Errorf("%w: share %s is owed by another user", entities.ErrForbidden, shareID)
+	}
+
+	if share.IsSettled() || share.Amount == 0 {
+		return nil, fmt.Errorf("%w: share %s is %s", entities.ErrNothingToPay, shareID, share.Status)
+	}
+
+	existing, err := s.paymentsRepo.GetActiveByShareID(ctx, shareID)
+	if err == nil {
+		return existing, nil
+	}
+
+	if !errors.Is(err, entities.ErrNotFound) {
+		return nil, fmt.Errorf("get active share payment: %w", err)
+	}
+
+	_, err = s.paymentsRepo.GetActiveByReservationID(ctx, reservationID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: the booker is covering reservation %s", entities.ErrPaymentAlreadyExist, reservationID)
+	}
+
+	if !errors.Is(err, entities.ErrNotFound) {
+		return nil, fmt.Errorf("get active payment: %w", err)
+	}
+
+	return s.open(ctx, entities.NewSharePayment(share, s.provider.Name(), s.clock.Now()))
+}
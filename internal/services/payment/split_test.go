@@ -0,0 +1,395 @@
+package payment_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func splitShares() []entities.PaymentShare {
+	shares := make([]entities.PaymentShare, 0, 2)
+	for i, userID := range []string{"user-1", "user-2"} {
+		shares = append(shares, entities.PaymentShare{
+			ID:            fmt.Sprintf("share-%d", i+1),
+			ReservationID: "res-1",
+			UserID:        userID,
+			Amount:        1500,
+			Currency:      "EUR",
+			Status:        entities.UnpaidShareStatus,
+		})
+	}
+
+	return shares
+}
+
+func doublesCourt() *entities.Court {
+	return &entities.Court{ID: "court-1", OrganizationID: "org-1", MaxPlayers: entities.DefaultMaxPlayers}
+}
+
+func sharePayment() *entities.Payment {
+	p := pendingPayment()
+	p.ID = "pay-2"
+	p.ShareID = "share-2"
+	p.UserID = "user-2"
+	p.Amount = 1500
+	p.ProviderRef = "fake_pi_pay-2"
+	return p
+}
+
+func (s *ServiceSuite) TestSplitReservation() {
+	ctx := context.Background()
+
+	s.Run("splits the price between the players", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.roster.EXPECT().FindUser(ctx, entities.CoPlayer{Nickname: "bob"}).Return(&entities.User{ID: "user-2"}, nil)
+		s.roster.EXPECT().
+			FindUser(ctx, entities.CoPlayer{PhoneNumber: "+34600000003"}).
+			Return(&entities.User{ID: "user-3"}, nil)
+		s.paymentsRepo.EXPECT().CreateShares(ctx, gomock.Any()).Return(nil)
+
+		shares, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "bob"},
+			{PhoneNumber: "+34600000003"},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(shares, 3)
+
+		for i, want := range []struct {
+			userID string
+			amount int64
+		}{{"user-1", 1000}, {"user-2", 1000}, {"user-3", 1000}} {
+			s.Equal("res-1", shares[i].ReservationID)
+			s.Equal(want.userID, shares[i].UserID)
+			s.Equal(want.amount, shares[i].Amount)
+			s.Equal("EUR", shares[i].Currency)
+			s.Equal(entities.UnpaidShareStatus, shares[i].Status)
+		}
+	})
+
+	s.Run("the booker pays the leftover", func() {
+		rsv := hold()
+		rsv.Price.Amount = 3001
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.roster.EXPECT().FindUser(ctx, entities.CoPlayer{Nickname: "bob"}).Return(&entities.User{ID: "user-2"}, nil)
+		s.paymentsRepo.EXPECT().CreateShares(ctx, gomock.Any()).Return(nil)
+
+		shares, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "bob"},
+		})
+		s.Require().NoError(err)
+		s.Equal(int64(1501), shares[0].Amount)
+		s.Equal(int64(1500), shares[1].Amount)
+	})
+
+	s.Run("more players than the court allows", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "a"}, {Nickname: "b"}, {Nickname: "c"}, {Nickname: "d"},
+		})
+		s.ErrorIs(err, entities.ErrInvalidSplit)
+	})
+
+	s.Run("court for more players", func() {
+		court := doublesCourt()
+		court.MaxPlayers = 6
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.roster.EXPECT().
+			FindUser(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, invitee entities.CoPlayer) (*entities.User, error) {
+				return &entities.User{ID: "user-" + invitee.Nickname}, nil
+			}).
+			Times(4)
+		s.paymentsRepo.EXPECT().CreateShares(ctx, gomock.Any()).Return(nil)
+
+		shares, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "a"}, {Nickname: "b"}, {Nickname: "c"}, {Nickname: "d"},
+		})
+		s.Require().NoError(err)
+		s.Len(shares, 5)
+	})
+
+	s.Run("no players", func() {
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", nil)
+		s.ErrorIs(err, entities.ErrInvalidSplit)
+	})
+
+	s.Run("booker invites themselves", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.roster.EXPECT().FindUser(ctx, entities.CoPlayer{Nickname: "alice"}).Return(&entities.User{ID: "user-1"}, nil)
+
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "alice"},
+		})
+		s.ErrorIs(err, entities.ErrInvalidSplit)
+	})
+
+	s.Run("unknown player", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.roster.EXPECT().FindUser(ctx, entities.CoPlayer{Nickname: "ghost"}).Return(nil, entities.ErrNotFound)
+
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "ghost"},
+		})
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("already split", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(splitShares(), nil)
+
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "bob"},
+		})
+		s.ErrorIs(err, entities.ErrReservationAlreadySplit)
+	})
+
+	s.Run("booker already pays the whole price", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(doublesCourt(), nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(nil, nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(pendingPayment(), nil)
+
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-1", []entities.CoPlayer{
+			{Nickname: "bob"},
+		})
+		s.ErrorIs(err, entities.ErrPaymentAlreadyExist)
+	})
+
+	s.Run("another user", func() {
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+
+		_, err := s.service.SplitReservation(ctx, "court-1", "res-1", "user-2", []entities.CoPlayer{
+			{Nickname: "bob"},
+		})
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+}
+
+func (s *ServiceSuite) TestPayShare() {
+	ctx := context.Background()
+
+	s.Run("creates a payment for the share", func() {
+		share := splitShares()[1]
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetShare(ctx, "share-2").Return(&share, nil)
+		s.paymentsRepo.EXPECT().GetActiveByShareID(ctx, "share-2").Return(nil, entities.ErrNotFound)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.provider.EXPECT().
+			CreateIntent(ctx, gomock.Any()).
+			Return(&entities.PaymentIntent{Ref: "fake_pi_2", ClientSecret: "secret"}, nil)
+		s.paymentsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		p, err := s.service.PayShare(ctx, "court-1", "res-1", "share-2", "user-2")
+		s.Require().NoError(err)
+		s.Equal("share-2", p.ShareID)
+		s.Equal("user-2", p.UserID)
+		s.Equal(int64(1500), p.Amount)
+		s.Equal("fake_pi_2", p.ProviderRef)
+	})
+
+	s.Run("booker is covering the rest", func() {
+		share := splitShares()[1]
+
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetShare(ctx, "share-2").Return(&share, nil)
+		s.paymentsRepo.EXPECT().GetActiveByShareID(ctx, "share-2").Return(nil, entities.ErrNotFound)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(pendingPayment(), nil)
+
+		_, err := s.service.PayShare(ctx, "court-1", "res-1", "share-2", "user-2")
+		s.ErrorIs(err, entities.ErrPaymentAlreadyExist)
+	})
+
+	tests := []struct {
+		name    string
+		mutate  func(share *entities.PaymentShare)
+		wantErr error
+	}{
+		{
+			name:    "share of another reservation",
+			mutate:  func(share *entities.PaymentShare) { share.ReservationID = "res-2" },
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name:    "share of another player",
+			mutate:  func(share *entities.PaymentShare) { share.UserID = "user-3" },
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:    "covered share",
+			mutate:  func(share *entities.PaymentShare) { share.Status = entities.CoveredShareStatus },
+			wantErr: entities.ErrNothingToPay,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			share := splitShares()[1]
+			tt.mutate(&share)
+
+			s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+			s.paymentsRepo.EXPECT().GetShare(ctx, "share-2").Return(&share, nil)
+
+			_, err := s.service.PayShare(ctx, "court-1", "res-1", "share-2", "user-2")
+			s.ErrorIs(err, tt.wantErr)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayReservation_CoversTheRest() {
+	ctx := context.Background()
+
+	shares := splitShares()
+	shares[1].Status = entities.PaidShareStatus
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+	s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+	s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(shares, nil)
+	s.provider.EXPECT().
+		CreateIntent(ctx, gomock.Any()).
+		Return(&entities.PaymentIntent{Ref: "fake_pi_1", ClientSecret: "secret"}, nil)
+	s.paymentsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	p, err := s.service.PayReservation(ctx, "court-1", "res-1", "user-1")
+	s.Require().NoError(err)
+	s.Empty(p.ShareID)
+	s.Equal(int64(1500), p.Amount)
+}
+
+func (s *ServiceSuite) TestHandleWebhook_Shares() {
+	ctx := context.Background()
+	payload := []byte(`{}`)
+
+	notify := func(status entities.PaymentStatus) {
+		s.provider.EXPECT().
+			ParseWebhook(payload, "sig").
+			Return(&entities.PaymentNotification{Ref: "fake_pi_pay-2", Status: status}, nil)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-2").Return(sharePayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-2", entities.PendingPaymentStatus, status, "", paymentNow).
+			Return(nil)
+	}
+
+	expectRefund := func() {
+		s.provider.EXPECT().Refund(ctx, "fake_pi_pay-2", int64(1500), "refund_pay-2").Return(nil)
+		s.paymentsRepo.EXPECT().
+			RecordRefund(ctx, "pay-2", entities.RefundedPaymentStatus, int64(1500), gomock.Any(), paymentNow).
+			Return(nil)
+	}
+
+	s.Run("share is paid while others are still owed", func() {
+		shares := splitShares()
+		shares[1].Status = entities.PaidShareStatus
+
+		notify(entities.SucceededPaymentStatus)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.paymentsRepo.EXPECT().MarkSharePaid(ctx, "share-2", paymentNow).Return(nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(shares, nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("last share confirms the reservation", func() {
+		shares := splitShares()
+		shares[0].Status = entities.PaidShareStatus
+		shares[1].Status = entities.PaidShareStatus
+
+		notify(entities.SucceededPaymentStatus)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.paymentsRepo.EXPECT().MarkSharePaid(ctx, "share-2", paymentNow).Return(nil)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(shares, nil)
+		s.reservationsRepo.EXPECT().ConfirmReservation(ctx, "res-1", paymentNow).Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("share paid while the booker covers the rest is refunded", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(pendingPayment(), nil)
+		expectRefund()
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("share covered by the booker is refunded", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+		s.paymentsRepo.EXPECT().GetActiveByReservationID(ctx, "res-1").Return(nil, entities.ErrNotFound)
+		s.paymentsRepo.EXPECT().MarkSharePaid(ctx, "share-2", paymentNow).Return(entities.ErrInvalidPaymentTransition)
+		expectRefund()
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("share paid after the hold expired is refunded", func() {
+		expired := hold()
+		expired.Status = entities.ExpiredReservationStatus
+
+		notify(entities.SucceededPaymentStatus)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(expired, nil)
+		expectRefund()
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("failed share keeps the hold", func() {
+		notify(entities.FailedPaymentStatus)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+}
+
+func (s *ServiceSuite) TestHandleWebhook_Cover() {
+	ctx := context.Background()
+	payload := []byte(`{}`)
+
+	notify := func(status entities.PaymentStatus) {
+		s.provider.EXPECT().
+			ParseWebhook(payload, "sig").
+			Return(&entities.PaymentNotification{Ref: "fake_pi_pay-1", Status: status}, nil)
+		s.paymentsRepo.EXPECT().GetByProviderRef(ctx, "fake", "fake_pi_pay-1").Return(pendingPayment(), nil)
+		s.paymentsRepo.EXPECT().
+			UpdateStatus(ctx, "pay-1", entities.PendingPaymentStatus, status, "", paymentNow).
+			Return(nil)
+	}
+
+	s.Run("cover settles the shares left and confirms", func() {
+		notify(entities.SucceededPaymentStatus)
+		s.paymentsRepo.EXPECT().CoverShares(ctx, "res-1", paymentNow).Return(int64(1), nil)
+		s.reservationsRepo.EXPECT().ConfirmReservation(ctx, "res-1", paymentNow).Return(nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+
+	s.Run("failed cover keeps the hold of a split reservation", func() {
+		notify(entities.FailedPaymentStatus)
+		s.paymentsRepo.EXPECT().ListShares(ctx, "res-1").Return(splitShares(), nil)
+
+		s.NoError(s.service.HandleWebhook(ctx, payload, "sig"))
+	})
+}
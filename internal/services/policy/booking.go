@@ -0,0 +1,76 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+)
+
+// SetBookingRules replaces the booking rules of the court, or the organization default when the rules have
+// no court.
+func (s *Service) SetBookingRules(ctx context.Context, rules *entities.BookingRules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	if rules.CourtID != "" {
+		if _, err := ownership.Court(ctx, s.courtsRepo, rules.OrganizationID, rules.CourtID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.policiesRepo.UpsertBookingRules(ctx, rules); err != nil {
+		return fmt.Errorf("upsert booking rules: %w", err)
+	}
+
+	return nil
+}
+
+// GetBookingRules returns the booking rules of the court, falling back to the organization default when the
+// court has none. An empty courtID returns the organization default. It returns ErrNotFound when there are
+// no rules, reservations are then only limited by the opening hours.
+func (s *Service) GetBookingRules(ctx context.Context, organizationID, courtID string) (*entities.BookingRules, error) {
+	if courtID != "" {
+		if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.resolveBookingRules(ctx, organizationID, courtID)
+}
+
+// CourtBookingRules returns the booking rules of the court, using the organization owning it as fallback.
+func (s *Service) CourtBookingRules(ctx context.Context, courtID string) (*entities.BookingRules, error) {
+	court, err := s.courtsRepo.GetByID(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	return s.resolveBookingRules(ctx, court.OrganizationID, courtID)
+}
+
+func (s *Service) resolveBookingRules(
+	ctx context.Context,
+	organizationID, courtID string,
+) (*entities.BookingRules, error) {
+	if courtID != "" {
+		rules, err := s.policiesRepo.GetBookingRules(ctx, organizationID, courtID)
+		if err == nil {
+			return rules, nil
+		}
+
+		if !errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("get court booking rules: %w", err)
+		}
+	}
+
+	rules, err := s.policiesRepo.GetBookingRules(ctx, organizationID, "")
+	if err != nil {
+		return nil, fmt.Errorf("get organization booking rules: %w", err)
+	}
+
+	return rules, nil
+}
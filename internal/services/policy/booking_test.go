@@ -0,0 +1,86 @@
+package policy_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *ServiceSuite) TestSetBookingRules() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		rules   entities.BookingRules
+		wantErr error
+	}{
+		{
+			name: "fixed durations on half hour slots",
+			rules: entities.BookingRules{
+				Durations:         []time.Duration{60 * time.Minute, 90 * time.Minute, 120 * time.Minute},
+				SlotInterval:      30 * time.Minute,
+				MaxAdvance:        14 * 24 * time.Hour,
+				MinNotice:         time.Hour,
+				MaxActiveBookings: 3,
+			},
+		},
+		{
+			name:    "min duration longer than max",
+			rules:   entities.BookingRules{MinDuration: 2 * time.Hour, MaxDuration: time.Hour},
+			wantErr: entities.ErrInvalidBookingRules,
+		},
+		{
+			name:    "min notice longer than max advance",
+			rules:   entities.BookingRules{MinNotice: 48 * time.Hour, MaxAdvance: 24 * time.Hour},
+			wantErr: entities.ErrInvalidBookingRules,
+		},
+		{
+			name:    "slot interval that does not divide a day",
+			rules:   entities.BookingRules{SlotInterval: 7 * time.Hour},
+			wantErr: entities.ErrInvalidBookingRules,
+		},
+		{
+			name:    "duration listed twice",
+			rules:   entities.BookingRules{Durations: []time.Duration{time.Hour, time.Hour}},
+			wantErr: entities.ErrInvalidBookingRules,
+		},
+		{
+			name:    "negative booking limit",
+			rules:   entities.BookingRules{MaxActiveBookings: -1},
+			wantErr: entities.ErrInvalidBookingRules,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rules := tt.rules
+			rules.OrganizationID = "org-1"
+
+			if tt.wantErr == nil {
+				s.policiesRepo.EXPECT().UpsertBookingRules(ctx, &rules).Return(nil)
+			}
+
+			err := s.service.SetBookingRules(ctx, &rules)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestCourtBookingRules_FallsBackToOrganization() {
+	ctx := context.Background()
+	rules := &entities.BookingRules{OrganizationID: "org-1", MaxActiveBookings: 2}
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+	s.policiesRepo.EXPECT().GetBookingRules(ctx, "org-1", "court-1").Return(nil, entities.ErrNotFound)
+	s.policiesRepo.EXPECT().GetBookingRules(ctx, "org-1", "").Return(rules, nil)
+
+	got, err := s.service.CourtBookingRules(ctx, "court-1")
+	s.Require().NoError(err)
+	s.Equal(rules, got)
+}
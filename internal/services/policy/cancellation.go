@@ -0,0 +1,45 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// SetCancellationPolicy replaces the cancellation policy of the organization.
+func (s *Service) SetCancellationPolicy(ctx context.Context, policy *entities.CancellationPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.policiesRepo.UpsertCancellationPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("upsert cancellation policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetCancellationPolicy returns the cancellation policy of the organization. It returns ErrNotFound when
+// the organization has none, its reservations are then cancelled free of charge.
+func (s *Service) GetCancellationPolicy(
+	ctx context.Context,
+	organizationID string,
+) (*entities.CancellationPolicy, error) {
+	policy, err := s.policiesRepo.GetCancellationPolicy(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get cancellation policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// CourtCancellationPolicy returns the cancellation policy of the organization owning the court.
+func (s *Service) CourtCancellationPolicy(ctx context.Context, courtID string) (*entities.CancellationPolicy, error) {
+	court, err := s.courtsRepo.GetByID(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	return s.GetCancellationPolicy(ctx, court.OrganizationID)
+}
@@ -0,0 +1,91 @@
+package policy_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+func (s *ServiceSuite) TestSetCancellationPolicy() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		tiers   []entities.CancellationTier
+		wantErr error
+	}{
+		{
+			name: "free until a day before, half until six hours before",
+			tiers: []entities.CancellationTier{
+				{Notice: 24 * time.Hour, RefundPercent: 100},
+				{Notice: 6 * time.Hour, RefundPercent: 50},
+			},
+		},
+		{
+			name:  "no tiers never refunds",
+			tiers: nil,
+		},
+		{
+			name:    "refund above the price",
+			tiers:   []entities.CancellationTier{{Notice: time.Hour, RefundPercent: 120}},
+			wantErr: entities.ErrInvalidCancellationPolicy,
+		},
+		{
+			name:    "negative notice",
+			tiers:   []entities.CancellationTier{{Notice: -time.Hour, RefundPercent: 50}},
+			wantErr: entities.ErrInvalidCancellationPolicy,
+		},
+		{
+			name: "two tiers with the same notice",
+			tiers: []entities.CancellationTier{
+				{Notice: time.Hour, RefundPercent: 50},
+				{Notice: time.Hour, RefundPercent: 20},
+			},
+			wantErr: entities.ErrInvalidCancellationPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			policy := &entities.CancellationPolicy{OrganizationID: "org-1", Tiers: tt.tiers}
+
+			if tt.wantErr == nil {
+				s.policiesRepo.EXPECT().UpsertCancellationPolicy(ctx, policy).Return(nil)
+			}
+
+			err := s.service.SetCancellationPolicy(ctx, policy)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestCourtCancellationPolicy() {
+	ctx := context.Background()
+
+	policy := &entities.CancellationPolicy{
+		OrganizationID: "org-1",
+		Tiers: []entities.CancellationTier{
+			{Notice: 6 * time.Hour, RefundPercent: 50},
+			{Notice: 24 * time.Hour, RefundPercent: 100},
+		},
+	}
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+	s.policiesRepo.EXPECT().GetCancellationPolicy(ctx, "org-1").Return(policy, nil)
+
+	got, err := s.service.CourtCancellationPolicy(ctx, "court-1")
+	s.Require().NoError(err)
+
+	// tiers apply from the longest notice down, whatever order they were set in
+	s.Equal(100, got.RefundPercentFor(30*time.Hour))
+	s.Equal(100, got.RefundPercentFor(24*time.Hour))
+	s.Equal(50, got.RefundPercentFor(10*time.Hour))
+	s.Equal(0, got.RefundPercentFor(2*time.Hour))
+	s.Equal(0, got.RefundPercentFor(-time.Hour))
+}
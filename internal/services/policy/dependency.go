@@ -0,0 +1,21 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package policy
+
+import (
+	"context"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type PoliciesRepository interface {
+	UpsertCancellationPolicy(ctx context.Context, policy *entities.CancellationPolicy) error
+	GetCancellationPolicy(ctx context.Context, organizationID string) (*entities.CancellationPolicy, error)
+
+	UpsertBookingRules(ctx context.Context, rules *entities.BookingRules) error
+	GetBookingRules(ctx context.Context, organizationID, courtID string) (*entities.BookingRules, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
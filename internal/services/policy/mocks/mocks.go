@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/policy/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockPoliciesRepository is a mock of PoliciesRepository interface.
+type MockPoliciesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoliciesRepositoryMockRecorder
+}
+
+// MockPoliciesRepositoryMockRecorder is the mock recorder for MockPoliciesRepository.
+type MockPoliciesRepositoryMockRecorder struct {
+	mock *MockPoliciesRepository
+}
+
+// NewMockPoliciesRepository creates a new mock instance.
+func NewMockPoliciesRepository(ctrl *gomock.Controller) *MockPoliciesRepository {
+	mock := &MockPoliciesRepository{ctrl: ctrl}
+	mock.recorder = &MockPoliciesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPoliciesRepository) EXPECT() *MockPoliciesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBookingRules mocks base method.
+func (m *MockPoliciesRepository) GetBookingRules(ctx context.Context, organizationID, courtID string) (*entities.BookingRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingRules", ctx, organizationID, courtID)
+	ret0, _ := ret[0].(*entities.BookingRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingRules indicates an expected call of GetBookingRules.
+func (mr *MockPoliciesRepositoryMockRecorder) GetBookingRules(ctx, organizationID, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingRules", reflect.TypeOf((*MockPoliciesRepository)(nil).GetBookingRules), ctx, organizationID, courtID)
+}
+
+// GetCancellationPolicy mocks base method.
+func (m *MockPoliciesRepository) GetCancellationPolicy(ctx context.Context, organizationID string) (*entities.CancellationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCancellationPolicy", ctx, organizationID)
+	ret0, _ := ret[0].(*entities.CancellationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCancellationPolicy indicates an expected call of GetCancellationPolicy.
+func (mr *MockPoliciesRepositoryMockRecorder) GetCancellationPolicy(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCancellationPolicy", reflect.TypeOf((*MockPoliciesRepository)(nil).GetCancellationPolicy), ctx, organizationID)
+}
+
+// UpsertBookingRules mocks base method.
+func (m *MockPoliciesRepository) UpsertBookingRules(ctx context.Context, rules *entities.BookingRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBookingRules", ctx, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBookingRules indicates an expected call of UpsertBookingRules.
+func (mr *MockPoliciesRepositoryMockRecorder) UpsertBookingRules(ctx, rules interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBookingRules", reflect.TypeOf((*MockPoliciesRepository)(nil).UpsertBookingRules), ctx, rules)
+}
+
+// UpsertCancellationPolicy mocks base method.
+func (m *MockPoliciesRepository) UpsertCancellationPolicy(ctx context.Context, policy *entities.CancellationPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCancellationPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertCancellationPolicy indicates an expected call of UpsertCancellationPolicy.
+func (mr *MockPoliciesRepositoryMockRecorder) UpsertCancellationPolicy(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCancellationPolicy", reflect.TypeOf((*MockPoliciesRepository)(nil).UpsertCancellationPolicy), ctx, policy)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
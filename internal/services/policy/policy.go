@@ -0,0 +1,13 @@
+package policy
+
+type Service struct {
+	policiesRepo PoliciesRepository
+	courtsRepo   CourtsRepository
+}
+
+func NewService(policiesRepo PoliciesRepository, courtsRepo CourtsRepository) *Service {
+	return &Service{
+		policiesRepo: policiesRepo,
+		courtsRepo:   courtsRepo,
+	}
+}
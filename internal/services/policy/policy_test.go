@@ -0,0 +1,34 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/services/policy"
+	"github.com/lever-dev/padel-backend/internal/services/policy/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	policiesRepo *mocks.MockPoliciesRepository
+	courtsRepo   *mocks.MockCourtsRepository
+	service      *policy.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.policiesRepo = mocks.NewMockPoliciesRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.service = policy.NewService(s.policiesRepo, s.courtsRepo)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
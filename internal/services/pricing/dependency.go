@@ -0,0 +1,22 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package pricing
+
+import (
+	"context"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type RulesRepository interface {
+	Upsert(ctx context.Context, rule *entities.PricingRule) error
+	Get(ctx context.Context, organizationID, courtID string) (*entities.PricingRule, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
+
+type Scheduler interface {
+	CourtSchedule(ctx context.Context, courtID string) (*entities.Schedule, error)
+}
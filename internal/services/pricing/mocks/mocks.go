@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/pricing/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockRulesRepository is a mock of RulesRepository interface.
+type MockRulesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRulesRepositoryMockRecorder
+}
+
+// MockRulesRepositoryMockRecorder is the mock recorder for MockRulesRepository.
+type MockRulesRepositoryMockRecorder struct {
+	mock *MockRulesRepository
+}
+
+// NewMockRulesRepository creates a new mock instance.
+func NewMockRulesRepository(ctrl *gomock.Controller) *MockRulesRepository {
+	mock := &MockRulesRepository{ctrl: ctrl}
+	mock.recorder = &MockRulesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRulesRepository) EXPECT() *MockRulesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockRulesRepository) Get(ctx context.Context, organizationID, courtID string) (*entities.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, organizationID, courtID)
+	ret0, _ := ret[0].(*entities.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRulesRepositoryMockRecorder) Get(ctx, organizationID, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRulesRepository)(nil).Get), ctx, organizationID, courtID)
+}
+
+// Upsert mocks base method.
+func (m *MockRulesRepository) Upsert(ctx context.Context, rule *entities.PricingRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockRulesRepositoryMockRecorder) Upsert(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockRulesRepository)(nil).Upsert), ctx, rule)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// CourtSchedule mocks base method.
+func (m *MockScheduler) CourtSchedule(ctx context.Context, courtID string) (*entities.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CourtSchedule", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CourtSchedule indicates an expected call of CourtSchedule.
+func (mr *MockSchedulerMockRecorder) CourtSchedule(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CourtSchedule", reflect.TypeOf((*MockScheduler)(nil).CourtSchedule), ctx, courtID)
+}
@@ -0,0 +1,130 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+)
+
+type Service struct {
+	rulesRepo  RulesRepository
+	courtsRepo CourtsRepository
+	scheduler  Scheduler
+}
+
+func NewService(rulesRepo RulesRepository, courtsRepo CourtsRepository, scheduler Scheduler) *Service {
+	return &Service{
+		rulesRepo:  rulesRepo,
+		courtsRepo: courtsRepo,
+		scheduler:  scheduler,
+	}
+}
+
+// GetRule returns the rule that prices the court, falling back to the organization default when the court
+// has no rule of its own. An empty courtID returns the organization default.
+func (s *Service) GetRule(ctx context.Context, organizationID, courtID string) (*entities.PricingRule, error) {
+	if courtID != "" {
+		if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.resolveRule(ctx, organizationID, courtID)
+}
+
+// SetRule replaces the rule of the court, or the organization default when the rule has no court.
+func (s *Service) SetRule(ctx context.Context, rule *entities.PricingRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	if rule.CourtID != "" {
+		if _, err := ownership.Court(ctx, s.courtsRepo, rule.OrganizationID, rule.CourtID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.rulesRepo.Upsert(ctx, rule); err != nil {
+		return fmt.Errorf("upsert pricing rule: %w", err)
+	}
+
+	return nil
+}
+
+// Quote prices the slot on a court of the organization.
+func (s *Service) Quote(
+	ctx context.Context,
+	organizationID, courtID string,
+	from, to time.Time,
+) (*entities.Quote, error) {
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return nil, err
+	}
+
+	return s.quote(ctx, organizationID, courtID, from, to)
+}
+
+// CourtLocation returns the time zone of the organization of the court.
+func (s *Service) CourtLocation(ctx context.Context, courtID string) (*time.Location, error) {
+	schedule, err := s.scheduler.CourtSchedule(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court schedule: %w", err)
+	}
+
+	return schedule.TimeZone(), nil
+}
+
+// QuoteCourt prices the slot on the court using the rules of the organization owning it.
+// It returns ErrNotFound when neither the court nor its organization has a pricing rule.
+func (s *Service) QuoteCourt(ctx context.Context, courtID string, from, to time.Time) (*entities.Quote, error) {
+	court, err := s.courtsRepo.GetByID(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	return s.quote(ctx, court.OrganizationID, courtID, from, to)
+}
+
+func (s *Service) quote(
+	ctx context.Context,
+	organizationID, courtID string,
+	from, to time.Time,
+) (*entities.Quote, error) {
+	rule, err := s.resolveRule(ctx, organizationID, courtID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduler.CourtSchedule(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court schedule: %w", err)
+	}
+
+	q := rule.Quote(courtID, from, to, schedule.TimeZone())
+
+	return &q, nil
+}
+
+func (s *Service) resolveRule(ctx context.Context, organizationID, courtID string) (*entities.PricingRule, error) {
+	if courtID != "" {
+		rule, err := s.rulesRepo.Get(ctx, organizationID, courtID)
+		if err == nil {
+			return rule, nil
+		}
+
+		if !errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("get court pricing rule: %w", err)
+		}
+	}
+
+	rule, err := s.rulesRepo.Get(ctx, organizationID, "")
+	if err != nil {
+		return nil, fmt.Errorf("get organization pricing rule: %w", err)
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,366 @@
+package pricing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/pricing"
+	"github.com/lever-dev/padel-backend/internal/services/pricing/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	rulesRepo  *mocks.MockRulesRepository
+	courtsRepo *mocks.MockCourtsRepository
+	scheduler  *mocks.MockScheduler
+	service    *pricing.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.rulesRepo = mocks.NewMockRulesRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.scheduler = mocks.NewMockScheduler(s.ctrl)
+	s.service = pricing.NewService(s.rulesRepo, s.courtsRepo, s.scheduler)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// peakRule charges 20.00 per hour, 30.00 on weekday evenings from 18:00 and 40.00 on Christmas.
+func peakRule() *entities.PricingRule {
+	return &entities.PricingRule{
+		OrganizationID: "org-1",
+		Currency:       "EUR",
+		BaseHourlyRate: 2000,
+		Bands: []entities.PriceBand{
+			{
+				Weekdays: []time.Weekday{
+					time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+				},
+				From:       18 * 60,
+				To:         22 * 60,
+				HourlyRate: 3000,
+			},
+		},
+		Holidays:          []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+		HolidayHourlyRate: 4000,
+		MinimumCharge:     1500,
+	}
+}
+
+func (s *ServiceSuite) TestQuote() {
+	ctx := context.Background()
+	// 2024-07-15 is a Monday
+	monday := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name      string
+		loc       *time.Location
+		from      time.Time
+		to        time.Time
+		wantLines []entities.QuoteLine
+		wantTotal int64
+		wantMin   bool
+	}{
+		{
+			name: "off-peak hour",
+			from: monday.Add(10 * time.Hour),
+			to:   monday.Add(11 * time.Hour),
+			wantLines: []entities.QuoteLine{
+				{From: monday.Add(10 * time.Hour), To: monday.Add(11 * time.Hour), HourlyRate: 2000, Amount: 2000},
+			},
+			wantTotal: 2000,
+		},
+		{
+			name: "slot crossing into the evening band",
+			from: monday.Add(17*time.Hour + 30*time.Minute),
+			to:   monday.Add(19 * time.Hour),
+			wantLines: []entities.QuoteLine{
+				{
+					From:       monday.Add(17*time.Hour + 30*time.Minute),
+					To:         monday.Add(18 * time.Hour),
+					HourlyRate: 2000,
+					Amount:     1000,
+				},
+				{From: monday.Add(18 * time.Hour), To: monday.Add(19 * time.Hour), HourlyRate: 3000, Amount: 3000},
+			},
+			wantTotal: 4000,
+		},
+		{
+			name: "weekend evening is not in the band",
+			from: monday.AddDate(0, 0, 5).Add(18 * time.Hour),
+			to:   monday.AddDate(0, 0, 5).Add(19 * time.Hour),
+			wantLines: []entities.QuoteLine{
+				{
+					From:       monday.AddDate(0, 0, 5).Add(18 * time.Hour),
+					To:         monday.AddDate(0, 0, 5).Add(19 * time.Hour),
+					HourlyRate: 2000,
+					Amount:     2000,
+				},
+			},
+			wantTotal: 2000,
+		},
+		{
+			name: "holiday rate replaces the bands",
+			from: time.Date(2024, 12, 25, 18, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 25, 19, 30, 0, 0, time.UTC),
+			wantLines: []entities.QuoteLine{
+				{
+					From:       time.Date(2024, 12, 25, 18, 0, 0, 0, time.UTC),
+					To:         time.Date(2024, 12, 25, 19, 30, 0, 0, time.UTC),
+					HourlyRate: 4000,
+					Amount:     6000,
+				},
+			},
+			wantTotal: 6000,
+		},
+		{
+			name: "bands follow the wall clock of the club",
+			loc:  madrid,
+			from: monday.Add(15 * time.Hour),
+			to:   monday.Add(17 * time.Hour),
+			wantLines: []entities.QuoteLine{
+				{From: monday.Add(15 * time.Hour), To: monday.Add(16 * time.Hour), HourlyRate: 2000, Amount: 2000},
+				{From: monday.Add(16 * time.Hour), To: monday.Add(17 * time.Hour), HourlyRate: 3000, Amount: 3000},
+			},
+			wantTotal: 5000,
+		},
+		{
+			name: "holidays follow the calendar of the club",
+			loc:  madrid,
+			from: time.Date(2024, 12, 24, 23, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+			wantLines: []entities.QuoteLine{
+				{
+					From:       time.Date(2024, 12, 24, 23, 0, 0, 0, time.UTC),
+					To:         time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+					HourlyRate: 4000,
+					Amount:     4000,
+				},
+			},
+			wantTotal: 4000,
+		},
+		{
+			name: "a whole day is cut at the bands only",
+			from: monday.AddDate(0, 0, 4).Add(20 * time.Hour),
+			to:   monday.AddDate(0, 0, 5).Add(20 * time.Hour),
+			wantLines: []entities.QuoteLine{
+				{
+					From:       monday.AddDate(0, 0, 4).Add(20 * time.Hour),
+					To:         monday.AddDate(0, 0, 4).Add(22 * time.Hour),
+					HourlyRate: 3000,
+					Amount:     6000,
+				},
+				{
+					From:       monday.AddDate(0, 0, 4).Add(22 * time.Hour),
+					To:         monday.AddDate(0, 0, 5).Add(20 * time.Hour),
+					HourlyRate: 2000,
+					Amount:     44000,
+				},
+			},
+			wantTotal: 50000,
+		},
+		{
+			name: "minimum charge",
+			from: monday.Add(10 * time.Hour),
+			to:   monday.Add(10*time.Hour + 30*time.Minute),
+			wantLines: []entities.QuoteLine{
+				{
+					From:       monday.Add(10 * time.Hour),
+					To:         monday.Add(10*time.Hour + 30*time.Minute),
+					HourlyRate: 2000,
+					Amount:     1000,
+				},
+			},
+			wantTotal: 1500,
+			wantMin:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.courtsRepo.EXPECT().
+				GetByID(ctx, "court-1").
+				Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+			s.rulesRepo.EXPECT().
+				Get(ctx, "org-1", "court-1").
+				Return(nil, entities.ErrNotFound)
+			s.rulesRepo.EXPECT().
+				Get(ctx, "org-1", "").
+				Return(peakRule(), nil)
+			s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&entities.Schedule{Location: tt.loc}, nil)
+
+			quote, err := s.service.Quote(ctx, "org-1", "court-1", tt.from, tt.to)
+			s.Require().NoError(err)
+
+			s.Equal("court-1", quote.CourtID)
+			s.Equal("EUR", quote.Currency)
+			s.Equal(tt.wantLines, quote.Lines)
+			s.Equal(tt.wantTotal, quote.Total)
+			s.Equal(tt.wantMin, quote.MinimumChargeApplied)
+			s.Equal(entities.Price{Amount: tt.wantTotal, Currency: "EUR"}, quote.Price())
+		})
+	}
+}
+
+func (s *ServiceSuite) TestQuote_CourtRuleWins() {
+	ctx := context.Background()
+	from := time.Date(2024, 7, 15, 10, 0, 0, 0, time.UTC)
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.rulesRepo.EXPECT().
+		Get(ctx, "org-1", "court-1").
+		Return(&entities.PricingRule{
+			OrganizationID: "org-1",
+			CourtID:        "court-1",
+			Currency:       "EUR",
+			BaseHourlyRate: 5000,
+		}, nil)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&entities.Schedule{}, nil)
+
+	quote, err := s.service.QuoteCourt(ctx, "court-1", from, from.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal(int64(5000), quote.Total)
+}
+
+func (s *ServiceSuite) TestQuote_Errors() {
+	ctx := context.Background()
+	from := time.Date(2024, 7, 15, 10, 0, 0, 0, time.UTC)
+
+	s.Run("court of another organization", func() {
+		s.courtsRepo.EXPECT().
+			GetByID(ctx, "court-1").
+			Return(&entities.Court{ID: "court-1", OrganizationID: "org-2"}, nil)
+
+		_, err := s.service.Quote(ctx, "org-1", "court-1", from, from.Add(time.Hour))
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("no rule", func() {
+		s.courtsRepo.EXPECT().
+			GetByID(ctx, "court-1").
+			Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+		s.rulesRepo.EXPECT().Get(ctx, "org-1", "court-1").Return(nil, entities.ErrNotFound)
+		s.rulesRepo.EXPECT().Get(ctx, "org-1", "").Return(nil, entities.ErrNotFound)
+
+		_, err := s.service.Quote(ctx, "org-1", "court-1", from, from.Add(time.Hour))
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("repository error is not treated as a missing rule", func() {
+		s.courtsRepo.EXPECT().
+			GetByID(ctx, "court-1").
+			Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+		s.rulesRepo.EXPECT().Get(ctx, "org-1", "court-1").Return(nil, errors.New("db down"))
+
+		_, err := s.service.Quote(ctx, "org-1", "court-1", from, from.Add(time.Hour))
+		s.Error(err)
+		s.NotErrorIs(err, entities.ErrNotFound)
+	})
+}
+
+func (s *ServiceSuite) TestCourtLocation() {
+	ctx := context.Background()
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	s.Require().NoError(err)
+
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&entities.Schedule{Location: madrid}, nil)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-2").Return(nil, entities.ErrNotFound)
+
+	loc, err := s.service.CourtLocation(ctx, "court-1")
+	s.Require().NoError(err)
+	s.Equal(madrid, loc)
+
+	_, err = s.service.CourtLocation(ctx, "court-2")
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *ServiceSuite) TestSetRule() {
+	ctx := context.Background()
+
+	s.Run("organization default", func() {
+		rule := peakRule()
+
+		s.rulesRepo.EXPECT().Upsert(ctx, rule).Return(nil)
+
+		s.NoError(s.service.SetRule(ctx, rule))
+	})
+
+	s.Run("court of the organization", func() {
+		rule := peakRule()
+		rule.CourtID = "court-1"
+
+		s.courtsRepo.EXPECT().
+			GetByID(ctx, "court-1").
+			Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+		s.rulesRepo.EXPECT().Upsert(ctx, rule).Return(nil)
+
+		s.NoError(s.service.SetRule(ctx, rule))
+	})
+
+	s.Run("court of another organization", func() {
+		rule := peakRule()
+		rule.CourtID = "court-1"
+
+		s.courtsRepo.EXPECT().
+			GetByID(ctx, "court-1").
+			Return(&entities.Court{ID: "court-1", OrganizationID: "org-2"}, nil)
+
+		s.ErrorIs(s.service.SetRule(ctx, rule), entities.ErrNotFound)
+	})
+
+	invalid := []struct {
+		name   string
+		mutate func(r *entities.PricingRule)
+	}{
+		{
+			name:   "lowercase currency",
+			mutate: func(r *entities.PricingRule) { r.Currency = "eur" },
+		},
+		{
+			name:   "negative base rate",
+			mutate: func(r *entities.PricingRule) { r.BaseHourlyRate = -1 },
+		},
+		{
+			name:   "band ending before it starts",
+			mutate: func(r *entities.PricingRule) { r.Bands[0].To = r.Bands[0].From },
+		},
+		{
+			name: "overlapping bands on a shared weekday",
+			mutate: func(r *entities.PricingRule) {
+				r.Bands = append(r.Bands, entities.PriceBand{
+					Weekdays:   []time.Weekday{time.Friday, time.Saturday},
+					From:       21 * 60,
+					To:         23 * 60,
+					HourlyRate: 3500,
+				})
+			},
+		},
+	}
+
+	for _, tt := range invalid {
+		s.Run(tt.name, func() {
+			rule := peakRule()
+			tt.mutate(rule)
+
+			s.ErrorIs(s.service.SetRule(ctx, rule), entities.ErrInvalidPricing)
+		})
+	}
+}
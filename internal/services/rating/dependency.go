@@ -0,0 +1,33 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package rating
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type ReservationsRepository interface {
+	GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error)
+	ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error)
+
+	SaveResult(ctx context.Context, result *entities.MatchResult) error
+	GetResult(ctx context.Context, reservationID string) (*entities.MatchResult, error)
+	DisputeResult(ctx context.Context, reservationID, userID string, now time.Time) error
+	ConfirmResult(
+		ctx context.Context,
+		result *entities.MatchResult,
+		from entities.ResultStatus,
+	) ([]entities.RatingChange, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
+
+type UsersRepository interface {
+	GetByID(ctx context.Context, userID string) (*entities.User, error)
+	ListRatingHistory(ctx context.Context, userID string, limit int) ([]entities.RatingChange, error)
+}
@@ -0,0 +1,216 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/rating/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockReservationsRepository is a mock of ReservationsRepository interface.
+type MockReservationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsRepositoryMockRecorder
+}
+
+// MockReservationsRepositoryMockRecorder is the mock recorder for MockReservationsRepository.
+type MockReservationsRepositoryMockRecorder struct {
+	mock *MockReservationsRepository
+}
+
+// NewMockReservationsRepository creates a new mock instance.
+func NewMockReservationsRepository(ctrl *gomock.Controller) *MockReservationsRepository {
+	mock := &MockReservationsRepository{ctrl: ctrl}
+	mock.recorder = &MockReservationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsRepository) EXPECT() *MockReservationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmResult mocks base method.
+func (m *MockReservationsRepository) ConfirmResult(ctx context.Context, result *entities.MatchResult, from entities.ResultStatus) ([]entities.RatingChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmResult", ctx, result, from)
+	ret0, _ := ret[0].([]entities.RatingChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmResult indicates an expected call of ConfirmResult.
+func (mr *MockReservationsRepositoryMockRecorder) ConfirmResult(ctx, result, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmResult", reflect.TypeOf((*MockReservationsRepository)(nil).ConfirmResult), ctx, result, from)
+}
+
+// DisputeResult mocks base method.
+func (m *MockReservationsRepository) DisputeResult(ctx context.Context, reservationID, userID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputeResult", ctx, reservationID, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisputeResult indicates an expected call of DisputeResult.
+func (mr *MockReservationsRepositoryMockRecorder) DisputeResult(ctx, reservationID, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputeResult", reflect.TypeOf((*MockReservationsRepository)(nil).DisputeResult), ctx, reservationID, userID, now)
+}
+
+// GetByID mocks base method.
+func (m *MockReservationsRepository) GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReservationsRepositoryMockRecorder) GetByID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetByID), ctx, reservationID)
+}
+
+// GetResult mocks base method.
+func (m *MockReservationsRepository) GetResult(ctx context.Context, reservationID string) (*entities.MatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResult", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.MatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResult indicates an expected call of GetResult.
+func (mr *MockReservationsRepositoryMockRecorder) GetResult(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResult", reflect.TypeOf((*MockReservationsRepository)(nil).GetResult), ctx, reservationID)
+}
+
+// ListPlayers mocks base method.
+func (m *MockReservationsRepository) ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlayers", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.ReservationPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlayers indicates an expected call of ListPlayers.
+func (mr *MockReservationsRepositoryMockRecorder) ListPlayers(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayers", reflect.TypeOf((*MockReservationsRepository)(nil).ListPlayers), ctx, reservationID)
+}
+
+// SaveResult mocks base method.
+func (m *MockReservationsRepository) SaveResult(ctx context.Context, result *entities.MatchResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResult", ctx, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResult indicates an expected call of SaveResult.
+func (mr *MockReservationsRepositoryMockRecorder) SaveResult(ctx, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResult", reflect.TypeOf((*MockReservationsRepository)(nil).SaveResult), ctx, result)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockUsersRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUsersRepositoryMockRecorder) GetByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsersRepository)(nil).GetByID), ctx, userID)
+}
+
+// ListRatingHistory mocks base method.
+func (m *MockUsersRepository) ListRatingHistory(ctx context.Context, userID string, limit int) ([]entities.RatingChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRatingHistory", ctx, userID, limit)
+	ret0, _ := ret[0].([]entities.RatingChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRatingHistory indicates an expected call of ListRatingHistory.
+func (mr *MockUsersRepositoryMockRecorder) ListRatingHistory(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRatingHistory", reflect.TypeOf((*MockUsersRepository)(nil).ListRatingHistory), ctx, userID, limit)
+}
@@ -0,0 +1,271 @@
+package rating
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+)
+
+// historyLimit caps the rating changes returned with the rating of a player.
+const historyLimit = 50
+
+type Service struct {
+	reservationsRepo ReservationsRepository
+	courtsRepo       CourtsRepository
+	usersRepo        UsersRepository
+	clock            clock.Clock
+}
+
+func NewService(
+	reservationsRepo ReservationsRepository,
+	courtsRepo CourtsRepository,
+	usersRepo UsersRepository,
+	clock clock.Clock,
+) *Service {
+	return &Service{
+		reservationsRepo: reservationsRepo,
+		courtsRepo:       courtsRepo,
+		usersRepo:        usersRepo,
+		clock:            clock,
+	}
+}
+
+// ReportResult records the result of a reservation that is over. The reporter plays in one of the teams,
+// and every player must have been on the roster. The result waits for the opposing team to confirm it.
+func (s *Service) ReportResult(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+	teamA, teamB []string,
+	sets []entities.SetScore,
+) (*entities.MatchResult, error) {
+	rsv, err := s.getPlayedReservation(ctx, organizationID, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := entities.NewMatchResult(reservationID, userID, teamA, teamB, sets, s.clock.Now())
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(result.Players(), userID) {
+		return nil, fmt.Errorf("%w: only a player of the match reports its result", entities.ErrForbidden)
+	}
+
+	if err := s.checkRoster(ctx, rsv, result); err != nil {
+		return nil, err
+	}
+
+	if err := s.reservationsRepo.SaveResult(ctx, result); err != nil {
+		return nil, fmt.Errorf("save result: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetResult(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+) (*entities.MatchResult, error) {
+	if _, err := s.getPlayedReservation(ctx, organizationID, courtID, reservationID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.reservationsRepo.GetResult(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ConfirmResult accepts the pending result on behalf of the opposing team and updates the ratings of
+// its players.
+func (s *Service) ConfirmResult(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+) (*entities.MatchResult, error) {
+	result, err := s.getPendingResult(ctx, organizationID, courtID, reservationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result.AnsweredBy = userID
+	result.UpdatedAt = s.clock.Now()
+
+	if _, err := s.reservationsRepo.ConfirmResult(ctx, result, entities.PendingResultStatus); err != nil {
+		return nil, fmt.Errorf("confirm result: %w", err)
+	}
+
+	return result, nil
+}
+
+// DisputeResult contests the pending result on behalf of the opposing team. The ratings of its players
+// do not change until the club resolves the dispute.
+func (s *Service) DisputeResult(ctx context.Context, organizationID, courtID, reservationID, userID string) error {
+	if _, err := s.getPendingResult(ctx, organizationID, courtID, reservationID, userID); err != nil {
+		return err
+	}
+
+	if err := s.reservationsRepo.DisputeResult(ctx, reservationID, userID, s.clock.Now()); err != nil {
+		return fmt.Errorf("dispute result: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveDispute settles a disputed result with the teams and sets the club decided on, and updates the
+// ratings of its players. The caller must be staff of the organization.
+func (s *Service) ResolveDispute(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+	teamA, teamB []string,
+	sets []entities.SetScore,
+) (*entities.MatchResult, error) {
+	rsv, err := s.getPlayedReservation(ctx, organizationID, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.reservationsRepo.GetResult(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get result: %w", err)
+	}
+
+	if result.Status != entities.DisputedResultStatus {
+		return nil, fmt.Errorf("%w: result of reservation %s is %s, not disputed",
+			entities.ErrInvalidResultTransition, reservationID, result.Status)
+	}
+
+	result.TeamA = teamA
+	result.TeamB = teamB
+	result.Sets = sets
+	result.AnsweredBy = userID
+	result.UpdatedAt = s.clock.Now()
+
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRoster(ctx, rsv, result); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.reservationsRepo.ConfirmResult(ctx, result, entities.DisputedResultStatus); err != nil {
+		return nil, fmt.Errorf("confirm result: %w", err)
+	}
+
+	return result, nil
+}
+
+// Rating returns the current rating of the user with their latest rating changes.
+func (s *Service) Rating(ctx context.Context, userID string) (entities.PlayerRating, []entities.RatingChange, error) {
+	user, err := s.usersRepo.GetByID(ctx, userID)
+	if err != nil {
+		return entities.PlayerRating{}, nil, fmt.Errorf("get user by id: %w", err)
+	}
+
+	history, err := s.usersRepo.ListRatingHistory(ctx, userID, historyLimit)
+	if err != nil {
+		return entities.PlayerRating{}, nil, fmt.Errorf("list rating history: %w", err)
+	}
+
+	rating := entities.PlayerRating{
+		UserID:     user.ID,
+		Rating:     user.Rating,
+		RatedGames: user.RatedGames,
+	}
+
+	return rating, history, nil
+}
+
+// getPlayedReservation returns the reservation on the court of the organization as long as it was played,
+// that is it was booked and is over.
+func (s *Service) getPlayedReservation(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+) (*entities.Reservation, error) {
+	court, err := s.courtsRepo.GetByID(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court by id: %w", err)
+	}
+
+	if court.OrganizationID != organizationID {
+		return nil, fmt.Errorf("%w: court %s does not belong to organization %s",
+			entities.ErrNotFound, courtID, organizationID)
+	}
+
+	rsv, err := s.reservationsRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	if rsv.CourtID != courtID {
+		return nil, fmt.Errorf("%w: reservation %s is not on court %s", entities.ErrNotFound, reservationID, courtID)
+	}
+
+	if rsv.Status != entities.ReservedReservationStatus {
+		return nil, fmt.Errorf("%w: reservation %s is %s", entities.ErrReservationNotActive, reservationID, rsv.Status)
+	}
+
+	if s.clock.Now().Before(rsv.ReservedTo) {
+		return nil, fmt.Errorf("%w: reservation %s ends at %s",
+			entities.ErrReservationNotOver, reservationID, rsv.ReservedTo)
+	}
+
+	return rsv, nil
+}
+
+// getPendingResult returns the result waiting for an answer of the user, who must have played against
+// the reporter.
+func (s *Service) getPendingResult(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+) (*entities.MatchResult, error) {
+	result, err := s.GetResult(ctx, organizationID, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status != entities.PendingResultStatus {
+		return nil, fmt.Errorf("%w: result of reservation %s is %s, not pending",
+			entities.ErrInvalidResultTransition, reservationID, result.Status)
+	}
+
+	if !result.AreOpponents(userID, result.ReportedBy) {
+		return nil, fmt.Errorf("%w: only the opposing team answers the result", entities.ErrForbidden)
+	}
+
+	return result, nil
+}
+
+// checkRoster makes sure every player of the result was on the roster: the booker or a player who
+// accepted to play.
+func (s *Service) checkRoster(ctx context.Context, rsv *entities.Reservation, result *entities.MatchResult) error {
+	players, err := s.reservationsRepo.ListPlayers(ctx, rsv.ID)
+	if err != nil {
+		return fmt.Errorf("list players: %w", err)
+	}
+
+	roster := []string{rsv.ReservedBy}
+	for _, player := range players {
+		if player.Status == entities.AcceptedPlayerStatus {
+			roster = append(roster, player.UserID)
+		}
+	}
+
+	for _, id := range result.Players() {
+		if !slices.Contains(roster, id) {
+			return fmt.Errorf("%w: user %s was not on the roster", entities.ErrInvalidResult, id)
+		}
+	}
+
+	return nil
+}
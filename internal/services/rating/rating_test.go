@@ -0,0 +1,222 @@
+package rating_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/rating"
+	"github.com/lever-dev/padel-backend/internal/services/rating/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	usersRepo        *mocks.MockUsersRepository
+	service          *rating.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+var ratingNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(ratingNow).AnyTimes()
+
+	s.service = rating.NewService(s.reservationsRepo, s.courtsRepo, s.usersRepo, clock)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func reservation() *entities.Reservation {
+	return &entities.Reservation{
+		ID:           "res-1",
+		CourtID:      "court-1",
+		ReservedBy:   "user-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: ratingNow.Add(-2 * time.Hour),
+		ReservedTo:   ratingNow.Add(-30 * time.Minute),
+	}
+}
+
+func roster() []entities.ReservationPlayer {
+	return []entities.ReservationPlayer{
+		{UserID: "user-2", Status: entities.AcceptedPlayerStatus},
+		{UserID: "user-3", Status: entities.AcceptedPlayerStatus},
+		{UserID: "user-4", Status: entities.AcceptedPlayerStatus},
+		{UserID: "user-5", Status: entities.DeclinedPlayerStatus},
+	}
+}
+
+var (
+	teamA = []string{"user-1", "user-2"}
+	teamB = []string{"user-3", "user-4"}
+	sets  = []entities.SetScore{{TeamA: 6, TeamB: 3}, {TeamA: 6, TeamB: 4}}
+)
+
+func result(status entities.ResultStatus) *entities.MatchResult {
+	r := entities.NewMatchResult("res-1", "user-1", teamA, teamB, sets, ratingNow)
+	r.Status = status
+	return r
+}
+
+func (s *ServiceSuite) expectReservation(rsv *entities.Reservation) {
+	s.courtsRepo.EXPECT().GetByID(gomock.Any(), "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().GetByID(gomock.Any(), "res-1").Return(rsv, nil)
+}
+
+func (s *ServiceSuite) TestReportResult() {
+	ctx := context.Background()
+
+	s.Run("reported", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return(roster(), nil)
+		s.reservationsRepo.EXPECT().SaveResult(ctx, gomock.Any()).Return(nil)
+
+		got, err := s.service.ReportResult(ctx, "org-1", "court-1", "res-1", "user-3", teamA, teamB, sets)
+		s.Require().NoError(err)
+		s.Equal(entities.PendingResultStatus, got.Status)
+		s.Equal("user-3", got.ReportedBy)
+	})
+
+	s.Run("reservation is not over", func() {
+		rsv := reservation()
+		rsv.ReservedTo = ratingNow.Add(time.Minute)
+		s.expectReservation(rsv)
+
+		_, err := s.service.ReportResult(ctx, "org-1", "court-1", "res-1", "user-1", teamA, teamB, sets)
+		s.ErrorIs(err, entities.ErrReservationNotOver)
+	})
+
+	s.Run("reservation was cancelled", func() {
+		rsv := reservation()
+		rsv.Status = entities.CancelledReservationStatus
+		s.expectReservation(rsv)
+
+		_, err := s.service.ReportResult(ctx, "org-1", "court-1", "res-1", "user-1", teamA, teamB, sets)
+		s.ErrorIs(err, entities.ErrReservationNotActive)
+	})
+
+	s.Run("tied sets", func() {
+		s.expectReservation(reservation())
+
+		tied := []entities.SetScore{{TeamA: 6, TeamB: 3}, {TeamA: 3, TeamB: 6}}
+		_, err := s.service.ReportResult(ctx, "org-1", "court-1", "res-1", "user-1", teamA, teamB, tied)
+		s.ErrorIs(err, entities.ErrInvalidResult)
+	})
+
+	s.Run("reporter did not play", func() {
+		s.expectReservation(reservation())
+
+		_, err := s.service.ReportResult(ctx, "org-1", "court-1", "res-1", "user-9", teamA, teamB, sets)
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+
+	s.Run("player was not on the roster", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return(roster(), nil)
+
+		withDeclined := []string{"user-3", "user-5"}
+		_, err := s.service.ReportResult(ctx, "org-1", "court-1", "res-1", "user-1", teamA, withDeclined, sets)
+		s.ErrorIs(err, entities.ErrInvalidResult)
+	})
+}
+
+func (s *ServiceSuite) TestAnswerResult() {
+	ctx := context.Background()
+
+	s.Run("confirmed by the opposing team", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().GetResult(ctx, "res-1").Return(result(entities.PendingResultStatus), nil)
+		s.reservationsRepo.EXPECT().ConfirmResult(ctx, gomock.Any(), entities.PendingResultStatus).
+			Return([]entities.RatingChange{}, nil)
+
+		got, err := s.service.ConfirmResult(ctx, "org-1", "court-1", "res-1", "user-4")
+		s.Require().NoError(err)
+		s.Equal("user-4", got.AnsweredBy)
+	})
+
+	s.Run("teammate of the reporter can not confirm", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().GetResult(ctx, "res-1").Return(result(entities.PendingResultStatus), nil)
+
+		_, err := s.service.ConfirmResult(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+
+	s.Run("disputed by the opposing team", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().GetResult(ctx, "res-1").Return(result(entities.PendingResultStatus), nil)
+		s.reservationsRepo.EXPECT().DisputeResult(ctx, "res-1", "user-3", ratingNow).Return(nil)
+
+		s.NoError(s.service.DisputeResult(ctx, "org-1", "court-1", "res-1", "user-3"))
+	})
+
+	s.Run("disputed result is not confirmed by players", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().GetResult(ctx, "res-1").Return(result(entities.DisputedResultStatus), nil)
+
+		_, err := s.service.ConfirmResult(ctx, "org-1", "court-1", "res-1", "user-3")
+		s.ErrorIs(err, entities.ErrInvalidResultTransition)
+	})
+}
+
+func (s *ServiceSuite) TestResolveDispute() {
+	ctx := context.Background()
+	corrected := []entities.SetScore{{TeamA: 4, TeamB: 6}, {TeamA: 3, TeamB: 6}}
+
+	s.Run("resolved with the corrected sets", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().GetResult(ctx, "res-1").Return(result(entities.DisputedResultStatus), nil)
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").Return(roster(), nil)
+		s.reservationsRepo.EXPECT().ConfirmResult(ctx, gomock.Any(), entities.DisputedResultStatus).
+			Return([]entities.RatingChange{}, nil)
+
+		got, err := s.service.ResolveDispute(ctx, "org-1", "court-1", "res-1", "staff-1", teamA, teamB, corrected)
+		s.Require().NoError(err)
+		s.Equal(corrected, got.Sets)
+		s.Equal("staff-1", got.AnsweredBy)
+		s.False(got.TeamAWon())
+	})
+
+	s.Run("only disputed results are resolved", func() {
+		s.expectReservation(reservation())
+		s.reservationsRepo.EXPECT().GetResult(ctx, "res-1").Return(result(entities.PendingResultStatus), nil)
+
+		_, err := s.service.ResolveDispute(ctx, "org-1", "court-1", "res-1", "staff-1", teamA, teamB, corrected)
+		s.ErrorIs(err, entities.ErrInvalidResultTransition)
+	})
+}
+
+func (s *ServiceSuite) TestRating() {
+	ctx := context.Background()
+
+	history := []entities.RatingChange{{UserID: "user-1", ReservationID: "res-1", Before: 1500, After: 1520}}
+
+	s.usersRepo.EXPECT().GetByID(ctx, "user-1").
+		Return(&entities.User{ID: "user-1", Rating: 1520, RatedGames: 1}, nil)
+	s.usersRepo.EXPECT().ListRatingHistory(ctx, "user-1", 50).Return(history, nil)
+
+	got, gotHistory, err := s.service.Rating(ctx, "user-1")
+	s.Require().NoError(err)
+	s.Equal(entities.PlayerRating{UserID: "user-1", Rating: 1520, RatedGames: 1}, got)
+	s.Equal(history, gotHistory)
+}
@@ -0,0 +1,143 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+)
+
+// GetCourtAvailability splits the opening hours of the court on the given day into slots
+// and marks every slot that overlaps an active reservation as booked and every slot closed by
+// a blackout as blocked. The day is the calendar day of date in the time zone of the organization,
+// and holidays and special hours of the organization replace the weekly hours on their dates.
+func (s *Service) GetCourtAvailability(
+	ctx context.Context,
+	organizationID string,
+	courtID string,
+	date time.Time,
+) (*entities.CourtAvailability, error) {
+	court, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability, err := s.courtAvailability(ctx, *court, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &availability, nil
+}
+
+func (s *Service) GetOrganizationAvailability(
+	ctx context.Context,
+	organizationID string,
+	date time.Time,
+) ([]entities.CourtAvailability, error) {
+	courts, err := s.courtsRepo.ListByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list courts by organization id: %w", err)
+	}
+
+	result := make([]entities.CourtAvailability, 0, len(courts))
+	for _, court := range courts {
+		availability, err := s.courtAvailability(ctx, court, date)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, availability)
+	}
+
+	return result, nil
+}
+
+func (s *Service) courtAvailability(
+	ctx context.Context,
+	court entities.Court,
+	date time.Time,
+) (entities.CourtAvailability, error) {
+	schedule, err := s.scheduler.CourtSchedule(ctx, court.ID)
+	if err != nil {
+		return entities.CourtAvailability{}, fmt.Errorf("get court schedule: %w", err)
+	}
+
+	dayStart := schedule.Day(date)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	reservations, err := s.reservationsRepo.ListByCourtAndTimeRange(ctx, court.ID, dayStart, dayEnd)
+	if err != nil {
+		return entities.CourtAvailability{}, fmt.Errorf("list reservations by court and time range: %w", err)
+	}
+
+	blackouts, err := s.reservationsRepo.ListBlackoutsByCourt(ctx, court.ID, dayStart, dayEnd)
+	if err != nil {
+		return entities.CourtAvailability{}, fmt.Errorf("list blackouts by court: %w", err)
+	}
+
+	return entities.CourtAvailability{
+		CourtID: court.ID,
+		Date:    dayStart,
+		Slots:   buildSlots(court, schedule.HoursOn(dayStart), dayStart, reservations, blackouts, s.clock.Now()),
+	}, nil
+}
+
+func buildSlots(
+	court entities.Court,
+	windows []entities.OpeningHours,
+	day time.Time,
+	reservations []entities.Reservation,
+	blackouts []entities.Blackout,
+	now time.Time,
+) []entities.Slot {
+	slotDuration := court.SlotDuration
+	if slotDuration <= 0 {
+		slotDuration = entities.DefaultSlotDuration
+	}
+
+	var slots []entities.Slot
+
+	for _, window := range windows {
+		opensAt := window.OpensAt.On(day)
+		closesAt := window.ClosesAt.On(day)
+
+		for from := opensAt; !from.Add(slotDuration).After(closesAt); from = from.Add(slotDuration) {
+			to := from.Add(slotDuration)
+
+			status := entities.FreeSlotStatus
+			switch {
+			case isBlocked(blackouts, from, to):
+				status = entities.BlockedSlotStatus
+			case isBooked(reservations, from, to, now):
+				status = entities.BookedSlotStatus
+			}
+
+			slots = append(slots, entities.Slot{From: from, To: to, Status: status})
+		}
+	}
+
+	return slots
+}
+
+func isBooked(reservations []entities.Reservation, from, to, now time.Time) bool {
+	for _, rsv := range reservations {
+		if rsv.IsActiveAt(now) && rsv.ReservedFrom.Before(to) && rsv.ReservedTo.After(from) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isBlocked(blackouts []entities.Blackout, from, to time.Time) bool {
+	for _, blackout := range blackouts {
+		if blackout.Overlaps(from, to) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,332 @@
+package reservation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type AvailabilitySuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	scheduler        *mocks.MockScheduler
+	clock            *clockmocks.MockClock
+	service          *reservation.Service
+}
+
+func TestAvailabilitySuite(t *testing.T) {
+	suite.Run(t, new(AvailabilitySuite))
+}
+
+func (s *AvailabilitySuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.scheduler = mocks.NewMockScheduler(s.ctrl)
+	s.clock = clockmocks.NewMockClock(s.ctrl)
+	s.clock.EXPECT().Now().Return(availabilityDay.Add(8 * time.Hour)).AnyTimes()
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Scheduler:    s.scheduler,
+			Clock:        s.clock,
+		},
+		reservation.DefaultHoldTTL,
+	)
+}
+
+func (s *AvailabilitySuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// 2025-11-03 is a Monday.
+var availabilityDay = time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+
+func (s *AvailabilitySuite) court(id string) *entities.Court {
+	return &entities.Court{
+		ID:             id,
+		OrganizationID: "org-1",
+		Name:           "Court " + id,
+		SlotDuration:   time.Hour,
+		OpeningHours: []entities.OpeningHours{
+			{Weekday: time.Monday, OpensAt: 18 * 60, ClosesAt: 21 * 60},
+			{Weekday: time.Monday, OpensAt: 9 * 60, ClosesAt: 11 * 60},
+			{Weekday: time.Tuesday, OpensAt: 7 * 60, ClosesAt: 23 * 60},
+		},
+	}
+}
+
+// expectSchedule serves the schedule of a court of an organization in UTC without hours of its own.
+func (s *AvailabilitySuite) expectSchedule(court *entities.Court) {
+	schedule := entities.NewSchedule(entities.Organization{}, *court)
+	s.scheduler.EXPECT().CourtSchedule(gomock.Any(), court.ID).Return(&schedule, nil)
+}
+
+func (s *AvailabilitySuite) TestGetCourtAvailability() {
+	ctx := context.Background()
+	at := func(hour int) time.Time { return availabilityDay.Add(time.Duration(hour) * time.Hour) }
+
+	court := s.court("court-1")
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+	s.expectSchedule(court)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", availabilityDay, availabilityDay.AddDate(0, 0, 1)).
+		Return([]entities.Reservation{
+			{
+				ID:           "booked",
+				CourtID:      "court-1",
+				Status:       entities.ReservedReservationStatus,
+				ReservedFrom: at(9).Add(30 * time.Minute),
+				ReservedTo:   at(10).Add(30 * time.Minute),
+			},
+			{
+				ID:           "cancelled",
+				CourtID:      "court-1",
+				Status:       entities.CancelledReservationStatus,
+				ReservedFrom: at(18),
+				ReservedTo:   at(19),
+			},
+			{
+				ID:           "expired-hold",
+				CourtID:      "court-1",
+				Status:       entities.PendingReservationStatus,
+				ReservedFrom: at(19),
+				ReservedTo:   at(20),
+				ExpiresAt:    at(7),
+			},
+			{
+				ID:           "pending",
+				CourtID:      "court-1",
+				Status:       entities.PendingReservationStatus,
+				ReservedFrom: at(20),
+				ReservedTo:   at(21),
+				ExpiresAt:    at(8).Add(10 * time.Minute),
+			},
+		}, nil)
+
+	result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay.Add(15*time.Hour))
+	s.Require().NoError(err)
+
+	s.Equal("court-1", result.CourtID)
+	s.Equal(availabilityDay, result.Date)
+	s.Equal([]entities.Slot{
+		{From: at(9), To: at(10), Status: entities.BookedSlotStatus},
+		{From: at(10), To: at(11), Status: entities.BookedSlotStatus},
+		{From: at(18), To: at(19), Status: entities.FreeSlotStatus},
+		{From: at(19), To: at(20), Status: entities.FreeSlotStatus},
+		{From: at(20), To: at(21), Status: entities.BookedSlotStatus},
+	}, result.Slots)
+}
+
+func (s *AvailabilitySuite) TestGetCourtAvailability_DropsPartialSlots() {
+	ctx := context.Background()
+
+	court := s.court("court-1")
+	court.SlotDuration = 90 * time.Minute
+	court.OpeningHours = []entities.OpeningHours{
+		{Weekday: time.Monday, OpensAt: 9 * 60, ClosesAt: 13 * 60},
+	}
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+	s.expectSchedule(court)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay)
+	s.Require().NoError(err)
+
+	s.Len(result.Slots, 2)
+	s.Equal(availabilityDay.Add(9*time.Hour), result.Slots[0].From)
+	s.Equal(availabilityDay.Add(12*time.Hour), result.Slots[1].To)
+}
+
+func (s *AvailabilitySuite) TestGetCourtAvailability_ClosedDay() {
+	ctx := context.Background()
+
+	court := s.court("court-1")
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+	s.expectSchedule(court)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	// 2025-11-05 is a Wednesday, the court has no opening hours.
+	result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay.AddDate(0, 0, 2))
+	s.Require().NoError(err)
+	s.Empty(result.Slots)
+}
+
+func (s *AvailabilitySuite) TestGetCourtAvailability_TimeZone() {
+	ctx := context.Background()
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	s.Require().NoError(err)
+
+	court := s.court("court-1")
+	schedule := entities.NewSchedule(entities.Organization{TimeZone: "Europe/Madrid"}, *court)
+	dayStart := time.Date(2025, 11, 3, 0, 0, 0, 0, madrid)
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&schedule, nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", dayStart, dayStart.AddDate(0, 0, 1)).
+		Return(nil, nil)
+
+	result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay)
+	s.Require().NoError(err)
+
+	s.True(dayStart.Equal(result.Date))
+	s.Require().Len(result.Slots, 5)
+	// 09:00 in Madrid is 08:00 UTC in November
+	s.True(result.Slots[0].From.Equal(availabilityDay.Add(8 * time.Hour)))
+}
+
+func (s *AvailabilitySuite) TestGetCourtAvailability_SpecialHours() {
+	ctx := context.Background()
+
+	court := s.court("court-1")
+	org := entities.Organization{
+		SpecialHours: []entities.SpecialHours{
+			{
+				Date:    availabilityDay,
+				Windows: []entities.OpeningHours{{Weekday: time.Monday, OpensAt: 10 * 60, ClosesAt: 12 * 60}},
+				Reason:  "Tournament",
+			},
+			{Date: availabilityDay.AddDate(0, 0, 1), Reason: "Holiday"},
+		},
+	}
+	schedule := entities.NewSchedule(org, *court)
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil).Times(2)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&schedule, nil).Times(2)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(2)
+
+	result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay)
+	s.Require().NoError(err)
+	s.Equal([]entities.Slot{
+		{From: availabilityDay.Add(10 * time.Hour), To: availabilityDay.Add(11 * time.Hour), Status: entities.FreeSlotStatus},
+		{From: availabilityDay.Add(11 * time.Hour), To: availabilityDay.Add(12 * time.Hour), Status: entities.FreeSlotStatus},
+	}, result.Slots)
+
+	result, err = s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+	s.Empty(result.Slots)
+}
+
+func (s *AvailabilitySuite) TestGetCourtAvailability_Errors() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		setupMocks func()
+		wantErr    error
+	}{
+		{
+			name: "court not found",
+			setupMocks: func() {
+				s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(nil, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "court belongs to another organization",
+			setupMocks: func() {
+				court := s.court("court-1")
+				court.OrganizationID = "org-2"
+				s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "reservations error",
+			setupMocks: func() {
+				court := s.court("court-1")
+				s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+				s.expectSchedule(court)
+				s.reservationsRepo.EXPECT().
+					ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", availabilityDay)
+			s.Require().Error(err)
+			s.Nil(result)
+
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func (s *AvailabilitySuite) TestGetOrganizationAvailability() {
+	ctx := context.Background()
+
+	courts := []*entities.Court{s.court("court-1"), s.court("court-2")}
+	s.courtsRepo.EXPECT().
+		ListByOrganizationID(ctx, "org-1").
+		Return([]entities.Court{*courts[0], *courts[1]}, nil)
+	s.expectSchedule(courts[0])
+	s.expectSchedule(courts[1])
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-2", gomock.Any(), gomock.Any()).
+		Return([]entities.Reservation{
+			{
+				CourtID:      "court-2",
+				Status:       entities.ReservedReservationStatus,
+				ReservedFrom: availabilityDay.Add(9 * time.Hour),
+				ReservedTo:   availabilityDay.Add(11 * time.Hour),
+			},
+		}, nil)
+
+	result, err := s.service.GetOrganizationAvailability(ctx, "org-1", availabilityDay)
+	s.Require().NoError(err)
+	s.Require().Len(result, 2)
+
+	s.Equal("court-1", result[0].CourtID)
+	s.Equal(entities.FreeSlotStatus, result[0].Slots[0].Status)
+	s.Equal("court-2", result[1].CourtID)
+	s.Equal(entities.BookedSlotStatus, result[1].Slots[0].Status)
+	s.Equal(entities.BookedSlotStatus, result[1].Slots[1].Status)
+	s.Equal(entities.FreeSlotStatus, result[1].Slots[2].Status)
+}
+
+func (s *AvailabilitySuite) TestGetOrganizationAvailability_Error() {
+	ctx := context.Background()
+
+	s.courtsRepo.EXPECT().
+		ListByOrganizationID(ctx, "org-1").
+		Return(nil, fmt.Errorf("db error"))
+
+	_, err := s.service.GetOrganizationAvailability(ctx, "org-1", availabilityDay)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "list courts by organization id")
+}
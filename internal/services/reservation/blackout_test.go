@@ -0,0 +1,154 @@
+package reservation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+// BlackoutSuite covers how blackouts closing a court conflict with bookings and show in its availability.
+type BlackoutSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	scheduler        *mocks.MockScheduler
+	service          *reservation.Service
+}
+
+func TestBlackoutSuite(t *testing.T) {
+	suite.Run(t, new(BlackoutSuite))
+}
+
+// 2025-11-03 is a Monday.
+var blackoutDay = time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+
+func (s *BlackoutSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.scheduler = mocks.NewMockScheduler(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(blackoutDay.Add(-24 * time.Hour)).AnyTimes()
+
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Scheduler:    s.scheduler,
+			Clock:        clock,
+		},
+		reservation.DefaultHoldTTL,
+	)
+	noExpiredHolds(s.reservationsRepo)
+}
+
+func (s *BlackoutSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func blackoutAt(hour int) time.Time {
+	return blackoutDay.Add(time.Duration(hour) * time.Hour)
+}
+
+func (s *BlackoutSuite) TestReserveCourt_BlackedOut() {
+	ctx := context.Background()
+	rsv := entities.NewReservation("court-1", blackoutAt(10), blackoutAt(11), "user-1")
+
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&entities.Schedule{}, nil)
+	s.reservationsRepo.EXPECT().ListByCourtAndTimeRange(ctx, "court-1", blackoutAt(10), blackoutAt(11)).Return(nil, nil)
+	s.reservationsRepo.EXPECT().
+		ListBlackoutsByCourt(ctx, "court-1", blackoutAt(10), blackoutAt(11)).
+		Return([]entities.Blackout{{ID: "blackout-1", From: blackoutAt(8), To: blackoutAt(12), Reason: "resurfacing"}}, nil)
+
+	err := s.service.ReserveCourt(ctx, "court-1", rsv)
+	s.Require().ErrorIs(err, entities.ErrCourtBlackedOut)
+	s.ErrorContains(err, "resurfacing")
+}
+
+func (s *BlackoutSuite) TestGetCourtAvailability_BlockedSlots() {
+	ctx := context.Background()
+
+	court := &entities.Court{
+		ID:             "court-1",
+		OrganizationID: "org-1",
+		SlotDuration:   time.Hour,
+		OpeningHours:   []entities.OpeningHours{{Weekday: time.Monday, OpensAt: 9 * 60, ClosesAt: 13 * 60}},
+	}
+	schedule := entities.NewSchedule(entities.Organization{}, *court)
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court, nil)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&schedule, nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", blackoutDay, blackoutDay.AddDate(0, 0, 1)).
+		Return([]entities.Reservation{{
+			ID:           "booked",
+			CourtID:      "court-1",
+			Status:       entities.ReservedReservationStatus,
+			ReservedFrom: blackoutAt(10),
+			ReservedTo:   blackoutAt(12),
+		}}, nil)
+	s.reservationsRepo.EXPECT().
+		ListBlackoutsByCourt(ctx, "court-1", blackoutDay, blackoutDay.AddDate(0, 0, 1)).
+		Return([]entities.Blackout{{ID: "blackout-1", From: blackoutAt(11), To: blackoutAt(12).Add(30 * time.Minute)}}, nil)
+
+	result, err := s.service.GetCourtAvailability(ctx, "org-1", "court-1", blackoutDay)
+	s.Require().NoError(err)
+
+	s.Equal([]entities.Slot{
+		{From: blackoutAt(9), To: blackoutAt(10), Status: entities.FreeSlotStatus},
+		{From: blackoutAt(10), To: blackoutAt(11), Status: entities.BookedSlotStatus},
+		{From: blackoutAt(11), To: blackoutAt(12), Status: entities.BlockedSlotStatus},
+		{From: blackoutAt(12), To: blackoutAt(13), Status: entities.BlockedSlotStatus},
+	}, result.Slots)
+}
+
+func (s *BlackoutSuite) TestCreateSeries_BlackedOutOccurrence() {
+	ctx := context.Background()
+	series := weeklySeries()
+	tournament := entities.Blackout{
+		ID:     "blackout-1",
+		From:   time.Date(2025, 9, 9, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC),
+		Reason: "tournament",
+	}
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&entities.Schedule{}, nil).Times(5)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(5)
+	s.reservationsRepo.EXPECT().
+		ListBlackoutsByCourt(ctx, "court-1", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, from, to time.Time) ([]entities.Blackout, error) {
+			if tournament.Overlaps(from, to) {
+				return []entities.Blackout{tournament}, nil
+			}
+			return nil, nil
+		}).
+		Times(5)
+	s.reservationsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(4)
+	s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+	occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+	s.Require().Len(occurrences, 5)
+
+	for i, occ := range occurrences {
+		s.Equal(i == 1, occ.Conflict, "occurrence %d", i)
+	}
+}
@@ -0,0 +1,306 @@
+package reservation_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type BookingRulesSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	policies         *mocks.MockPolicies
+	service          *reservation.Service
+}
+
+func TestBookingRulesSuite(t *testing.T) {
+	suite.Run(t, new(BookingRulesSuite))
+}
+
+var (
+	bookingNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	// clubRules allows games of 60, 90 or 120 minutes on half hour slots, booked one hour to a week ahead,
+	// two upcoming games per player
+	clubRules = &entities.BookingRules{
+		OrganizationID:    "org-1",
+		Durations:         []time.Duration{60 * time.Minute, 90 * time.Minute, 120 * time.Minute},
+		SlotInterval:      30 * time.Minute,
+		MaxAdvance:        7 * 24 * time.Hour,
+		MinNotice:         time.Hour,
+		MaxActiveBookings: 2,
+	}
+)
+
+func (s *BookingRulesSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.policies = mocks.NewMockPolicies(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(bookingNow).AnyTimes()
+
+	s.policies.EXPECT().CourtBookingRules(gomock.Any(), "court-1").Return(clubRules, nil).AnyTimes()
+
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Policies:     s.policies,
+			Clock:        clock,
+		},
+		10*time.Minute,
+	)
+}
+
+func (s *BookingRulesSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *BookingRulesSuite) TestReserveCourt_EnforcesRules() {
+	ctx := context.Background()
+	tomorrow := time.Date(2025, 11, 4, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		duration time.Duration
+		active   int
+		wantErr  error
+	}{
+		{
+			name:     "allowed",
+			from:     tomorrow,
+			duration: 90 * time.Minute,
+			active:   1,
+		},
+		{
+			name:     "duration not offered",
+			from:     tomorrow,
+			duration: 75 * time.Minute,
+			wantErr:  entities.ErrBookingDurationNotAllowed,
+		},
+		{
+			name:     "off the slot boundaries",
+			from:     tomorrow.Add(15 * time.Minute),
+			duration: time.Hour,
+			wantErr:  entities.ErrBookingNotAligned,
+		},
+		{
+			name:     "too close to the start",
+			from:     bookingNow.Add(30 * time.Minute),
+			duration: time.Hour,
+			wantErr:  entities.ErrBookingNoticeTooShort,
+		},
+		{
+			name:     "too far in advance",
+			from:     bookingNow.AddDate(0, 0, 8),
+			duration: time.Hour,
+			wantErr:  entities.ErrBookingTooFarAhead,
+		},
+		{
+			name:     "too many upcoming games",
+			from:     tomorrow,
+			duration: time.Hour,
+			active:   2,
+			wantErr:  entities.ErrTooManyActiveBookings,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rsv := entities.NewReservation("court-1", tt.from, tt.from.Add(tt.duration), "user-1")
+
+			// upcoming games are only counted for slots the rules allow
+			if tt.active > 0 {
+				s.reservationsRepo.EXPECT().CountActiveByUser(ctx, "org-1", "user-1", bookingNow).Return(tt.active, nil)
+			}
+
+			if tt.wantErr == nil {
+				s.reservationsRepo.EXPECT().
+					ListByCourtAndTimeRange(ctx, "court-1", rsv.ReservedFrom, rsv.ReservedTo).
+					Return(nil, nil)
+				s.reservationsRepo.EXPECT().Create(ctx, rsv).Return(nil)
+			}
+
+			err := s.service.ReserveCourt(ctx, "court-1", rsv)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(entities.PendingReservationStatus, rsv.Status)
+		})
+	}
+}
+
+func (s *BookingRulesSuite) TestReserveCourt_AlignsToClubTime() {
+	ctx := context.Background()
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	s.Require().NoError(err)
+
+	// hourly slots in a club half an hour off UTC
+	s.policies.EXPECT().
+		CourtBookingRules(gomock.Any(), "court-2").
+		Return(&entities.BookingRules{OrganizationID: "org-1", SlotInterval: time.Hour}, nil).
+		AnyTimes()
+
+	scheduler := mocks.NewMockScheduler(s.ctrl)
+	scheduler.EXPECT().CourtSchedule(gomock.Any(), "court-2").Return(&entities.Schedule{Location: kolkata}, nil).AnyTimes()
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(bookingNow).AnyTimes()
+
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Policies:     s.policies,
+			Scheduler:    scheduler,
+			Clock:        clock,
+		},
+		10*time.Minute,
+	)
+
+	// 13:30 UTC is 19:00 in Kolkata
+	from := time.Date(2025, 11, 4, 13, 30, 0, 0, time.UTC)
+	rsv := entities.NewReservation("court-2", from, from.Add(time.Hour), "user-1")
+
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-2", rsv.ReservedFrom, rsv.ReservedTo).
+		Return(nil, nil)
+	s.reservationsRepo.EXPECT().Create(ctx, rsv).Return(nil)
+
+	s.Require().NoError(service.ReserveCourt(ctx, "court-2", rsv))
+
+	// 14:00 UTC is 19:30 in Kolkata
+	from = time.Date(2025, 11, 4, 14, 0, 0, 0, time.UTC)
+	rsv = entities.NewReservation("court-2", from, from.Add(time.Hour), "user-1")
+
+	s.ErrorIs(service.ReserveCourt(ctx, "court-2", rsv), entities.ErrBookingNotAligned)
+}
+
+func (s *BookingRulesSuite) TestReserveCourt_ActiveBookingsRace() {
+	ctx := context.Background()
+	from := time.Date(2025, 11, 4, 18, 0, 0, 0, time.UTC)
+
+	// one upcoming game per player, booked on two courts at once
+	s.policies.EXPECT().
+		CourtBookingRules(gomock.Any(), gomock.Any()).
+		Return(&entities.BookingRules{OrganizationID: "org-1", MaxActiveBookings: 1}, nil).
+		AnyTimes()
+
+	var (
+		mu      sync.Mutex
+		created int
+	)
+
+	s.reservationsRepo.EXPECT().
+		CountActiveByUser(ctx, "org-1", "user-1", bookingNow).
+		DoAndReturn(func(context.Context, string, string, time.Time) (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return created, nil
+		}).
+		Times(2)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, gomock.Any(), from, from.Add(time.Hour)).
+		Return(nil, nil).
+		AnyTimes()
+	s.reservationsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(context.Context, *entities.Reservation) error {
+			// widen the window between counting and storing
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			created++
+			return nil
+		}).
+		AnyTimes()
+
+	errs := make([]error, 2)
+
+	var wg sync.WaitGroup
+	for i, courtID := range []string{"court-a", "court-b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rsv := entities.NewReservation(courtID, from, from.Add(time.Hour), "user-1")
+			errs[i] = s.service.ReserveCourt(ctx, courtID, rsv)
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(1, created)
+
+	if errs[0] == nil {
+		s.ErrorIs(errs[1], entities.ErrTooManyActiveBookings)
+	} else {
+		s.ErrorIs(errs[0], entities.ErrTooManyActiveBookings)
+		s.NoError(errs[1])
+	}
+}
+
+func (s *BookingRulesSuite) TestCreateSeries_EnforcesRules() {
+	ctx := context.Background()
+
+	// every day at 19:00 for ten days, the club takes two upcoming games a week ahead at most
+	series := entities.NewReservationSeries(
+		"court-1",
+		entities.RecurrenceRule{Frequency: entities.DailyRecurrence, Interval: 1},
+		time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 11, 13, 0, 0, 0, 0, time.UTC),
+		19*60,
+		90*time.Minute,
+		"user-1",
+	)
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+
+	created := 0
+	s.reservationsRepo.EXPECT().
+		CountActiveByUser(ctx, "org-1", "user-1", bookingNow).
+		DoAndReturn(func(context.Context, string, string, time.Time) (int, error) {
+			return created, nil
+		}).
+		AnyTimes()
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+	s.reservationsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(context.Context, *entities.Reservation) error {
+			created++
+			return nil
+		}).
+		Times(2)
+	s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+	s.Require().Len(occurrences, 10)
+
+	for i, occ := range occurrences {
+		s.Equal(i >= 2, occ.Conflict, "occurrence %d", i)
+	}
+}
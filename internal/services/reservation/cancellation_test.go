@@ -0,0 +1,217 @@
+package reservation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type CancellationSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	policies         *mocks.MockPolicies
+	refunder         *mocks.MockRefunder
+	service          *reservation.Service
+}
+
+func TestCancellationSuite(t *testing.T) {
+	suite.Run(t, new(CancellationSuite))
+}
+
+var cancellationNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+// dayAheadPolicy is free until 24h before the game and refunds half until 6h before.
+var dayAheadPolicy = &entities.CancellationPolicy{
+	OrganizationID: "org-1",
+	Tiers: []entities.CancellationTier{
+		{Notice: 24 * time.Hour, RefundPercent: 100},
+		{Notice: 6 * time.Hour, RefundPercent: 50},
+	},
+}
+
+func (s *CancellationSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.policies = mocks.NewMockPolicies(s.ctrl)
+	s.refunder = mocks.NewMockRefunder(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(cancellationNow).AnyTimes()
+
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Policies:     s.policies,
+			Refunder:     s.refunder,
+			Clock:        clock,
+		},
+		10*time.Minute,
+	)
+}
+
+func (s *CancellationSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *CancellationSuite) booked(notice time.Duration, status entities.ReservationStatus) *entities.Reservation {
+	// started slots are not offered to the waitlist, so the tests do not have to expect it
+	from := cancellationNow.Add(notice)
+
+	return &entities.Reservation{
+		ID:           "res-1",
+		CourtID:      "court-1",
+		ReservedBy:   "user-1",
+		Status:       status,
+		ReservedFrom: from,
+		ReservedTo:   from.Add(time.Hour),
+		Price:        entities.Price{Amount: 3000, Currency: "EUR"},
+	}
+}
+
+func (s *CancellationSuite) TestCancelReservation_AppliesPolicy() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		notice time.Duration
+		want   entities.Cancellation
+	}{
+		{
+			name:   "free a day ahead",
+			notice: 30 * time.Hour,
+			want:   entities.Cancellation{RefundPercent: 100},
+		},
+		{
+			name:   "half refunded the same day",
+			notice: 10 * time.Hour,
+			want:   entities.Cancellation{RefundPercent: 50, Fee: 1500},
+		},
+		{
+			name:   "nothing refunded at the last minute",
+			notice: time.Hour,
+			want:   entities.Cancellation{RefundPercent: 0, Fee: 3000},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rsv := s.booked(tt.notice, entities.ReservedReservationStatus)
+
+			s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+			s.policies.EXPECT().CourtCancellationPolicy(ctx, "court-1").Return(dayAheadPolicy, nil)
+			s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", tt.want).Return(nil)
+			s.refunder.EXPECT().RefundReservation(ctx, rsv.ID, tt.want.RefundPercent).Return(nil)
+			// the freed slot is still ahead, nobody waits for it
+			s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+			s.reservationsRepo.EXPECT().
+				ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), cancellationNow).
+				Return(nil, entities.ErrNotFound)
+
+			got, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+			s.Require().NoError(err)
+
+			s.Equal(entities.CancelledReservationStatus, got.Status)
+			s.Equal(&tt.want, got.Cancellation)
+			s.Equal(3000-tt.want.Fee, got.Cancellation.Refund(got.Price))
+		})
+	}
+}
+
+func (s *CancellationSuite) TestCancelReservation_FreeWithoutPolicy() {
+	ctx := context.Background()
+	rsv := s.booked(-time.Minute, entities.ReservedReservationStatus)
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.policies.EXPECT().CourtCancellationPolicy(ctx, "court-1").Return(nil, entities.ErrNotFound)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+	s.refunder.EXPECT().RefundReservation(ctx, rsv.ID, 100).Return(nil)
+
+	got, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+	s.Equal(entities.FreeCancellation(false), *got.Cancellation)
+}
+
+func (s *CancellationSuite) TestCancelReservation_PendingHoldIsFree() {
+	ctx := context.Background()
+	rsv := s.booked(-time.Minute, entities.PendingReservationStatus)
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+	s.refunder.EXPECT().RefundReservation(ctx, rsv.ID, 100).Return(nil)
+
+	_, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+}
+
+func (s *CancellationSuite) TestCancelReservation_StaffWaivesPolicy() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		actor   entities.Actor
+		orgID   string
+		wantErr error
+	}{
+		{
+			name:  "staff of the organization",
+			actor: entities.Actor{UserID: "staff-1", Role: entities.StaffRole},
+			orgID: "org-1",
+		},
+		{
+			name:    "booker who is not staff",
+			actor:   entities.Actor{UserID: "user-1", Role: entities.PlayerRole},
+			orgID:   "org-1",
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:    "staff of another organization",
+			actor:   entities.Actor{UserID: "staff-1", Role: entities.StaffRole},
+			orgID:   "org-2",
+			wantErr: entities.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rsv := s.booked(-time.Minute, entities.ReservedReservationStatus)
+
+			s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+			s.courtsRepo.EXPECT().
+				GetByID(ctx, "court-1").
+				Return(&entities.Court{OrganizationID: "org-1"}, nil).
+				AnyTimes()
+
+			if tt.wantErr == nil {
+				s.reservationsRepo.EXPECT().
+					CancelReservation(ctx, rsv.ID, tt.actor.UserID, entities.FreeCancellation(true)).
+					Return(nil)
+				s.refunder.EXPECT().RefundReservation(ctx, rsv.ID, 100).Return(nil)
+			}
+
+			got, err := s.service.CancelReservation(ctx, tt.orgID, "court-1", rsv.ID, tt.actor, true)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.True(got.Cancellation.Waived)
+			s.Equal(int64(3000), got.Cancellation.Refund(got.Price))
+		})
+	}
+}
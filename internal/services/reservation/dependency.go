@@ -12,6 +12,71 @@ import (
 type ReservationsRepository interface {
 	Create(ctx context.Context, reservation *entities.Reservation) error
 	ListByCourtAndTimeRange(ctx context.Context, courtID string, from, to time.Time) ([]entities.Reservation, error)
+	CountActiveByUser(ctx context.Context, organizationID, userID string, now time.Time) (int, error)
 	GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error)
-	CancelReservation(ctx context.Context, reservationID string, cancelledBy string) error
+	CancelReservation(
+		ctx context.Context,
+		reservationID string,
+		cancelledBy string,
+		cancellation entities.Cancellation,
+	) error
+	ConfirmReservation(ctx context.Context, reservationID string, now time.Time) error
+	ExpirePendingReservations(ctx context.Context, now time.Time) ([]entities.Reservation, error)
+	ExpireOverlappingHolds(
+		ctx context.Context,
+		courtID string,
+		from, to time.Time,
+		now time.Time,
+	) ([]entities.Reservation, error)
+	MoveReservation(ctx context.Context, change *entities.ReservationChange) error
+	ListReservationChanges(ctx context.Context, reservationID string) ([]entities.ReservationChange, error)
+	ListBlackoutsByCourt(ctx context.Context, courtID string, from, to time.Time) ([]entities.Blackout, error)
+
+	CreateSeries(ctx context.Context, series *entities.ReservationSeries) error
+	GetSeriesByID(ctx context.Context, seriesID string) (*entities.ReservationSeries, error)
+	ListBySeries(ctx context.Context, seriesID string) ([]entities.Reservation, error)
+	DeleteSeries(ctx context.Context, seriesID string) error
+
+	CreateWaitlistEntry(ctx context.Context, entry *entities.WaitlistEntry) error
+	ListWaitlistByUser(ctx context.Context, userID string) ([]entities.WaitlistEntry, error)
+	DeleteWaitlistEntry(ctx context.Context, entryID, userID string) error
+	ClaimWaitlistEntry(
+		ctx context.Context,
+		organizationID, courtID string,
+		from, to time.Time,
+		reservationID string,
+		now time.Time,
+	) (*entities.WaitlistEntry, error)
+	ReleaseWaitlistEntry(ctx context.Context, entryID string, now time.Time) error
+	ResetWaitlistOffer(ctx context.Context, reservationID string, now time.Time) error
+	ExpireWaitlistEntries(ctx context.Context, now time.Time) ([]entities.WaitlistEntry, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+	ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error)
+}
+
+// Pricer prices a slot on a court. It returns ErrNotFound when neither the court nor its organization has
+// a pricing rule.
+type Pricer interface {
+	QuoteCourt(ctx context.Context, courtID string, from, to time.Time) (*entities.Quote, error)
+}
+
+// Policies tells the rules of booking and cancelling on a court. Each returns ErrNotFound when neither the
+// court nor its organization has a cancellation policy or booking rules.
+type Policies interface {
+	CourtCancellationPolicy(ctx context.Context, courtID string) (*entities.CancellationPolicy, error)
+	CourtBookingRules(ctx context.Context, courtID string) (*entities.BookingRules, error)
+}
+
+// Scheduler tells when a court is open, in the time zone of its organization.
+type Scheduler interface {
+	CourtSchedule(ctx context.Context, courtID string) (*entities.Schedule, error)
+	OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error)
+}
+
+// Refunder refunds part of the settled payments of a cancelled or expired reservation, if there are any.
+type Refunder interface {
+	RefundReservation(ctx context.Context, reservationID string, refundPercent int) error
 }
@@ -0,0 +1,82 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultExpirerInterval is how often the expirer looks for unconfirmed holds when no interval is configured.
+const DefaultExpirerInterval = time.Minute
+
+// Expirer periodically releases pending holds that were not confirmed before their TTL ran out, and takes the
+// waitlist entries whose window started off the queue.
+type Expirer struct {
+	service  *Service
+	interval time.Duration
+}
+
+func NewExpirer(service *Service, interval time.Duration) *Expirer {
+	if interval <= 0 {
+		interval = DefaultExpirerInterval
+	}
+
+	return &Expirer{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run expires holds and waitlist entries on every tick until ctx is cancelled.
+func (e *Expirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.expire(ctx)
+		}
+	}
+}
+
+func (e *Expirer) expire(ctx context.Context) {
+	e.expireHolds(ctx)
+	// holds go first, an offer that ran out on a started window puts its entry back to be expired here
+	e.expireWaitlist(ctx)
+}
+
+func (e *Expirer) expireHolds(ctx context.Context) {
+	expired, err := e.service.ExpireHolds(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to expire reservation holds")
+		return
+	}
+
+	for _, rsv := range expired {
+		log.Info().
+			Str("reservation_id", rsv.ID).
+			Str("court_id", rsv.CourtID).
+			Time("expires_at", rsv.ExpiresAt).
+			Msg("reservation hold expired")
+	}
+}
+
+func (e *Expirer) expireWaitlist(ctx context.Context) {
+	expired, err := e.service.ExpireWaitlist(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to expire waitlist entries")
+		return
+	}
+
+	for _, entry := range expired {
+		log.Info().
+			Str("waitlist_entry_id", entry.ID).
+			Str("user_id", entry.UserID).
+			Time("reserved_from", entry.From).
+			Msg("waitlist entry expired")
+	}
+}
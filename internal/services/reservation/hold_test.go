@@ -0,0 +1,326 @@
+package reservation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type HoldSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	clock            *clockmocks.MockClock
+	service          *reservation.Service
+}
+
+func TestHoldSuite(t *testing.T) {
+	suite.Run(t, new(HoldSuite))
+}
+
+var holdNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+func (s *HoldSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.clock = clockmocks.NewMockClock(s.ctrl)
+	s.clock.EXPECT().Now().Return(holdNow).AnyTimes()
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Clock:        s.clock,
+		},
+		10*time.Minute,
+	)
+}
+
+func (s *HoldSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *HoldSuite) TestReserveCourt_CreatesHold() {
+	ctx := context.Background()
+	from := holdNow.Add(6 * time.Hour)
+	rsv := entities.NewReservation("court-1", from, from.Add(time.Hour), "user-1")
+
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", rsv.ReservedFrom, rsv.ReservedTo).
+		Return(nil, nil)
+	s.reservationsRepo.EXPECT().Create(ctx, rsv).Return(nil)
+
+	err := s.service.ReserveCourt(ctx, "court-1", rsv)
+	s.Require().NoError(err)
+
+	s.Equal(entities.PendingReservationStatus, rsv.Status)
+	s.Equal(holdNow.Add(10*time.Minute), rsv.ExpiresAt)
+}
+
+func (s *HoldSuite) TestReserveCourt_ExpiredHoldDoesNotBlock() {
+	ctx := context.Background()
+	from := holdNow.Add(6 * time.Hour)
+	rsv := entities.NewReservation("court-1", from, from.Add(time.Hour), "user-1")
+
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", rsv.ReservedFrom, rsv.ReservedTo).
+		Return([]entities.Reservation{{
+			ID:           "stale-hold",
+			CourtID:      "court-1",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: from,
+			ReservedTo:   from.Add(time.Hour),
+			ExpiresAt:    holdNow.Add(-time.Minute),
+		}}, nil)
+	s.reservationsRepo.EXPECT().Create(ctx, rsv).Return(nil)
+
+	s.NoError(s.service.ReserveCourt(ctx, "court-1", rsv))
+}
+
+func (s *HoldSuite) TestReserveCourt_ReleasesRunOutHold() {
+	ctx := context.Background()
+	from := holdNow.Add(6 * time.Hour)
+	rsv := entities.NewReservation("court-1", from, from.Add(time.Hour), "user-1")
+	stale := entities.Reservation{
+		ID:           "stale-hold",
+		CourtID:      "court-1",
+		Status:       entities.ExpiredReservationStatus,
+		ReservedFrom: from,
+		ReservedTo:   from.Add(time.Hour),
+		ExpiresAt:    holdNow.Add(-time.Minute),
+	}
+
+	repo := mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(repo)
+	courts := mocks.NewMockCourtsRepository(s.ctrl)
+	refunder := mocks.NewMockRefunder(s.ctrl)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: repo,
+			Courts:       courts,
+			Refunder:     refunder,
+			Clock:        s.clock,
+		},
+		10*time.Minute,
+	)
+
+	// the run out hold goes through the same refund and waitlist path as the expirer
+	repo.EXPECT().
+		ExpireOverlappingHolds(ctx, "court-1", rsv.ReservedFrom, rsv.ReservedTo, holdNow).
+		Return([]entities.Reservation{stale}, nil)
+	refunder.EXPECT().RefundReservation(ctx, "stale-hold", 100).Return(nil)
+	courts.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	repo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", from, from.Add(time.Hour), gomock.Any(), holdNow).
+		Return(nil, entities.ErrNotFound)
+	repo.EXPECT().ResetWaitlistOffer(ctx, "stale-hold", holdNow).Return(entities.ErrNotFound)
+	repo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", rsv.ReservedFrom, rsv.ReservedTo).
+		Return(nil, nil)
+	repo.EXPECT().Create(ctx, rsv).Return(nil)
+
+	s.NoError(service.ReserveCourt(ctx, "court-1", rsv))
+}
+
+func (s *HoldSuite) TestReserveCourt_ActiveHoldBlocks() {
+	ctx := context.Background()
+	from := holdNow.Add(6 * time.Hour)
+	rsv := entities.NewReservation("court-1", from, from.Add(time.Hour), "user-1")
+
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", rsv.ReservedFrom, rsv.ReservedTo).
+		Return([]entities.Reservation{{
+			ID:           "hold",
+			CourtID:      "court-1",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: from,
+			ReservedTo:   from.Add(time.Hour),
+			ExpiresAt:    holdNow.Add(time.Minute),
+		}}, nil)
+
+	err := s.service.ReserveCourt(ctx, "court-1", rsv)
+	s.ErrorIs(err, entities.ErrCourtAlreadyReserved)
+}
+
+func (s *HoldSuite) TestConfirmReservation() {
+	ctx := context.Background()
+
+	hold := func() *entities.Reservation {
+		return &entities.Reservation{
+			ID:         "res-1",
+			CourtID:    "court-1",
+			ReservedBy: "user-1",
+			Status:     entities.PendingReservationStatus,
+			ExpiresAt:  holdNow.Add(5 * time.Minute),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func()
+		wantErr    error
+	}{
+		{
+			name: "success",
+			setupMocks: func() {
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+				s.reservationsRepo.EXPECT().ConfirmReservation(ctx, "res-1", holdNow).Return(nil)
+			},
+		},
+		{
+			name: "hold expired",
+			setupMocks: func() {
+				rsv := hold()
+				rsv.ExpiresAt = holdNow
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+			},
+			wantErr: entities.ErrReservationHoldExpired,
+		},
+		{
+			name: "already confirmed",
+			setupMocks: func() {
+				rsv := hold()
+				rsv.Status = entities.ReservedReservationStatus
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+			},
+			wantErr: entities.ErrReservationNotPending,
+		},
+		{
+			name: "priced hold is confirmed by its payment",
+			setupMocks: func() {
+				rsv := hold()
+				rsv.Price = entities.Price{Amount: 3000, Currency: "EUR"}
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+			},
+			wantErr: entities.ErrPaymentRequired,
+		},
+		{
+			name: "reservation on another court",
+			setupMocks: func() {
+				rsv := hold()
+				rsv.CourtID = "court-2"
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "hold of another user",
+			setupMocks: func() {
+				rsv := hold()
+				rsv.ReservedBy = "user-2"
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name: "expired by the expirer in between",
+			setupMocks: func() {
+				s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(hold(), nil)
+				s.reservationsRepo.EXPECT().
+					ConfirmReservation(ctx, "res-1", holdNow).
+					Return(entities.ErrReservationNotPending)
+			},
+			wantErr: entities.ErrReservationNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			rsv, err := s.service.ConfirmReservation(ctx, "court-1", "res-1", "user-1")
+			if tt.wantErr != nil {
+				s.Require().Error(err)
+				s.ErrorIs(err, tt.wantErr)
+				s.Nil(rsv)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(entities.ReservedReservationStatus, rsv.Status)
+			s.True(rsv.ExpiresAt.IsZero())
+		})
+	}
+}
+
+func (s *HoldSuite) TestExpireHolds() {
+	ctx := context.Background()
+	expired := []entities.Reservation{{ID: "res-1", Status: entities.ExpiredReservationStatus}}
+
+	s.reservationsRepo.EXPECT().ExpirePendingReservations(ctx, holdNow).Return(expired, nil)
+	s.reservationsRepo.EXPECT().ResetWaitlistOffer(ctx, "res-1", holdNow).Return(entities.ErrNotFound)
+
+	result, err := s.service.ExpireHolds(ctx)
+	s.Require().NoError(err)
+	s.Equal(expired, result)
+
+	s.reservationsRepo.EXPECT().ExpirePendingReservations(ctx, holdNow).Return(nil, fmt.Errorf("db error"))
+
+	_, err = s.service.ExpireHolds(ctx)
+	s.Error(err)
+}
+
+func (s *HoldSuite) TestExpireHolds_Refunds() {
+	ctx := context.Background()
+	expired := []entities.Reservation{
+		{ID: "res-1", Status: entities.ExpiredReservationStatus},
+		{ID: "res-2", Status: entities.ExpiredReservationStatus},
+	}
+
+	refunder := mocks.NewMockRefunder(s.ctrl)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Refunder:     refunder,
+			Clock:        s.clock,
+		},
+		10*time.Minute,
+	)
+
+	s.reservationsRepo.EXPECT().ExpirePendingReservations(ctx, holdNow).Return(expired, nil)
+	// a failed refund does not keep the other holds from being refunded
+	refunder.EXPECT().RefundReservation(ctx, "res-1", 100).Return(fmt.Errorf("provider down"))
+	refunder.EXPECT().RefundReservation(ctx, "res-2", 100).Return(nil)
+	s.reservationsRepo.EXPECT().ResetWaitlistOffer(ctx, gomock.Any(), holdNow).Return(entities.ErrNotFound).Times(2)
+
+	result, err := service.ExpireHolds(ctx)
+	s.Require().NoError(err)
+	s.Equal(expired, result)
+}
+
+func (s *HoldSuite) TestExpirer_Run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	s.reservationsRepo.EXPECT().
+		ExpirePendingReservations(ctx, holdNow).
+		DoAndReturn(func(context.Context, time.Time) ([]entities.Reservation, error) {
+			cancel()
+			return nil, nil
+		}).
+		MinTimes(1)
+	s.reservationsRepo.EXPECT().ExpireWaitlistEntries(ctx, holdNow).Return(nil, nil).MinTimes(1)
+
+	go func() {
+		reservation.NewExpirer(s.service, time.Millisecond).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.Fail("expirer did not stop after the context was cancelled")
+	}
+}
@@ -2,52 +2,125 @@ package reservation
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
 )
 
+// Locker serialises bookings per court. LockAll and UnlockAll take and release a set of keys at
+// once, for callers closing many courts together.
 type Locker interface {
 	Lock(ctx context.Context, key string) error
 	Unlock(ctx context.Context, key string) error
+	LockAll(ctx context.Context, keys []string) error
+	UnlockAll(ctx context.Context, keys []string) error
 }
 
+// LocalLocker serialises reservations within a single process. Use the Postgres locker when
+// more than one replica is running.
 type LocalLocker struct {
 	mu    sync.Mutex
-	locks map[string]*sync.Mutex
+	locks map[string]*localLock
+}
+
+// localLock is a one slot semaphore. refs counts the holder and every waiter so the entry
+// can be dropped from the map once nobody uses it.
+type localLock struct {
+	sem  chan struct{}
+	refs int
 }
 
 func NewLocalLocker() *LocalLocker {
 	return &LocalLocker{
-		locks: make(map[string]*sync.Mutex),
+		locks: make(map[string]*localLock),
 	}
 }
 
-func (l *LocalLocker) getOrCreateMutex(key string) *sync.Mutex {
+func (l *LocalLocker) acquire(key string) *localLock {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	if _, exists := l.locks[key]; !exists {
-		l.locks[key] = &sync.Mutex{}
+	lock, exists := l.locks[key]
+	if !exists {
+		lock = &localLock{sem: make(chan struct{}, 1)}
+		l.locks[key] = lock
 	}
 
-	return l.locks[key]
+	lock.refs++
+
+	return lock
+}
+
+// release must be called with l.mu held.
+func (l *LocalLocker) release(key string, lock *localLock) {
+	lock.refs--
+	if lock.refs == 0 {
+		delete(l.locks, key)
+	}
 }
 
 func (l *LocalLocker) Lock(ctx context.Context, key string) error {
-	mu := l.getOrCreateMutex(key)
-	mu.Lock()
-	return nil
+	lock := l.acquire(key)
+
+	select {
+	case lock.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.release(key, lock)
+		l.mu.Unlock()
+
+		return fmt.Errorf("wait for lock %s: %w", key, ctx.Err())
+	}
 }
 
 func (l *LocalLocker) Unlock(ctx context.Context, key string) error {
 	l.mu.Lock()
-	mutex, exists := l.locks[key]
-	l.mu.Unlock()
+	defer l.mu.Unlock()
 
+	lock, exists := l.locks[key]
 	if !exists {
 		return fmt.Errorf("no lock found for key: %s", key)
 	}
 
-	mutex.Unlock()
+	select {
+	case <-lock.sem:
+	default:
+		return fmt.Errorf("lock for key %s is not held", key)
+	}
+
+	l.release(key, lock)
+
 	return nil
 }
+
+// LockAll takes the keys in sorted order so two callers locking overlapping keys can't deadlock.
+// Either every key is held once it returns, or none is.
+func (l *LocalLocker) LockAll(ctx context.Context, keys []string) error {
+	keys = slices.Compact(slices.Sorted(slices.Values(keys)))
+
+	for i, key := range keys {
+		if err := l.Lock(ctx, key); err != nil {
+			for _, held := range keys[:i] {
+				_ = l.Unlock(ctx, held)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnlockAll releases keys taken with LockAll. Every key is unlocked even when one of them fails.
+func (l *LocalLocker) UnlockAll(ctx context.Context, keys []string) error {
+	var errs []error
+
+	for _, key := range slices.Compact(slices.Sorted(slices.Values(keys))) {
+		if err := l.Unlock(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
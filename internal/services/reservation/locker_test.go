@@ -0,0 +1,108 @@
+package reservation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalLockerSuite struct {
+	suite.Suite
+	locker *reservation.LocalLocker
+}
+
+func TestLocalLockerSuite(t *testing.T) {
+	suite.Run(t, new(LocalLockerSuite))
+}
+
+func (s *LocalLockerSuite) SetupTest() {
+	s.locker = reservation.NewLocalLocker()
+}
+
+func (s *LocalLockerSuite) TestLock_HonorsContext() {
+	ctx := context.Background()
+	s.Require().NoError(s.locker.Lock(ctx, "court-1"))
+
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	err := s.locker.Lock(waitCtx, "court-1")
+	s.Require().Error(err)
+	s.ErrorIs(err, context.DeadlineExceeded)
+
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Lock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+}
+
+func (s *LocalLockerSuite) TestLock_DifferentKeys() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	s.Require().NoError(s.locker.Lock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Lock(ctx, "court-2"))
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Unlock(ctx, "court-2"))
+}
+
+func (s *LocalLockerSuite) TestUnlock_ReleasesEntry() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.locker.Lock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+
+	// The entry is gone once nobody holds or waits for the lock.
+	s.Error(s.locker.Unlock(ctx, "court-1"))
+}
+
+func (s *LocalLockerSuite) TestUnlock_WakesWaiter() {
+	ctx := context.Background()
+	s.Require().NoError(s.locker.Lock(ctx, "court-1"))
+
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- s.locker.Lock(ctx, "court-1")
+	}()
+
+	select {
+	case <-acquired:
+		s.FailNow("lock acquired while held")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+
+	select {
+	case err := <-acquired:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.FailNow("waiter was not woken up")
+	}
+
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+	s.Error(s.locker.Unlock(ctx, "court-1"))
+}
+
+func (s *LocalLockerSuite) TestLockAll_NoneHeldOnFailure() {
+	ctx := context.Background()
+	s.Require().NoError(s.locker.Lock(ctx, "court-2"))
+
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	err := s.locker.LockAll(waitCtx, []string{"court-3", "court-2", "court-1"})
+	s.Require().Error(err)
+	s.ErrorIs(err, context.DeadlineExceeded)
+
+	// court-1 was taken before court-2 timed out and has been given back.
+	s.Require().NoError(s.locker.Lock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Unlock(ctx, "court-1"))
+	s.Require().NoError(s.locker.Unlock(ctx, "court-2"))
+
+	s.Require().NoError(s.locker.LockAll(ctx, []string{"court-3", "court-2", "court-1"}))
+	s.Require().NoError(s.locker.UnlockAll(ctx, []string{"court-3", "court-2", "court-1"}))
+	s.Error(s.locker.Unlock(ctx, "court-1"))
+}
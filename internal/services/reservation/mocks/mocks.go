@@ -37,17 +37,61 @@ func (m *MockReservationsRepository) EXPECT() *MockReservationsRepositoryMockRec
 }
 
 // CancelReservation mocks base method.
-func (m *MockReservationsRepository) CancelReservation(ctx context.Context, reservationID, cancelledBy string) error {
+func (m *MockReservationsRepository) CancelReservation(ctx context.Context, reservationID, cancelledBy string, cancellation entities.Cancellation) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelReservation", ctx, reservationID, cancelledBy)
+	ret := m.ctrl.Call(m, "CancelReservation", ctx, reservationID, cancelledBy, cancellation)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CancelReservation indicates an expected call of CancelReservation.
-func (mr *MockReservationsRepositoryMockRecorder) CancelReservation(ctx, reservationID, cancelledBy interface{}) *gomock.Call {
+func (mr *MockReservationsRepositoryMockRecorder) CancelReservation(ctx, reservationID, cancelledBy, cancellation interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationsRepository)(nil).CancelReservation), ctx, reservationID, cancelledBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationsRepository)(nil).CancelReservation), ctx, reservationID, cancelledBy, cancellation)
+}
+
+// ClaimWaitlistEntry mocks base method.
+func (m *MockReservationsRepository) ClaimWaitlistEntry(ctx context.Context, organizationID, courtID string, from, to time.Time, reservationID string, now time.Time) (*entities.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWaitlistEntry", ctx, organizationID, courtID, from, to, reservationID, now)
+	ret0, _ := ret[0].(*entities.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWaitlistEntry indicates an expected call of ClaimWaitlistEntry.
+func (mr *MockReservationsRepositoryMockRecorder) ClaimWaitlistEntry(ctx, organizationID, courtID, from, to, reservationID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWaitlistEntry", reflect.TypeOf((*MockReservationsRepository)(nil).ClaimWaitlistEntry), ctx, organizationID, courtID, from, to, reservationID, now)
+}
+
+// ConfirmReservation mocks base method.
+func (m *MockReservationsRepository) ConfirmReservation(ctx context.Context, reservationID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmReservation", ctx, reservationID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmReservation indicates an expected call of ConfirmReservation.
+func (mr *MockReservationsRepositoryMockRecorder) ConfirmReservation(ctx, reservationID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReservation", reflect.TypeOf((*MockReservationsRepository)(nil).ConfirmReservation), ctx, reservationID, now)
+}
+
+// CountActiveByUser mocks base method.
+func (m *MockReservationsRepository) CountActiveByUser(ctx context.Context, organizationID, userID string, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveByUser", ctx, organizationID, userID, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveByUser indicates an expected call of CountActiveByUser.
+func (mr *MockReservationsRepositoryMockRecorder) CountActiveByUser(ctx, organizationID, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveByUser", reflect.TypeOf((*MockReservationsRepository)(nil).CountActiveByUser), ctx, organizationID, userID, now)
 }
 
 // Create mocks base method.
@@ -64,6 +108,107 @@ func (mr *MockReservationsRepositoryMockRecorder) Create(ctx, reservation interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReservationsRepository)(nil).Create), ctx, reservation)
 }
 
+// CreateSeries mocks base method.
+func (m *MockReservationsRepository) CreateSeries(ctx context.Context, series *entities.ReservationSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", ctx, series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockReservationsRepositoryMockRecorder) CreateSeries(ctx, series interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockReservationsRepository)(nil).CreateSeries), ctx, series)
+}
+
+// CreateWaitlistEntry mocks base method.
+func (m *MockReservationsRepository) CreateWaitlistEntry(ctx context.Context, entry *entities.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWaitlistEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWaitlistEntry indicates an expected call of CreateWaitlistEntry.
+func (mr *MockReservationsRepositoryMockRecorder) CreateWaitlistEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWaitlistEntry", reflect.TypeOf((*MockReservationsRepository)(nil).CreateWaitlistEntry), ctx, entry)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockReservationsRepository) DeleteSeries(ctx context.Context, seriesID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, seriesID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockReservationsRepositoryMockRecorder) DeleteSeries(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockReservationsRepository)(nil).DeleteSeries), ctx, seriesID)
+}
+
+// DeleteWaitlistEntry mocks base method.
+func (m *MockReservationsRepository) DeleteWaitlistEntry(ctx context.Context, entryID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWaitlistEntry", ctx, entryID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWaitlistEntry indicates an expected call of DeleteWaitlistEntry.
+func (mr *MockReservationsRepositoryMockRecorder) DeleteWaitlistEntry(ctx, entryID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWaitlistEntry", reflect.TypeOf((*MockReservationsRepository)(nil).DeleteWaitlistEntry), ctx, entryID, userID)
+}
+
+// ExpireOverlappingHolds mocks base method.
+func (m *MockReservationsRepository) ExpireOverlappingHolds(ctx context.Context, courtID string, from, to, now time.Time) ([]entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOverlappingHolds", ctx, courtID, from, to, now)
+	ret0, _ := ret[0].([]entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOverlappingHolds indicates an expected call of ExpireOverlappingHolds.
+func (mr *MockReservationsRepositoryMockRecorder) ExpireOverlappingHolds(ctx, courtID, from, to, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOverlappingHolds", reflect.TypeOf((*MockReservationsRepository)(nil).ExpireOverlappingHolds), ctx, courtID, from, to, now)
+}
+
+// ExpirePendingReservations mocks base method.
+func (m *MockReservationsRepository) ExpirePendingReservations(ctx context.Context, now time.Time) ([]entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePendingReservations", ctx, now)
+	ret0, _ := ret[0].([]entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePendingReservations indicates an expected call of ExpirePendingReservations.
+func (mr *MockReservationsRepositoryMockRecorder) ExpirePendingReservations(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePendingReservations", reflect.TypeOf((*MockReservationsRepository)(nil).ExpirePendingReservations), ctx, now)
+}
+
+// ExpireWaitlistEntries mocks base method.
+func (m *MockReservationsRepository) ExpireWaitlistEntries(ctx context.Context, now time.Time) ([]entities.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireWaitlistEntries", ctx, now)
+	ret0, _ := ret[0].([]entities.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireWaitlistEntries indicates an expected call of ExpireWaitlistEntries.
+func (mr *MockReservationsRepositoryMockRecorder) ExpireWaitlistEntries(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireWaitlistEntries", reflect.TypeOf((*MockReservationsRepository)(nil).ExpireWaitlistEntries), ctx, now)
+}
+
 // GetByID mocks base method.
 func (m *MockReservationsRepository) GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +224,36 @@ func (mr *MockReservationsRepositoryMockRecorder) GetByID(ctx, reservationID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetByID), ctx, reservationID)
 }
 
+// GetSeriesByID mocks base method.
+func (m *MockReservationsRepository) GetSeriesByID(ctx context.Context, seriesID string) (*entities.ReservationSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesByID", ctx, seriesID)
+	ret0, _ := ret[0].(*entities.ReservationSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesByID indicates an expected call of GetSeriesByID.
+func (mr *MockReservationsRepositoryMockRecorder) GetSeriesByID(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetSeriesByID), ctx, seriesID)
+}
+
+// ListBlackoutsByCourt mocks base method.
+func (m *MockReservationsRepository) ListBlackoutsByCourt(ctx context.Context, courtID string, from, to time.Time) ([]entities.Blackout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlackoutsByCourt", ctx, courtID, from, to)
+	ret0, _ := ret[0].([]entities.Blackout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlackoutsByCourt indicates an expected call of ListBlackoutsByCourt.
+func (mr *MockReservationsRepositoryMockRecorder) ListBlackoutsByCourt(ctx, courtID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlackoutsByCourt", reflect.TypeOf((*MockReservationsRepository)(nil).ListBlackoutsByCourt), ctx, courtID, from, to)
+}
+
 // ListByCourtAndTimeRange mocks base method.
 func (m *MockReservationsRepository) ListByCourtAndTimeRange(ctx context.Context, courtID string, from, to time.Time) ([]entities.Reservation, error) {
 	m.ctrl.T.Helper()
@@ -93,3 +268,324 @@ func (mr *MockReservationsRepositoryMockRecorder) ListByCourtAndTimeRange(ctx, c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCourtAndTimeRange", reflect.TypeOf((*MockReservationsRepository)(nil).ListByCourtAndTimeRange), ctx, courtID, from, to)
 }
+
+// ListBySeries mocks base method.
+func (m *MockReservationsRepository) ListBySeries(ctx context.Context, seriesID string) ([]entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySeries", ctx, seriesID)
+	ret0, _ := ret[0].([]entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySeries indicates an expected call of ListBySeries.
+func (mr *MockReservationsRepositoryMockRecorder) ListBySeries(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySeries", reflect.TypeOf((*MockReservationsRepository)(nil).ListBySeries), ctx, seriesID)
+}
+
+// ListReservationChanges mocks base method.
+func (m *MockReservationsRepository) ListReservationChanges(ctx context.Context, reservationID string) ([]entities.ReservationChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReservationChanges", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.ReservationChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReservationChanges indicates an expected call of ListReservationChanges.
+func (mr *MockReservationsRepositoryMockRecorder) ListReservationChanges(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReservationChanges", reflect.TypeOf((*MockReservationsRepository)(nil).ListReservationChanges), ctx, reservationID)
+}
+
+// ListWaitlistByUser mocks base method.
+func (m *MockReservationsRepository) ListWaitlistByUser(ctx context.Context, userID string) ([]entities.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWaitlistByUser", ctx, userID)
+	ret0, _ := ret[0].([]entities.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWaitlistByUser indicates an expected call of ListWaitlistByUser.
+func (mr *MockReservationsRepositoryMockRecorder) ListWaitlistByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitlistByUser", reflect.TypeOf((*MockReservationsRepository)(nil).ListWaitlistByUser), ctx, userID)
+}
+
+// MoveReservation mocks base method.
+func (m *MockReservationsRepository) MoveReservation(ctx context.Context, change *entities.ReservationChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveReservation", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveReservation indicates an expected call of MoveReservation.
+func (mr *MockReservationsRepositoryMockRecorder) MoveReservation(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveReservation", reflect.TypeOf((*MockReservationsRepository)(nil).MoveReservation), ctx, change)
+}
+
+// ReleaseWaitlistEntry mocks base method.
+func (m *MockReservationsRepository) ReleaseWaitlistEntry(ctx context.Context, entryID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWaitlistEntry", ctx, entryID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWaitlistEntry indicates an expected call of ReleaseWaitlistEntry.
+func (mr *MockReservationsRepositoryMockRecorder) ReleaseWaitlistEntry(ctx, entryID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWaitlistEntry", reflect.TypeOf((*MockReservationsRepository)(nil).ReleaseWaitlistEntry), ctx, entryID, now)
+}
+
+// ResetWaitlistOffer mocks base method.
+func (m *MockReservationsRepository) ResetWaitlistOffer(ctx context.Context, reservationID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWaitlistOffer", ctx, reservationID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWaitlistOffer indicates an expected call of ResetWaitlistOffer.
+func (mr *MockReservationsRepositoryMockRecorder) ResetWaitlistOffer(ctx, reservationID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWaitlistOffer", reflect.TypeOf((*MockReservationsRepository)(nil).ResetWaitlistOffer), ctx, reservationID, now)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// ListByOrganizationID mocks base method.
+func (m *MockCourtsRepository) ListByOrganizationID(ctx context.Context, organizationID string) ([]entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrganizationID indicates an expected call of ListByOrganizationID.
+func (mr *MockCourtsRepositoryMockRecorder) ListByOrganizationID(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrganizationID", reflect.TypeOf((*MockCourtsRepository)(nil).ListByOrganizationID), ctx, organizationID)
+}
+
+// MockPricer is a mock of Pricer interface.
+type MockPricer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricerMockRecorder
+}
+
+// MockPricerMockRecorder is the mock recorder for MockPricer.
+type MockPricerMockRecorder struct {
+	mock *MockPricer
+}
+
+// NewMockPricer creates a new mock instance.
+func NewMockPricer(ctrl *gomock.Controller) *MockPricer {
+	mock := &MockPricer{ctrl: ctrl}
+	mock.recorder = &MockPricerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricer) EXPECT() *MockPricerMockRecorder {
+	return m.recorder
+}
+
+// QuoteCourt mocks base method.
+func (m *MockPricer) QuoteCourt(ctx context.Context, courtID string, from, to time.Time) (*entities.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteCourt", ctx, courtID, from, to)
+	ret0, _ := ret[0].(*entities.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteCourt indicates an expected call of QuoteCourt.
+func (mr *MockPricerMockRecorder) QuoteCourt(ctx, courtID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteCourt", reflect.TypeOf((*MockPricer)(nil).QuoteCourt), ctx, courtID, from, to)
+}
+
+// MockPolicies is a mock of Policies interface.
+type MockPolicies struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoliciesMockRecorder
+}
+
+// MockPoliciesMockRecorder is the mock recorder for MockPolicies.
+type MockPoliciesMockRecorder struct {
+	mock *MockPolicies
+}
+
+// NewMockPolicies creates a new mock instance.
+func NewMockPolicies(ctrl *gomock.Controller) *MockPolicies {
+	mock := &MockPolicies{ctrl: ctrl}
+	mock.recorder = &MockPoliciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicies) EXPECT() *MockPoliciesMockRecorder {
+	return m.recorder
+}
+
+// CourtBookingRules mocks base method.
+func (m *MockPolicies) CourtBookingRules(ctx context.Context, courtID string) (*entities.BookingRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CourtBookingRules", ctx, courtID)
+	ret0, _ := ret[0].(*entities.BookingRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CourtBookingRules indicates an expected call of CourtBookingRules.
+func (mr *MockPoliciesMockRecorder) CourtBookingRules(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CourtBookingRules", reflect.TypeOf((*MockPolicies)(nil).CourtBookingRules), ctx, courtID)
+}
+
+// CourtCancellationPolicy mocks base method.
+func (m *MockPolicies) CourtCancellationPolicy(ctx context.Context, courtID string) (*entities.CancellationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CourtCancellationPolicy", ctx, courtID)
+	ret0, _ := ret[0].(*entities.CancellationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CourtCancellationPolicy indicates an expected call of CourtCancellationPolicy.
+func (mr *MockPoliciesMockRecorder) CourtCancellationPolicy(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CourtCancellationPolicy", reflect.TypeOf((*MockPolicies)(nil).CourtCancellationPolicy), ctx, courtID)
+}
+
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// CourtSchedule mocks base method.
+func (m *MockScheduler) CourtSchedule(ctx context.Context, courtID string) (*entities.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CourtSchedule", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CourtSchedule indicates an expected call of CourtSchedule.
+func (mr *MockSchedulerMockRecorder) CourtSchedule(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CourtSchedule", reflect.TypeOf((*MockScheduler)(nil).CourtSchedule), ctx, courtID)
+}
+
+// OrganizationLocation mocks base method.
+func (m *MockScheduler) OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrganizationLocation", ctx, organizationID)
+	ret0, _ := ret[0].(*time.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrganizationLocation indicates an expected call of OrganizationLocation.
+func (mr *MockSchedulerMockRecorder) OrganizationLocation(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrganizationLocation", reflect.TypeOf((*MockScheduler)(nil).OrganizationLocation), ctx, organizationID)
+}
+
+// MockRefunder is a mock of Refunder interface.
+type MockRefunder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefunderMockRecorder
+}
+
+// MockRefunderMockRecorder is the mock recorder for MockRefunder.
+type MockRefunderMockRecorder struct {
+	mock *MockRefunder
+}
+
+// NewMockRefunder creates a new mock instance.
+func NewMockRefunder(ctrl *gomock.Controller) *MockRefunder {
+	mock := &MockRefunder{ctrl: ctrl}
+	mock.recorder = &MockRefunderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefunder) EXPECT() *MockRefunderMockRecorder {
+	return m.recorder
+}
+
+// RefundReservation mocks base method.
+func (m *MockRefunder) RefundReservation(ctx context.Context, reservationID string, refundPercent int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundReservation", ctx, reservationID, refundPercent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundReservation indicates an expected call of RefundReservation.
+func (mr *MockRefunderMockRecorder) RefundReservation(ctx, reservationID, refundPercent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundReservation", reflect.TypeOf((*MockRefunder)(nil).RefundReservation), ctx, reservationID, refundPercent)
+}
@@ -0,0 +1,161 @@
+package reservation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+// OpeningHoursSuite covers how the opening hours, holidays and time zone of an organization limit bookings.
+type OpeningHoursSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	scheduler        *mocks.MockScheduler
+	service          *reservation.Service
+}
+
+func TestOpeningHoursSuite(t *testing.T) {
+	suite.Run(t, new(OpeningHoursSuite))
+}
+
+// 2025-11-03 is a Monday.
+var openingHoursDay = time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+
+func (s *OpeningHoursSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.scheduler = mocks.NewMockScheduler(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(openingHoursDay.Add(-24 * time.Hour)).AnyTimes()
+
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Scheduler:    s.scheduler,
+			Clock:        clock,
+		},
+		reservation.DefaultHoldTTL,
+	)
+}
+
+func (s *OpeningHoursSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// expectSchedule serves the schedule of a club in Madrid open 09:00-21:00 on weekdays.
+func (s *OpeningHoursSuite) expectSchedule(special ...entities.SpecialHours) {
+	org := entities.Organization{TimeZone: "Europe/Madrid", SpecialHours: special}
+	for day := time.Monday; day <= time.Friday; day++ {
+		org.OpeningHours = append(org.OpeningHours, entities.OpeningHours{Weekday: day, OpensAt: 9 * 60, ClosesAt: 21 * 60})
+	}
+
+	schedule := entities.NewSchedule(org, entities.Court{ID: "court-1"})
+	s.scheduler.EXPECT().CourtSchedule(gomock.Any(), "court-1").Return(&schedule, nil).AnyTimes()
+}
+
+func (s *OpeningHoursSuite) TestReserveCourt_ClubTimeZone() {
+	ctx := context.Background()
+	s.expectSchedule()
+
+	// 08:00 UTC is 09:00 in Madrid in November
+	from := openingHoursDay.Add(8 * time.Hour)
+	rsv := entities.NewReservation("court-1", from, from.Add(time.Hour), "user-1")
+
+	s.reservationsRepo.EXPECT().ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.reservationsRepo.EXPECT().Create(ctx, rsv).Return(nil)
+
+	s.Require().NoError(s.service.ReserveCourt(ctx, "court-1", rsv))
+}
+
+func (s *OpeningHoursSuite) TestReserveCourt_OutsideOpeningHours() {
+	ctx := context.Background()
+	s.expectSchedule()
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{
+			name: "before opening",
+			from: openingHoursDay.Add(7 * time.Hour),
+			to:   openingHoursDay.Add(8 * time.Hour),
+		},
+		{
+			name: "past closing",
+			from: openingHoursDay.Add(19 * time.Hour),
+			to:   openingHoursDay.Add(20*time.Hour + 30*time.Minute),
+		},
+		{
+			name: "weekend",
+			from: openingHoursDay.AddDate(0, 0, -1).Add(10 * time.Hour),
+			to:   openingHoursDay.AddDate(0, 0, -1).Add(11 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rsv := entities.NewReservation("court-1", tt.from, tt.to, "user-1")
+
+			err := s.service.ReserveCourt(ctx, "court-1", rsv)
+			s.Require().ErrorIs(err, entities.ErrOutsideOpeningHours)
+		})
+	}
+}
+
+func (s *OpeningHoursSuite) TestReserveCourt_Holiday() {
+	ctx := context.Background()
+	s.expectSchedule(entities.SpecialHours{Date: openingHoursDay, Reason: "All Saints' bridge"})
+
+	from := openingHoursDay.Add(10 * time.Hour)
+	rsv := entities.NewReservation("court-1", from, from.Add(time.Hour), "user-1")
+
+	err := s.service.ReserveCourt(ctx, "court-1", rsv)
+	s.Require().ErrorIs(err, entities.ErrOutsideOpeningHours)
+	s.ErrorContains(err, "All Saints' bridge")
+}
+
+func (s *OpeningHoursSuite) TestCreateSeries_HolidayOccurrence() {
+	ctx := context.Background()
+	series := weeklySeries()
+
+	schedule := entities.NewSchedule(entities.Organization{
+		OpeningHours: []entities.OpeningHours{{Weekday: time.Tuesday, OpensAt: 9 * 60, ClosesAt: 22 * 60}},
+		SpecialHours: []entities.SpecialHours{{Date: time.Date(2025, 9, 16, 0, 0, 0, 0, time.UTC), Reason: "Holiday"}},
+	}, entities.Court{ID: "court-1"})
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.scheduler.EXPECT().CourtSchedule(ctx, "court-1").Return(&schedule, nil).Times(5)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(4)
+	s.reservationsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(4)
+	s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+	occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+	s.Require().Len(occurrences, 5)
+
+	for i, occ := range occurrences {
+		s.Equal(i == 2, occ.Conflict, "occurrence %d", i)
+	}
+}
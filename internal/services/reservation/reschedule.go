@@ -0,0 +1,167 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+	"github.com/rs/zerolog/log"
+)
+
+// RescheduleReservation moves the reservation to another slot on the same court or on another court of the
+// organization, on behalf of the booker or staff of the organization. The reservation keeps its id, so its
+// roster, payments and open match follow it, and keeps the price it was booked for, so it may only be moved
+// to a slot that costs the same. Only reservations that have not started yet may be moved, to a slot the
+// booking rules of the court allow while the court is open.
+// The move is recorded in the history of the reservation and the slot it frees is offered to the waitlist.
+func (s *Service) RescheduleReservation(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	actor entities.Actor,
+	targetCourtID string,
+	from, to time.Time,
+) (*entities.Reservation, error) {
+	rsv, err := s.GetReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeChange(ctx, organizationID, rsv.CourtID, rsv.ReservedBy, actor); err != nil {
+		return nil, fmt.Errorf("reservation %s: %w", rsv.ID, err)
+	}
+
+	if targetCourtID == "" {
+		targetCourtID = rsv.CourtID
+	}
+
+	// a reservation is never moved to the court of another organization
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, rsv.CourtID); err != nil {
+		return nil, err
+	}
+
+	if targetCourtID != rsv.CourtID {
+		if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, targetCourtID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.clock.Now()
+	if !rsv.IsActiveAt(now) || !rsv.ReservedFrom.After(now) {
+		return nil, fmt.Errorf("%w: reservation %s is %s and starts at %s",
+			entities.ErrReservationNotActive, rsv.ID, rsv.Status, rsv.ReservedFrom)
+	}
+
+	change := entities.NewReservationChange(*rsv, targetCourtID, from, to, actor.UserID, now)
+	if err := change.Validate(now); err != nil {
+		return nil, err
+	}
+
+	// moving does not add a reservation, so only the slot is checked against the rules of the new court
+	rules, err := s.bookingRules(ctx, targetCourtID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules != nil {
+		loc, err := s.CourtLocation(ctx, targetCourtID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := rules.CheckSlot(from, to, now, loc); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.checkOpen(ctx, targetCourtID, from, to); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSamePrice(ctx, rsv, change); err != nil {
+		return nil, err
+	}
+
+	if err := s.move(ctx, change); err != nil {
+		return nil, err
+	}
+
+	freed := *rsv
+	change.Apply(rsv)
+
+	if change.FreesPreviousSlot() {
+		s.offerToWaitlist(ctx, freed)
+	}
+
+	return rsv, nil
+}
+
+// checkSamePrice refuses slots priced differently than the reservation. Its payments and shares were made
+// for the price it was booked for, which a move neither charges more nor refunds.
+func (s *Service) checkSamePrice(
+	ctx context.Context,
+	rsv *entities.Reservation,
+	change *entities.ReservationChange,
+) error {
+	moved := *rsv
+	change.Apply(&moved)
+
+	price, err := s.price(ctx, change.CourtID, &moved)
+	if err != nil {
+		return err
+	}
+
+	if price != rsv.Price {
+		return fmt.Errorf("%w: the slot costs %d %s, the reservation was booked for %d %s",
+			entities.ErrInvalidReschedule, price.Amount, price.Currency, rsv.Price.Amount, rsv.Price.Currency)
+	}
+
+	return nil
+}
+
+// move takes the new slot under the same lock as reserve, the exclusion constraint in the database still
+// guards against overlaps across replicas.
+func (s *Service) move(ctx context.Context, change *entities.ReservationChange) error {
+	if err := s.releaseExpiredHolds(ctx, change.CourtID, change.ReservedFrom, change.ReservedTo); err != nil {
+		return err
+	}
+
+	if err := s.locker.Lock(ctx, change.CourtID); err != nil {
+		return fmt.Errorf("failed to lock court: %w", err)
+	}
+
+	defer func() {
+		if err := s.locker.Unlock(ctx, change.CourtID); err != nil {
+			log.Error().Err(err).Str("court_id", change.CourtID).Msg("failed to unlock court")
+		}
+	}()
+
+	err := s.ensureSlotFree(ctx, change.CourtID, change.ReservedFrom, change.ReservedTo, change.ReservationID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.reservationsRepo.MoveReservation(ctx, change); err != nil {
+		return fmt.Errorf("move reservation: %w", err)
+	}
+
+	return nil
+}
+
+// ListReservationChanges returns the history of moves of the reservation, the earliest first.
+func (s *Service) ListReservationChanges(
+	ctx context.Context,
+	courtID, reservationID string,
+) ([]entities.ReservationChange, error) {
+	if _, err := s.GetReservation(ctx, courtID, reservationID); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.reservationsRepo.ListReservationChanges(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("list reservation changes: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,264 @@
+package reservation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type RescheduleSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	service          *reservation.Service
+}
+
+func TestRescheduleSuite(t *testing.T) {
+	suite.Run(t, new(RescheduleSuite))
+}
+
+var (
+	rescheduleNow  = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	rescheduleFrom = rescheduleNow.Add(24 * time.Hour)
+)
+
+func (s *RescheduleSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(rescheduleNow).AnyTimes()
+
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Pricer:       hourlyPriced(s.ctrl, map[string]int64{"court-1": 3000, "court-2": 3000, "court-3": 4500}),
+			Clock:        clock,
+		},
+		10*time.Minute,
+	)
+
+	s.courtsRepo.EXPECT().GetByID(gomock.Any(), "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil).AnyTimes()
+	s.courtsRepo.EXPECT().GetByID(gomock.Any(), "court-2").Return(&entities.Court{OrganizationID: "org-1"}, nil).AnyTimes()
+	s.courtsRepo.EXPECT().GetByID(gomock.Any(), "court-3").Return(&entities.Court{OrganizationID: "org-1"}, nil).AnyTimes()
+	s.courtsRepo.EXPECT().GetByID(gomock.Any(), "court-9").Return(&entities.Court{OrganizationID: "org-9"}, nil).AnyTimes()
+}
+
+// hourlyPriced returns a pricer billing every court at its hourly rate in EUR.
+func hourlyPriced(ctrl *gomock.Controller, rates map[string]int64) *mocks.MockPricer {
+	pricer := mocks.NewMockPricer(ctrl)
+	pricer.EXPECT().
+		QuoteCourt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, courtID string, from, to time.Time) (*entities.Quote, error) {
+			total := rates[courtID] * int64(to.Sub(from)/time.Minute) / 60
+			return &entities.Quote{CourtID: courtID, From: from, To: to, Currency: "EUR", Total: total}, nil
+		}).
+		AnyTimes()
+
+	return pricer
+}
+
+func (s *RescheduleSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *RescheduleSuite) booked() *entities.Reservation {
+	return &entities.Reservation{
+		ID:           "res-1",
+		CourtID:      "court-1",
+		ReservedBy:   "user-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: rescheduleFrom,
+		ReservedTo:   rescheduleFrom.Add(time.Hour),
+		Price:        entities.Price{Amount: 3000, Currency: "EUR"},
+	}
+}
+
+func (s *RescheduleSuite) TestRescheduleReservation_MovesToAnotherCourt() {
+	ctx := context.Background()
+	rsv := s.booked()
+	to := rescheduleFrom.Add(2 * time.Hour)
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-2", rescheduleFrom.Add(time.Hour), to).
+		Return([]entities.Reservation{
+			// an expired hold does not stand in the way
+			{ID: "res-2", CourtID: "court-2", Status: entities.PendingReservationStatus, ExpiresAt: rescheduleNow},
+		}, nil)
+	s.reservationsRepo.EXPECT().
+		MoveReservation(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, change *entities.ReservationChange) error {
+			s.Equal(rsv.ID, change.ReservationID)
+			s.Equal("court-1", change.PreviousCourtID)
+			s.Equal(rescheduleFrom, change.PreviousFrom)
+			s.Equal("court-2", change.CourtID)
+			s.Equal("user-1", change.ChangedBy)
+			s.Equal(rescheduleNow, change.ChangedAt)
+			return nil
+		})
+	// the freed slot is offered to the waitlist
+	s.reservationsRepo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", rescheduleFrom, rsv.ReservedTo, gomock.Any(), rescheduleNow).
+		Return(nil, entities.ErrNotFound)
+
+	got, err := s.service.RescheduleReservation(
+		ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, "court-2", rescheduleFrom.Add(time.Hour), to,
+	)
+	s.Require().NoError(err)
+
+	s.Equal(rsv.ID, got.ID)
+	s.Equal("court-2", got.CourtID)
+	s.Equal(rescheduleFrom.Add(time.Hour), got.ReservedFrom)
+	s.Equal(to, got.ReservedTo)
+	s.Equal(int64(3000), got.Price.Amount)
+}
+
+func (s *RescheduleSuite) TestRescheduleReservation_ShiftOnSameCourt() {
+	ctx := context.Background()
+	rsv := s.booked()
+	from := rescheduleFrom.Add(30 * time.Minute)
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	// the reservation itself overlaps the new slot and is not a conflict
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", from, from.Add(time.Hour)).
+		Return([]entities.Reservation{*rsv}, nil)
+	s.reservationsRepo.EXPECT().MoveReservation(ctx, gomock.Any()).Return(nil)
+
+	// the previous slot still overlaps the new one, so nothing is offered to the waitlist
+	got, err := s.service.RescheduleReservation(
+		ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, "", from, from.Add(time.Hour),
+	)
+	s.Require().NoError(err)
+	s.Equal("court-1", got.CourtID)
+	s.Equal(from, got.ReservedFrom)
+}
+
+func (s *RescheduleSuite) TestRescheduleReservation_Errors() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		rsv     func() *entities.Reservation
+		actor   entities.Actor
+		courtID string
+		from    time.Time
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "slot taken",
+			rsv:     s.booked,
+			actor:   entities.Actor{UserID: "user-1"},
+			courtID: "court-2",
+			from:    rescheduleFrom,
+			setup: func() {
+				s.reservationsRepo.EXPECT().
+					ListByCourtAndTimeRange(ctx, "court-2", rescheduleFrom, rescheduleFrom.Add(time.Hour)).
+					Return([]entities.Reservation{{ID: "res-2", Status: entities.ReservedReservationStatus}}, nil)
+			},
+			wantErr: entities.ErrCourtAlreadyReserved,
+		},
+		{
+			name:    "booked by another player",
+			rsv:     s.booked,
+			actor:   entities.Actor{UserID: "user-2", Role: entities.PlayerRole},
+			from:    rescheduleFrom.Add(time.Hour),
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:    "court of another organization",
+			rsv:     s.booked,
+			actor:   entities.Actor{UserID: "staff-1", Role: entities.StaffRole},
+			courtID: "court-9",
+			from:    rescheduleFrom,
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "already started",
+			rsv: func() *entities.Reservation {
+				rsv := s.booked()
+				rsv.ReservedFrom = rescheduleNow.Add(-time.Minute)
+				return rsv
+			},
+			actor:   entities.Actor{UserID: "user-1"},
+			from:    rescheduleFrom,
+			wantErr: entities.ErrReservationNotActive,
+		},
+		{
+			name: "cancelled",
+			rsv: func() *entities.Reservation {
+				rsv := s.booked()
+				rsv.Status = entities.CancelledReservationStatus
+				return rsv
+			},
+			actor:   entities.Actor{UserID: "user-1"},
+			from:    rescheduleFrom.Add(time.Hour),
+			wantErr: entities.ErrReservationNotActive,
+		},
+		{
+			name:    "slot in the past",
+			rsv:     s.booked,
+			actor:   entities.Actor{UserID: "user-1"},
+			from:    rescheduleNow.Add(-time.Hour),
+			wantErr: entities.ErrInvalidReschedule,
+		},
+		{
+			name:    "pricier court",
+			rsv:     s.booked,
+			actor:   entities.Actor{UserID: "user-1"},
+			courtID: "court-3",
+			from:    rescheduleFrom,
+			wantErr: entities.ErrInvalidReschedule,
+		},
+		{
+			name: "slot priced since the booking",
+			rsv: func() *entities.Reservation {
+				rsv := s.booked()
+				rsv.Price = entities.Price{}
+				return rsv
+			},
+			actor:   entities.Actor{UserID: "user-1"},
+			from:    rescheduleFrom.Add(2 * time.Hour),
+			wantErr: entities.ErrInvalidReschedule,
+		},
+		{
+			name:    "same slot",
+			rsv:     s.booked,
+			actor:   entities.Actor{UserID: "user-1"},
+			from:    rescheduleFrom,
+			wantErr: entities.ErrInvalidReschedule,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rsv := tt.rsv()
+			s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			_, err := s.service.RescheduleReservation(
+				ctx, "org-1", "court-1", rsv.ID, tt.actor, tt.courtID, tt.from, tt.from.Add(time.Hour),
+			)
+			s.ErrorIs(err, tt.wantErr)
+		})
+	}
+}
@@ -2,26 +2,105 @@ package reservation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+	"github.com/lever-dev/padel-backend/pkg/clock"
 	"github.com/rs/zerolog/log"
 )
 
+// DefaultHoldTTL is how long a pending reservation keeps its slot when no TTL is configured.
+const DefaultHoldTTL = 15 * time.Minute
+
 type Service struct {
 	reservationsRepo ReservationsRepository
+	courtsRepo       CourtsRepository
+	pricer           Pricer
+	policies         Policies
+	scheduler        Scheduler
+	refunder         Refunder
 	locker           Locker
+	clock            clock.Clock
+	holdTTL          time.Duration
+}
+
+// Dependencies are the repositories and the services the reservation service works with.
+type Dependencies struct {
+	Reservations ReservationsRepository
+	Courts       CourtsRepository
+	Pricer       Pricer
+	Policies     Policies
+	Scheduler    Scheduler
+	Refunder     Refunder
+	Locker       Locker
+	Clock        clock.Clock
 }
 
-func NewService(repo ReservationsRepository, locker Locker) *Service {
+// NewService keeps pending reservations for holdTTL, or DefaultHoldTTL when it is not positive.
+func NewService(deps Dependencies, holdTTL time.Duration) *Service {
+	if holdTTL <= 0 {
+		holdTTL = DefaultHoldTTL
+	}
+
 	return &Service{
-		reservationsRepo: repo,
-		locker:           locker,
+		reservationsRepo: deps.Reservations,
+		courtsRepo:       deps.Courts,
+		pricer:           deps.Pricer,
+		policies:         deps.Policies,
+		scheduler:        deps.Scheduler,
+		refunder:         deps.Refunder,
+		locker:           deps.Locker,
+		clock:            deps.Clock,
+		holdTTL:          holdTTL,
 	}
 }
 
+// ReserveCourt places a pending hold on the slot. The hold has to be confirmed with
+// ConfirmReservation before it expires, otherwise the slot is released. The slot has to lie within the
+// opening hours of the court and the booking rules of the court have to allow it.
 func (s *Service) ReserveCourt(ctx context.Context, courtID string, reservation *entities.Reservation) error {
+	reservation.Status = entities.PendingReservationStatus
+	reservation.ExpiresAt = s.clock.Now().Add(s.holdTTL)
+
+	return s.reserve(ctx, courtID, reservation)
+}
+
+// reserve stores the reservation with its current status unless the booking rules of the court refuse it or
+// the slot is taken by an active reservation. The court lock only saves a round trip on obvious conflicts,
+// the exclusion constraint in the database is what guarantees that no two active reservations overlap
+// across replicas. When the rules cap the upcoming reservations of a user, the user is locked too, so
+// parallel bookings on different courts can't both pass the cap.
+func (s *Service) reserve(ctx context.Context, courtID string, reservation *entities.Reservation) error {
+	if err := s.checkOpen(ctx, courtID, reservation.ReservedFrom, reservation.ReservedTo); err != nil {
+		return err
+	}
+
+	rules, err := s.bookingRules(ctx, courtID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.releaseExpiredHolds(ctx, courtID, reservation.ReservedFrom, reservation.ReservedTo); err != nil {
+		return err
+	}
+
+	if rules != nil && rules.MaxActiveBookings > 0 {
+		key := userLockKey(rules.OrganizationID, reservation.ReservedBy)
+
+		if err := s.locker.Lock(ctx, key); err != nil {
+			return fmt.Errorf("failed to lock user: %w", err)
+		}
+
+		defer func() {
+			if err := s.locker.Unlock(ctx, key); err != nil {
+				log.Error().Err(err).Str("user_id", reservation.ReservedBy).Msg("failed to unlock user")
+			}
+		}()
+	}
+
 	if err := s.locker.Lock(ctx, courtID); err != nil {
 		return fmt.Errorf("failed to lock court: %w", err)
 	}
@@ -32,29 +111,158 @@ func (s *Service) ReserveCourt(ctx context.Context, courtID string, reservation
 		}
 	}()
 
-	overlapping, err := s.reservationsRepo.ListByCourtAndTimeRange(
-		ctx,
-		courtID,
-		reservation.ReservedFrom,
-		reservation.ReservedTo,
-	)
+	if err := s.checkBookingRules(ctx, courtID, rules, reservation); err != nil {
+		return err
+	}
+
+	if err := s.ensureSlotFree(ctx, courtID, reservation.ReservedFrom, reservation.ReservedTo, ""); err != nil {
+		return err
+	}
+
+	price, err := s.price(ctx, courtID, reservation)
+	if err != nil {
+		return err
+	}
+
+	reservation.Price = price
+
+	if err := s.reservationsRepo.Create(ctx, reservation); err != nil {
+		return fmt.Errorf("create reservation: %w", err)
+	}
+
+	return nil
+}
+
+// userLockKey is the lock serialising the bookings of a user at an organization.
+func userLockKey(organizationID, userID string) string {
+	return "user:" + organizationID + ":" + userID
+}
+
+// checkBookingRules tells whether the booking rules of the court let the user make the reservation. Courts
+// without rules take any reservation that fits.
+func (s *Service) checkBookingRules(
+	ctx context.Context,
+	courtID string,
+	rules *entities.BookingRules,
+	reservation *entities.Reservation,
+) error {
+	if rules == nil {
+		return nil
+	}
+
+	loc, err := s.CourtLocation(ctx, courtID)
+	if err != nil {
+		return err
+	}
+
+	now := s.clock.Now()
+
+	if err := rules.CheckSlot(reservation.ReservedFrom, reservation.ReservedTo, now, loc); err != nil {
+		return err
+	}
+
+	if rules.MaxActiveBookings == 0 {
+		return nil
+	}
+
+	active, err := s.reservationsRepo.CountActiveByUser(ctx, rules.OrganizationID, reservation.ReservedBy, now)
+	if err != nil {
+		return fmt.Errorf("count active reservations: %w", err)
+	}
+
+	return rules.CheckActiveBookings(active)
+}
+
+// checkOpen fails with ErrOutsideOpeningHours when the court is closed during any part of the slot.
+func (s *Service) checkOpen(ctx context.Context, courtID string, from, to time.Time) error {
+	schedule, err := s.scheduler.CourtSchedule(ctx, courtID)
+	if err != nil {
+		return fmt.Errorf("get court schedule: %w", err)
+	}
+
+	return schedule.CheckOpen(from, to)
+}
+
+// CourtLocation returns the time zone of the organization of the court, the one reservation times without an
+// offset are given in.
+func (s *Service) CourtLocation(ctx context.Context, courtID string) (*time.Location, error) {
+	schedule, err := s.scheduler.CourtSchedule(ctx, courtID)
+	if err != nil {
+		return nil, fmt.Errorf("get court schedule: %w", err)
+	}
+
+	return schedule.TimeZone(), nil
+}
+
+// OrganizationLocation returns the time zone of the organization.
+func (s *Service) OrganizationLocation(ctx context.Context, organizationID string) (*time.Location, error) {
+	loc, err := s.scheduler.OrganizationLocation(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get organization time zone: %w", err)
+	}
+
+	return loc, nil
+}
+
+// bookingRules returns nil rules when the court has none.
+func (s *Service) bookingRules(ctx context.Context, courtID string) (*entities.BookingRules, error) {
+	rules, err := s.policies.CourtBookingRules(ctx, courtID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get booking rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// ensureSlotFree fails with ErrCourtAlreadyReserved when an active reservation other than the one with the id
+// except overlaps the slot, and with ErrCourtBlackedOut when a blackout closes the court during the slot.
+// The caller has to hold the lock of the court.
+func (s *Service) ensureSlotFree(ctx context.Context, courtID string, from, to time.Time, except string) error {
+	overlapping, err := s.reservationsRepo.ListByCourtAndTimeRange(ctx, courtID, from, to)
 	if err != nil {
 		return fmt.Errorf("failed to check overlapping reservations: %w", err)
 	}
 
+	now := s.clock.Now()
+
 	for _, val := range overlapping {
-		if val.IsReserved() {
+		if val.ID != except && val.IsActiveAt(now) {
 			return entities.ErrCourtAlreadyReserved
 		}
 	}
 
-	if err := s.reservationsRepo.Create(ctx, reservation); err != nil {
-		return fmt.Errorf("create reservation: %w", err)
+	blackouts, err := s.reservationsRepo.ListBlackoutsByCourt(ctx, courtID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to check blackouts: %w", err)
+	}
+
+	if len(blackouts) > 0 {
+		return fmt.Errorf("%w: %s", entities.ErrCourtBlackedOut, blackouts[0].Reason)
 	}
 
 	return nil
 }
 
+// price snapshots the price of the reservation. Courts without a pricing rule are booked unpriced.
+func (s *Service) price(
+	ctx context.Context,
+	courtID string,
+	reservation *entities.Reservation,
+) (entities.Price, error) {
+	quote, err := s.pricer.QuoteCourt(ctx, courtID, reservation.ReservedFrom, reservation.ReservedTo)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return entities.Price{}, nil
+		}
+		return entities.Price{}, fmt.Errorf("quote reservation: %w", err)
+	}
+
+	return quote.Price(), nil
+}
+
 func (s *Service) ListReservations(
 	ctx context.Context,
 	courtID string,
@@ -67,14 +275,223 @@ func (s *Service) ListReservations(
 	return revs, nil
 }
 
-func (s *Service) CancelReservation(ctx context.Context, reservationID string, cancelledBy string) error {
-	if err := s.reservationsRepo.CancelReservation(ctx, reservationID, cancelledBy); err != nil {
-		return fmt.Errorf("cancel reservation: %w", err)
+// CancelReservation cancels the reservation on behalf of the actor, who has to be the one who booked it
+// or staff of the organization owning the court. The cancellation policy of the organization decides how much
+// of the price is refunded, unless staff waive it. The cancelled reservation carries the terms it was
+// cancelled on. Cancelling a cancelled reservation again retries its refund on the recorded terms, payments
+// already refunded are left alone.
+func (s *Service) CancelReservation(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	actor entities.Actor,
+	waivePolicy bool,
+) (*entities.Reservation, error) {
+	rsv, err := s.GetReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cancelReservation(ctx, organizationID, rsv, actor, waivePolicy)
+}
+
+func (s *Service) cancelReservation(
+	ctx context.Context,
+	organizationID string,
+	rsv *entities.Reservation,
+	actor entities.Actor,
+	waivePolicy bool,
+) (*entities.Reservation, error) {
+	if err := s.authorizeChange(ctx, organizationID, rsv.CourtID, rsv.ReservedBy, actor); err != nil {
+		return nil, fmt.Errorf("reservation %s: %w", rsv.ID, err)
+	}
+
+	if rsv.Status == entities.CancelledReservationStatus && rsv.Cancellation != nil {
+		if err := s.refunder.RefundReservation(ctx, rsv.ID, rsv.Cancellation.RefundPercent); err != nil {
+			return nil, fmt.Errorf("refund reservation: %w", err)
+		}
+
+		return rsv, nil
+	}
+
+	if waivePolicy {
+		if err := s.authorizeWaiver(ctx, organizationID, rsv.CourtID, actor); err != nil {
+			return nil, fmt.Errorf("reservation %s: %w", rsv.ID, err)
+		}
+	}
+
+	cancellation, err := s.cancellationTerms(ctx, rsv, waivePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reservationsRepo.CancelReservation(ctx, rsv.ID, actor.UserID, cancellation); err != nil {
+		return nil, fmt.Errorf("cancel reservation: %w", err)
+	}
+
+	if err := s.refunder.RefundReservation(ctx, rsv.ID, cancellation.RefundPercent); err != nil {
+		return nil, fmt.Errorf("refund reservation: %w", err)
+	}
+
+	rsv.Status = entities.CancelledReservationStatus
+	rsv.CancelledBy = actor.UserID
+	rsv.Cancellation = &cancellation
+
+	s.offerToWaitlist(ctx, *rsv)
+
+	return rsv, nil
+}
+
+// cancellationTerms applies the cancellation policy of the organization owning the court at the moment of
+// the cancellation. Waived cancellations, holds that were never confirmed and reservations of organizations
+// without a policy are cancelled free of charge.
+func (s *Service) cancellationTerms(
+	ctx context.Context,
+	rsv *entities.Reservation,
+	waivePolicy bool,
+) (entities.Cancellation, error) {
+	if waivePolicy {
+		return entities.FreeCancellation(true), nil
+	}
+
+	if rsv.Status != entities.ReservedReservationStatus {
+		return entities.FreeCancellation(false), nil
+	}
+
+	policy, err := s.policies.CourtCancellationPolicy(ctx, rsv.CourtID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return entities.FreeCancellation(false), nil
+		}
+		return entities.Cancellation{}, fmt.Errorf("get cancellation policy: %w", err)
+	}
+
+	return entities.NewCancellation(*policy, *rsv, s.clock.Now()), nil
+}
+
+// authorizeWaiver lets staff of the organization owning the court cancel without applying its policy.
+func (s *Service) authorizeWaiver(ctx context.Context, organizationID, courtID string, actor entities.Actor) error {
+	if !actor.IsStaff() {
+		return fmt.Errorf("%w: only staff may waive the cancellation policy", entities.ErrForbidden)
+	}
+
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// authorizeChange lets the booker cancel or move their own booking. Anyone else has to be staff of the
+// organization, and the court has to belong to it, since the role was resolved for that organization.
+func (s *Service) authorizeChange(
+	ctx context.Context,
+	organizationID, courtID, reservedBy string,
+	actor entities.Actor,
+) error {
+	if reservedBy == actor.UserID {
+		return nil
+	}
+
+	if !actor.IsStaff() {
+		return fmt.Errorf("%w: booked by another user", entities.ErrForbidden)
+	}
+
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConfirmReservation turns a pending hold into a reservation. Only the user who placed the hold may confirm it.
+// Holds with a price are confirmed by their payment instead.
+func (s *Service) ConfirmReservation(
+	ctx context.Context,
+	courtID, reservationID string,
+	userID string,
+) (*entities.Reservation, error) {
+	rsv, err := s.GetReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsv.ReservedBy != userID {
+		return nil, fmt.Errorf("%w: reservation %s was booked by another user", entities.ErrForbidden, reservationID)
+	}
+
+	if rsv.Status != entities.PendingReservationStatus {
+		return nil, fmt.Errorf("%w: reservation %s is %s",
+			entities.ErrReservationNotPending, reservationID, rsv.Status)
+	}
+
+	if rsv.Price.Amount > 0 {
+		return nil, fmt.Errorf("%w: reservation %s costs %d %s",
+			entities.ErrPaymentRequired, reservationID, rsv.Price.Amount, rsv.Price.Currency)
+	}
+
+	now := s.clock.Now()
+	if rsv.IsHoldExpired(now) {
+		return nil, fmt.Errorf("%w: reservation %s expired at %s",
+			entities.ErrReservationHoldExpired, reservationID, rsv.ExpiresAt)
+	}
+
+	if err := s.reservationsRepo.ConfirmReservation(ctx, reservationID, now); err != nil {
+		return nil, fmt.Errorf("confirm reservation: %w", err)
+	}
+
+	rsv.Status = entities.ReservedReservationStatus
+	rsv.ExpiresAt = time.Time{}
+
+	return rsv, nil
+}
+
+// ExpireHolds releases every pending hold that was not confirmed in time. Shares already paid on a split
+// hold are refunded, a refund that fails is logged and left to the staff. The released slots are offered
+// to the waitlist.
+func (s *Service) ExpireHolds(ctx context.Context) ([]entities.Reservation, error) {
+	expired, err := s.reservationsRepo.ExpirePendingReservations(ctx, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("expire pending reservations: %w", err)
+	}
+
+	s.releaseHolds(ctx, expired)
+
+	return expired, nil
+}
+
+// releaseExpiredHolds expires the holds on the court that overlap the slot and ran out before the expirer got
+// to them, and releases them like ExpireHolds does. It runs before the lock of the court is taken, since the
+// waitlist offer books through it.
+func (s *Service) releaseExpiredHolds(ctx context.Context, courtID string, from, to time.Time) error {
+	expired, err := s.reservationsRepo.ExpireOverlappingHolds(ctx, courtID, from, to, s.clock.Now())
+	if err != nil {
+		return fmt.Errorf("expire overlapping holds: %w", err)
 	}
 
+	s.releaseHolds(ctx, expired)
+
 	return nil
 }
 
+// releaseHolds refunds the shares already paid on the expired holds and offers their slots to the waitlist.
+// A hold that was itself a waitlist offer puts its entry back in the queue once the slot went to the next one,
+// so the same user is not offered the slot they let run out again. A refund that fails is logged and left to
+// the staff.
+func (s *Service) releaseHolds(ctx context.Context, expired []entities.Reservation) {
+	for _, rsv := range expired {
+		if err := s.refunder.RefundReservation(ctx, rsv.ID, 100); err != nil {
+			log.Error().Err(err).Str("reservation_id", rsv.ID).Msg("failed to refund expired hold")
+		}
+
+		s.offerToWaitlist(ctx, rsv)
+
+		err := s.reservationsRepo.ResetWaitlistOffer(ctx, rsv.ID, s.clock.Now())
+		if err != nil && !errors.Is(err, entities.ErrNotFound) {
+			log.Error().Err(err).Str("reservation_id", rsv.ID).Msg("failed to reset waitlist offer")
+		}
+	}
+}
+
 func (s *Service) GetReservation(ctx context.Context, courtID, reservationID string) (*entities.Reservation, error) {
 	rev, err := s.reservationsRepo.GetByID(ctx, reservationID)
 	if err != nil {
@@ -87,7 +504,8 @@ func (s *Service) GetReservation(ctx context.Context, courtID, reservationID str
 			Str("courtID", courtID).
 			Msg("reservation's court ID does not match the requested court ID")
 		return nil, fmt.Errorf(
-			"reservation court ids mismatch, reservation id: %s, court id: %s",
+			"%w: reservation court ids mismatch, reservation id: %s, court id: %s",
+			entities.ErrNotFound,
 			reservationID,
 			courtID,
 		)
@@ -12,6 +12,7 @@ import (
 	"github.com/lever-dev/padel-backend/internal/entities"
 	"github.com/lever-dev/padel-backend/internal/services/reservation"
 	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	"github.com/lever-dev/padel-backend/pkg/clock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -32,6 +33,104 @@ func (s *ServiceSuite) TearDownTest() {
 	s.ctrl.Finish()
 }
 
+// noBlackouts lets the repository report that no blackout closes any court.
+func noBlackouts(repo *mocks.MockReservationsRepository) {
+	repo.EXPECT().
+		ListBlackoutsByCourt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+}
+
+// noExpiredHolds lets the repository report that no run out hold is left on any court.
+func noExpiredHolds(repo *mocks.MockReservationsRepository) {
+	repo.EXPECT().
+		ExpireOverlappingHolds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+}
+
+// unpriced returns a pricer for courts without a pricing rule.
+func unpriced(ctrl *gomock.Controller) *mocks.MockPricer {
+	pricer := mocks.NewMockPricer(ctrl)
+	pricer.EXPECT().
+		QuoteCourt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, entities.ErrNotFound).
+		AnyTimes()
+
+	return pricer
+}
+
+// unrestricted returns the policies of courts without a cancellation policy or booking rules.
+func unrestricted(ctrl *gomock.Controller) *mocks.MockPolicies {
+	policies := mocks.NewMockPolicies(ctrl)
+	policies.EXPECT().
+		CourtCancellationPolicy(gomock.Any(), gomock.Any()).
+		Return(nil, entities.ErrNotFound).
+		AnyTimes()
+	policies.EXPECT().
+		CourtBookingRules(gomock.Any(), gomock.Any()).
+		Return(nil, entities.ErrNotFound).
+		AnyTimes()
+
+	return policies
+}
+
+// alwaysOpen returns a scheduler for courts without opening hours, in UTC.
+func alwaysOpen(ctrl *gomock.Controller) *mocks.MockScheduler {
+	scheduler := mocks.NewMockScheduler(ctrl)
+	scheduler.EXPECT().
+		CourtSchedule(gomock.Any(), gomock.Any()).
+		Return(&entities.Schedule{}, nil).
+		AnyTimes()
+
+	return scheduler
+}
+
+// unpaid returns a refunder for reservations without a settled payment.
+func unpaid(ctrl *gomock.Controller) *mocks.MockRefunder {
+	refunder := mocks.NewMockRefunder(ctrl)
+	refunder.EXPECT().
+		RefundReservation(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	return refunder
+}
+
+// newService builds a service over deps. The collaborators left nil are filled with courts that are
+// unpriced, unrestricted, always open and unpaid, a local locker and the real clock.
+func newService(ctrl *gomock.Controller, deps reservation.Dependencies, holdTTL time.Duration) *reservation.Service {
+	if deps.Courts == nil {
+		deps.Courts = mocks.NewMockCourtsRepository(ctrl)
+	}
+
+	if deps.Pricer == nil {
+		deps.Pricer = unpriced(ctrl)
+	}
+
+	if deps.Policies == nil {
+		deps.Policies = unrestricted(ctrl)
+	}
+
+	if deps.Scheduler == nil {
+		deps.Scheduler = alwaysOpen(ctrl)
+	}
+
+	if deps.Refunder == nil {
+		deps.Refunder = unpaid(ctrl)
+	}
+
+	if deps.Locker == nil {
+		deps.Locker = reservation.NewLocalLocker()
+	}
+
+	if deps.Clock == nil {
+		deps.Clock = clock.Real{}
+	}
+
+	return reservation.NewService(deps, holdTTL)
+}
+
 func (s *ServiceSuite) TestReserveCourt() {
 	tests := []struct {
 		name        string
@@ -152,8 +251,17 @@ func (s *ServiceSuite) TestReserveCourt() {
 			ctx := context.Background()
 
 			mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+			noBlackouts(mockRepo)
+			noExpiredHolds(mockRepo)
 			locker := reservation.NewLocalLocker()
-			service := reservation.NewService(mockRepo, locker)
+			service := newService(
+				s.ctrl,
+				reservation.Dependencies{
+					Reservations: mockRepo,
+					Locker:       locker,
+				},
+				reservation.DefaultHoldTTL,
+			)
 
 			tt.setupMocks(mockRepo, tt.reservation)
 
@@ -169,6 +277,80 @@ func (s *ServiceSuite) TestReserveCourt() {
 	}
 }
 
+func (s *ServiceSuite) TestReserveCourt_PriceSnapshot() {
+	ctx := context.Background()
+	courtID := "court-1"
+
+	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(mockRepo)
+	noExpiredHolds(mockRepo)
+	pricer := mocks.NewMockPricer(s.ctrl)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+			Pricer:       pricer,
+		},
+		reservation.DefaultHoldTTL,
+	)
+
+	s.Run("priced court", func() {
+		rsv := entities.NewReservation(courtID, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), "user-1")
+
+		mockRepo.EXPECT().
+			ListByCourtAndTimeRange(ctx, courtID, rsv.ReservedFrom, rsv.ReservedTo).
+			Return(nil, nil)
+		pricer.EXPECT().
+			QuoteCourt(ctx, courtID, rsv.ReservedFrom, rsv.ReservedTo).
+			Return(&entities.Quote{Currency: "EUR", Total: 2500}, nil)
+		mockRepo.EXPECT().Create(ctx, rsv).Return(nil)
+
+		s.Require().NoError(service.ReserveCourt(ctx, courtID, rsv))
+		s.Equal(entities.Price{Amount: 2500, Currency: "EUR"}, rsv.Price)
+	})
+
+	s.Run("pricing failure blocks the booking", func() {
+		rsv := entities.NewReservation(courtID, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), "user-1")
+
+		mockRepo.EXPECT().
+			ListByCourtAndTimeRange(ctx, courtID, rsv.ReservedFrom, rsv.ReservedTo).
+			Return(nil, nil)
+		pricer.EXPECT().
+			QuoteCourt(ctx, courtID, rsv.ReservedFrom, rsv.ReservedTo).
+			Return(nil, errors.New("db down"))
+
+		s.Error(service.ReserveCourt(ctx, courtID, rsv))
+	})
+}
+
+func (s *ServiceSuite) TestReserveCourt_StorageConflict() {
+	ctx := context.Background()
+	courtID := "court-1"
+
+	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(mockRepo)
+	noExpiredHolds(mockRepo)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+		},
+		reservation.DefaultHoldTTL,
+	)
+
+	rsv := entities.NewReservation(courtID, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), "user-1")
+
+	// Another replica booked the slot between the overlap check and the insert.
+	mockRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, courtID, rsv.ReservedFrom, rsv.ReservedTo).
+		Return(nil, nil)
+	mockRepo.EXPECT().Create(ctx, rsv).Return(entities.ErrCourtAlreadyReserved)
+
+	err := service.ReserveCourt(ctx, courtID, rsv)
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrCourtAlreadyReserved)
+}
+
 func (s *ServiceSuite) TestReserveCourt_ConcurrentReservations() {
 	ctx := context.Background()
 	courtID := "court-1"
@@ -176,8 +358,17 @@ func (s *ServiceSuite) TestReserveCourt_ConcurrentReservations() {
 	reservedTo := time.Now().Add(2 * time.Hour)
 
 	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(mockRepo)
+	noExpiredHolds(mockRepo)
 	locker := reservation.NewLocalLocker()
-	service := reservation.NewService(mockRepo, locker)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+			Locker:       locker,
+		},
+		reservation.DefaultHoldTTL,
+	)
 
 	firstCall := mockRepo.EXPECT().
 		ListByCourtAndTimeRange(ctx, courtID, gomock.Any(), gomock.Any()).
@@ -250,8 +441,17 @@ func (s *ServiceSuite) TestReserveCourt_DifferentCourts() {
 	ctx := context.Background()
 
 	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(mockRepo)
+	noExpiredHolds(mockRepo)
 	locker := reservation.NewLocalLocker()
-	service := reservation.NewService(mockRepo, locker)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+			Locker:       locker,
+		},
+		reservation.DefaultHoldTTL,
+	)
 
 	mockRepo.EXPECT().
 		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
@@ -300,8 +500,17 @@ func (s *ServiceSuite) TestReserveCourt_LockIsReleased() {
 	courtID := "court-1"
 
 	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(mockRepo)
+	noExpiredHolds(mockRepo)
 	locker := reservation.NewLocalLocker()
-	service := reservation.NewService(mockRepo, locker)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+			Locker:       locker,
+		},
+		reservation.DefaultHoldTTL,
+	)
 
 	reservation := &entities.Reservation{
 		ID:           "reservation-1",
@@ -332,37 +541,108 @@ func (s *ServiceSuite) TestReserveCourt_LockIsReleased() {
 
 func (s *ServiceSuite) TestCancelReservation() {
 	ctx := context.Background()
+	courtID := "court-1"
 	reservationID := "reservation-1"
 	cancelledBy := "user-123"
 
+	// booked returns a fresh reservation each time, the service marks the one it cancels
+	booked := func() *entities.Reservation {
+		return &entities.Reservation{
+			ID:         reservationID,
+			CourtID:    courtID,
+			ReservedBy: cancelledBy,
+			Status:     entities.ReservedReservationStatus,
+		}
+	}
+
+	type repos struct {
+		reservations *mocks.MockReservationsRepository
+		courts       *mocks.MockCourtsRepository
+	}
+
+	otherUsers := &entities.Reservation{
+		ID:         reservationID,
+		CourtID:    courtID,
+		ReservedBy: "user-456",
+		Status:     entities.ReservedReservationStatus,
+	}
+
 	tests := []struct {
 		name       string
+		role       entities.Role
 		setupMocks func(mockRepo *mocks.MockReservationsRepository)
+		setupRepos func(r repos)
 		wantErr    error
 	}{
 		{
 			name: "success",
 			setupMocks: func(mockRepo *mocks.MockReservationsRepository) {
+				mockRepo.EXPECT().GetByID(ctx, reservationID).Return(booked(), nil)
 				mockRepo.EXPECT().
-					CancelReservation(ctx, reservationID, cancelledBy).
+					CancelReservation(ctx, reservationID, cancelledBy, entities.FreeCancellation(false)).
 					Return(nil)
 			},
 			wantErr: nil,
 		},
 		{
 			name: "reservation not found",
+			setupMocks: func(mockRepo *mocks.MockReservationsRepository) {
+				mockRepo.EXPECT().GetByID(ctx, reservationID).Return(nil, entities.ErrNotFound)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "reservation on another court",
+			setupMocks: func(mockRepo *mocks.MockReservationsRepository) {
+				mockRepo.EXPECT().
+					GetByID(ctx, reservationID).
+					Return(&entities.Reservation{ID: reservationID, CourtID: "court-2", ReservedBy: cancelledBy}, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "booked by another user",
 			setupMocks: func(mockRepo *mocks.MockReservationsRepository) {
 				mockRepo.EXPECT().
-					CancelReservation(ctx, reservationID, cancelledBy).
-					Return(entities.ErrNotFound)
+					GetByID(ctx, reservationID).
+					Return(&entities.Reservation{ID: reservationID, CourtID: courtID, ReservedBy: "user-456"}, nil)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name: "staff cancels a booking of another user",
+			role: entities.StaffRole,
+			setupRepos: func(r repos) {
+				r.reservations.EXPECT().GetByID(ctx, reservationID).Return(otherUsers, nil)
+				r.courts.EXPECT().GetByID(ctx, courtID).Return(&entities.Court{ID: courtID, OrganizationID: "org-1"}, nil)
+				r.reservations.EXPECT().
+					CancelReservation(ctx, reservationID, cancelledBy, entities.FreeCancellation(false)).
+					Return(nil)
+			},
+		},
+		{
+			name: "staff of another organization",
+			role: entities.ManagerRole,
+			setupRepos: func(r repos) {
+				r.reservations.EXPECT().GetByID(ctx, reservationID).Return(otherUsers, nil)
+				r.courts.EXPECT().GetByID(ctx, courtID).Return(&entities.Court{ID: courtID, OrganizationID: "org-2"}, nil)
 			},
 			wantErr: entities.ErrNotFound,
 		},
+		{
+			name: "player cannot cancel a booking of another user",
+			role: entities.PlayerRole,
+			setupMocks: func(mockRepo *mocks.MockReservationsRepository) {
+				mockRepo.EXPECT().GetByID(ctx, reservationID).Return(otherUsers, nil)
+			},
+			wantErr: entities.ErrForbidden,
+		},
 		{
 			name: "internal error",
 			setupMocks: func(mockRepo *mocks.MockReservationsRepository) {
+				mockRepo.EXPECT().GetByID(ctx, reservationID).Return(booked(), nil)
 				mockRepo.EXPECT().
-					CancelReservation(ctx, reservationID, cancelledBy).
+					CancelReservation(ctx, reservationID, cancelledBy, entities.FreeCancellation(false)).
 					Return(fmt.Errorf("db error"))
 			},
 			wantErr: fmt.Errorf("db error"),
@@ -372,18 +652,34 @@ func (s *ServiceSuite) TestCancelReservation() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+			courtsRepo := mocks.NewMockCourtsRepository(s.ctrl)
 			locker := reservation.NewLocalLocker()
-			service := reservation.NewService(mockRepo, locker)
-
-			tt.setupMocks(mockRepo)
+			service := newService(
+				s.ctrl,
+				reservation.Dependencies{
+					Reservations: mockRepo,
+					Courts:       courtsRepo,
+					Locker:       locker,
+				},
+				reservation.DefaultHoldTTL,
+			)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo)
+			}
+			if tt.setupRepos != nil {
+				tt.setupRepos(repos{reservations: mockRepo, courts: courtsRepo})
+			}
 
-			err := service.CancelReservation(ctx, reservationID, cancelledBy)
+			actor := entities.Actor{UserID: cancelledBy, Role: tt.role}
+			_, err := service.CancelReservation(ctx, "org-1", courtID, reservationID, actor, false)
 
 			if tt.wantErr != nil {
 				s.Require().Error(err)
-				if errors.Is(tt.wantErr, entities.ErrNotFound) {
-					s.ErrorIs(err, entities.ErrNotFound)
-				} else {
+				switch {
+				case errors.Is(tt.wantErr, entities.ErrNotFound), errors.Is(tt.wantErr, entities.ErrForbidden):
+					s.ErrorIs(err, tt.wantErr)
+				default:
 					s.Contains(err.Error(), "cancel reservation")
 				}
 			} else {
@@ -393,6 +689,81 @@ func (s *ServiceSuite) TestCancelReservation() {
 	}
 }
 
+func (s *ServiceSuite) TestCancelReservation_Refunds() {
+	ctx := context.Background()
+
+	booked := &entities.Reservation{
+		ID:         "reservation-1",
+		CourtID:    "court-1",
+		ReservedBy: "user-1",
+		Status:     entities.ReservedReservationStatus,
+	}
+
+	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	refunder := mocks.NewMockRefunder(s.ctrl)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+			Refunder:     refunder,
+		},
+		reservation.DefaultHoldTTL,
+	)
+
+	actor := entities.Actor{UserID: "user-1", Role: entities.PlayerRole}
+
+	mockRepo.EXPECT().GetByID(ctx, booked.ID).Return(booked, nil)
+	mockRepo.EXPECT().CancelReservation(ctx, booked.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+
+	refunder.EXPECT().RefundReservation(ctx, booked.ID, 100).Return(fmt.Errorf("provider down"))
+	_, err := service.CancelReservation(ctx, "org-1", "court-1", booked.ID, actor, false)
+	s.Error(err)
+
+	// cancelling again retries the refund on the recorded terms without cancelling twice
+	cancelled := *booked
+	cancelled.Status = entities.CancelledReservationStatus
+	cancelled.Cancellation = &entities.Cancellation{RefundPercent: 100}
+
+	mockRepo.EXPECT().GetByID(ctx, booked.ID).Return(&cancelled, nil)
+	refunder.EXPECT().RefundReservation(ctx, booked.ID, 100).Return(nil)
+
+	got, err := service.CancelReservation(ctx, "org-1", "court-1", booked.ID, actor, false)
+	s.Require().NoError(err)
+	s.Equal(entities.CancelledReservationStatus, got.Status)
+}
+
+func (s *ServiceSuite) TestCancelReservation_CancelledConcurrently() {
+	ctx := context.Background()
+
+	booked := &entities.Reservation{
+		ID:         "reservation-1",
+		CourtID:    "court-1",
+		ReservedBy: "user-1",
+		Status:     entities.ReservedReservationStatus,
+	}
+
+	mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: mockRepo,
+			Refunder:     mocks.NewMockRefunder(s.ctrl),
+		},
+		reservation.DefaultHoldTTL,
+	)
+
+	actor := entities.Actor{UserID: "user-1", Role: entities.PlayerRole}
+
+	// another request cancelled it between the read and the update, the refund is left to that one
+	mockRepo.EXPECT().GetByID(ctx, booked.ID).Return(booked, nil)
+	mockRepo.EXPECT().
+		CancelReservation(ctx, booked.ID, "user-1", entities.FreeCancellation(false)).
+		Return(entities.ErrReservationNotActive)
+
+	_, err := service.CancelReservation(ctx, "org-1", "court-1", booked.ID, actor, false)
+	s.ErrorIs(err, entities.ErrReservationNotActive)
+}
+
 func (s *ServiceSuite) TestGetListReservations() {
 	ctx := context.Background()
 	courtID := "court-1"
@@ -460,7 +831,14 @@ func (s *ServiceSuite) TestGetListReservations() {
 		s.Run(tt.name, func() {
 			mockRepo := mocks.NewMockReservationsRepository(s.ctrl)
 			locker := reservation.NewLocalLocker()
-			service := reservation.NewService(mockRepo, locker)
+			service := newService(
+				s.ctrl,
+				reservation.Dependencies{
+					Reservations: mockRepo,
+					Locker:       locker,
+				},
+				reservation.DefaultHoldTTL,
+			)
 
 			tt.setupMocks(mockRepo)
 
@@ -0,0 +1,217 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+	"github.com/rs/zerolog/log"
+)
+
+// CreateSeries stores the series and books every occurrence like a single booking, so each one is checked and
+// priced the same way. The priced occurrences are held together until the series is paid with one payment and
+// run out together when it isn't, free ones are confirmed right away. Occurrences that clash with existing
+// reservations or blackouts, fall on a date the court is closed or are refused by the booking rules of the
+// court, e.g. beyond how far ahead players may book, are skipped and reported as conflicts. Any other error
+// undoes the series, the occurrences booked so far included.
+func (s *Service) CreateSeries(
+	ctx context.Context,
+	organizationID string,
+	series *entities.ReservationSeries,
+) ([]entities.SeriesOccurrence, error) {
+	reservations, err := series.Reservations()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, series.CourtID); err != nil {
+		return nil, err
+	}
+
+	if err := s.reservationsRepo.CreateSeries(ctx, series); err != nil {
+		return nil, fmt.Errorf("create series: %w", err)
+	}
+
+	occurrences, err := s.bookSeries(ctx, series, reservations)
+	if err != nil {
+		s.undoSeries(ctx, series.ID)
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+// bookSeries books the occurrences of the series, every hold expiring at the same time.
+func (s *Service) bookSeries(
+	ctx context.Context,
+	series *entities.ReservationSeries,
+	reservations []*entities.Reservation,
+) ([]entities.SeriesOccurrence, error) {
+	expiresAt := s.clock.Now().Add(s.holdTTL)
+
+	occurrences := make([]entities.SeriesOccurrence, 0, len(reservations))
+
+	for _, rsv := range reservations {
+		rsv.Status = entities.PendingReservationStatus
+		rsv.ExpiresAt = expiresAt
+
+		err := s.reserve(ctx, series.CourtID, rsv)
+		conflict := errors.Is(err, entities.ErrCourtAlreadyReserved) ||
+			errors.Is(err, entities.ErrCourtBlackedOut) ||
+			errors.Is(err, entities.ErrOutsideOpeningHours) ||
+			isBookingRuleViolation(err)
+		if err != nil && !conflict {
+			return nil, fmt.Errorf("reserve occurrence at %s: %w", rsv.ReservedFrom, err)
+		}
+
+		if err == nil && rsv.Price.Amount == 0 {
+			if err := s.confirmFree(ctx, rsv); err != nil {
+				return nil, err
+			}
+		}
+
+		occurrences = append(occurrences, entities.SeriesOccurrence{
+			Reservation: *rsv,
+			Conflict:    err != nil,
+		})
+	}
+
+	return occurrences, nil
+}
+
+// undoSeries removes a series that failed to be booked together with the occurrences stored so far, so no
+// hold is left behind. A failure is only logged, the holds then run out like any other.
+func (s *Service) undoSeries(ctx context.Context, seriesID string) {
+	if err := s.reservationsRepo.DeleteSeries(context.WithoutCancel(ctx), seriesID); err != nil {
+		log.Error().Err(err).Str("series_id", seriesID).Msg("failed to undo series")
+	}
+}
+
+// confirmFree confirms a hold without a price on behalf of its booker.
+func (s *Service) confirmFree(ctx context.Context, rsv *entities.Reservation) error {
+	if err := s.reservationsRepo.ConfirmReservation(ctx, rsv.ID, s.clock.Now()); err != nil {
+		return fmt.Errorf("confirm reservation %s: %w", rsv.ID, err)
+	}
+
+	rsv.Status = entities.ReservedReservationStatus
+	rsv.ExpiresAt = time.Time{}
+
+	return nil
+}
+
+func (s *Service) GetSeries(
+	ctx context.Context,
+	courtID, seriesID string,
+) (*entities.ReservationSeries, []entities.Reservation, error) {
+	series, err := s.getCourtSeries(ctx, courtID, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservations, err := s.reservationsRepo.ListBySeries(ctx, seriesID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list reservations by series: %w", err)
+	}
+
+	return series, reservations, nil
+}
+
+// CancelSeries cancels every active occurrence of the series that starts at or after from, each one as
+// CancelReservation does, so the cancellation policy, refunds and the waitlist apply to every occurrence.
+// A zero from cancels the whole series. Only the user who booked the series or org staff may cancel it.
+// Occurrences cancelled or expired in the meantime are skipped.
+func (s *Service) CancelSeries(
+	ctx context.Context,
+	organizationID, courtID, seriesID string,
+	from time.Time,
+	actor entities.Actor,
+) (int64, error) {
+	series, err := s.getCourtSeries(ctx, courtID, seriesID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.authorizeChange(ctx, organizationID, courtID, series.ReservedBy, actor); err != nil {
+		return 0, fmt.Errorf("series %s: %w", seriesID, err)
+	}
+
+	reservations, err := s.reservationsRepo.ListBySeries(ctx, seriesID)
+	if err != nil {
+		return 0, fmt.Errorf("list reservations by series: %w", err)
+	}
+
+	var cancelled int64
+
+	for i := range reservations {
+		rsv := &reservations[i]
+
+		active := rsv.Status == entities.PendingReservationStatus || rsv.Status == entities.ReservedReservationStatus
+		if !active || rsv.ReservedFrom.Before(from) {
+			continue
+		}
+
+		if _, err := s.cancelReservation(ctx, organizationID, rsv, actor, false); err != nil {
+			if errors.Is(err, entities.ErrReservationNotActive) {
+				continue
+			}
+			return cancelled, fmt.Errorf("cancel occurrence %s: %w", rsv.ID, err)
+		}
+
+		cancelled++
+	}
+
+	return cancelled, nil
+}
+
+func (s *Service) CancelSeriesOccurrence(
+	ctx context.Context,
+	organizationID, courtID, seriesID, reservationID string,
+	actor entities.Actor,
+) error {
+	rsv, err := s.GetReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return err
+	}
+
+	if rsv.SeriesID != seriesID {
+		return fmt.Errorf("%w: reservation %s is not part of series %s",
+			entities.ErrNotFound, reservationID, seriesID)
+	}
+
+	_, err = s.cancelReservation(ctx, organizationID, rsv, actor, false)
+	return err
+}
+
+func (s *Service) getCourtSeries(ctx context.Context, courtID, seriesID string) (*entities.ReservationSeries, error) {
+	series, err := s.reservationsRepo.GetSeriesByID(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("get series by id: %w", err)
+	}
+
+	if series.CourtID != courtID {
+		return nil, fmt.Errorf("%w: series %s does not belong to court %s",
+			entities.ErrNotFound, seriesID, courtID)
+	}
+
+	return series, nil
+}
+
+// isBookingRuleViolation reports whether the booking rules of the court refused the reservation.
+func isBookingRuleViolation(err error) bool {
+	for _, target := range []error{
+		entities.ErrBookingDurationNotAllowed,
+		entities.ErrBookingNotAligned,
+		entities.ErrBookingTooFarAhead,
+		entities.ErrBookingNoticeTooShort,
+		entities.ErrTooManyActiveBookings,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,603 @@
+package reservation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type SeriesSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	service          *reservation.Service
+}
+
+func TestSeriesSuite(t *testing.T) {
+	suite.Run(t, new(SeriesSuite))
+}
+
+func (s *SeriesSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+		},
+		reservation.DefaultHoldTTL,
+	)
+}
+
+func (s *SeriesSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// weeklySeries books court-1 every Tuesday at 19:00 for 90 minutes in September 2025.
+func weeklySeries() *entities.ReservationSeries {
+	return entities.NewReservationSeries(
+		"court-1",
+		entities.RecurrenceRule{
+			Frequency: entities.WeeklyRecurrence,
+			Interval:  1,
+			Weekdays:  []time.Weekday{time.Tuesday},
+		},
+		time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC),
+		19*60,
+		90*time.Minute,
+		"user-1",
+	)
+}
+
+func (s *SeriesSuite) TestCreateSeries() {
+	ctx := context.Background()
+	series := weeklySeries()
+
+	tuesdays := []time.Time{
+		time.Date(2025, 9, 2, 19, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 9, 19, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 16, 19, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 23, 19, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 30, 19, 0, 0, 0, time.UTC),
+	}
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+
+	for i, start := range tuesdays {
+		var existing []entities.Reservation
+		if i == 2 {
+			existing = []entities.Reservation{{
+				ID:           "other",
+				CourtID:      "court-1",
+				Status:       entities.ReservedReservationStatus,
+				ReservedFrom: start,
+				ReservedTo:   start.Add(time.Hour),
+			}}
+		}
+
+		s.reservationsRepo.EXPECT().
+			ListByCourtAndTimeRange(ctx, "court-1", start, start.Add(90*time.Minute)).
+			Return(existing, nil)
+	}
+
+	s.reservationsRepo.EXPECT().
+		Create(ctx, gomock.AssignableToTypeOf(&entities.Reservation{})).
+		DoAndReturn(func(_ context.Context, rsv *entities.Reservation) error {
+			s.Equal(series.ID, rsv.SeriesID)
+			s.Equal("user-1", rsv.ReservedBy)
+			s.Equal(entities.PendingReservationStatus, rsv.Status)
+			return nil
+		}).
+		Times(4)
+	s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+	occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+	s.Require().Len(occurrences, len(tuesdays))
+
+	for i, occ := range occurrences {
+		s.Equal(tuesdays[i], occ.Reservation.ReservedFrom)
+		s.Equal(tuesdays[i].Add(90*time.Minute), occ.Reservation.ReservedTo)
+		s.Equal(i == 2, occ.Conflict, "occurrence %d", i)
+		if !occ.Conflict {
+			s.Equal(entities.ReservedReservationStatus, occ.Reservation.Status)
+			s.True(occ.Reservation.ExpiresAt.IsZero())
+		}
+	}
+}
+
+func (s *SeriesSuite) TestCreateSeries_Priced() {
+	ctx := context.Background()
+	series := weeklySeries()
+	now := time.Date(2025, 8, 20, 12, 0, 0, 0, time.UTC)
+
+	pricer := mocks.NewMockPricer(s.ctrl)
+	pricer.EXPECT().
+		QuoteCourt(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(&entities.Quote{Currency: "EUR", Total: 3000}, nil).
+		Times(5)
+
+	// the clock moves on while the occurrences are booked
+	ticks := 0
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time {
+		ticks++
+		return now.Add(time.Duration(ticks-1) * time.Second)
+	}).AnyTimes()
+
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Pricer:       pricer,
+			Clock:        clock,
+		},
+		reservation.DefaultHoldTTL,
+	)
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(5)
+	s.reservationsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(5)
+
+	occurrences, err := service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+	s.Require().Len(occurrences, 5)
+
+	// priced occurrences are held together until the series is paid
+	for _, occ := range occurrences {
+		s.False(occ.Conflict)
+		s.Equal(entities.PendingReservationStatus, occ.Reservation.Status)
+		s.Equal(now.Add(reservation.DefaultHoldTTL), occ.Reservation.ExpiresAt)
+		s.Equal(entities.Price{Amount: 3000, Currency: "EUR"}, occ.Reservation.Price)
+	}
+}
+
+func (s *SeriesSuite) TestCreateSeries_EveryOtherWeek() {
+	ctx := context.Background()
+
+	series := weeklySeries()
+	series.Rule.Interval = 2
+	series.Rule.Weekdays = []time.Weekday{time.Monday, time.Thursday}
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+	s.reservationsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()
+	s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+
+	var days []int
+	for _, occ := range occurrences {
+		days = append(days, occ.Reservation.ReservedFrom.Day())
+	}
+
+	// Weeks starting Aug 31, Sep 14 and Sep 28 are booked, the ones in between are skipped.
+	s.Equal([]int{1, 4, 15, 18, 29}, days)
+}
+
+func (s *SeriesSuite) TestCreateSeries_LocalTime() {
+	ctx := context.Background()
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	s.Require().NoError(err)
+
+	// every other Sunday at 19:00 in Madrid, across the change to summer time on March 30
+	series := weeklySeries()
+	series.Rule.Interval = 2
+	series.Rule.Weekdays = []time.Weekday{time.Sunday}
+	series.StartDate = time.Date(2025, 3, 23, 0, 0, 0, 0, madrid)
+	series.EndDate = time.Date(2025, 4, 20, 0, 0, 0, 0, madrid)
+
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+	s.reservationsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()
+	s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+	s.Require().NoError(err)
+
+	var starts []time.Time
+	for _, occ := range occurrences {
+		starts = append(starts, occ.Reservation.ReservedFrom)
+	}
+
+	s.Equal([]time.Time{
+		time.Date(2025, 3, 23, 18, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 6, 17, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 20, 17, 0, 0, 0, time.UTC),
+	}, starts)
+}
+
+func (s *SeriesSuite) TestCreateSeries_Errors() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		series     func() *entities.ReservationSeries
+		setupMocks func(series *entities.ReservationSeries)
+		wantErr    error
+	}{
+		{
+			name: "invalid rule",
+			series: func() *entities.ReservationSeries {
+				series := weeklySeries()
+				series.Rule.Interval = 0
+				return series
+			},
+			setupMocks: func(*entities.ReservationSeries) {},
+			wantErr:    entities.ErrInvalidRecurrence,
+		},
+		{
+			name: "too many occurrences",
+			series: func() *entities.ReservationSeries {
+				series := weeklySeries()
+				series.Rule.Frequency = entities.DailyRecurrence
+				series.EndDate = series.StartDate.AddDate(1, 0, 0)
+				return series
+			},
+			setupMocks: func(*entities.ReservationSeries) {},
+			wantErr:    entities.ErrInvalidRecurrence,
+		},
+		{
+			name:   "court of another organization",
+			series: weeklySeries,
+			setupMocks: func(*entities.ReservationSeries) {
+				s.courtsRepo.EXPECT().
+					GetByID(ctx, "court-1").
+					Return(&entities.Court{ID: "court-1", OrganizationID: "org-2"}, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name:   "reservation error stops the series",
+			series: weeklySeries,
+			setupMocks: func(series *entities.ReservationSeries) {
+				s.courtsRepo.EXPECT().
+					GetByID(ctx, "court-1").
+					Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+				s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+				s.reservationsRepo.EXPECT().
+					ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("db error"))
+				s.reservationsRepo.EXPECT().DeleteSeries(gomock.Any(), series.ID).Return(nil)
+			},
+		},
+		{
+			name:   "reservation error undoes the occurrences booked before",
+			series: weeklySeries,
+			setupMocks: func(series *entities.ReservationSeries) {
+				s.courtsRepo.EXPECT().
+					GetByID(ctx, "court-1").
+					Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil)
+				s.reservationsRepo.EXPECT().CreateSeries(ctx, series).Return(nil)
+				gomock.InOrder(
+					s.reservationsRepo.EXPECT().
+						ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+						Return(nil, nil),
+					s.reservationsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil),
+					s.reservationsRepo.EXPECT().ConfirmReservation(ctx, gomock.Any(), gomock.Any()).Return(nil),
+					s.reservationsRepo.EXPECT().
+						ListByCourtAndTimeRange(ctx, "court-1", gomock.Any(), gomock.Any()).
+						Return(nil, fmt.Errorf("db error")),
+					s.reservationsRepo.EXPECT().DeleteSeries(gomock.Any(), series.ID).Return(nil),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			series := tt.series()
+			tt.setupMocks(series)
+
+			occurrences, err := s.service.CreateSeries(ctx, "org-1", series)
+			s.Require().Error(err)
+			s.Nil(occurrences)
+
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// seriesReservations returns the occurrences of series-1 on court-1 booked by user-1, every Wednesday of
+// September 2025 in the given statuses.
+func seriesReservations(statuses ...entities.ReservationStatus) []entities.Reservation {
+	reservations := make([]entities.Reservation, 0, len(statuses))
+	for i, status := range statuses {
+		from := time.Date(2025, 9, 3+7*i, 19, 0, 0, 0, time.UTC)
+		reservations = append(reservations, entities.Reservation{
+			ID:           fmt.Sprintf("res-%d", i+1),
+			CourtID:      "court-1",
+			Status:       status,
+			ReservedFrom: from,
+			ReservedTo:   from.Add(90 * time.Minute),
+			ReservedBy:   "user-1",
+			SeriesID:     "series-1",
+		})
+	}
+
+	return reservations
+}
+
+func (s *SeriesSuite) TestCancelSeries() {
+	ctx := context.Background()
+	from := time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	s.reservationsRepo.EXPECT().
+		GetSeriesByID(ctx, "series-1").
+		Return(&entities.ReservationSeries{ID: "series-1", CourtID: "court-1", ReservedBy: "user-1"}, nil)
+	s.reservationsRepo.EXPECT().
+		ListBySeries(ctx, "series-1").
+		Return(seriesReservations(
+			entities.ReservedReservationStatus,
+			entities.ReservedReservationStatus,
+			entities.CancelledReservationStatus,
+			entities.PendingReservationStatus,
+			entities.ReservedReservationStatus,
+		), nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-2", "user-1", entities.FreeCancellation(false)).
+		Return(nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-4", "user-1", entities.FreeCancellation(false)).
+		Return(nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-5", "user-1", entities.FreeCancellation(false)).
+		Return(entities.ErrReservationNotActive)
+
+	cancelled, err := s.service.CancelSeries(ctx, "org-1", "court-1", "series-1", from, entities.Actor{UserID: "user-1"})
+	s.Require().NoError(err)
+	s.EqualValues(2, cancelled)
+}
+
+func (s *SeriesSuite) TestCancelSeries_AppliesPolicy() {
+	ctx := context.Background()
+	now := time.Date(2025, 9, 2, 12, 0, 0, 0, time.UTC)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+
+	policies := mocks.NewMockPolicies(s.ctrl)
+	policies.EXPECT().
+		CourtCancellationPolicy(ctx, "court-1").
+		Return(&entities.CancellationPolicy{
+			OrganizationID: "org-1",
+			Tiers: []entities.CancellationTier{
+				{Notice: 48 * time.Hour, RefundPercent: 100},
+				{Notice: 0, RefundPercent: 50},
+			},
+		}, nil).
+		Times(2)
+
+	refunder := mocks.NewMockRefunder(s.ctrl)
+	refunder.EXPECT().RefundReservation(ctx, "res-1", 50).Return(nil)
+	refunder.EXPECT().RefundReservation(ctx, "res-2", 100).Return(nil)
+
+	service := newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Policies:     policies,
+			Refunder:     refunder,
+			Clock:        clock,
+		},
+		reservation.DefaultHoldTTL,
+	)
+
+	reservations := seriesReservations(entities.ReservedReservationStatus, entities.ReservedReservationStatus)
+
+	s.reservationsRepo.EXPECT().
+		GetSeriesByID(ctx, "series-1").
+		Return(&entities.ReservationSeries{ID: "series-1", CourtID: "court-1", ReservedBy: "user-1"}, nil)
+	s.reservationsRepo.EXPECT().ListBySeries(ctx, "series-1").Return(reservations, nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-1", "user-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, cancellation entities.Cancellation) error {
+			s.Equal(50, cancellation.RefundPercent)
+			return nil
+		})
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-2", "user-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, cancellation entities.Cancellation) error {
+			s.Equal(100, cancellation.RefundPercent)
+			return nil
+		})
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil).
+		Times(2)
+	s.reservationsRepo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", gomock.Any(), gomock.Any(), gomock.Any(), now).
+		Return(nil, entities.ErrNotFound).
+		Times(2)
+
+	actor := entities.Actor{UserID: "user-1"}
+	cancelled, err := service.CancelSeries(ctx, "org-1", "court-1", "series-1", time.Time{}, actor)
+	s.Require().NoError(err)
+	s.EqualValues(2, cancelled)
+}
+
+func (s *SeriesSuite) TestCancelSeries_Staff() {
+	ctx := context.Background()
+
+	s.reservationsRepo.EXPECT().
+		GetSeriesByID(ctx, "series-1").
+		Return(&entities.ReservationSeries{ID: "series-1", CourtID: "court-1", ReservedBy: "user-2"}, nil)
+	s.courtsRepo.EXPECT().
+		GetByID(ctx, "court-1").
+		Return(&entities.Court{ID: "court-1", OrganizationID: "org-1"}, nil).
+		Times(3)
+	s.reservationsRepo.EXPECT().
+		ListBySeries(ctx, "series-1").
+		Return(seriesReservations(entities.ReservedReservationStatus, entities.ReservedReservationStatus), nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, gomock.Any(), "staff-1", entities.FreeCancellation(false)).
+		Return(nil).
+		Times(2)
+
+	actor := entities.Actor{UserID: "staff-1", Role: entities.StaffRole}
+	cancelled, err := s.service.CancelSeries(ctx, "org-1", "court-1", "series-1", time.Time{}, actor)
+	s.Require().NoError(err)
+	s.EqualValues(2, cancelled)
+}
+
+func (s *SeriesSuite) TestCancelSeries_StopsOnError() {
+	ctx := context.Background()
+
+	s.reservationsRepo.EXPECT().
+		GetSeriesByID(ctx, "series-1").
+		Return(&entities.ReservationSeries{ID: "series-1", CourtID: "court-1", ReservedBy: "user-1"}, nil)
+	s.reservationsRepo.EXPECT().
+		ListBySeries(ctx, "series-1").
+		Return(seriesReservations(entities.ReservedReservationStatus, entities.ReservedReservationStatus), nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-1", "user-1", entities.FreeCancellation(false)).
+		Return(nil)
+	s.reservationsRepo.EXPECT().
+		CancelReservation(ctx, "res-2", "user-1", entities.FreeCancellation(false)).
+		Return(fmt.Errorf("db error"))
+
+	actor := entities.Actor{UserID: "user-1"}
+	cancelled, err := s.service.CancelSeries(ctx, "org-1", "court-1", "series-1", time.Time{}, actor)
+	s.Require().Error(err)
+	s.EqualValues(1, cancelled)
+}
+
+func (s *SeriesSuite) TestCancelSeries_AnotherUser() {
+	ctx := context.Background()
+
+	s.reservationsRepo.EXPECT().
+		GetSeriesByID(ctx, "series-1").
+		Return(&entities.ReservationSeries{ID: "series-1", CourtID: "court-1", ReservedBy: "user-2"}, nil)
+
+	_, err := s.service.CancelSeries(ctx, "org-1", "court-1", "series-1", time.Time{}, entities.Actor{UserID: "user-1"})
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrForbidden)
+}
+
+func (s *SeriesSuite) TestCancelSeries_WrongCourt() {
+	ctx := context.Background()
+
+	s.reservationsRepo.EXPECT().
+		GetSeriesByID(ctx, "series-1").
+		Return(&entities.ReservationSeries{ID: "series-1", CourtID: "court-2"}, nil)
+
+	_, err := s.service.CancelSeries(ctx, "org-1", "court-1", "series-1", time.Time{}, entities.Actor{UserID: "user-1"})
+	s.Require().Error(err)
+	s.ErrorIs(err, entities.ErrNotFound)
+}
+
+func (s *SeriesSuite) TestCancelSeriesOccurrence() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		setupMocks func()
+		wantErr    error
+	}{
+		{
+			name: "success",
+			setupMocks: func() {
+				s.reservationsRepo.EXPECT().
+					GetByID(ctx, "res-1").
+					Return(&entities.Reservation{
+						ID:         "res-1",
+						CourtID:    "court-1",
+						SeriesID:   "series-1",
+						ReservedBy: "user-1",
+					}, nil)
+				s.reservationsRepo.EXPECT().
+					CancelReservation(ctx, "res-1", "user-1", entities.FreeCancellation(false)).
+					Return(nil)
+			},
+		},
+		{
+			name: "occurrence booked by another user",
+			setupMocks: func() {
+				s.reservationsRepo.EXPECT().
+					GetByID(ctx, "res-1").
+					Return(&entities.Reservation{
+						ID:         "res-1",
+						CourtID:    "court-1",
+						SeriesID:   "series-1",
+						ReservedBy: "user-2",
+					}, nil)
+			},
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name: "reservation from another series",
+			setupMocks: func() {
+				s.reservationsRepo.EXPECT().
+					GetByID(ctx, "res-1").
+					Return(&entities.Reservation{ID: "res-1", CourtID: "court-1", SeriesID: "series-2"}, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name: "reservation on another court",
+			setupMocks: func() {
+				s.reservationsRepo.EXPECT().
+					GetByID(ctx, "res-1").
+					Return(&entities.Reservation{ID: "res-1", CourtID: "court-2", SeriesID: "series-1"}, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMocks()
+
+			actor := entities.Actor{UserID: "user-1", Role: entities.PlayerRole}
+			err := s.service.CancelSeriesOccurrence(ctx, "org-1", "court-1", "series-1", "res-1", actor)
+			if tt.wantErr != nil {
+				s.Require().Error(err)
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.NoError(err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+	"github.com/rs/zerolog/log"
+)
+
+// JoinWaitlist queues the user for the window of the entry, on its court or on any court of the
+// organization when the entry has none.
+func (s *Service) JoinWaitlist(ctx context.Context, entry *entities.WaitlistEntry) error {
+	if err := entry.Validate(s.clock.Now()); err != nil {
+		return err
+	}
+
+	if entry.CourtID != "" {
+		if _, err := ownership.Court(ctx, s.courtsRepo, entry.OrganizationID, entry.CourtID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.reservationsRepo.CreateWaitlistEntry(ctx, entry); err != nil {
+		return fmt.Errorf("create waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) ListWaitlist(ctx context.Context, userID string) ([]entities.WaitlistEntry, error) {
+	entries, err := s.reservationsRepo.ListWaitlistByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list waitlist by user: %w", err)
+	}
+
+	return entries, nil
+}
+
+// LeaveWaitlist takes the user off the waitlist. Entries that were offered a hold already are kept.
+func (s *Service) LeaveWaitlist(ctx context.Context, userID, entryID string) error {
+	if err := s.reservationsRepo.DeleteWaitlistEntry(ctx, entryID, userID); err != nil {
+		return fmt.Errorf("delete waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+// ExpireWaitlist takes the entries whose window already started out of the queue, since no slot can be
+// offered to them anymore.
+func (s *Service) ExpireWaitlist(ctx context.Context) ([]entities.WaitlistEntry, error) {
+	expired, err := s.reservationsRepo.ExpireWaitlistEntries(ctx, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("expire waitlist entries: %w", err)
+	}
+
+	return expired, nil
+}
+
+// offerToWaitlist hands the slot freed by the reservation to the first user waiting for a window within it,
+// as a pending hold they have to confirm like any other. When the hold can't be placed for an entry, e.g.
+// because the booking rules refuse it to that user, the slot goes to the next one in the queue. Entries
+// passed over stay claimed until the slot is handed out, so they are not tried twice, and are then put
+// back in the queue. Slots that already started are not offered. A failed offer does not undo what freed
+// the slot, so it is logged instead of returned.
+func (s *Service) offerToWaitlist(ctx context.Context, freed entities.Reservation) {
+	now := s.clock.Now()
+	if !freed.ReservedFrom.After(now) {
+		return
+	}
+
+	court, err := s.courtsRepo.GetByID(ctx, freed.CourtID)
+	if err != nil {
+		log.Error().Err(err).Str("court_id", freed.CourtID).Msg("failed to get court of freed slot")
+		return
+	}
+
+	var passed []string
+	defer func() {
+		for _, entryID := range passed {
+			if err := s.reservationsRepo.ReleaseWaitlistEntry(ctx, entryID, now); err != nil {
+				log.Error().Err(err).Str("waitlist_entry_id", entryID).Msg("failed to release waitlist entry")
+			}
+		}
+	}()
+
+	for {
+		// the hold is prepared up front, so the claimed entry can point at it
+		hold := entities.NewReservation(freed.CourtID, freed.ReservedFrom, freed.ReservedTo, "")
+		hold.CreatedAt = now
+
+		entry, err := s.reservationsRepo.ClaimWaitlistEntry(
+			ctx,
+			court.OrganizationID,
+			freed.CourtID,
+			freed.ReservedFrom,
+			freed.ReservedTo,
+			hold.ID,
+			now,
+		)
+		if err != nil {
+			if !errors.Is(err, entities.ErrNotFound) {
+				log.Error().Err(err).Str("reservation_id", freed.ID).Msg("failed to claim waitlist entry")
+			}
+			return
+		}
+
+		hold.ReservedFrom = entry.From
+		hold.ReservedTo = entry.To
+		hold.ReservedBy = entry.UserID
+
+		if err := s.ReserveCourt(ctx, freed.CourtID, hold); err != nil {
+			log.Error().
+				Err(err).
+				Str("waitlist_entry_id", entry.ID).
+				Str("court_id", freed.CourtID).
+				Msg("failed to place hold for waitlist entry")
+
+			passed = append(passed, entry.ID)
+			continue
+		}
+
+		log.Info().
+			Str("waitlist_entry_id", entry.ID).
+			Str("reservation_id", hold.ID).
+			Str("user_id", entry.UserID).
+			Time("expires_at", hold.ExpiresAt).
+			Msg("waitlist entry offered a hold")
+
+		return
+	}
+}
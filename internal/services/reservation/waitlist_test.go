@@ -0,0 +1,345 @@
+package reservation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/reservation"
+	"github.com/lever-dev/padel-backend/internal/services/reservation/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type WaitlistSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	service          *reservation.Service
+}
+
+func TestWaitlistSuite(t *testing.T) {
+	suite.Run(t, new(WaitlistSuite))
+}
+
+var (
+	waitlistNow  = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	waitlistFrom = waitlistNow.Add(24 * time.Hour)
+)
+
+func (s *WaitlistSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	noBlackouts(s.reservationsRepo)
+	noExpiredHolds(s.reservationsRepo)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(waitlistNow).AnyTimes()
+
+	s.service = newService(
+		s.ctrl,
+		reservation.Dependencies{
+			Reservations: s.reservationsRepo,
+			Courts:       s.courtsRepo,
+			Clock:        clock,
+		},
+		10*time.Minute,
+	)
+}
+
+func (s *WaitlistSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *WaitlistSuite) booked() *entities.Reservation {
+	return &entities.Reservation{
+		ID:           "res-1",
+		CourtID:      "court-1",
+		ReservedBy:   "user-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: waitlistFrom,
+		ReservedTo:   waitlistFrom.Add(90 * time.Minute),
+	}
+}
+
+func (s *WaitlistSuite) TestJoinWaitlist() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		courtID string
+		from    time.Time
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "any court of the organization",
+			from:    waitlistFrom,
+			setup:   func() { s.reservationsRepo.EXPECT().CreateWaitlistEntry(ctx, gomock.Any()).Return(nil) },
+			wantErr: nil,
+		},
+		{
+			name:    "court of the organization",
+			courtID: "court-1",
+			from:    waitlistFrom,
+			setup: func() {
+				s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+				s.reservationsRepo.EXPECT().CreateWaitlistEntry(ctx, gomock.Any()).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "court of another organization",
+			courtID: "court-1",
+			from:    waitlistFrom,
+			setup: func() {
+				s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-2"}, nil)
+			},
+			wantErr: entities.ErrNotFound,
+		},
+		{
+			name:    "window in the past",
+			from:    waitlistNow.Add(-time.Hour),
+			setup:   func() {},
+			wantErr: entities.ErrInvalidWaitlistEntry,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setup()
+
+			entry := entities.NewWaitlistEntry("org-1", tt.courtID, "user-2", tt.from, tt.from.Add(time.Hour), waitlistNow)
+
+			err := s.service.JoinWaitlist(ctx, entry)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(entities.WaitingWaitlistStatus, entry.Status)
+		})
+	}
+}
+
+func (s *WaitlistSuite) TestCancelReservation_OffersHold() {
+	ctx := context.Background()
+	rsv := s.booked()
+	entry := entities.NewWaitlistEntry("org-1", "", "user-2", waitlistFrom, waitlistFrom.Add(time.Hour), waitlistNow)
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+
+	var holdID string
+
+	s.reservationsRepo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), waitlistNow).
+		DoAndReturn(func(
+			_ context.Context,
+			_, _ string,
+			_, _ time.Time,
+			reservationID string,
+			_ time.Time,
+		) (*entities.WaitlistEntry, error) {
+			holdID = reservationID
+			entry.Status = entities.OfferedWaitlistStatus
+			entry.ReservationID = reservationID
+			return entry, nil
+		})
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", entry.From, entry.To).
+		Return([]entities.Reservation{{ID: rsv.ID, Status: entities.CancelledReservationStatus}}, nil)
+	s.reservationsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, hold *entities.Reservation) error {
+			s.Equal(holdID, hold.ID)
+			s.Equal("user-2", hold.ReservedBy)
+			s.Equal(entry.From, hold.ReservedFrom)
+			s.Equal(entry.To, hold.ReservedTo)
+			s.Equal(entities.PendingReservationStatus, hold.Status)
+			s.Equal(waitlistNow.Add(10*time.Minute), hold.ExpiresAt)
+			return nil
+		})
+
+	_, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+}
+
+func (s *WaitlistSuite) TestCancelReservation_NobodyWaiting() {
+	ctx := context.Background()
+	rsv := s.booked()
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), waitlistNow).
+		Return(nil, entities.ErrNotFound)
+
+	_, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+}
+
+func (s *WaitlistSuite) TestCancelReservation_SlotRetakenReleasesEntry() {
+	ctx := context.Background()
+	rsv := s.booked()
+	entry := entities.NewWaitlistEntry(
+		"org-1", "court-1", "user-2", waitlistFrom, waitlistFrom.Add(time.Hour), waitlistNow,
+	)
+	entry.Status = entities.OfferedWaitlistStatus
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+	s.reservationsRepo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), waitlistNow).
+		Return(entry, nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", entry.From, entry.To).
+		Return([]entities.Reservation{{
+			ID:           "res-2",
+			Status:       entities.PendingReservationStatus,
+			ReservedFrom: waitlistFrom,
+			ReservedTo:   waitlistFrom.Add(time.Hour),
+			ExpiresAt:    waitlistNow.Add(time.Minute),
+		}}, nil)
+	s.reservationsRepo.EXPECT().
+		ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), waitlistNow).
+		Return(nil, entities.ErrNotFound)
+	s.reservationsRepo.EXPECT().ReleaseWaitlistEntry(ctx, entry.ID, waitlistNow).Return(nil)
+
+	_, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+}
+
+func (s *WaitlistSuite) TestCancelReservation_OffersNextEntry() {
+	ctx := context.Background()
+	rsv := s.booked()
+
+	// the first in the queue waits for the first hour, which a hold took meanwhile
+	first := entities.NewWaitlistEntry(
+		"org-1", "court-1", "user-2", waitlistFrom, waitlistFrom.Add(time.Hour), waitlistNow,
+	)
+	next := entities.NewWaitlistEntry(
+		"org-1", "court-1", "user-3", waitlistFrom.Add(30*time.Minute), waitlistFrom.Add(90*time.Minute), waitlistNow,
+	)
+	taken := entities.Reservation{
+		ID:           "res-2",
+		Status:       entities.PendingReservationStatus,
+		ReservedFrom: waitlistFrom,
+		ReservedTo:   waitlistFrom.Add(30 * time.Minute),
+		ExpiresAt:    waitlistNow.Add(time.Minute),
+	}
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+
+	gomock.InOrder(
+		s.reservationsRepo.EXPECT().
+			ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), waitlistNow).
+			Return(first, nil),
+		s.reservationsRepo.EXPECT().
+			ClaimWaitlistEntry(ctx, "org-1", "court-1", rsv.ReservedFrom, rsv.ReservedTo, gomock.Any(), waitlistNow).
+			Return(next, nil),
+		s.reservationsRepo.EXPECT().ReleaseWaitlistEntry(ctx, first.ID, waitlistNow).Return(nil),
+	)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", first.From, first.To).
+		Return([]entities.Reservation{taken}, nil)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", next.From, next.To).
+		Return(nil, nil)
+	s.reservationsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, hold *entities.Reservation) error {
+			s.Equal("user-3", hold.ReservedBy)
+			s.Equal(next.From, hold.ReservedFrom)
+			s.Equal(next.To, hold.ReservedTo)
+			return nil
+		})
+
+	_, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+}
+
+func (s *WaitlistSuite) TestCancelReservation_StartedSlotIsNotOffered() {
+	ctx := context.Background()
+	rsv := s.booked()
+	rsv.ReservedFrom = waitlistNow.Add(-time.Minute)
+
+	s.reservationsRepo.EXPECT().GetByID(ctx, rsv.ID).Return(rsv, nil)
+	s.reservationsRepo.EXPECT().CancelReservation(ctx, rsv.ID, "user-1", entities.FreeCancellation(false)).Return(nil)
+
+	_, err := s.service.CancelReservation(ctx, "org-1", "court-1", rsv.ID, entities.Actor{UserID: "user-1"}, false)
+	s.Require().NoError(err)
+}
+
+func (s *WaitlistSuite) TestExpireHolds_ReoffersLapsedOffer() {
+	ctx := context.Background()
+
+	// the hold offered to user-2 ran out unconfirmed
+	lapsed := entities.Reservation{
+		ID:           "res-offer",
+		CourtID:      "court-1",
+		ReservedBy:   "user-2",
+		Status:       entities.ExpiredReservationStatus,
+		ReservedFrom: waitlistFrom,
+		ReservedTo:   waitlistFrom.Add(time.Hour),
+		ExpiresAt:    waitlistNow.Add(-time.Minute),
+	}
+	next := entities.NewWaitlistEntry(
+		"org-1", "court-1", "user-3", waitlistFrom, waitlistFrom.Add(time.Hour), waitlistNow,
+	)
+
+	s.reservationsRepo.EXPECT().ExpirePendingReservations(ctx, waitlistNow).Return([]entities.Reservation{lapsed}, nil)
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(&entities.Court{OrganizationID: "org-1"}, nil)
+	// the slot goes to the next one in the queue before the lapsed entry is back in it
+	gomock.InOrder(
+		s.reservationsRepo.EXPECT().
+			ClaimWaitlistEntry(ctx, "org-1", "court-1", lapsed.ReservedFrom, lapsed.ReservedTo, gomock.Any(), waitlistNow).
+			Return(next, nil),
+		s.reservationsRepo.EXPECT().ResetWaitlistOffer(ctx, lapsed.ID, waitlistNow).Return(nil),
+	)
+	s.reservationsRepo.EXPECT().
+		ListByCourtAndTimeRange(ctx, "court-1", next.From, next.To).
+		Return([]entities.Reservation{lapsed}, nil)
+	s.reservationsRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, hold *entities.Reservation) error {
+			s.Equal("user-3", hold.ReservedBy)
+			s.Equal(entities.PendingReservationStatus, hold.Status)
+			return nil
+		})
+
+	expired, err := s.service.ExpireHolds(ctx)
+	s.Require().NoError(err)
+	s.Equal([]entities.Reservation{lapsed}, expired)
+}
+
+func (s *WaitlistSuite) TestExpireWaitlist() {
+	ctx := context.Background()
+	stale := entities.NewWaitlistEntry(
+		"org-1", "", "user-2", waitlistNow.Add(-time.Hour), waitlistNow, waitlistNow.Add(-24*time.Hour),
+	)
+	stale.Status = entities.ExpiredWaitlistStatus
+
+	s.reservationsRepo.EXPECT().ExpireWaitlistEntries(ctx, waitlistNow).Return([]entities.WaitlistEntry{*stale}, nil)
+
+	expired, err := s.service.ExpireWaitlist(ctx)
+	s.Require().NoError(err)
+	s.Equal([]entities.WaitlistEntry{*stale}, expired)
+
+	s.reservationsRepo.EXPECT().ExpireWaitlistEntries(ctx, waitlistNow).Return(nil, fmt.Errorf("db error"))
+
+	_, err = s.service.ExpireWaitlist(ctx)
+	s.Error(err)
+}
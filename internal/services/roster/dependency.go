@@ -0,0 +1,33 @@
+//go:generate mockgen -source=dependency.go -destination=./mocks/mocks.go -package=mocks
+
+package roster
+
+import (
+	"context"
+	"time"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+)
+
+type ReservationsRepository interface {
+	GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error)
+	AddPlayer(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error
+	GetPlayer(ctx context.Context, reservationID, userID string) (*entities.ReservationPlayer, error)
+	ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error)
+	UpdatePlayerStatus(
+		ctx context.Context,
+		reservationID, userID string,
+		from, to entities.PlayerStatus,
+		now time.Time,
+	) error
+	ListUpcomingByUser(ctx context.Context, userID string, now time.Time) ([]entities.Game, error)
+}
+
+type CourtsRepository interface {
+	GetByID(ctx context.Context, courtID string) (*entities.Court, error)
+}
+
+type UsersRepository interface {
+	GetByNickname(ctx context.Context, nickname string) (entities.User, error)
+	GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entities.User, error)
+}
@@ -0,0 +1,216 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/roster/dependency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/lever-dev/padel-backend/internal/entities"
+)
+
+// MockReservationsRepository is a mock of ReservationsRepository interface.
+type MockReservationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsRepositoryMockRecorder
+}
+
+// MockReservationsRepositoryMockRecorder is the mock recorder for MockReservationsRepository.
+type MockReservationsRepositoryMockRecorder struct {
+	mock *MockReservationsRepository
+}
+
+// NewMockReservationsRepository creates a new mock instance.
+func NewMockReservationsRepository(ctrl *gomock.Controller) *MockReservationsRepository {
+	mock := &MockReservationsRepository{ctrl: ctrl}
+	mock.recorder = &MockReservationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsRepository) EXPECT() *MockReservationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPlayer mocks base method.
+func (m *MockReservationsRepository) AddPlayer(ctx context.Context, player *entities.ReservationPlayer, maxPlayers int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlayer", ctx, player, maxPlayers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlayer indicates an expected call of AddPlayer.
+func (mr *MockReservationsRepositoryMockRecorder) AddPlayer(ctx, player, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlayer", reflect.TypeOf((*MockReservationsRepository)(nil).AddPlayer), ctx, player, maxPlayers)
+}
+
+// GetByID mocks base method.
+func (m *MockReservationsRepository) GetByID(ctx context.Context, reservationID string) (*entities.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, reservationID)
+	ret0, _ := ret[0].(*entities.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReservationsRepositoryMockRecorder) GetByID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationsRepository)(nil).GetByID), ctx, reservationID)
+}
+
+// GetPlayer mocks base method.
+func (m *MockReservationsRepository) GetPlayer(ctx context.Context, reservationID, userID string) (*entities.ReservationPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayer", ctx, reservationID, userID)
+	ret0, _ := ret[0].(*entities.ReservationPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayer indicates an expected call of GetPlayer.
+func (mr *MockReservationsRepositoryMockRecorder) GetPlayer(ctx, reservationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayer", reflect.TypeOf((*MockReservationsRepository)(nil).GetPlayer), ctx, reservationID, userID)
+}
+
+// ListPlayers mocks base method.
+func (m *MockReservationsRepository) ListPlayers(ctx context.Context, reservationID string) ([]entities.ReservationPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlayers", ctx, reservationID)
+	ret0, _ := ret[0].([]entities.ReservationPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlayers indicates an expected call of ListPlayers.
+func (mr *MockReservationsRepositoryMockRecorder) ListPlayers(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayers", reflect.TypeOf((*MockReservationsRepository)(nil).ListPlayers), ctx, reservationID)
+}
+
+// ListUpcomingByUser mocks base method.
+func (m *MockReservationsRepository) ListUpcomingByUser(ctx context.Context, userID string, now time.Time) ([]entities.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpcomingByUser", ctx, userID, now)
+	ret0, _ := ret[0].([]entities.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUpcomingByUser indicates an expected call of ListUpcomingByUser.
+func (mr *MockReservationsRepositoryMockRecorder) ListUpcomingByUser(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpcomingByUser", reflect.TypeOf((*MockReservationsRepository)(nil).ListUpcomingByUser), ctx, userID, now)
+}
+
+// UpdatePlayerStatus mocks base method.
+func (m *MockReservationsRepository) UpdatePlayerStatus(ctx context.Context, reservationID, userID string, from, to entities.PlayerStatus, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlayerStatus", ctx, reservationID, userID, from, to, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlayerStatus indicates an expected call of UpdatePlayerStatus.
+func (mr *MockReservationsRepositoryMockRecorder) UpdatePlayerStatus(ctx, reservationID, userID, from, to, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlayerStatus", reflect.TypeOf((*MockReservationsRepository)(nil).UpdatePlayerStatus), ctx, reservationID, userID, from, to, now)
+}
+
+// MockCourtsRepository is a mock of CourtsRepository interface.
+type MockCourtsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourtsRepositoryMockRecorder
+}
+
+// MockCourtsRepositoryMockRecorder is the mock recorder for MockCourtsRepository.
+type MockCourtsRepositoryMockRecorder struct {
+	mock *MockCourtsRepository
+}
+
+// NewMockCourtsRepository creates a new mock instance.
+func NewMockCourtsRepository(ctrl *gomock.Controller) *MockCourtsRepository {
+	mock := &MockCourtsRepository{ctrl: ctrl}
+	mock.recorder = &MockCourtsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourtsRepository) EXPECT() *MockCourtsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCourtsRepository) GetByID(ctx context.Context, courtID string) (*entities.Court, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, courtID)
+	ret0, _ := ret[0].(*entities.Court)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourtsRepositoryMockRecorder) GetByID(ctx, courtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourtsRepository)(nil).GetByID), ctx, courtID)
+}
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByNickname mocks base method.
+func (m *MockUsersRepository) GetByNickname(ctx context.Context, nickname string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByNickname", ctx, nickname)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByNickname indicates an expected call of GetByNickname.
+func (mr *MockUsersRepositoryMockRecorder) GetByNickname(ctx, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNickname", reflect.TypeOf((*MockUsersRepository)(nil).GetByNickname), ctx, nickname)
+}
+
+// GetByPhoneNumber mocks base method.
+func (m *MockUsersRepository) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPhoneNumber indicates an expected call of GetByPhoneNumber.
+func (mr *MockUsersRepositoryMockRecorder) GetByPhoneNumber(ctx, phoneNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPhoneNumber", reflect.TypeOf((*MockUsersRepository)(nil).GetByPhoneNumber), ctx, phoneNumber)
+}
@@ -0,0 +1,272 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/ownership"
+	"github.com/lever-dev/padel-backend/pkg/clock"
+)
+
+type Service struct {
+	reservationsRepo ReservationsRepository
+	courtsRepo       CourtsRepository
+	usersRepo        UsersRepository
+	clock            clock.Clock
+}
+
+func NewService(
+	reservationsRepo ReservationsRepository,
+	courtsRepo CourtsRepository,
+	usersRepo UsersRepository,
+	clock clock.Clock,
+) *Service {
+	return &Service{
+		reservationsRepo: reservationsRepo,
+		courtsRepo:       courtsRepo,
+		usersRepo:        usersRepo,
+		clock:            clock,
+	}
+}
+
+// Invite adds the player to the roster of a reservation that did not end yet. Only the booker invites,
+// up to the number of players the court allows.
+func (s *Service) Invite(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+	invitee entities.CoPlayer,
+) (*entities.ReservationPlayer, error) {
+	court, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsv, err := s.getActiveReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsv.ReservedBy != userID {
+		return nil, fmt.Errorf("%w: reservation %s was booked by another user", entities.ErrForbidden, reservationID)
+	}
+
+	user, err := s.FindUser(ctx, invitee)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ID == rsv.ReservedBy {
+		return nil, fmt.Errorf("%w: the booker is already on the roster", entities.ErrInvalidInvitation)
+	}
+
+	player := entities.NewReservationPlayer(reservationID, user.ID, userID, s.clock.Now())
+
+	if err := s.reservationsRepo.AddPlayer(ctx, player, court.MaxPlayers); err != nil {
+		return nil, fmt.Errorf("add player: %w", err)
+	}
+
+	return player, nil
+}
+
+// FindUser looks up the player invited by nickname or by phone number.
+func (s *Service) FindUser(ctx context.Context, invitee entities.CoPlayer) (*entities.User, error) {
+	switch {
+	case invitee.Nickname != "" && invitee.PhoneNumber != "":
+		return nil, fmt.Errorf("%w: a player is invited by nickname or by phone number, not both",
+			entities.ErrInvalidInvitation)
+	case invitee.Nickname != "":
+		user, err := s.usersRepo.GetByNickname(ctx, invitee.Nickname)
+		if err != nil {
+			return nil, fmt.Errorf("get user by nickname %s: %w", invitee.Nickname, err)
+		}
+
+		return &user, nil
+	case invitee.PhoneNumber != "":
+		user, err := s.usersRepo.GetByPhoneNumber(ctx, invitee.PhoneNumber)
+		if err != nil {
+			return nil, fmt.Errorf("get user by phone number: %w", err)
+		}
+
+		return user, nil
+	default:
+		return nil, fmt.Errorf("%w: a player needs a nickname or a phone number", entities.ErrInvalidInvitation)
+	}
+}
+
+// Accept takes the spot the player was invited to. Only the invited player answers the invitation.
+func (s *Service) Accept(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error {
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return err
+	}
+
+	if _, err := s.getActiveReservation(ctx, courtID, reservationID); err != nil {
+		return err
+	}
+
+	return s.answer(ctx, reservationID, playerID, userID, entities.AcceptedPlayerStatus)
+}
+
+// Decline turns the invitation down and frees the spot. Only the invited player answers the invitation.
+func (s *Service) Decline(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error {
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return err
+	}
+
+	if _, err := s.getReservation(ctx, courtID, reservationID); err != nil {
+		return err
+	}
+
+	return s.answer(ctx, reservationID, playerID, userID, entities.DeclinedPlayerStatus)
+}
+
+func (s *Service) answer(ctx context.Context, reservationID, playerID, userID string, to entities.PlayerStatus) error {
+	if playerID != userID {
+		return fmt.Errorf("%w: invitation of user %s", entities.ErrForbidden, playerID)
+	}
+
+	player, err := s.reservationsRepo.GetPlayer(ctx, reservationID, playerID)
+	if err != nil {
+		return fmt.Errorf("get player: %w", err)
+	}
+
+	if player.Status != entities.InvitedPlayerStatus {
+		return fmt.Errorf("%w: player %s is %s", entities.ErrInvalidPlayerTransition, playerID, player.Status)
+	}
+
+	return s.updateStatus(ctx, player, to)
+}
+
+// Leave takes the player off the roster. Players leave on their own, the booker may remove any of them.
+// Players who joined an open match give their spot back to the match.
+func (s *Service) Leave(ctx context.Context, organizationID, courtID, reservationID, playerID, userID string) error {
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return err
+	}
+
+	rsv, err := s.getReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return err
+	}
+
+	if playerID != userID && rsv.ReservedBy != userID {
+		return fmt.Errorf("%w: user %s can not remove player %s", entities.ErrForbidden, userID, playerID)
+	}
+
+	player, err := s.reservationsRepo.GetPlayer(ctx, reservationID, playerID)
+	if err != nil {
+		return fmt.Errorf("get player: %w", err)
+	}
+
+	if !player.Status.CanTransitionTo(entities.LeftPlayerStatus) {
+		return fmt.Errorf("%w: player %s is %s", entities.ErrInvalidPlayerTransition, playerID, player.Status)
+	}
+
+	return s.updateStatus(ctx, player, entities.LeftPlayerStatus)
+}
+
+func (s *Service) updateStatus(
+	ctx context.Context,
+	player *entities.ReservationPlayer,
+	to entities.PlayerStatus,
+) error {
+	err := s.reservationsRepo.UpdatePlayerStatus(
+		ctx,
+		player.ReservationID,
+		player.UserID,
+		player.Status,
+		to,
+		s.clock.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("update player status: %w", err)
+	}
+
+	return nil
+}
+
+// ListRoster returns the roster of the reservation, the booker first. Only the booker and the invited
+// players may see it.
+func (s *Service) ListRoster(
+	ctx context.Context,
+	organizationID, courtID, reservationID string,
+	userID string,
+) ([]entities.ReservationPlayer, error) {
+	if _, err := ownership.Court(ctx, s.courtsRepo, organizationID, courtID); err != nil {
+		return nil, err
+	}
+
+	rsv, err := s.getReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.reservationsRepo.ListPlayers(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("list players: %w", err)
+	}
+
+	visible := rsv.ReservedBy == userID
+	for _, player := range players {
+		if player.UserID == userID {
+			visible = true
+		}
+	}
+
+	if !visible {
+		return nil, fmt.Errorf("%w: user %s does not play in reservation %s", entities.ErrForbidden, userID, reservationID)
+	}
+
+	booker := entities.ReservationPlayer{
+		ReservationID: reservationID,
+		UserID:        rsv.ReservedBy,
+		InvitedBy:     rsv.ReservedBy,
+		Status:        entities.AcceptedPlayerStatus,
+		CreatedAt:     rsv.CreatedAt,
+		UpdatedAt:     rsv.CreatedAt,
+	}
+
+	return append([]entities.ReservationPlayer{booker}, players...), nil
+}
+
+// UpcomingGames returns the reservations the user booked, was invited to or accepted which did not end yet.
+func (s *Service) UpcomingGames(ctx context.Context, userID string) ([]entities.Game, error) {
+	games, err := s.reservationsRepo.ListUpcomingByUser(ctx, userID, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("list upcoming games: %w", err)
+	}
+
+	return games, nil
+}
+
+func (s *Service) getReservation(ctx context.Context, courtID, reservationID string) (*entities.Reservation, error) {
+	rsv, err := s.reservationsRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("get reservation by id: %w", err)
+	}
+
+	if rsv.CourtID != courtID {
+		return nil, fmt.Errorf("%w: reservation %s is not on court %s", entities.ErrNotFound, reservationID, courtID)
+	}
+
+	return rsv, nil
+}
+
+// getActiveReservation returns the reservation on the court as long as players can still join it.
+func (s *Service) getActiveReservation(
+	ctx context.Context,
+	courtID, reservationID string,
+) (*entities.Reservation, error) {
+	rsv, err := s.getReservation(ctx, courtID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if !rsv.IsActiveAt(now) || !rsv.ReservedTo.After(now) {
+		return nil, fmt.Errorf("%w: reservation %s", entities.ErrReservationNotActive, reservationID)
+	}
+
+	return rsv, nil
+}
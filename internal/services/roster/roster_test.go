@@ -0,0 +1,297 @@
+package roster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lever-dev/padel-backend/internal/entities"
+	"github.com/lever-dev/padel-backend/internal/services/roster"
+	"github.com/lever-dev/padel-backend/internal/services/roster/mocks"
+	clockmocks "github.com/lever-dev/padel-backend/pkg/clock/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	reservationsRepo *mocks.MockReservationsRepository
+	courtsRepo       *mocks.MockCourtsRepository
+	usersRepo        *mocks.MockUsersRepository
+	service          *roster.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+var rosterNow = time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reservationsRepo = mocks.NewMockReservationsRepository(s.ctrl)
+	s.courtsRepo = mocks.NewMockCourtsRepository(s.ctrl)
+	s.usersRepo = mocks.NewMockUsersRepository(s.ctrl)
+
+	clock := clockmocks.NewMockClock(s.ctrl)
+	clock.EXPECT().Now().Return(rosterNow).AnyTimes()
+
+	s.service = roster.NewService(s.reservationsRepo, s.courtsRepo, s.usersRepo, clock)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func court() *entities.Court {
+	return &entities.Court{ID: "court-1", OrganizationID: "org-1", MaxPlayers: 4}
+}
+
+func reservation() *entities.Reservation {
+	return &entities.Reservation{
+		ID:           "res-1",
+		CourtID:      "court-1",
+		ReservedBy:   "user-1",
+		Status:       entities.ReservedReservationStatus,
+		ReservedFrom: rosterNow.Add(24 * time.Hour),
+		ReservedTo:   rosterNow.Add(25 * time.Hour),
+		CreatedAt:    rosterNow.Add(-time.Hour),
+	}
+}
+
+func invited(userID string) *entities.ReservationPlayer {
+	return &entities.ReservationPlayer{
+		ReservationID: "res-1",
+		UserID:        userID,
+		InvitedBy:     "user-1",
+		Status:        entities.InvitedPlayerStatus,
+	}
+}
+
+func (s *ServiceSuite) TestInvite() {
+	ctx := context.Background()
+
+	s.Run("invites the player up to the size of the court", func() {
+		c := court()
+		c.MaxPlayers = 2
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(c, nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.usersRepo.EXPECT().GetByNickname(ctx, "bob").Return(entities.User{ID: "user-2"}, nil)
+		s.reservationsRepo.EXPECT().AddPlayer(ctx, gomock.Any(), 2).Return(nil)
+
+		player, err := s.service.Invite(ctx, "org-1", "court-1", "res-1", "user-1", entities.CoPlayer{Nickname: "bob"})
+		s.Require().NoError(err)
+		s.Equal(&entities.ReservationPlayer{
+			ReservationID: "res-1",
+			UserID:        "user-2",
+			InvitedBy:     "user-1",
+			Status:        entities.InvitedPlayerStatus,
+			CreatedAt:     rosterNow,
+			UpdatedAt:     rosterNow,
+		}, player)
+	})
+
+	s.Run("only the booker invites", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+
+		_, err := s.service.Invite(ctx, "org-1", "court-1", "res-1", "user-2", entities.CoPlayer{Nickname: "carol"})
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+
+	s.Run("the booker can not invite themself", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.usersRepo.EXPECT().GetByPhoneNumber(ctx, "+34600000001").Return(&entities.User{ID: "user-1"}, nil)
+
+		_, err := s.service.Invite(
+			ctx, "org-1", "court-1", "res-1", "user-1", entities.CoPlayer{PhoneNumber: "+34600000001"},
+		)
+		s.ErrorIs(err, entities.ErrInvalidInvitation)
+	})
+
+	s.Run("a player needs a nickname or a phone number", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+
+		_, err := s.service.Invite(ctx, "org-1", "court-1", "res-1", "user-1", entities.CoPlayer{})
+		s.ErrorIs(err, entities.ErrInvalidInvitation)
+	})
+
+	s.Run("reservation that is over", func() {
+		rsv := reservation()
+		rsv.ReservedTo = rosterNow
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+
+		_, err := s.service.Invite(ctx, "org-1", "court-1", "res-1", "user-1", entities.CoPlayer{Nickname: "bob"})
+		s.ErrorIs(err, entities.ErrReservationNotActive)
+	})
+
+	s.Run("court of another organization", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+
+		_, err := s.service.Invite(ctx, "org-2", "court-1", "res-1", "user-1", entities.CoPlayer{Nickname: "bob"})
+		s.ErrorIs(err, entities.ErrNotFound)
+	})
+
+	s.Run("roster is full", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.usersRepo.EXPECT().GetByNickname(ctx, "bob").Return(entities.User{ID: "user-2"}, nil)
+		s.reservationsRepo.EXPECT().AddPlayer(ctx, gomock.Any(), 4).Return(entities.ErrRosterFull)
+
+		_, err := s.service.Invite(ctx, "org-1", "court-1", "res-1", "user-1", entities.CoPlayer{Nickname: "bob"})
+		s.ErrorIs(err, entities.ErrRosterFull)
+	})
+}
+
+func (s *ServiceSuite) TestAccept() {
+	ctx := context.Background()
+
+	s.Run("accepts the invitation", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.reservationsRepo.EXPECT().GetPlayer(ctx, "res-1", "user-2").Return(invited("user-2"), nil)
+		s.reservationsRepo.EXPECT().UpdatePlayerStatus(
+			ctx, "res-1", "user-2", entities.InvitedPlayerStatus, entities.AcceptedPlayerStatus, rosterNow,
+		).Return(nil)
+
+		s.NoError(s.service.Accept(ctx, "org-1", "court-1", "res-1", "user-2", "user-2"))
+	})
+
+	s.Run("only the invited player answers", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+
+		err := s.service.Accept(ctx, "org-1", "court-1", "res-1", "user-2", "user-1")
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+
+	s.Run("invitation was already answered", func() {
+		player := invited("user-2")
+		player.Status = entities.DeclinedPlayerStatus
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.reservationsRepo.EXPECT().GetPlayer(ctx, "res-1", "user-2").Return(player, nil)
+
+		err := s.service.Accept(ctx, "org-1", "court-1", "res-1", "user-2", "user-2")
+		s.ErrorIs(err, entities.ErrInvalidPlayerTransition)
+	})
+
+	s.Run("cancelled reservation", func() {
+		rsv := reservation()
+		rsv.Status = entities.CancelledReservationStatus
+
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(rsv, nil)
+
+		err := s.service.Accept(ctx, "org-1", "court-1", "res-1", "user-2", "user-2")
+		s.ErrorIs(err, entities.ErrReservationNotActive)
+	})
+}
+
+func (s *ServiceSuite) TestDecline() {
+	ctx := context.Background()
+
+	s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+	s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+	s.reservationsRepo.EXPECT().GetPlayer(ctx, "res-1", "user-2").Return(invited("user-2"), nil)
+	s.reservationsRepo.EXPECT().UpdatePlayerStatus(
+		ctx, "res-1", "user-2", entities.InvitedPlayerStatus, entities.DeclinedPlayerStatus, rosterNow,
+	).Return(nil)
+
+	s.NoError(s.service.Decline(ctx, "org-1", "court-1", "res-1", "user-2", "user-2"))
+}
+
+func (s *ServiceSuite) TestLeave() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		userID  string
+		status  entities.PlayerStatus
+		wantErr error
+	}{
+		{
+			name:   "player leaves",
+			userID: "user-2",
+			status: entities.AcceptedPlayerStatus,
+		},
+		{
+			name:   "booker removes a player",
+			userID: "user-1",
+			status: entities.InvitedPlayerStatus,
+		},
+		{
+			name:    "another player can not remove them",
+			userID:  "user-3",
+			wantErr: entities.ErrForbidden,
+		},
+		{
+			name:    "player already left",
+			userID:  "user-2",
+			status:  entities.LeftPlayerStatus,
+			wantErr: entities.ErrInvalidPlayerTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+			s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+
+			if tt.status != "" {
+				player := invited("user-2")
+				player.Status = tt.status
+				s.reservationsRepo.EXPECT().GetPlayer(ctx, "res-1", "user-2").Return(player, nil)
+			}
+
+			if tt.wantErr == nil {
+				s.reservationsRepo.EXPECT().UpdatePlayerStatus(
+					ctx, "res-1", "user-2", tt.status, entities.LeftPlayerStatus, rosterNow,
+				).Return(nil)
+			}
+
+			err := s.service.Leave(ctx, "org-1", "court-1", "res-1", "user-2", tt.userID)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestListRoster() {
+	ctx := context.Background()
+
+	s.Run("booker comes first", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").
+			Return([]entities.ReservationPlayer{*invited("user-2")}, nil)
+
+		players, err := s.service.ListRoster(ctx, "org-1", "court-1", "res-1", "user-2")
+		s.Require().NoError(err)
+		s.Require().Len(players, 2)
+		s.Equal("user-1", players[0].UserID)
+		s.Equal(entities.AcceptedPlayerStatus, players[0].Status)
+		s.Equal("user-2", players[1].UserID)
+	})
+
+	s.Run("hidden from other users", func() {
+		s.courtsRepo.EXPECT().GetByID(ctx, "court-1").Return(court(), nil)
+		s.reservationsRepo.EXPECT().GetByID(ctx, "res-1").Return(reservation(), nil)
+		s.reservationsRepo.EXPECT().ListPlayers(ctx, "res-1").
+			Return([]entities.ReservationPlayer{*invited("user-2")}, nil)
+
+		_, err := s.service.ListRoster(ctx, "org-1", "court-1", "res-1", "user-3")
+		s.ErrorIs(err, entities.ErrForbidden)
+	})
+}
@@ -0,0 +1,48 @@
+// Package sms holds stand-in SMS senders for development and tests. Production deployments plug
+// a provider in through the auth.SMSSender interface.
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// LogSender writes messages to the application log instead of sending them.
+type LogSender struct{}
+
+func (LogSender) Send(_ context.Context, phoneNumber, message string) error {
+	log.Info().Str("phone number", phoneNumber).Str("message", message).Msg("sms")
+	return nil
+}
+
+// FileSender appends messages to a file, one line per message, so that e2e tests can read codes back.
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(_ context.Context, phoneNumber, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open sms file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintf(f, "%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339), phoneNumber, message); err != nil {
+		return fmt.Errorf("write sms file: %w", err)
+	}
+
+	return nil
+}
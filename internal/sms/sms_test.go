@@ -0,0 +1,29 @@
+package sms_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/lever-dev/padel-backend/internal/sms"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.log")
+	sender := sms.NewFileSender(path)
+
+	require.NoError(t, sender.Send(context.Background(), "+77010000001", "code 123456"))
+	require.NoError(t, sender.Send(context.Background(), "+77010000002", "code 654321"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasSuffix(lines[0], "\t+77010000001\tcode 123456"))
+	require.True(t, strings.HasSuffix(lines[1], "\t+77010000002\tcode 654321"))
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source=clock.go -destination=./mocks/mocks.go -package=mocks
+
+package clock
+
+import "time"
+
+// Clock tells the current time. Services accept a clock so tests can pin "now".
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is a clock backed by the system time.
+type Real struct{}
+
+func (Real) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,28 @@
+package httputil
+
+import "encoding/json"
+
+// Optional is a request field that tells a field left out of the body from one sent as null. Set is true when
+// the field was sent, Value is nil when it was sent as null.
+type Optional[T any] struct {
+	Set   bool
+	Value *T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+
+	if string(data) == "null" {
+		o.Value = nil
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	o.Value = &value
+
+	return nil
+}
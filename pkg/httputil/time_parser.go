@@ -5,7 +5,14 @@ import (
 	"time"
 )
 
+// ParseTime parses a timestamp, times without an offset are read as UTC.
 func ParseTime(s string) (time.Time, error) {
+	return ParseTimeIn(s, time.UTC)
+}
+
+// ParseTimeIn parses a timestamp, times without an offset are read as wall-clock time in loc. The result is
+// in UTC.
+func ParseTimeIn(s string, loc *time.Location) (time.Time, error) {
 	layouts := []string{
 		"2006-01-02T15:04",    // 2025-11-04T18:30
 		time.RFC3339,          // 2025-11-04T19:45:00Z
@@ -14,7 +21,7 @@ func ParseTime(s string) (time.Time, error) {
 
 	var errs []error
 	for _, layout := range layouts {
-		if t, err := time.Parse(layout, s); err == nil {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
 			return t.UTC(), nil
 		} else {
 			errs = append(errs, err)
@@ -23,3 +30,14 @@ func ParseTime(s string) (time.Time, error) {
 
 	return time.Time{}, errors.Join(errs...)
 }
+
+// ParseDate parses a calendar date in YYYY-MM-DD format as midnight UTC.
+func ParseDate(s string) (time.Time, error) {
+	return ParseDateIn(s, time.UTC)
+}
+
+// ParseDateIn parses a calendar date in YYYY-MM-DD format as midnight in loc. Unlike ParseTimeIn the result
+// stays in loc, so calendar arithmetic on it follows the days of loc.
+func ParseDateIn(s string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation(time.DateOnly, s, loc)
+}